@@ -116,7 +116,8 @@ func NewWithScheme(mgrScheme *runtime.Scheme) (*App, error) {
 
 	defaultPluginsHub := pluginshub.NewDefaultPluginsHub(mgr.GetClient(), configs.SearchForLegacyPodGroups,
 		configs.KnativeGangSchedule, configs.SchedulingQueueLabelKey, configs.NodePoolLabelKey,
-		configs.DefaultPrioritiesConfigMapName, configs.DefaultPrioritiesConfigMapNamespace)
+		configs.DefaultPrioritiesConfigMapName, configs.DefaultPrioritiesConfigMapNamespace,
+		configs.NamespaceDefaultQueues)
 
 	app := &App{
 		Mgr:               mgr,
@@ -27,6 +27,7 @@ type Options struct {
 	NamespaceLabelSelectorStr           string
 	DefaultPrioritiesConfigMapName      string
 	DefaultPrioritiesConfigMapNamespace string
+	NamespaceDefaultQueuesStr           string
 }
 
 func (o *Options) AddFlags(fs *flag.FlagSet) {
@@ -43,6 +44,7 @@ func (o *Options) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.SchedulingQueueLabelKey, "queue-label-key", constants.DefaultQueueLabel, "Scheduling queue label key name")
 	fs.StringVar(&o.DefaultPrioritiesConfigMapName, "default-priorities-configmap-name", "", "The name of the configmap that contains default priorities for pod groups")
 	fs.StringVar(&o.DefaultPrioritiesConfigMapNamespace, "default-priorities-configmap-namespace", "", "The namespace of the configmap that contains default priorities for pod groups")
+	fs.StringVar(&o.NamespaceDefaultQueuesStr, "namespace-default-queues", "", "The default queues of namespaces in namespace=queue comma-separated format, for pod groups without an explicit queue. Pod groups of other namespaces are put in default-queue")
 	flag.StringVar(&o.PodLabelSelectorStr, "pod-label-selector", "", "Pod label selector in key=value comma-separated format")
 	flag.StringVar(&o.NamespaceLabelSelectorStr, "namespace-label-selector", "", "Namespace label selector in key=value comma-separated format")
 }
@@ -59,6 +61,7 @@ func (o *Options) Configs() controllers.Configs {
 		NamespaceLabelSelector:              parseLabelSelector(o.NamespaceLabelSelectorStr),
 		DefaultPrioritiesConfigMapName:      o.DefaultPrioritiesConfigMapName,
 		DefaultPrioritiesConfigMapNamespace: o.DefaultPrioritiesConfigMapNamespace,
+		NamespaceDefaultQueues:              parseLabelSelector(o.NamespaceDefaultQueuesStr),
 	}
 }
 
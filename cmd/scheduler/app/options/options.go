@@ -20,26 +20,36 @@ limitations under the License.
 package options
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/pflag"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
 
 const (
-	defaultSchedulerPeriod             = time.Second
-	defaultStalenessGracePeriod        = 60 * time.Second
-	defaultListenAddress               = ":8080"
-	defaultProfilerApiPort             = "8182"
-	defaultVerbosityLevel              = 3
-	defaultMaxConsolidationPreemptees  = 16
-	defaultDetailedFitError            = false
-	DefaultPyroscopeMutexProfilerRate  = 5
-	DefaultPyroscopeBlockProfilerRate  = 5
-	defaultNumOfStatusRecordingWorkers = 5
+	defaultSchedulerPeriod              = time.Second
+	defaultStalenessGracePeriod         = 60 * time.Second
+	defaultCheckpointEvictionTimeout    = 30 * time.Second
+	defaultTopNodesScoreEpsilon         = 1.0
+	defaultNodeConsolidationThreshold   = 0.25
+	defaultNodeScoringSampleSize        = 100
+	defaultListenAddress                = ":8080"
+	defaultProfilerApiPort              = "8182"
+	defaultVerbosityLevel               = 3
+	defaultMaxConsolidationPreemptees   = 16
+	defaultDetailedFitError             = false
+	DefaultPyroscopeMutexProfilerRate   = 5
+	DefaultPyroscopeBlockProfilerRate   = 5
+	defaultNumOfStatusRecordingWorkers  = 5
+	defaultMaxBindFallbackAttempts      = 2
+	defaultGpuSharingNodePressurePolicy = string(conf.GpuSharingNodePressureNone)
+	defaultGpuGroupLossPolicy           = string(conf.GpuGroupLossNone)
+	defaultVictimOrder                  = string(conf.VictimOrderDefault)
 )
 
 // ServerOption is the main context object for the controller manager.
@@ -54,6 +64,10 @@ type ServerOption struct {
 	RestrictSchedulingNodes           bool
 	NodePoolLabelKey                  string
 	NodePoolLabelValue                string
+	CrossPartitionReclaimNodePools    []string
+	GpuMemoryQuantum                  string
+	MaxGpuSharingTenants              int
+	ReservedGpuMemory                 int64
 	ListenAddress                     string
 	EnableProfiler                    bool
 	ProfilerApiPort                   string
@@ -69,7 +83,27 @@ type ServerOption struct {
 	FullHierarchyFairness             bool
 	AllowConsolidatingReclaim         bool
 	NumOfStatusRecordingWorkers       int
+	NodeScoringWorkers                int
 	GlobalDefaultStalenessGracePeriod time.Duration
+	CheckpointEvictionTimeout         time.Duration
+	RandomizeTopNodes                 bool
+	TopNodesScoreEpsilon              float64
+	AllowNodeConsolidation            bool
+	NodeConsolidationThreshold        float64
+	MaxSnapshotStaleness              time.Duration
+	MaxBindFallbackAttempts           int
+	GangCompletionPreemption          bool
+	NodeMismatchEvictionGracePeriod   time.Duration
+	NodeScoringBudget                 time.Duration
+	NodeScoringSampleSize             int
+	MaxFitErrorsPerTask               int
+	IncrementalNodeRescoring          bool
+	GpuSharingNodePressurePolicy      string
+	GpuGroupLossPolicy                string
+	VictimOrder                       string
+	CheckpointAwareVictimOrder        bool
+	StalePipelineCycles               int
+	DeferWholeGpuFragmentation        bool
 	PluginServerPort                  int
 	CPUWorkerNodeLabelKey             string
 	GPUWorkerNodeLabelKey             string
@@ -96,6 +130,10 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.RestrictSchedulingNodes, "restrict-node-scheduling", false, "kai-scheduler will allocate jobs only to restricted nodes")
 	fs.StringVar(&s.NodePoolLabelKey, "nodepool-label-key", constants.DefaultNodePoolLabelKey, "The label key by which to filter scheduling nodepool")
 	fs.StringVar(&s.NodePoolLabelValue, "partition-label-value", "", "The label value by which to filter scheduling partition")
+	fs.StringSliceVar(&s.CrossPartitionReclaimNodePools, "cross-partition-reclaim-node-pools", nil, "Node pools whose jobs may be reclaimed by jobs of this partition, when the partition-label-value is set. Cross partition reclaim is disabled when empty")
+	fs.StringVar(&s.GpuMemoryQuantum, "gpu-memory-quantum", "", "The quantum that the GPU memory requests of pods are rounded up to on the nodes of the partition, either as memory, e.g. 1Gi, or as a fraction of the GPU, e.g. 1/7. Requests are not rounded when empty")
+	fs.IntVar(&s.MaxGpuSharingTenants, "max-gpu-sharing-tenants", 0, "The maximum number of fractional pods that share a GPU on the nodes of the partition. The tenants of a GPU are not limited when 0")
+	fs.Int64Var(&s.ReservedGpuMemory, "reserved-gpu-memory", 0, "The GPU memory, in MiB, kept free on every GPU of the nodes of the partition for system daemons. Nodes may override it with the kai.scheduler/reserved-gpu-memory annotation. No memory is reserved when 0")
 	fs.StringVar(&s.SchedulerConf, "scheduler-conf", "", "The absolute path of scheduler configuration file")
 	fs.DurationVar(&s.SchedulePeriod, "schedule-period", defaultSchedulerPeriod, "The period between each scheduling cycle")
 	fs.BoolVar(&s.EnableLeaderElection, "leader-elect", false,
@@ -119,6 +157,26 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.FullHierarchyFairness, "full-hierarchy-fairness", true, "Fairness across project and department levels")
 	fs.BoolVar(&s.AllowConsolidatingReclaim, "allow-consolidating-reclaim", true, "Do not count pipelined pods towards 'reclaimed' resources")
 	fs.IntVar(&s.NumOfStatusRecordingWorkers, "num-of-status-recording-workers", defaultNumOfStatusRecordingWorkers, "specifies the max number of go routines spawned to update pod and podgroups conditions and events. Defaults to 5")
+	fs.IntVar(&s.NodeScoringWorkers, "node-scoring-workers", 0, "specifies the max number of go routines used to score nodes for a task. Defaults to GOMAXPROCS")
+	fs.DurationVar(&s.NodeScoringBudget, "node-scoring-budget", 0, "The time budget for scoring the nodes of a task. Once it is exceeded, and at least node-scoring-sample-size nodes were scored, the remaining nodes are not scored and are tried after the scored ones. Nodes are scored in a random order. Disabled when 0")
+	fs.IntVar(&s.NodeScoringSampleSize, "node-scoring-sample-size", defaultNodeScoringSampleSize, "The minimal number of nodes scored for a task before the node-scoring-budget applies. Defaults to 100")
+	fs.IntVar(&s.MaxFitErrorsPerTask, "max-fit-errors-per-task", 0, "The maximal number of per-node fit errors retained for a pending task, to bound their memory on large clusters. The reasons of the other nodes are only counted. Unlimited when 0")
+	fs.StringVar(&s.GpuSharingNodePressurePolicy, "gpu-sharing-node-pressure-policy", defaultGpuSharingNodePressurePolicy, "Which GPUs of a node with the MemoryPressure or DiskPressure condition are kept from new fractional pods: SharedGpus keeps them off the GPUs that are already shared, AllGpus off all the GPUs of the node, and None ignores node pressure. Defaults to None")
+	fs.StringVar(&s.GpuGroupLossPolicy, "gpu-group-loss-policy", defaultGpuGroupLossPolicy, "How running fractional pods whose GPU groups were lost, because the reservation pod of the group is gone or its GPU was removed or became unhealthy, are remediated: Evict evicts them so they are rescheduled, Mark annotates them with kai.scheduler/lost-gpu-groups and records an event on them, and None leaves them as they are. Defaults to None")
+	fs.StringVar(&s.VictimOrder, "victim-order", defaultVictimOrder, "Which jobs of a queue are taken first as victims of preemption and reclaim among jobs of the same priority: NewestSubmittedFirst or NewestStartedFirst take the most recently submitted or started jobs first to protect long running work, OldestSubmittedFirst or OldestStartedFirst take the earliest submitted or started jobs first, and Default takes them in the reverse of their allocation order. Defaults to Default")
+	fs.BoolVar(&s.CheckpointAwareVictimOrder, "checkpoint-aware-victim-order", false, "Among jobs of the same priority in a queue, take the jobs whose pods are furthest from their next checkpoint, by the kai.scheduler/next-checkpoint-eta pod annotation, first as victims of preemption and reclaim, before applying victim-order")
+	fs.IntVar(&s.StalePipelineCycles, "stale-pipeline-cycles", 0, "Release the pipeline of a pod that was pipelined to the same node for this many consecutive scheduling cycles without being allocated, e.g. since its victims do not terminate, and re-evaluate its placement. Disabled when 0")
+	fs.BoolVar(&s.DeferWholeGpuFragmentation, "defer-whole-gpu-fragmentation", false, "Allocate fractional pods on nodes whose shared GPUs can host them before nodes that would have to share a whole GPU, to keep whole GPUs free for jobs that need them")
+	fs.BoolVar(&s.IncrementalNodeRescoring, "incremental-node-rescoring", false, "Reuse the node scores of a task for the next tasks of its pod group with the same resource requests, scoring again only the nodes that the earlier placements changed")
+	fs.DurationVar(&s.CheckpointEvictionTimeout, "checkpoint-eviction-timeout", defaultCheckpointEvictionTimeout, "How long to wait for a pod with the graceful-checkpoint annotation to terminate by itself before evicting it. Defaults to 30s")
+	fs.BoolVar(&s.RandomizeTopNodes, "randomize-top-nodes", false, "Select randomly, weighted by score, among the nodes whose score is within top-nodes-score-epsilon of the best node, instead of always selecting the best node")
+	fs.Float64Var(&s.TopNodesScoreEpsilon, "top-nodes-score-epsilon", defaultTopNodesScoreEpsilon, "The score distance from the best node within which nodes are selected randomly when randomize-top-nodes is set. Defaults to 1")
+	fs.BoolVar(&s.AllowNodeConsolidation, "allow-node-consolidation", false, "Allow the nodeconsolidation action to move the pods of lightly loaded nodes to other nodes, and mark the emptied nodes as scale-down candidates")
+	fs.Float64Var(&s.NodeConsolidationThreshold, "node-consolidation-threshold", defaultNodeConsolidationThreshold, "The fraction of a node's allocatable GPUs, or CPU for CPU-only nodes, below which the node is considered lightly loaded by the nodeconsolidation action. Defaults to 0.25")
+	fs.DurationVar(&s.MaxSnapshotStaleness, "max-snapshot-staleness", 0, "Skip scheduling cycles while the cache informers have not resynced from the API server for longer than this duration, e.g. due to a broken watch. Disabled when 0")
+	fs.IntVar(&s.MaxBindFallbackAttempts, "max-bind-fallback-attempts", defaultMaxBindFallbackAttempts, "The maximum number of alternative nodes to bind a pod to within the same scheduling cycle, when binding it fails because its node was deleted, cordoned or became not ready since the snapshot. Disabled when 0. Defaults to 2")
+	fs.BoolVar(&s.GangCompletionPreemption, "gang-completion-preemption", false, "Allow the preempt action to evict jobs of the same priority and queue that are partially placed gangs, to complete a partially placed gang that is closer to completion, so gangs holding part of their resources do not deadlock")
+	fs.DurationVar(&s.NodeMismatchEvictionGracePeriod, "node-mismatch-eviction-grace-period", 0, "Evict running pods whose node has a NoSchedule taint they do not tolerate, or no longer matches their node selector or required node affinity, for longer than this duration, so they are rescheduled. Disabled when 0")
 	fs.DurationVar(&s.GlobalDefaultStalenessGracePeriod, "default-staleness-grace-period", defaultStalenessGracePeriod, "Global default staleness grace period duration. Negative values means infinite. Defaults to 60s")
 	fs.IntVar(&s.PluginServerPort, "plugin-server-port", 8081, "The port to bind for plugin server requests")
 	fs.StringVar(&s.CPUWorkerNodeLabelKey, "cpu-worker-node-label-key", constants.DefaultCPUWorkerNodeLabelKey, "The label key for CPU worker nodes")
@@ -133,5 +191,54 @@ func (so *ServerOption) ValidateOptions() error {
 	pflag.VisitAll(func(flag *pflag.Flag) {
 		log.InfraLogger.V(1).Infof("FLAG: --%s=%q", flag.Name, flag.Value)
 	})
+	if so.TopNodesScoreEpsilon < 0 {
+		return fmt.Errorf("top-nodes-score-epsilon must not be negative, got %v", so.TopNodesScoreEpsilon)
+	}
+	if so.NodeConsolidationThreshold < 0 || so.NodeConsolidationThreshold > 1 {
+		return fmt.Errorf("node-consolidation-threshold must be between 0 and 1, got %v", so.NodeConsolidationThreshold)
+	}
+	if so.MaxSnapshotStaleness < 0 {
+		return fmt.Errorf("max-snapshot-staleness must not be negative, got %v", so.MaxSnapshotStaleness)
+	}
+	if len(so.CrossPartitionReclaimNodePools) > 0 && so.NodePoolLabelValue == "" {
+		return fmt.Errorf("cross-partition-reclaim-node-pools requires partition-label-value to be set")
+	}
+	if _, err := conf.ParseGpuMemoryQuantum(so.GpuMemoryQuantum); err != nil {
+		return fmt.Errorf("gpu-memory-quantum: %w", err)
+	}
+	if _, err := conf.ParseGpuSharingNodePressurePolicy(so.GpuSharingNodePressurePolicy); err != nil {
+		return fmt.Errorf("gpu-sharing-node-pressure-policy: %w", err)
+	}
+	if _, err := conf.ParseGpuGroupLossPolicy(so.GpuGroupLossPolicy); err != nil {
+		return fmt.Errorf("gpu-group-loss-policy: %w", err)
+	}
+	if _, err := conf.ParseVictimOrder(so.VictimOrder); err != nil {
+		return fmt.Errorf("victim-order: %w", err)
+	}
+	if so.MaxGpuSharingTenants < 0 {
+		return fmt.Errorf("max-gpu-sharing-tenants must not be negative, got %v", so.MaxGpuSharingTenants)
+	}
+	if so.ReservedGpuMemory < 0 {
+		return fmt.Errorf("reserved-gpu-memory must not be negative, got %v", so.ReservedGpuMemory)
+	}
+	if so.StalePipelineCycles < 0 {
+		return fmt.Errorf("stale-pipeline-cycles must not be negative, got %v", so.StalePipelineCycles)
+	}
+	if so.NodeMismatchEvictionGracePeriod < 0 {
+		return fmt.Errorf("node-mismatch-eviction-grace-period must not be negative, got %v",
+			so.NodeMismatchEvictionGracePeriod)
+	}
+	if so.NodeScoringBudget < 0 {
+		return fmt.Errorf("node-scoring-budget must not be negative, got %v", so.NodeScoringBudget)
+	}
+	if so.NodeScoringSampleSize < 0 {
+		return fmt.Errorf("node-scoring-sample-size must not be negative, got %v", so.NodeScoringSampleSize)
+	}
+	if so.MaxFitErrorsPerTask < 0 {
+		return fmt.Errorf("max-fit-errors-per-task must not be negative, got %v", so.MaxFitErrorsPerTask)
+	}
+	if so.MaxBindFallbackAttempts < 0 {
+		return fmt.Errorf("max-bind-fallback-attempts must not be negative, got %v", so.MaxBindFallbackAttempts)
+	}
 	return nil
 }
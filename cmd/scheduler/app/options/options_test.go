@@ -65,7 +65,15 @@ func TestAddFlags(t *testing.T) {
 		PyroscopeBlockProfilerRate:        DefaultPyroscopeBlockProfilerRate,
 		PyroscopeMutexProfilerRate:        DefaultPyroscopeMutexProfilerRate,
 		GlobalDefaultStalenessGracePeriod: defaultStalenessGracePeriod,
+		CheckpointEvictionTimeout:         defaultCheckpointEvictionTimeout,
+		TopNodesScoreEpsilon:              defaultTopNodesScoreEpsilon,
+		NodeConsolidationThreshold:        defaultNodeConsolidationThreshold,
+		NodeScoringSampleSize:             defaultNodeScoringSampleSize,
+		GpuSharingNodePressurePolicy:      defaultGpuSharingNodePressurePolicy,
+		GpuGroupLossPolicy:                defaultGpuGroupLossPolicy,
+		VictimOrder:                       defaultVictimOrder,
 		NumOfStatusRecordingWorkers:       defaultNumOfStatusRecordingWorkers,
+		MaxBindFallbackAttempts:           defaultMaxBindFallbackAttempts,
 		NodePoolLabelKey:                  constants.DefaultNodePoolLabelKey,
 		PluginServerPort:                  8081,
 		CPUWorkerNodeLabelKey:             constants.DefaultCPUWorkerNodeLabelKey,
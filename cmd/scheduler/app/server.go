@@ -70,9 +70,16 @@ func flushLogs() {
 }
 
 func BuildSchedulerParams(opt *options.ServerOption) *conf.SchedulerParams {
+	// The quantum is validated with the other options
+	gpuMemoryQuantum, _ := conf.ParseGpuMemoryQuantum(opt.GpuMemoryQuantum)
 	schedulingPartitionParams := &conf.SchedulingNodePoolParams{
 		NodePoolLabelKey:   opt.NodePoolLabelKey,
 		NodePoolLabelValue: opt.NodePoolLabelValue,
+
+		CrossPartitionReclaimNodePools: opt.CrossPartitionReclaimNodePools,
+		GpuMemoryQuantum:               gpuMemoryQuantum,
+		MaxGpuSharingTenants:           opt.MaxGpuSharingTenants,
+		ReservedGpuMemory:              opt.ReservedGpuMemory,
 	}
 
 	return &conf.SchedulerParams{
@@ -89,6 +96,26 @@ func BuildSchedulerParams(opt *options.ServerOption) *conf.SchedulerParams {
 		SchedulePeriod:                    opt.SchedulePeriod,
 		DetailedFitErrors:                 opt.DetailedFitErrors,
 		UpdatePodEvictionCondition:        opt.UpdatePodEvictionCondition,
+		NodeScoringWorkers:                opt.NodeScoringWorkers,
+		CheckpointEvictionTimeout:         opt.CheckpointEvictionTimeout,
+		RandomizeTopNodes:                 opt.RandomizeTopNodes,
+		TopNodesScoreEpsilon:              opt.TopNodesScoreEpsilon,
+		AllowNodeConsolidation:            opt.AllowNodeConsolidation,
+		NodeConsolidationThreshold:        opt.NodeConsolidationThreshold,
+		MaxSnapshotStaleness:              opt.MaxSnapshotStaleness,
+		MaxBindFallbackAttempts:           opt.MaxBindFallbackAttempts,
+		GangCompletionPreemption:          opt.GangCompletionPreemption,
+		NodeMismatchEvictionGracePeriod:   opt.NodeMismatchEvictionGracePeriod,
+		NodeScoringBudget:                 opt.NodeScoringBudget,
+		NodeScoringSampleSize:             opt.NodeScoringSampleSize,
+		MaxFitErrorsPerTask:               opt.MaxFitErrorsPerTask,
+		IncrementalNodeRescoring:          opt.IncrementalNodeRescoring,
+		GpuSharingNodePressurePolicy:      conf.GpuSharingNodePressurePolicy(opt.GpuSharingNodePressurePolicy),
+		DeferWholeGpuFragmentation:        opt.DeferWholeGpuFragmentation,
+		GpuGroupLossPolicy:                conf.GpuGroupLossPolicy(opt.GpuGroupLossPolicy),
+		VictimOrder:                       conf.VictimOrder(opt.VictimOrder),
+		CheckpointAwareVictimOrder:        opt.CheckpointAwareVictimOrder,
+		StalePipelineCycles:               opt.StalePipelineCycles,
 	}
 }
 
@@ -90,11 +90,12 @@ func main() {
 	defer framework.CloseSession(ssn)
 
 	actions, _ := conf_util.GetActionsFromConfig(snapshot.Config)
-	for _, action := range actions {
+	for _, dispatch := range ssn.DispatchActions(actions) {
+		action := dispatch.Action
 		log.InfraLogger.SetAction(string(action.Name()))
 		metrics.SetCurrentAction(string(action.Name()))
 		actionStartTime := time.Now()
-		action.Execute(ssn)
+		dispatch.Execute(ssn)
 		metrics.UpdateActionDuration(string(action.Name()), metrics.Duration(actionStartTime))
 	}
 }
@@ -48,6 +48,11 @@ type Args struct {
 	// DefaultPrioritiesConfigMapNamespace The namespace of the configmap that contains default priorities for pod groups
 	// +kubebuilder:validation:Optional
 	DefaultPrioritiesConfigMapNamespace *string `json:"defaultPrioritiesConfigMapNamespace,omitempty"`
+
+	// NamespaceDefaultQueues maps namespaces to the queue of their pod groups without an explicit queue. Pod groups of
+	// other namespaces are put in default-queue
+	// +kubebuilder:validation:Optional
+	NamespaceDefaultQueues map[string]string `json:"namespaceDefaultQueues,omitempty"`
 }
 
 func (pg *PodGrouper) SetDefaultsWhereNeeded(replicaCount *int32) {
@@ -31,6 +31,13 @@ func (in *Args) DeepCopyInto(out *Args) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NamespaceDefaultQueues != nil {
+		in, out := &in.NamespaceDefaultQueues, &out.NamespaceDefaultQueues
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Args.
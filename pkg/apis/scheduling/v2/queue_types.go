@@ -37,6 +37,11 @@ type QueueSpec struct {
 	// +optional
 	Priority *int `json:"priority,omitempty"`
 
+	// PriorityClass of the queue. Queues with a higher priority class are always ordered before queues with a lower
+	// one, for both allocation and reclaim, regardless of their fair share. When not set, default is 0.
+	// +optional
+	PriorityClass *int `json:"priorityClass,omitempty"`
+
 	// Minimum runtime of a job in queue before it can be preempted.
 	// +optional
 	PreemptMinRuntime *metav1.Duration `json:"preemptMinRuntime,omitempty"`
@@ -44,8 +49,76 @@ type QueueSpec struct {
 	// Minimum runtime of a job in queue before it can be reclaimed.
 	// +optional
 	ReclaimMinRuntime *metav1.Duration `json:"reclaimMinRuntime,omitempty"`
+
+	// Time after binding during which a task of a job in queue cannot be a victim of preemption or reclaim.
+	// +optional
+	PreemptionGracePeriod *metav1.Duration `json:"preemptionGracePeriod,omitempty"`
+
+	// PreemptionPolicy defines which jobs of other queues the jobs of the queue may preempt or reclaim, and which queues
+	// may preempt or reclaim the jobs of the queue. When not set, the policy of the parent queue is used, and Any if no
+	// queue in the hierarchy sets it.
+	// +optional
+	PreemptionPolicy QueuePreemptionPolicy `json:"preemptionPolicy,omitempty"`
+
+	// OverQuotaPolicy defines how the jobs of the queue use resources beyond the deserved quota of the queue. When not
+	// set, the policy of the parent queue is used, and Borrow if no queue in the hierarchy sets it.
+	// +optional
+	OverQuotaPolicy QueueOverQuotaPolicy `json:"overQuotaPolicy,omitempty"`
+
+	// OverQuotaDeadline is the time after a job of a queue with the Elastic over-quota policy starts during which the
+	// job cannot be reclaimed. When not set, the jobs of an Elastic queue are never reclaimed.
+	// +optional
+	OverQuotaDeadline *metav1.Duration `json:"overQuotaDeadline,omitempty"`
+
+	// MaxRunningJobs caps the number of running jobs of the queue and its child queues, regardless of their resources.
+	// A queue at its cap admits no new jobs. When not set, the number of running jobs is not limited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRunningJobs *int `json:"maxRunningJobs,omitempty"`
+
+	// GuaranteeWeight derives the deserved quota of the queue from the deserved quota of its parent queue, or from the
+	// cluster capacity for top queues. The parent quota left after the explicit quotas of its child queues is divided
+	// among the child queues with a guarantee weight, in proportion to their weights. A resource with an explicit
+	// quota keeps it. When not set, the quotas of the queue are taken from its resources only.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	GuaranteeWeight *int `json:"guaranteeWeight,omitempty"`
+
+	// NodeSelector restricts the jobs of the queue and its child queues to nodes with matching labels. When not set,
+	// the jobs can run on any node allowed by the parent queues.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
+// QueueOverQuotaPolicy defines how the jobs of a queue use resources beyond the deserved quota of the queue.
+// +kubebuilder:validation:Enum=Borrow;Strict;Elastic
+type QueueOverQuotaPolicy string
+
+const (
+	// OverQuotaPolicyBorrow allows using idle resources beyond the deserved quota, which other queues may reclaim.
+	OverQuotaPolicyBorrow QueueOverQuotaPolicy = "Borrow"
+	// OverQuotaPolicyStrict forbids using resources beyond the deserved quota.
+	OverQuotaPolicyStrict QueueOverQuotaPolicy = "Strict"
+	// OverQuotaPolicyElastic allows using idle resources beyond the deserved quota, which other queues may only
+	// reclaim once the over-quota deadline of the queue has passed.
+	OverQuotaPolicyElastic QueueOverQuotaPolicy = "Elastic"
+)
+
+// QueuePreemptionPolicy defines which jobs of other queues the jobs of a queue may preempt or reclaim, and which queues
+// may preempt or reclaim the jobs of the queue.
+// +kubebuilder:validation:Enum=Any;LowerPriorityOnly;Never
+type QueuePreemptionPolicy string
+
+const (
+	// PreemptionPolicyAny allows preempting and reclaiming jobs of any queue, and by jobs of any queue.
+	PreemptionPolicyAny QueuePreemptionPolicy = "Any"
+	// PreemptionPolicyLowerPriorityOnly allows preempting and reclaiming jobs of queues with a lower priority only, and
+	// by jobs of queues with a higher priority only.
+	PreemptionPolicyLowerPriorityOnly QueuePreemptionPolicy = "LowerPriorityOnly"
+	// PreemptionPolicyNever forbids preempting and reclaiming jobs of other queues, and by jobs of other queues.
+	PreemptionPolicyNever QueuePreemptionPolicy = "Never"
+)
+
 // QueueStatus defines the observed state of Queue
 type QueueStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
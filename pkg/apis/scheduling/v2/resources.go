@@ -12,6 +12,12 @@ type QueueResources struct {
 
 	// Memory resources in megabytes. 1 = 10^6  (1000*1000) bytes
 	Memory QueueResource `json:"memory,omitempty"`
+
+	// GpuTypes sets GPU quotas per GPU model, in addition to the quota of all the GPUs, keyed by the GPU model. A key
+	// matches the GPU product label of a node either exactly or as a dash separated part of it, so "H100" matches
+	// "NVIDIA-H100-80GB-HBM3".
+	// +optional
+	GpuTypes map[string]GpuTypeResource `json:"gpuTypes,omitempty"`
 }
 
 type QueueResource struct {
@@ -22,3 +28,12 @@ type QueueResource struct {
 	// +optional
 	Limit float64 `json:"limit"`
 }
+
+type GpuTypeResource struct {
+	// Quota of GPUs of the GPU type that non-preemptible jobs may use. When not set, it is not limited.
+	// +optional
+	Quota *float64 `json:"quota,omitempty"`
+	// Limit of GPUs of the GPU type that the jobs of the queue may use. When not set, it is not limited.
+	// +optional
+	Limit *float64 `json:"limit,omitempty"`
+}
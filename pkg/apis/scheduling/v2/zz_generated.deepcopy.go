@@ -15,6 +15,31 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuTypeResource) DeepCopyInto(out *GpuTypeResource) {
+	*out = *in
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuTypeResource.
+func (in *GpuTypeResource) DeepCopy() *GpuTypeResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuTypeResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Queue) DeepCopyInto(out *Queue) {
 	*out = *in
@@ -112,6 +137,13 @@ func (in *QueueResources) DeepCopyInto(out *QueueResources) {
 	out.GPU = in.GPU
 	out.CPU = in.CPU
 	out.Memory = in.Memory
+	if in.GpuTypes != nil {
+		in, out := &in.GpuTypes, &out.GpuTypes
+		*out = make(map[string]GpuTypeResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueResources.
@@ -130,13 +162,18 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(QueueResources)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Priority != nil {
 		in, out := &in.Priority, &out.Priority
 		*out = new(int)
 		**out = **in
 	}
+	if in.PriorityClass != nil {
+		in, out := &in.PriorityClass, &out.PriorityClass
+		*out = new(int)
+		**out = **in
+	}
 	if in.PreemptMinRuntime != nil {
 		in, out := &in.PreemptMinRuntime, &out.PreemptMinRuntime
 		*out = new(v1.Duration)
@@ -147,6 +184,33 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PreemptionGracePeriod != nil {
+		in, out := &in.PreemptionGracePeriod, &out.PreemptionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OverQuotaDeadline != nil {
+		in, out := &in.OverQuotaDeadline, &out.OverQuotaDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRunningJobs != nil {
+		in, out := &in.MaxRunningJobs, &out.MaxRunningJobs
+		*out = new(int)
+		**out = **in
+	}
+	if in.GuaranteeWeight != nil {
+		in, out := &in.GuaranteeWeight, &out.GuaranteeWeight
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
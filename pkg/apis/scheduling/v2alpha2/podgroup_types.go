@@ -231,6 +231,10 @@ type SchedulingConditionType string
 const (
 	// UnschedulableOnNodePool means the pod group is Unschedulable on the current node pool
 	UnschedulableOnNodePool SchedulingConditionType = "UnschedulableOnNodePool"
+
+	// SchedulingTimedOut means the pod group was not scheduled before its scheduling timeout expired, and the
+	// scheduler stopped trying to schedule it
+	SchedulingTimedOut SchedulingConditionType = "SchedulingTimedOut"
 )
 
 // These are reasons for a pod group's transition to a condition.
@@ -304,6 +308,31 @@ const (
 
 	// OverLimit means that the pod group is not schedulable because scheduling it would exceed the queue's limits.
 	OverLimit UnschedulableReason = "OverLimit"
+
+	// MaxRunningJobsReached means that the pod group is not schedulable because the queue or one of its ancestors
+	// already runs its maximum number of jobs.
+	MaxRunningJobsReached UnschedulableReason = "MaxRunningJobsReached"
+
+	// NoQueueNodes means that the pod group is not schedulable because no node matches the node selectors of its
+	// queue and the queue's ancestors.
+	NoQueueNodes UnschedulableReason = "NoQueueNodes"
+
+	// SchedulingDeadlineExceeded means that the pod group is not scheduled anymore because it was not scheduled
+	// within the timeout set by its kai.scheduler/scheduling-timeout annotation.
+	SchedulingDeadlineExceeded UnschedulableReason = "SchedulingDeadlineExceeded"
+
+	// ProtectedByPodDisruptionBudget means that resources could not be preempted or reclaimed for the pod group
+	// because the pods that would have to be evicted are protected by PodDisruptionBudgets that allow no more
+	// disruptions.
+	ProtectedByPodDisruptionBudget UnschedulableReason = "ProtectedByPodDisruptionBudget"
+
+	// StrictOverQuota means that the pod group is not schedulable because scheduling it would make the allocation of
+	// a queue with the Strict over-quota policy larger than the queue's quota.
+	StrictOverQuota UnschedulableReason = "StrictOverQuota"
+
+	// WaitingForDependency means that the pod group is not scheduled yet because a pod group named by its
+	// kai.scheduler/depends-on annotation has not completed.
+	WaitingForDependency UnschedulableReason = "WaitingForDependency"
 )
 
 func (e UnschedulableExplanations) String() string {
@@ -96,6 +96,15 @@ func (b *Binder) reserveGPUs(ctx context.Context, pod *v1.Pod, bindRequest *v1al
 		return nil, fmt.Errorf("no SelectedGPUGroups for fractional pod: %w", InvalidCrdWarning)
 	}
 
+	// The scheduler patches the GPU memory split on the pod asynchronously, while the reservation needs it to label
+	// the pod with each of its GPU groups, so it is taken from the bind request.
+	if gpuMemorySplit, found := bindRequest.Annotations[constants.GpuMemorySplit]; found {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[constants.GpuMemorySplit] = gpuMemorySplit
+	}
+
 	var gpuIndexes []string
 	for groupListIndex, gpuGroup := range bindRequest.Spec.SelectedGPUGroups {
 		gpuIndex, err := b.resourceReservationService.ReserveGpuDevice(ctx, pod, bindRequest.Spec.SelectedNode, gpuGroup)
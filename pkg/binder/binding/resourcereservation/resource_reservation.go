@@ -36,7 +36,7 @@ type Interface interface {
 const (
 	resourceReservation            = "resource-reservation"
 	gpuReservationPodPrefix        = "gpu-reservation"
-	gpuIndexAnnotationName         = "run.ai/reserve_for_gpu_index"
+	gpuIndexAnnotationName         = constants.ReservedGpuIndex
 	numberOfGPUsToReserve          = 1
 	reservationPodRandomCharacters = 5
 	unknownGpuIndicator            = "-1"
@@ -235,7 +235,7 @@ func (rsc *service) updatePodGPUGroup(
 	if pod.Labels == nil {
 		pod.Labels = map[string]string{}
 	}
-	isMultiFraction, err := resources.IsMultiFraction(pod)
+	isMultiFraction, err := isMultiGpuGroupPod(pod)
 	if err != nil {
 		return fmt.Errorf(
 			"failed to determine if pod <%s/%s> is a multi fractional pod while setting gpu group label. %w",
@@ -256,8 +256,17 @@ func (rsc *service) updatePodGPUGroup(
 	return nil
 }
 
+// isMultiGpuGroupPod returns whether the pod is connected to several GPU groups, either as a multi-fraction pod or as
+// a pod whose GPU memory is split across GPUs, in which case it is labelled with each of its GPU groups.
+func isMultiGpuGroupPod(pod *v1.Pod) (bool, error) {
+	if resources.IsGpuMemorySplit(pod) {
+		return true, nil
+	}
+	return resources.IsMultiFraction(pod)
+}
+
 func (rsc *service) RemovePodGpuGroupConnection(ctx context.Context, pod *v1.Pod, gpuGroup string) error {
-	isMultiFractionalPod, err := resources.IsMultiFraction(pod)
+	isMultiFractionalPod, err := isMultiGpuGroupPod(pod)
 	if err != nil {
 		return fmt.Errorf("failed to generate a patch for pod gpu-group removal. %w", err)
 	}
@@ -37,6 +37,50 @@ const (
 	MpsAnnotation            = "mps"
 	StalePodgroupTimeStamp   = "kai.scheduler/stale-podgroup-timestamp"
 	LastStartTimeStamp       = "kai.scheduler/last-start-timestamp"
+	WouldPreemptAnnotation   = "kai.scheduler/would-preempt"
+	PreemptionGracePeriod    = "kai.scheduler/preemption-grace-period"
+	SchedulingTrace          = "kai.scheduler/scheduling-trace"
+	GracefulCheckpoint       = "kai.scheduler/graceful-checkpoint"
+	CheckpointRequested      = "kai.scheduler/checkpoint-requested"
+	NextCheckpointEta        = "kai.scheduler/next-checkpoint-eta"
+	GpuGroupsAnnotation      = "kai.scheduler/gpu-groups"
+	SchedulingTimeout        = "kai.scheduler/scheduling-timeout"
+	PendingSinceTimeStamp    = "kai.scheduler/pending-since-timestamp"
+	NodeScaleDownCandidate   = "kai.scheduler/scale-down-candidate"
+	GpuModel                 = "kai.scheduler/gpu-model"
+	MinGpuMemory             = "kai.scheduler/min-gpu-memory"
+	GpuMemoryAllotment       = "kai.scheduler/gpu-memory-allotment"
+	InitGpuMemory            = "kai.scheduler/init-gpu-memory"
+	GpuNumaNodes             = "kai.scheduler/gpu-numa-nodes"
+	GpuDeviceIds             = "kai.scheduler/gpu-device-ids"
+	UnhealthyGpus            = "kai.scheduler/unhealthy-gpus"
+	GpuUtilization           = "kai.scheduler/gpu-utilization"
+	GpuTemperature           = "kai.scheduler/gpu-temperature"
+	GpuPowerUsage            = "kai.scheduler/gpu-power-usage"
+	GpuMetricsTimestamp      = "kai.scheduler/gpu-metrics-timestamp"
+	NumaNode                 = "kai.scheduler/numa-node"
+	SplittableGpuMemory      = "kai.scheduler/splittable-gpu-memory"
+	GpuMemorySplit           = "kai.scheduler/gpu-memory-split"
+	QuantizedGpuMemory       = "kai.scheduler/quantized-gpu-memory"
+	RightsizedResources      = "kai.scheduler/rightsized-resources"
+	LastGpuIndexes           = "kai.scheduler/last-gpu-indexes"
+	ExclusiveNode            = "kai.scheduler/exclusive-node"
+	MaxTasksPerNode          = "kai.scheduler/max-tasks-per-node"
+	MaxTasksPerNodePolicy    = "kai.scheduler/max-tasks-per-node-policy"
+	ElasticPodGroup          = "kai.scheduler/elastic"
+	ReservedGpuMemory        = "kai.scheduler/reserved-gpu-memory"
+	ReservedGpuMemoryPerGpu  = "kai.scheduler/reserved-gpu-memory-per-gpu"
+	DependsOn                = "kai.scheduler/depends-on"
+	LostGpuGroups            = "kai.scheduler/lost-gpu-groups"
+	GpuInterconnect          = "kai.scheduler/gpu-interconnect"
+	RuntimeClasses           = "kai.scheduler/runtime-classes"
+	LocalNvmeScratch         = "kai.scheduler/local-nvme-scratch"
+	LocalNvmeCapacity        = "kai.scheduler/local-nvme-capacity"
+	NodeHold                 = "kai.scheduler/hold"
+	GpuMemoryBandwidthWeight = "kai.scheduler/gpu-memory-bandwidth-weight"
+	GpuMemoryBandwidthBudget = "kai.scheduler/gpu-memory-bandwidth-budget"
+	RebindNode               = "kai.scheduler/rebind-node"
+	ReservedGpuIndex         = "run.ai/reserve_for_gpu_index"
 
 	// Labels
 	GPUGroup                 = "runai-gpu-group"
@@ -6,6 +6,7 @@ package resources
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -97,3 +98,45 @@ func IsMultiFraction(pod *v1.Pod) (bool, error) {
 	}
 	return numDevices > 1, nil
 }
+
+// IsGpuMemorySplit returns whether the pod was allocated the memory of several GPU groups for a single GPU device,
+// as recorded by its gpu-memory-split annotation.
+func IsGpuMemorySplit(pod *v1.Pod) bool {
+	return pod.Annotations[constants.GpuMemorySplit] != ""
+}
+
+// ParseGpuMemorySplit parses the value of the gpu-memory-split annotation, a comma separated list of
+// <gpu-group>:<memory in MiB> pairs, to the memory taken from every GPU group.
+func ParseGpuMemorySplit(value string) (map[string]int64, error) {
+	split := map[string]int64{}
+	for _, groupMemory := range strings.Split(value, ",") {
+		gpuGroup, memoryStr, found := strings.Cut(groupMemory, ":")
+		if !found || gpuGroup == "" {
+			return nil, fmt.Errorf("invalid GPU memory split entry <%s>", groupMemory)
+		}
+		memory, err := strconv.ParseInt(memoryStr, 10, 64)
+		if err != nil || memory <= 0 {
+			return nil, fmt.Errorf("invalid GPU memory <%s> for GPU group <%s>", memoryStr, gpuGroup)
+		}
+		if _, duplicate := split[gpuGroup]; duplicate {
+			return nil, fmt.Errorf("duplicate GPU group <%s>", gpuGroup)
+		}
+		split[gpuGroup] = memory
+	}
+	return split, nil
+}
+
+// FormatGpuMemorySplit returns the value of the gpu-memory-split annotation for the split, sorted by GPU group.
+func FormatGpuMemorySplit(split map[string]int64) string {
+	gpuGroups := make([]string, 0, len(split))
+	for gpuGroup := range split {
+		gpuGroups = append(gpuGroups, gpuGroup)
+	}
+	slices.Sort(gpuGroups)
+
+	entries := make([]string, 0, len(gpuGroups))
+	for _, gpuGroup := range gpuGroups {
+		entries = append(entries, fmt.Sprintf("%s:%d", gpuGroup, split[gpuGroup]))
+	}
+	return strings.Join(entries, ",")
+}
@@ -83,6 +83,10 @@ func buildArgsList(kaiConfig *kaiv1.Config) []string {
 			"--default-priorities-configmap-namespace", *config.Args.DefaultPrioritiesConfigMapNamespace)
 	}
 
+	if len(config.Args.NamespaceDefaultQueues) > 0 {
+		args = append(args, "--namespace-default-queues", formatLabelSelector(config.Args.NamespaceDefaultQueues))
+	}
+
 	if len(kaiConfig.Spec.Global.NamespaceLabelSelector) > 0 {
 		args = append(args, "--namespace-label-selector", formatLabelSelector(kaiConfig.Spec.Global.NamespaceLabelSelector))
 	}
@@ -157,6 +157,31 @@ func TestBuildArgsList(t *testing.T) {
 				"--knative-gang-schedule=true",
 			},
 		},
+		{
+			name: "with namespace default queues",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Global: &kaiv1.GlobalConfig{
+						SchedulerName:    ptr.To(constants.DefaultSchedulerName),
+						QueueLabelKey:    ptr.To(constants.DefaultQueueLabel),
+						NodePoolLabelKey: ptr.To(constants.DefaultNodePoolLabelKey),
+					},
+					PodGrouper: &pod_grouper.PodGrouper{
+						Replicas: ptr.To(int32(1)),
+						Args: &pod_grouper.Args{
+							NamespaceDefaultQueues: map[string]string{"team-a": "queue-a"},
+						},
+						K8sClientConfig: &common.K8sClientConfig{},
+					},
+				},
+			},
+			expected: []string{
+				"--scheduler-name", constants.DefaultSchedulerName,
+				"--queue-label-key", constants.DefaultQueueLabel,
+				"--nodepool-label-key", constants.DefaultNodePoolLabelKey,
+				"--namespace-default-queues", "team-a=queue-a",
+			},
+		},
 		{
 			name: "with leader election",
 			config: &kaiv1.Config{
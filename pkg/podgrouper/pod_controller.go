@@ -57,6 +57,8 @@ type Configs struct {
 
 	DefaultPrioritiesConfigMapName      string
 	DefaultPrioritiesConfigMapNamespace string
+
+	NamespaceDefaultQueues map[string]string
 }
 
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
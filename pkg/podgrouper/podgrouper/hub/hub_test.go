@@ -34,7 +34,7 @@ var _ = Describe("SupportedTypes", func() {
 		BeforeEach(func() {
 			kubeClient = fake.NewFakeClient()
 			hub = NewDefaultPluginsHub(
-				kubeClient, false, false, queueLabelKey, nodePoolLabelKey, "", "",
+				kubeClient, false, false, queueLabelKey, nodePoolLabelKey, "", "", nil,
 			)
 		})
 
@@ -70,7 +70,7 @@ var _ = Describe("SupportedTypes", func() {
 		BeforeEach(func() {
 			kubeClient = fake.NewFakeClient()
 			hub = NewDefaultPluginsHub(
-				kubeClient, false, false, queueLabelKey, nodePoolLabelKey, "", "",
+				kubeClient, false, false, queueLabelKey, nodePoolLabelKey, "", "", nil,
 			)
 		})
 
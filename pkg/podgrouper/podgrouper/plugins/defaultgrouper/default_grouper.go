@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
@@ -35,6 +36,9 @@ type DefaultGrouper struct {
 	defaultPrioritiesConfigMapName      string
 	defaultPrioritiesConfigMapNamespace string
 	kubeReader                          client.Reader
+
+	namespaceDefaultQueues        map[string]string
+	namespacesWithoutDefaultQueue sync.Map
 }
 
 func NewDefaultGrouper(queueLabelKey, nodePoolLabelKey string, kubeReader client.Reader) *DefaultGrouper {
@@ -50,6 +54,12 @@ func (dg *DefaultGrouper) SetDefaultPrioritiesConfigMapParams(defaultPrioritiesC
 	dg.defaultPrioritiesConfigMapNamespace = defaultPrioritiesConfigMapNamespace
 }
 
+// SetNamespaceDefaultQueues sets the queues of the pod groups without an explicit queue per namespace. Pod groups of
+// namespaces without a queue are put in the default queue.
+func (dg *DefaultGrouper) SetNamespaceDefaultQueues(namespaceDefaultQueues map[string]string) {
+	dg.namespaceDefaultQueues = namespaceDefaultQueues
+}
+
 func (dg *DefaultGrouper) Name() string {
 	return "Default Grouper"
 }
@@ -131,6 +141,21 @@ func (dg *DefaultGrouper) CalcPodGroupQueue(topOwner *unstructured.Unstructured,
 		return queue
 	}
 
+	return dg.namespaceDefaultQueue(pod.GetNamespace())
+}
+
+func (dg *DefaultGrouper) namespaceDefaultQueue(namespace string) string {
+	if len(dg.namespaceDefaultQueues) == 0 {
+		return constants.DefaultQueueName
+	}
+	if queue, found := dg.namespaceDefaultQueues[namespace]; found {
+		return queue
+	}
+
+	if _, warned := dg.namespacesWithoutDefaultQueue.LoadOrStore(namespace, true); !warned {
+		logger.Info("Namespace has no default queue, using the cluster's default queue for its pod groups "+
+			"without an explicit queue", "namespace", namespace, "queue", constants.DefaultQueueName)
+	}
 	return constants.DefaultQueueName
 }
 
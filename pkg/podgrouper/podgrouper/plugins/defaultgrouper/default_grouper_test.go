@@ -186,6 +186,67 @@ func TestGetPodGroupMetadataOnQueueFromPod(t *testing.T) {
 	assert.Equal(t, "my-queue", podGroupMetadata.Queue)
 }
 
+func TestGetPodGroupMetadataOnQueueFromNamespaceDefaultQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		podLabels     map[string]string
+		expectedQueue string
+	}{
+		{
+			name:          "default queue of the namespace",
+			namespace:     "team-a",
+			expectedQueue: "queue-a",
+		},
+		{
+			name:          "namespace without a default queue",
+			namespace:     "team-b",
+			expectedQueue: constants.DefaultQueueName,
+		},
+		{
+			name:          "explicit queue",
+			namespace:     "team-a",
+			podLabels:     map[string]string{queueLabelKey: "my-queue"},
+			expectedQueue: "my-queue",
+		},
+		{
+			name:          "explicit default queue",
+			namespace:     "team-a",
+			podLabels:     map[string]string{queueLabelKey: constants.DefaultQueueName},
+			expectedQueue: constants.DefaultQueueName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind":       "test_kind",
+					"apiVersion": "test_version",
+					"metadata": map[string]interface{}{
+						"name":      "test_name",
+						"namespace": tt.namespace,
+						"uid":       "1",
+					},
+				},
+			}
+			pod := &v1.Pod{
+				ObjectMeta: v12.ObjectMeta{
+					Namespace: tt.namespace,
+					Labels:    tt.podLabels,
+				},
+			}
+
+			defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+			defaultGrouper.SetNamespaceDefaultQueues(map[string]string{"team-a": "queue-a"})
+			podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedQueue, podGroupMetadata.Queue)
+		})
+	}
+}
+
 func TestGetPodGroupMetadataOnPriorityClassFromOwner(t *testing.T) {
 	myPriorityClass := priorityClassObj("my-priority", 1000)
 	kubeClient := fake.NewFakeClient(myPriorityClass)
@@ -122,7 +122,7 @@ func TestNewPodgrouper(t *testing.T) {
 	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(resources...).Build()
 
 	pluginsHub := pluginshub.NewDefaultPluginsHub(client, false, true,
-		queueLabelKey, nodePoolLabelKey, "", "")
+		queueLabelKey, nodePoolLabelKey, "", "", nil)
 	grouper := podgrouper.NewPodgrouper(client, client, pluginsHub)
 
 	topOwner, owners, err := grouper.GetPodOwners(context.Background(), &pod)
@@ -322,6 +322,7 @@ kind: Pod
 				nodePoolLabelKey,
 				"",
 				"",
+				nil,
 			)
 			grouper := podgrouper.NewPodgrouper(client, client, pluginsHub)
 
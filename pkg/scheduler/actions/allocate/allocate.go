@@ -66,6 +66,9 @@ func (alloc *allocateAction) Execute(ssn *framework.Session) {
 			if err == nil && !pipelined && !alreadyAllocated {
 				setLastStartTimestamp(job)
 			}
+			if err == nil {
+				common.ClearWouldPreempt(ssn, job)
+			}
 			if err == nil && podgroup_info.HasTasksToAllocate(job, true) {
 				jobsOrderByQueues.PushJob(job)
 				continue
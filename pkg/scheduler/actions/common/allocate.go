@@ -6,6 +6,7 @@ package common
 import (
 	"fmt"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -24,6 +25,16 @@ func AllocateJob(ssn *framework.Session, stmt *framework.Statement, nodes []*nod
 		if !isPipelineOnly {
 			job.SetJobFitError(result.Reason, result.Message, result.Details)
 		}
+		framework.TraceLogJobf(job, "not placed, the pod group is over its queue capacity: %s", result.Message)
+		return false
+	}
+
+	if len(ssn.NodesForQueue(job.Queue)) == 0 {
+		if !isPipelineOnly {
+			job.SetJobFitError(enginev2alpha2.NoQueueNodes,
+				fmt.Sprintf("No nodes match the node selectors of queue %s", job.Queue), nil)
+		}
+		framework.TraceLogJobf(job, "not placed, no nodes match the node selectors of queue %s", job.Queue)
 		return false
 	}
 
@@ -44,21 +55,26 @@ func AllocateJob(ssn *framework.Session, stmt *framework.Statement, nodes []*nod
 func allocateTaskOnNodeSet(ssn *framework.Session, stmt *framework.Statement, nodeSet node_info.NodeSet,
 	job *podgroup_info.PodGroupInfo, tasksToAllocate []*pod_info.PodInfo, isPipelineOnly bool) bool {
 	cp := stmt.Checkpoint()
+	ranker := newNodeRanker(ssn, stmt, nodeSet)
 	for index, task := range tasksToAllocate {
-		success := allocateTask(ssn, stmt, nodeSet, task, isPipelineOnly)
+		success := allocateTask(ssn, stmt, ranker, task, isPipelineOnly)
 		if !success {
 			if err := stmt.Rollback(cp); err != nil {
 				log.InfraLogger.Errorf("Failed to rollback statement in session %v, err: %v", ssn.UID, err)
 			}
 
 			handleFailedTaskAllocation(job, task, index)
+			for _, tracedTask := range tasksToAllocate {
+				framework.TraceLogf(tracedTask, "not placed, pod %s/%s of the pod group could not be placed",
+					task.Namespace, task.Name)
+			}
 			return false
 		}
 	}
 	return true
 }
 
-func allocateTask(ssn *framework.Session, stmt *framework.Statement, nodes []*node_info.NodeInfo,
+func allocateTask(ssn *framework.Session, stmt *framework.Statement, ranker *nodeRanker,
 	task *pod_info.PodInfo, isPipelineOnly bool) (success bool) {
 	job := ssn.PodGroupInfos[task.Job]
 	err := ssn.PrePredicateFn(task, job)
@@ -69,13 +85,17 @@ func allocateTask(ssn *framework.Session, stmt *framework.Statement, nodes []*no
 		fitErrors := common_info.NewFitErrors()
 		fitErrors.SetError(err.Error())
 		job.SetTaskFitError(task, fitErrors)
+		framework.TraceLogf(task, "pre-predicates failed: %v", err)
 		return false
 	}
 
 	log.InfraLogger.V(6).Infof("Looking for best node for task - Task: <%s/%s>, init requested: <%v>.",
 		task.Namespace, task.Name, task.ResReq)
 
-	orderedNodes := ssn.OrderedNodesByTask(nodes, task)
+	orderedNodes := ranker.orderedNodesByTask(task)
+	if ssn.SchedulerParams.DeferWholeGpuFragmentation && task.IsSharedGPURequest() {
+		orderedNodes = ssn.DeferWholeGpuFragmentation(task, orderedNodes)
+	}
 	for _, node := range orderedNodes {
 		if !ssn.FittingNode(task, node, !isPipelineOnly) {
 			continue
@@ -91,8 +111,10 @@ func allocateTask(ssn *framework.Session, stmt *framework.Statement, nodes []*no
 
 	if success {
 		log.InfraLogger.V(6).Infof("Allocation succeeded for task: <%v/%v>", task.Namespace, task.Name)
+		framework.TraceLogf(task, "placed on node <%s> as %s", task.NodeName, task.Status)
 	} else {
 		log.InfraLogger.V(6).Infof("Failed statement allocate for task: <%v/%v>", task.Namespace, task.Name)
+		framework.TraceLogf(task, "no node out of %d fits", len(orderedNodes))
 	}
 
 	return success
@@ -121,6 +143,11 @@ func bindTaskToNode(ssn *framework.Session, stmt *framework.Statement, task *pod
 }
 
 func pipelineTaskToNode(ssn *framework.Session, stmt *framework.Statement, task *pod_info.PodInfo, node *node_info.NodeInfo, updateTasksIfExistsOnNode bool) bool {
+	if ssn.IsStalePipeline(task, node.Name) {
+		log.InfraLogger.V(6).Infof("Not pipelining Task <%v/%v> to node <%v>, its pipeline to the node is stale",
+			task.Namespace, task.Name, node.Name)
+		return false
+	}
 	log.InfraLogger.V(6).Infof("Pipelining Task <%v/%v> to node <%v> requires: %v GPUs",
 		task.Namespace, task.Name, node.Name, task.ResReq)
 
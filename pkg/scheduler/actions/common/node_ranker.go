@@ -0,0 +1,41 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+// nodeRanker orders the nodes of a node set for the tasks of a pod group that are allocated one after the other. With
+// incremental node rescoring, a task with the same resource requests as the previous one reuses its node ranking, and
+// only the nodes that the statement changed since then are scored again.
+type nodeRanker struct {
+	ssn        *framework.Session
+	stmt       *framework.Statement
+	nodes      []*node_info.NodeInfo
+	ranking    *framework.NodeRanking
+	checkpoint framework.Checkpoint
+}
+
+func newNodeRanker(ssn *framework.Session, stmt *framework.Statement, nodes []*node_info.NodeInfo) *nodeRanker {
+	return &nodeRanker{ssn: ssn, stmt: stmt, nodes: nodes}
+}
+
+func (r *nodeRanker) orderedNodesByTask(task *pod_info.PodInfo) []*node_info.NodeInfo {
+	if !r.ssn.SchedulerParams.IncrementalNodeRescoring {
+		return r.ssn.OrderedNodesByTask(r.nodes, task)
+	}
+
+	var orderedNodes []*node_info.NodeInfo
+	if r.ranking.IsReusableFor(task) {
+		orderedNodes = r.ssn.Rescore(r.ranking, task, r.stmt.NodesChangedSince(r.checkpoint))
+	} else {
+		r.ranking = r.ssn.RankNodesByTask(r.nodes, task)
+		orderedNodes = r.ranking.OrderedNodes()
+	}
+	r.checkpoint = r.stmt.Checkpoint()
+	return orderedNodes
+}
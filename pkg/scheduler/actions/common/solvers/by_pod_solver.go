@@ -37,6 +37,9 @@ type solutionResult struct {
 	victimsTasks []*pod_info.PodInfo
 	victimJobs   []*podgroup_info.PodGroupInfo
 	statement    *framework.Statement
+	// vetoedVictimsTasks are the victims of a scenario that placed the preemptor but was rejected by the solution
+	// validator
+	vetoedVictimsTasks []*pod_info.PodInfo
 }
 
 type byPodSolver struct {
@@ -94,7 +97,7 @@ func (s *byPodSolver) solve(
 	}
 
 	statement.Discard() // No solution for scenario
-	return &solutionResult{false, nil, nil, nil}
+	return &solutionResult{false, nil, nil, nil, nil}
 }
 
 func (s *byPodSolver) runSimulation(
@@ -189,7 +192,7 @@ func (s *byPodSolver) handleScenarioSolution(
 		validSolution := s.solutionValidator(scenario)
 		if !validSolution {
 			statement.Discard()
-			return &solutionResult{false, nil, nil, nil}
+			return &solutionResult{false, nil, nil, nil, victimsTasks}
 		}
 	}
 
@@ -198,7 +201,7 @@ func (s *byPodSolver) handleScenarioSolution(
 		actualVictimJobs = getVictimJobsFromVictimTasks(victimsTasks, scenario)
 	}
 
-	return &solutionResult{true, victimsTasks, actualVictimJobs, statement}
+	return &solutionResult{true, victimsTasks, actualVictimJobs, statement, nil}
 }
 
 func getNodesOfJob(pj *podgroup_info.PodGroupInfo) []string {
@@ -290,7 +293,7 @@ func handleSolveError(pendingJob *podgroup_info.PodGroupInfo, nextTaskToFindAllo
 		pendingJob.Namespace, pendingJob.Name, pendingJob.GetAliveTasksRequestedGPUs(), nextTaskToFindAllocation,
 		err)
 	statement.Discard()
-	return &solutionResult{false, nil, nil, nil}
+	return &solutionResult{false, nil, nil, nil, nil}
 }
 
 func hasRecordedVictimsForSimulation(scenario *scenario.ByNodeScenario) bool {
@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package solvers
+
+import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// gpuGroupTenant is the next eviction unit of a victim job, when all of its tasks share a single GPU group on a node.
+type gpuGroupTenant struct {
+	tasks    []*pod_info.PodInfo
+	memory   int64
+	priority int32
+}
+
+type gpuGroupKey struct {
+	nodeName string
+	gpuGroup string
+}
+
+// gpuGroupVictimsPlan is the set of tenants to evict from a single GPU group to make room for a fractional task.
+type gpuGroupVictimsPlan struct {
+	tenants     []*gpuGroupTenant
+	freedMemory int64
+	maxPriority int32
+}
+
+func (p *gpuGroupVictimsPlan) isBetterThan(other *gpuGroupVictimsPlan) bool {
+	if other == nil {
+		return true
+	}
+	if p.maxPriority != other.maxPriority {
+		return p.maxPriority < other.maxPriority
+	}
+	if len(p.tenants) != len(other.tenants) {
+		return len(p.tenants) < len(other.tenants)
+	}
+	return p.freedMemory < other.freedMemory
+}
+
+func (p *gpuGroupVictimsPlan) addTenant(tenant *gpuGroupTenant) {
+	if len(p.tenants) == 0 || tenant.priority > p.maxPriority {
+		p.maxPriority = tenant.priority
+	}
+	p.tenants = append(p.tenants, tenant)
+	p.freedMemory += tenant.memory
+}
+
+// getGpuGroupVictims looks for the fractional tenants whose eviction frees enough memory on a single shared GPU group
+// for the pending task, so reclaiming for a fractional task does not evict whole-GPU victims when releasing memory
+// from a shared GPU is enough. Tenants are taken lowest priority and smallest memory first, and among all GPU groups
+// the plan evicting the lowest priorities, then the fewest tenants, then the least memory is chosen.
+// The victims are returned grouped by their jobs. It returns nil if the task is not a single device fractional
+// request or no GPU group can be freed.
+func getGpuGroupVictims(
+	ssn *framework.Session, task *pod_info.PodInfo, victimJobs []*podgroup_info.PodGroupInfo,
+	recordedVictimsTasks []*pod_info.PodInfo,
+) [][]*pod_info.PodInfo {
+	if !task.IsSharedGPURequest() || task.ResReq.GetNumOfGpuDevices() != 1 {
+		return nil
+	}
+
+	recordedVictims := map[common_info.PodID]bool{}
+	for _, recordedVictim := range recordedVictimsTasks {
+		recordedVictims[recordedVictim.UID] = true
+	}
+
+	tenantsByGpuGroup := map[gpuGroupKey][]*gpuGroupTenant{}
+	for _, job := range victimJobs {
+		tasks, _ := podgroup_info.GetTasksToEvict(job, ssn.SubGroupOrderFn, ssn.TaskOrderFn)
+		key, memory, ok := getSingleGpuGroupUsage(ssn, tasks, recordedVictims)
+		if !ok {
+			continue
+		}
+		tenantsByGpuGroup[key] = append(tenantsByGpuGroup[key],
+			&gpuGroupTenant{tasks: tasks, memory: memory, priority: job.Priority})
+	}
+
+	gpuGroups := maps.Keys(tenantsByGpuGroup)
+	sort.Slice(gpuGroups, func(i, j int) bool {
+		if gpuGroups[i].nodeName != gpuGroups[j].nodeName {
+			return gpuGroups[i].nodeName < gpuGroups[j].nodeName
+		}
+		return gpuGroups[i].gpuGroup < gpuGroups[j].gpuGroup
+	})
+
+	var bestPlan *gpuGroupVictimsPlan
+	for _, key := range gpuGroups {
+		plan := buildGpuGroupVictimsPlan(ssn.Nodes[key.nodeName], key.gpuGroup, task, tenantsByGpuGroup[key])
+		if plan != nil && plan.isBetterThan(bestPlan) {
+			bestPlan = plan
+		}
+	}
+	if bestPlan == nil {
+		return nil
+	}
+
+	var victimsByJob [][]*pod_info.PodInfo
+	var victimTasks []*pod_info.PodInfo
+	for _, tenant := range bestPlan.tenants {
+		victimsByJob = append(victimsByJob, tenant.tasks)
+		victimTasks = append(victimTasks, tenant.tasks...)
+	}
+	log.InfraLogger.V(5).Infof("Found GPU group victims for task <%s/%s>: %s",
+		task.Namespace, task.Name, victimPrintingStruct{victimTasks})
+	return victimsByJob
+}
+
+// getSingleGpuGroupUsage returns the GPU group and the memory used on it by tasks, if all the tasks are active
+// fractional allocations on the same GPU group of the same node.
+func getSingleGpuGroupUsage(
+	ssn *framework.Session, tasks []*pod_info.PodInfo, recordedVictims map[common_info.PodID]bool,
+) (gpuGroupKey, int64, bool) {
+	var key gpuGroupKey
+	var memory int64
+	for i, task := range tasks {
+		if recordedVictims[task.UID] || !pod_status.IsActiveAllocatedStatus(task.Status) ||
+			!task.IsSharedGPUAllocation() || len(task.GPUGroups) != 1 {
+			return gpuGroupKey{}, 0, false
+		}
+		taskKey := gpuGroupKey{nodeName: task.NodeName, gpuGroup: task.GPUGroups[0]}
+		if i == 0 {
+			key = taskKey
+		} else if taskKey != key {
+			return gpuGroupKey{}, 0, false
+		}
+		node, found := ssn.Nodes[task.NodeName]
+		if !found {
+			return gpuGroupKey{}, 0, false
+		}
+		memory += node.GetResourceGpuMemory(task.ResReq)
+	}
+	return key, memory, len(tasks) > 0
+}
+
+func buildGpuGroupVictimsPlan(
+	node *node_info.NodeInfo, gpuGroup string, task *pod_info.PodInfo, tenants []*gpuGroupTenant,
+) *gpuGroupVictimsPlan {
+	availableMemory := node.UsableGpuMemory(gpuGroup) - node.AllocatedSharedGPUsMemory[gpuGroup] +
+		node.ReleasingSharedGPUsMemory[gpuGroup]
+	missingMemory := node.GetResourceGpuMemory(task.ResReq) - availableMemory
+	if missingMemory <= 0 {
+		return nil
+	}
+
+	sort.SliceStable(tenants, func(i, j int) bool {
+		if tenants[i].priority != tenants[j].priority {
+			return tenants[i].priority < tenants[j].priority
+		}
+		return tenants[i].memory < tenants[j].memory
+	})
+
+	plan := &gpuGroupVictimsPlan{}
+	for _, tenant := range tenants {
+		plan.addTenant(tenant)
+		if plan.freedMemory >= missingMemory {
+			return plan
+		}
+	}
+	return nil
+}
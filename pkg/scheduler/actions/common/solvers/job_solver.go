@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	solverscenario "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers/scenario"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -23,11 +24,13 @@ type JobSolver struct {
 	solutionValidator    SolutionValidator
 	generateVictimsQueue GenerateVictimsQueue
 	actionType           framework.ActionType
+	vetoedVictimNames    []string
 }
 
 type solvingState struct {
 	recordedVictimsJobs  []*podgroup_info.PodGroupInfo
 	recordedVictimsTasks []*pod_info.PodInfo
+	vetoedVictimsTasks   []*pod_info.PodInfo
 }
 
 func NewJobsSolver(
@@ -58,7 +61,7 @@ func (s *JobSolver) Solve(
 		satisfactorySolution := len(pendingTasks) == len(tasksToAllocate)
 		partialPendingJob := getPartialJobRepresentative(pendingJob, pendingTasks)
 
-		result := s.solvePartialJob(ssn, &state, partialPendingJob)
+		result := s.solvePartialJob(ssn, &state, partialPendingJob, nextTaskToSolve)
 		if result == nil || !result.solved {
 			log.InfraLogger.V(5).Infof("No solution found for %d tasks out of %d tasks to allocate for %s",
 				len(pendingTasks), len(tasksToAllocate), pendingJob.Name)
@@ -85,13 +88,23 @@ func (s *JobSolver) Solve(
 		jobSolved = false
 	}
 
+	s.vetoedVictimNames = nil
+	if !jobSolved {
+		s.vetoedVictimNames = calcVictimNames(state.vetoedVictimsTasks)
+	}
 	return jobSolved, statement, calcVictimNames(state.recordedVictimsTasks)
 }
 
-func (s *JobSolver) solvePartialJob(ssn *framework.Session, state *solvingState, partialPendingJob *podgroup_info.PodGroupInfo) *solutionResult {
-	scenarioBuilder := NewPodAccumulatedScenarioBuilder(
-		ssn, partialPendingJob, state.recordedVictimsJobs, s.generateVictimsQueue())
+// VetoedVictimNames returns the victims of the last scenario that placed the job of the last Solve call but was
+// rejected by the solution validator, when the job was not solved. Victim names are formatted as "<namespace/name>".
+func (s *JobSolver) VetoedVictimNames() []string {
+	return s.vetoedVictimNames
+}
 
+func (s *JobSolver) solvePartialJob(
+	ssn *framework.Session, state *solvingState, partialPendingJob *podgroup_info.PodGroupInfo,
+	nextTaskToSolve *pod_info.PodInfo,
+) *solutionResult {
 	feasibleNodeMap := map[string]*node_info.NodeInfo{}
 	for _, node := range s.feasibleNodes {
 		feasibleNodeMap[node.Name] = node
@@ -102,6 +115,15 @@ func (s *JobSolver) solvePartialJob(ssn *framework.Session, state *solvingState,
 		feasibleNodeMap[task.NodeName] = node
 	}
 
+	if s.actionType == framework.Reclaim {
+		if result := s.solveByGpuGroupVictims(ssn, state, partialPendingJob, nextTaskToSolve,
+			feasibleNodeMap); result != nil {
+			return result
+		}
+	}
+
+	scenarioBuilder := NewPodAccumulatedScenarioBuilder(
+		ssn, partialPendingJob, state.recordedVictimsJobs, s.generateVictimsQueue())
 	for scenarioToSolve := scenarioBuilder.GetValidScenario(); scenarioToSolve != nil; scenarioToSolve =
 		scenarioBuilder.GetNextScenario() {
 		scenarioSolver := newByPodSolver(feasibleNodeMap, s.solutionValidator, ssn.AllowConsolidatingReclaim(),
@@ -114,11 +136,56 @@ func (s *JobSolver) solvePartialJob(ssn *framework.Session, state *solvingState,
 		if result.solved {
 			return result
 		}
+		state.recordVetoedVictims(result)
 	}
 
 	return nil
 }
 
+// solveByGpuGroupVictims tries to make room for a fractional task by releasing GPU memory from a single shared GPU
+// group, before falling back to accumulating whole victim jobs.
+func (s *JobSolver) solveByGpuGroupVictims(
+	ssn *framework.Session, state *solvingState, partialPendingJob *podgroup_info.PodGroupInfo,
+	nextTaskToSolve *pod_info.PodInfo, feasibleNodeMap map[string]*node_info.NodeInfo,
+) *solutionResult {
+	if !nextTaskToSolve.IsSharedGPURequest() {
+		return nil
+	}
+
+	var victimJobs []*podgroup_info.PodGroupInfo
+	for victimsQueue := s.generateVictimsQueue(); !victimsQueue.IsEmpty(); {
+		victimJobs = append(victimJobs, victimsQueue.PopNextJob())
+	}
+	victimsByJob := getGpuGroupVictims(ssn, nextTaskToSolve, victimJobs, state.recordedVictimsTasks)
+	if len(victimsByJob) == 0 {
+		return nil
+	}
+
+	scenarioToSolve := solverscenario.NewByNodeScenario(
+		ssn, partialPendingJob, partialPendingJob, nil, state.recordedVictimsJobs)
+	for _, victimTasks := range victimsByJob {
+		scenarioToSolve.AddPotentialVictimsTasks(victimTasks)
+	}
+	scenarioSolver := newByPodSolver(feasibleNodeMap, s.solutionValidator, ssn.AllowConsolidatingReclaim(),
+		s.actionType)
+
+	log.InfraLogger.V(5).Infof("Trying to solve GPU group scenario: %s", scenarioToSolve)
+	metrics.IncScenarioSimulatedByAction()
+
+	result := scenarioSolver.solve(ssn, scenarioToSolve)
+	if !result.solved {
+		state.recordVetoedVictims(result)
+		return nil
+	}
+	return result
+}
+
+func (state *solvingState) recordVetoedVictims(result *solutionResult) {
+	if len(result.vetoedVictimsTasks) > 0 {
+		state.vetoedVictimsTasks = result.vetoedVictimsTasks
+	}
+}
+
 func getPartialJobRepresentative(
 	job *podgroup_info.PodGroupInfo, pendingTasks []*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
 	jobRepresentative := job.CloneWithTasks(pendingTasks)
@@ -0,0 +1,44 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+// AnnotateWouldPreempt records on each of the pending tasks the victims that the preemption evaluation chose for
+// them, so users can see which workloads their pods would preempt. Victim names are expected as "<namespace/name>".
+func AnnotateWouldPreempt(ssn *framework.Session, tasks []*pod_info.PodInfo, victimNames []string) {
+	if len(victimNames) == 0 {
+		return
+	}
+
+	victims := make([]string, 0, len(victimNames))
+	for _, name := range victimNames {
+		victims = append(victims, strings.Trim(name, "<>"))
+	}
+	value := strings.Join(victims, ",")
+
+	for _, task := range tasks {
+		if task.Pod.Annotations[constants.WouldPreemptAnnotation] == value {
+			continue
+		}
+		ssn.Cache.PatchTaskAnnotations(task, map[string]any{constants.WouldPreemptAnnotation: value})
+	}
+}
+
+// ClearWouldPreempt removes the would-preempt annotation from the job's tasks that carry it.
+func ClearWouldPreempt(ssn *framework.Session, job *podgroup_info.PodGroupInfo) {
+	for _, task := range job.GetAllPodsMap() {
+		if _, found := task.Pod.Annotations[constants.WouldPreemptAnnotation]; !found {
+			continue
+		}
+		ssn.Cache.PatchTaskAnnotations(task, map[string]any{constants.WouldPreemptAnnotation: nil})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestAnnotateWouldPreempt(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	ssn := &framework.Session{Cache: mockCache}
+
+	fresh := buildAnnotatedTask("fresh", nil)
+	upToDate := buildAnnotatedTask("up-to-date", map[string]string{
+		constants.WouldPreemptAnnotation: "ns/victim-0,ns/victim-1",
+	})
+	stale := buildAnnotatedTask("stale", map[string]string{
+		constants.WouldPreemptAnnotation: "ns/victim-0",
+	})
+
+	expectedPatch := map[string]any{constants.WouldPreemptAnnotation: "ns/victim-0,ns/victim-1"}
+	mockCache.EXPECT().PatchTaskAnnotations(fresh, expectedPatch).Times(1)
+	mockCache.EXPECT().PatchTaskAnnotations(stale, expectedPatch).Times(1)
+
+	AnnotateWouldPreempt(ssn, []*pod_info.PodInfo{fresh, upToDate, stale}, []string{"<ns/victim-0>", "<ns/victim-1>"})
+	AnnotateWouldPreempt(ssn, []*pod_info.PodInfo{fresh}, nil)
+}
+
+func TestClearWouldPreempt(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	ssn := &framework.Session{Cache: mockCache}
+
+	annotated := buildAnnotatedTask("annotated", map[string]string{constants.WouldPreemptAnnotation: "ns/victim"})
+	plain := buildAnnotatedTask("plain", nil)
+	job := podgroup_info.NewPodGroupInfo("job", annotated, plain)
+
+	mockCache.EXPECT().PatchTaskAnnotations(annotated, map[string]any{constants.WouldPreemptAnnotation: nil}).Times(1)
+
+	ClearWouldPreempt(ssn, job)
+}
+
+func buildAnnotatedTask(name string, annotations map[string]string) *pod_info.PodInfo {
+	return pod_info.NewTaskInfo(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns",
+			UID:         types.UID(name),
+			Annotations: annotations,
+		},
+	})
+}
@@ -22,6 +22,7 @@ package actions
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/consolidation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/nodeconsolidation"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/stalegangeviction"
@@ -33,5 +34,8 @@ func InitDefaultActions() {
 	framework.RegisterAction(allocate.New())
 	framework.RegisterAction(preempt.New())
 	framework.RegisterAction(consolidation.New())
+	framework.RegisterAction(nodeconsolidation.New())
 	framework.RegisterAction(stalegangeviction.New())
+
+	framework.RegisterReclaimSolver(reclaim.SolveReclaim)
 }
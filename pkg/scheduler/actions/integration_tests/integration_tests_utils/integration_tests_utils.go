@@ -80,9 +80,9 @@ func runRoundsUntilMatch(testMetadata TestTopologyMetadata, controller *Controll
 }
 
 func runSchedulerOneRound(testMetadata *TestTopologyMetadata, controller *Controller, ssn **framework.Session) {
-	for _, action := range schedulerActions {
-		log.InfraLogger.SetAction(string(action.Name()))
-		action.Execute(*ssn)
+	for _, dispatch := range (*ssn).DispatchActions(schedulerActions) {
+		log.InfraLogger.SetAction(string(dispatch.Action.Name()))
+		dispatch.Execute(*ssn)
 	}
 
 	for _, jobMetadata := range testMetadata.Jobs {
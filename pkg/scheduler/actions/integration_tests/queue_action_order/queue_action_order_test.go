@@ -0,0 +1,208 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_action_order_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestQueueActionOrder(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	for testNumber, testMetadata := range getQueueActionOrderTestsMetadata() {
+		t.Logf("Running test number: %v, test name: %v,", testNumber, testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		for _, dispatch := range ssn.DispatchActions([]framework.Action{reclaim.New(), preempt.New()}) {
+			dispatch.Execute(ssn)
+		}
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getQueueActionOrderTestsMetadata() []integration_tests_utils.TestTopologyMetadata {
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: buildReclaimOrPreemptTopology(
+				"Pending job reclaims from the over quota queue before preempting its own queue",
+				nil,
+				map[string]test_utils.TestExpectedResultBasic{
+					"q0_running_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"q0_pending_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+					},
+					"q1_running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"q1_running_job1": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Releasing,
+					},
+				},
+			),
+		},
+		{
+			TestTopologyBasic: buildReclaimOrPreemptTopology(
+				"Pending job of a queue ordering preempt before reclaim preempts its own queue",
+				map[string][]string{"queue0": {"preempt", "reclaim"}},
+				map[string]test_utils.TestExpectedResultBasic{
+					"q0_running_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Releasing,
+					},
+					"q0_pending_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+					},
+					"q1_running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"q1_running_job1": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+				},
+			),
+		},
+		{
+			TestTopologyBasic: buildReclaimOrPreemptTopology(
+				"Queue action order of another queue does not change the order of the queue",
+				map[string][]string{"queue1": {"preempt", "reclaim"}},
+				map[string]test_utils.TestExpectedResultBasic{
+					"q0_running_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"q0_pending_job": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+					},
+					"q1_running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"q1_running_job1": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Releasing,
+					},
+				},
+			),
+		},
+	}
+}
+
+// buildReclaimOrPreemptTopology builds a full node where the pending job of queue0 can either reclaim the lower
+// priority job of the over quota queue1, or preempt the lower priority job of its own queue.
+func buildReclaimOrPreemptTopology(name string, queueActionOrder map[string][]string,
+	jobExpectedResults map[string]test_utils.TestExpectedResultBasic) test_utils.TestTopologyBasic {
+	return test_utils.TestTopologyBasic{
+		Name: name,
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "q0_running_job",
+				RequiredGPUsPerTask: 1,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node0",
+						State:    pod_status.Running,
+					},
+				},
+			}, {
+				Name:                "q0_pending_job",
+				RequiredGPUsPerTask: 1,
+				Priority:            constants.PriorityInteractivePreemptibleNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State: pod_status.Pending,
+					},
+				},
+			}, {
+				Name:                "q1_running_job0",
+				RequiredGPUsPerTask: 2,
+				Priority:            constants.PriorityInteractivePreemptibleNumber,
+				QueueName:           "queue1",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node0",
+						State:    pod_status.Running,
+					},
+				},
+			}, {
+				Name:                "q1_running_job1",
+				RequiredGPUsPerTask: 1,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue1",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node0",
+						State:    pod_status.Running,
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 4,
+			},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{
+				Name:         "queue0",
+				DeservedGPUs: 2,
+			},
+			{
+				Name:         "queue1",
+				DeservedGPUs: 2,
+			},
+		},
+		JobExpectedResults: jobExpectedResults,
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  1,
+				NumberOfPipelineActions: 1,
+			},
+			SchedulerConf: &conf.SchedulerConfiguration{
+				Actions:          "reclaim, preempt",
+				QueueActionOrder: queueActionOrder,
+			},
+		},
+	}
+}
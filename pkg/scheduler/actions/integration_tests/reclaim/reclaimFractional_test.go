@@ -487,5 +487,155 @@ func getReclaimFractionalTestsMetadata() []integration_tests_utils.TestTopologyM
 				},
 			},
 		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "reclaim fractional train by evicting the smallest fractional tenant of a shared GPU",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 0.6,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"0"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "running_job1",
+						RequiredGPUsPerTask: 0.4,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"0"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "running_job2",
+						RequiredGPUsPerTask: 0.2,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"1"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "running_job3",
+						RequiredGPUsPerTask: 0.2,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"1"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "running_job4",
+						RequiredGPUsPerTask: 0.2,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"1"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "running_job5",
+						RequiredGPUsPerTask: 0.4,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:  "node0",
+								GPUGroups: []string{"1"},
+								State:     pod_status.Running,
+							},
+						},
+					}, {
+						Name:                "pending_job0",
+						RequiredGPUsPerTask: 0.4,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 2,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 1,
+					},
+					{
+						Name:         "queue1",
+						DeservedGPUs: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						GPUsRequired: 0.6,
+						GPUGroups:    []string{"0"},
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						GPUsRequired: 0.4,
+						GPUGroups:    []string{"0"},
+						Status:       pod_status.Pending,
+					},
+					"running_job2": {
+						GPUsRequired: 0.2,
+						GPUGroups:    []string{"1"},
+						Status:       pod_status.Running,
+					},
+					"running_job3": {
+						GPUsRequired: 0.2,
+						GPUGroups:    []string{"1"},
+						Status:       pod_status.Running,
+					},
+					"running_job4": {
+						GPUsRequired: 0.2,
+						GPUGroups:    []string{"1"},
+						Status:       pod_status.Running,
+					},
+					"running_job5": {
+						GPUsRequired: 0.4,
+						GPUGroups:    []string{"1"},
+						Status:       pod_status.Running,
+					},
+					"pending_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 0.4,
+						GPUGroups:    []string{"0"},
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfCacheBinds:      5,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			},
+		},
 	}
 }
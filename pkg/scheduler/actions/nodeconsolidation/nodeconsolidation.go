@@ -0,0 +1,321 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeconsolidation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const noConsolidationPreempteesRestriction = -1
+
+type nodeConsolidationAction struct{}
+
+func New() *nodeConsolidationAction {
+	return &nodeConsolidationAction{}
+}
+
+func (action *nodeConsolidationAction) Name() framework.ActionType {
+	return framework.NodeConsolidation
+}
+
+// Execute looks for lightly loaded nodes whose pods can all be moved to other nodes, and moves them. The moves of a
+// node are validated like preemptions, by the preempt scenario validators. Nodes that are drained, and nodes that are
+// already empty, are annotated as scale-down candidates, so the cluster autoscaler can remove them, and no tasks are
+// placed on them anymore. When node consolidation is not allowed, the same plan is made in a dry run and discarded,
+// without evicting pods or annotating nodes, but only in a cycle that follows a request for a plan on the node
+// consolidation plan endpoint. The plan of every run is served on the endpoint.
+func (action *nodeConsolidationAction) Execute(ssn *framework.Session) {
+	log.InfraLogger.V(2).Infof("Enter NodeConsolidation ...")
+	defer log.InfraLogger.V(2).Infof("Leaving NodeConsolidation ...")
+
+	if ssn.GetMaxNumberConsolidationPreemptees() == 0 {
+		log.InfraLogger.V(4).Infof("Node consolidation is disabled, skipping")
+		return
+	}
+	dryRun := !ssn.AllowNodeConsolidation()
+	if dryRun {
+		if !framework.TakeNodeConsolidationPlanRequest() {
+			log.InfraLogger.V(4).Infof("Node consolidation is not allowed and no plan was requested, skipping")
+			return
+		}
+		log.InfraLogger.V(4).Infof("Node consolidation is not allowed, planning it in a dry run")
+	}
+
+	plan := framework.NodeConsolidationPlan{
+		DryRun:     dryRun,
+		Migrations: []framework.NodeConsolidationMigration{},
+		EmptyNodes: []string{},
+	}
+	var dryRunStatements []*framework.Statement
+	budget := ssn.GetMaxNumberConsolidationPreemptees()
+	drainedNodes := map[string]bool{}
+	for _, node := range candidateNodes(ssn) {
+		tasks, movable := tasksToMove(ssn, node)
+		if !movable {
+			continue
+		}
+		if len(tasks) == 0 {
+			log.InfraLogger.V(4).Infof("Node <%s> is empty, dry run: <%v>", node.Name, dryRun)
+			drainedNodes[node.Name] = true
+			plan.EmptyNodes = append(plan.EmptyNodes, node.Name)
+			continue
+		}
+		if budget != noConsolidationPreempteesRestriction && len(tasks) > budget {
+			log.InfraLogger.V(4).Infof("Not draining node <%s>, moving its <%d> pods exceeds the remaining budget <%d>",
+				node.Name, len(tasks), budget)
+			continue
+		}
+		stmt, migrations := drainNode(ssn, node, tasks, drainedNodes)
+		if stmt == nil {
+			continue
+		}
+		if dryRun {
+			dryRunStatements = append(dryRunStatements, stmt)
+		} else if err := stmt.Commit(); err != nil {
+			log.InfraLogger.Errorf("Failed to commit the drain of node <%s>: %v", node.Name, err)
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Drained node <%s>, moved <%d> pods, dry run: <%v>", node.Name, len(tasks), dryRun)
+
+		drainedNodes[node.Name] = true
+		plan.Migrations = append(plan.Migrations, migrations...)
+		plan.EmptyNodes = append(plan.EmptyNodes, node.Name)
+		if budget != noConsolidationPreempteesRestriction {
+			budget -= len(tasks)
+		}
+	}
+
+	// Later drains were planned on top of the earlier ones, so they are undone in reverse order.
+	for i := len(dryRunStatements) - 1; i >= 0; i-- {
+		dryRunStatements[i].Discard()
+	}
+	ssn.RecordNodeConsolidationPlan(plan)
+	if !dryRun {
+		updateScaleDownCandidates(ssn, drainedNodes)
+	}
+}
+
+// candidateNodes returns the schedulable nodes whose utilization is at or below the configured threshold, least
+// utilized first.
+func candidateNodes(ssn *framework.Session) []*node_info.NodeInfo {
+	threshold := ssn.GetNodeConsolidationThreshold()
+	var candidates []*node_info.NodeInfo
+	for _, node := range ssn.Nodes {
+		if node.Node.Spec.Unschedulable || ssn.IsCrossPartitionNode(node) || nodeUtilization(node) > threshold {
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+
+	slices.SortFunc(candidates, func(a, b *node_info.NodeInfo) int {
+		if diff := nodeUtilization(a) - nodeUtilization(b); diff != 0 {
+			if diff < 0 {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return candidates
+}
+
+// nodeUtilization returns the used fraction of the node's GPUs, or of its CPU for CPU only nodes.
+func nodeUtilization(node *node_info.NodeInfo) float64 {
+	if node.Allocatable.GPUs() > 0 {
+		return node.Used.GPUs() / node.Allocatable.GPUs()
+	}
+	if node.Allocatable.Cpu() > 0 {
+		return node.Used.Cpu() / node.Allocatable.Cpu()
+	}
+	return 1
+}
+
+// tasksToMove returns the pods that must be moved in order to drain the node, none for an empty node, and whether all
+// of them can be moved.
+// A pod can be moved only if it is running, does not share a GPU, belongs to a preemptible job and the rest of its
+// job runs on the same node, so the whole gang is moved together.
+func tasksToMove(ssn *framework.Session, node *node_info.NodeInfo) ([]*pod_info.PodInfo, bool) {
+	var tasks []*pod_info.PodInfo
+	for _, task := range node.PodInfos {
+		if isDaemonSetPod(task) || !pod_status.IsActiveAllocatedStatus(task.Status) {
+			continue
+		}
+		if task.Status != pod_status.Running || task.IsSharedGPUAllocation() {
+			log.InfraLogger.V(5).Infof("Not draining node <%s>, pod <%s/%s> cannot be moved",
+				node.Name, task.Namespace, task.Name)
+			return nil, false
+		}
+
+		job, found := ssn.PodGroupInfos[task.Job]
+		if !found || !job.IsPreemptibleJob() {
+			log.InfraLogger.V(5).Infof("Not draining node <%s>, pod <%s/%s> is not preemptible",
+				node.Name, task.Namespace, task.Name)
+			return nil, false
+		}
+		for _, jobTask := range job.GetAllPodsMap() {
+			if pod_status.IsActiveAllocatedStatus(jobTask.Status) && jobTask.NodeName != node.Name {
+				log.InfraLogger.V(5).Infof("Not draining node <%s>, job <%s> also runs on node <%s>",
+					node.Name, job.NamespacedName, jobTask.NodeName)
+				return nil, false
+			}
+		}
+		tasks = append(tasks, task)
+	}
+
+	slices.SortFunc(tasks, func(a, b *pod_info.PodInfo) int {
+		return strings.Compare(string(a.UID), string(b.UID))
+	})
+	return tasks, true
+}
+
+func isDaemonSetPod(task *pod_info.PodInfo) bool {
+	for _, owner := range task.Pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
+
+// drainNode moves all the given tasks away from the node, and returns the statement of the moves and the migrations
+// it is made of. The statement is returned only if every task fits on another node that is not being drained, and is
+// owned by the caller, which must either commit or discard it.
+func drainNode(
+	ssn *framework.Session, node *node_info.NodeInfo, tasks []*pod_info.PodInfo, drainedNodes map[string]bool,
+) (*framework.Statement, []framework.NodeConsolidationMigration) {
+	var targetNodes []*node_info.NodeInfo
+	for _, other := range maps.Values(ssn.Nodes) {
+		if other.Name == node.Name || drainedNodes[other.Name] || other.Node.Spec.Unschedulable {
+			continue
+		}
+		targetNodes = append(targetNodes, other)
+	}
+
+	if !ssn.PreemptScenarioValidator(newDrainScenario(ssn, tasks)) {
+		log.InfraLogger.V(4).Infof("Not draining node <%s>, moving its pods was rejected by the scenario validators",
+			node.Name)
+		return nil, nil
+	}
+
+	stmt := ssn.Statement()
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasks),
+		Action:           string(framework.NodeConsolidation),
+	}
+	for _, task := range tasks {
+		message := fmt.Sprintf("Pod %s/%s was moved in order to drain node %s", task.Namespace, task.Name, node.Name)
+		if err := stmt.Evict(task, message, evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> from node <%s>: %v",
+				task.Namespace, task.Name, node.Name, err)
+			stmt.Discard()
+			return nil, nil
+		}
+	}
+
+	// The jobs of the tasks run on the node only, so they are reallocated as a whole, the same way consolidation
+	// reallocates its victims.
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for _, task := range tasks {
+		jobs[task.Job] = ssn.PodGroupInfos[task.Job]
+	}
+	jobIDs := maps.Keys(jobs)
+	slices.Sort(jobIDs)
+	for _, jobID := range jobIDs {
+		if !common.AllocateJob(ssn, stmt, targetNodes, jobs[jobID], true) {
+			log.InfraLogger.V(4).Infof("Not draining node <%s>, job <%s> does not fit on the other nodes",
+				node.Name, jobs[jobID].NamespacedName)
+			stmt.Discard()
+			return nil, nil
+		}
+	}
+
+	var migrations []framework.NodeConsolidationMigration
+	for _, task := range tasks {
+		moved := jobs[task.Job].GetAllPodsMap()[task.UID]
+		if moved == nil || moved.Status != pod_status.Pipelined || moved.NodeName == node.Name {
+			log.InfraLogger.V(4).Infof("Not draining node <%s>, pod <%s/%s> was not moved to another node",
+				node.Name, task.Namespace, task.Name)
+			stmt.Discard()
+			return nil, nil
+		}
+		migrations = append(migrations, framework.NodeConsolidationMigration{
+			Namespace: task.Namespace,
+			Name:      task.Name,
+			FromNode:  node.Name,
+			ToNode:    moved.NodeName,
+		})
+	}
+
+	return stmt, migrations
+}
+
+// drainScenario is the scenario of draining a node, for the preempt scenario validators. It has no preemptor, as the
+// victims are only moved to other nodes.
+type drainScenario struct {
+	victims map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func newDrainScenario(ssn *framework.Session, tasks []*pod_info.PodInfo) *drainScenario {
+	scenario := &drainScenario{victims: map[common_info.PodGroupID]*api.VictimInfo{}}
+	for _, task := range tasks {
+		victim, found := scenario.victims[task.Job]
+		if !found {
+			victim = &api.VictimInfo{Job: ssn.PodGroupInfos[task.Job]}
+			scenario.victims[task.Job] = victim
+		}
+		victim.Tasks = append(victim.Tasks, victim.Job.GetAllPodsMap()[task.UID])
+	}
+	return scenario
+}
+
+func (s *drainScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return nil
+}
+
+func (s *drainScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+// updateScaleDownCandidates annotates the drained nodes as scale-down candidates, and removes the annotation from
+// nodes that run pods again.
+func updateScaleDownCandidates(ssn *framework.Session, drainedNodes map[string]bool) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, node := range ssn.Nodes {
+		annotated := framework.IsScaleDownCandidate(node)
+		switch {
+		case drainedNodes[node.Name] && !annotated:
+			log.InfraLogger.V(3).Infof("Marking node <%s> as a scale-down candidate", node.Name)
+			ssn.Cache.PatchNodeAnnotations(node.Node, map[string]any{commonconstants.NodeScaleDownCandidate: now})
+		case !drainedNodes[node.Name] && annotated && hasActivePods(node):
+			log.InfraLogger.V(3).Infof("Node <%s> is no longer a scale-down candidate", node.Name)
+			ssn.Cache.PatchNodeAnnotations(node.Node, map[string]any{commonconstants.NodeScaleDownCandidate: nil})
+		}
+	}
+}
+
+func hasActivePods(node *node_info.NodeInfo) bool {
+	for _, task := range node.PodInfos {
+		if !isDaemonSetPod(task) && pod_status.IsActiveAllocatedStatus(task.Status) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeconsolidation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/nodeconsolidation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+type nodeConsolidationTestMetadata struct {
+	test_utils.TestTopologyBasic
+	allowNodeConsolidation bool
+	maxPreemptees          int
+}
+
+func TestNodeConsolidation(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	testsMetadata := getTestsMetadata()
+
+	for testNumber, testMetadata := range testsMetadata {
+		fmt.Printf("Running test %d/%d: %s\n", testNumber, len(testsMetadata), testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		ssn.OverrideNodeConsolidation(testMetadata.allowNodeConsolidation, 0.25)
+		ssn.OverrideMaxNumberConsolidationPreemptees(testMetadata.maxPreemptees)
+		nodeconsolidation.New().Execute(ssn)
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func TestNodeConsolidationPlan(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name                   string
+		allowNodeConsolidation bool
+		requestPlan            bool
+		expectPlan             bool
+	}{
+		{
+			name: "dry run that was not requested",
+		},
+		{
+			name:        "requested dry run",
+			requestPlan: true,
+			expectPlan:  true,
+		},
+		{
+			name:                   "allowed node consolidation",
+			allowNodeConsolidation: true,
+			expectPlan:             true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A dry run plans the same moves without making them.
+			expectedNode, expectedStatus := "node0", pod_status.Running
+			if tt.allowNodeConsolidation {
+				expectedNode, expectedStatus = "node1", pod_status.Pipelined
+			}
+			topology := expectLightJob(
+				lightlyLoadedNodeTopology(tt.name, constants.PriorityTrainNumber, 1, 6),
+				expectedNode, expectedStatus)
+			ssn := test_utils.BuildSession(topology, controller)
+			ssn.OverrideNodeConsolidation(tt.allowNodeConsolidation, 0.25)
+			ssn.OverrideMaxNumberConsolidationPreemptees(-1)
+			ssn.RecordNodeConsolidationPlan(framework.NodeConsolidationPlan{})
+			if tt.requestPlan {
+				framework.RequestNodeConsolidationPlan()
+			}
+			nodeconsolidation.New().Execute(ssn)
+			test_utils.MatchExpectedAndRealTasks(t, 0, topology, ssn)
+
+			plan, found := framework.LastNodeConsolidationPlan()
+			assert.True(t, found)
+			if !tt.expectPlan {
+				assert.Empty(t, plan.EmptyNodes, "no plan is recorded")
+				return
+			}
+			assert.Equal(t, !tt.allowNodeConsolidation, plan.DryRun)
+			assert.Equal(t, []string{"node0"}, plan.EmptyNodes)
+			if assert.Len(t, plan.Migrations, 1) {
+				assert.Equal(t, "light_job-0", plan.Migrations[0].Name)
+				assert.Equal(t, "node0", plan.Migrations[0].FromNode)
+				assert.Equal(t, "node1", plan.Migrations[0].ToNode)
+			}
+			assert.False(t, framework.TakeNodeConsolidationPlanRequest(), "the request is taken by the run")
+		})
+	}
+}
+
+func TestNodeConsolidation_EmptyNodes(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	topology := expectLightJob(
+		lightlyLoadedNodeTopology("empty node", constants.PriorityTrainNumber, 1, 6), "node1", pod_status.Pipelined)
+	topology.Nodes["node2"] = nodes_fake.TestNodeBasic{GPUs: 8}
+	ssn := test_utils.BuildSession(topology, controller)
+	ssn.OverrideNodeConsolidation(true, 0.25)
+	ssn.OverrideMaxNumberConsolidationPreemptees(-1)
+	nodeconsolidation.New().Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 0, topology, ssn)
+
+	plan, found := framework.LastNodeConsolidationPlan()
+	assert.True(t, found)
+	assert.Equal(t, []string{"node2", "node0"}, plan.EmptyNodes,
+		"the empty node is a scale-down candidate, and is not a target of the drain")
+	if assert.Len(t, plan.Migrations, 1) {
+		assert.Equal(t, "node1", plan.Migrations[0].ToNode)
+	}
+}
+
+func TestNodeConsolidation_ScenarioValidators(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	topology := expectLightJob(
+		lightlyLoadedNodeTopology("drain rejected by a validator", constants.PriorityTrainNumber, 1, 6),
+		"node0", pod_status.Running)
+	ssn := test_utils.BuildSession(topology, controller)
+	ssn.OverrideNodeConsolidation(true, 0.25)
+	ssn.OverrideMaxNumberConsolidationPreemptees(-1)
+	var scenarios []api.ScenarioInfo
+	ssn.AddPreemptScenarioValidatorFn(func(scenario api.ScenarioInfo) bool {
+		scenarios = append(scenarios, scenario)
+		return false
+	})
+	nodeconsolidation.New().Execute(ssn)
+	test_utils.MatchExpectedAndRealTasks(t, 0, topology, ssn)
+
+	if assert.Len(t, scenarios, 1) {
+		assert.Nil(t, scenarios[0].GetPreemptor(), "a drain has no preemptor")
+		victims := scenarios[0].GetVictims()
+		if assert.Len(t, victims, 1) {
+			assert.Equal(t, "light_job", victims["light_job"].Job.Name)
+			assert.Len(t, victims["light_job"].Tasks, 1)
+		}
+	}
+	plan, found := framework.LastNodeConsolidationPlan()
+	assert.True(t, found)
+	assert.Empty(t, plan.Migrations)
+	assert.Empty(t, plan.EmptyNodes)
+}
+
+func lightlyLoadedNodeTopology(
+	name string, lightJobPriority int32, lightJobTasks int, heavyJobGPUs float64,
+) test_utils.TestTopologyBasic {
+	var lightJobTaskList []*tasks_fake.TestTaskBasic
+	for range lightJobTasks {
+		lightJobTaskList = append(lightJobTaskList, &tasks_fake.TestTaskBasic{
+			NodeName: "node0",
+			State:    pod_status.Running,
+		})
+	}
+	return test_utils.TestTopologyBasic{
+		Name: name,
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "light_job",
+				RequiredGPUsPerTask: 1,
+				Priority:            lightJobPriority,
+				QueueName:           "queue0",
+				Tasks:               lightJobTaskList,
+			},
+			{
+				Name:                "heavy_job",
+				RequiredGPUsPerTask: heavyJobGPUs,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node1",
+						State:    pod_status.Running,
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 8,
+			},
+			"node1": {
+				GPUs: 8,
+			},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{
+				Name:         "queue0",
+				DeservedGPUs: 16,
+			},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  lightJobTasks,
+				NumberOfPipelineActions: lightJobTasks,
+			},
+		},
+	}
+}
+
+func expectLightJob(topology test_utils.TestTopologyBasic, nodeName string, status pod_status.PodStatus,
+) test_utils.TestTopologyBasic {
+	topology.JobExpectedResults = map[string]test_utils.TestExpectedResultBasic{
+		"light_job": {
+			NodeName:     nodeName,
+			GPUsRequired: float64(len(topology.Jobs[0].Tasks)),
+			Status:       status,
+		},
+		"heavy_job": {
+			NodeName:     "node1",
+			GPUsRequired: topology.Jobs[1].RequiredGPUsPerTask,
+			Status:       pod_status.Running,
+		},
+	}
+	return topology
+}
+
+func getTestsMetadata() []nodeConsolidationTestMetadata {
+	return []nodeConsolidationTestMetadata{
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("lightly loaded node is drained", constants.PriorityTrainNumber, 1, 6),
+				"node1", pod_status.Pipelined),
+			allowNodeConsolidation: true,
+			maxPreemptees:          -1,
+		},
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("node consolidation is not allowed", constants.PriorityTrainNumber, 1, 6),
+				"node0", pod_status.Running),
+			maxPreemptees: -1,
+		},
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("consolidation is disabled", constants.PriorityTrainNumber, 1, 6),
+				"node0", pod_status.Running),
+			allowNodeConsolidation: true,
+		},
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("non preemptible pods are not moved", constants.PriorityBuildNumber, 1, 6),
+				"node0", pod_status.Running),
+			allowNodeConsolidation: true,
+			maxPreemptees:          -1,
+		},
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("node is not drained beyond the budget", constants.PriorityTrainNumber, 2, 6),
+				"node0", pod_status.Running),
+			allowNodeConsolidation: true,
+			maxPreemptees:          1,
+		},
+		{
+			TestTopologyBasic: expectLightJob(
+				lightlyLoadedNodeTopology("pods that do not fit elsewhere are not moved", constants.PriorityTrainNumber, 2, 7),
+				"node0", pod_status.Running),
+			allowNodeConsolidation: true,
+			maxPreemptees:          -1,
+		},
+	}
+}
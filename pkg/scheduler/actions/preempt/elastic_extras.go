@@ -0,0 +1,16 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// isElasticExtrasVictim returns whether the extras of the job, the tasks beyond its minimum available, may be
+// preempted for the preemptor regardless of the priorities of the jobs. Extras of elastic jobs with preemptible
+// extras are opportunistic, so any job of the queue that is still gathering its gang may take their resources. The
+// elastic plugin keeps the job at its minimum available.
+func isElasticExtrasVictim(preemptor, job *podgroup_info.PodGroupInfo) bool {
+	return job.ElasticExtrasPreemptible && job.HasElasticExtras() && !preemptor.IsGangSatisfied()
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+// isGangCompletionVictim returns whether the job may be preempted to complete the preemptor's gang. Two partially
+// placed gangs of the same priority can deadlock, each holding part of its resources, allocated or pipelined, while
+// waiting for the rest. To break the deadlock the gang closer to completion may preempt the other one, and between
+// gangs equally close to completion the first one in the job order may.
+func isGangCompletionVictim(ssn *framework.Session, preemptor, job *podgroup_info.PodGroupInfo) bool {
+	if !ssn.GangCompletionPreemption() || job.Priority != preemptor.Priority {
+		return false
+	}
+
+	preemptorCompletion := gangCompletion(preemptor)
+	jobCompletion := gangCompletion(job)
+	if !isPartiallyPlaced(preemptorCompletion) || !isPartiallyPlaced(jobCompletion) {
+		return false
+	}
+	if jobCompletion != preemptorCompletion {
+		return jobCompletion < preemptorCompletion
+	}
+	return ssn.JobOrderFn(preemptor, job)
+}
+
+// gangCompletion returns the fraction of the job's gang that is placed, counting allocated and pipelined tasks up to
+// the minimum available of each sub-group.
+func gangCompletion(job *podgroup_info.PodGroupInfo) float64 {
+	placed, required := 0, 0
+	for _, subGroup := range job.GetSubGroups() {
+		minAvailable := int(subGroup.GetMinAvailable())
+		placed += min(subGroup.GetNumActiveAllocatedTasks(), minAvailable)
+		required += minAvailable
+	}
+	if required == 0 {
+		return 1
+	}
+	return float64(placed) / float64(required)
+}
+
+func isPartiallyPlaced(completion float64) bool {
+	return completion > 0 && completion < 1
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -77,28 +78,53 @@ func (alloc *preemptAction) Execute(ssn *framework.Session) {
 			}
 		}
 
+		if job.IsPendingOnlyElasticExtras() {
+			log.InfraLogger.V(3).Infof(
+				"Skipping preemption for job: <%v/%v> - only its opportunistic elastic extras are pending",
+				job.Namespace, job.Name)
+			continue
+		}
+
 		metrics.IncPodgroupsConsideredByAction()
-		succeeded, statement, preemptedTasksNames := attemptToPreemptForPreemptor(ssn, job)
+		pendingTasks := maps.Values(job.PodStatusIndex[pod_status.Pending])
+		succeeded, statement, preemptedTasksNames, vetoedTasksNames := attemptToPreemptForPreemptor(ssn, job)
 		if succeeded {
+			common.AnnotateWouldPreempt(ssn, pendingTasks, preemptedTasksNames)
 			metrics.RegisterPreemptionAttempts()
 			metrics.IncPodgroupScheduledByAction()
 			log.InfraLogger.V(3).Infof(
 				"Successfully preempted for job <%s/%s>, preempted tasks: <%v>",
 				job.Namespace, job.Name, preemptedTasksNames)
+			for _, task := range pendingTasks {
+				framework.TraceLogf(task, "preempted pods <%v> to place the pod group", preemptedTasksNames)
+			}
 			if err := statement.Commit(); err != nil {
 				log.InfraLogger.Errorf("Failed to commit preemption statement: %v", err)
 			}
+		} else if len(vetoedTasksNames) > 0 {
+			log.InfraLogger.V(3).Infof(
+				"Preemption for job <%s/%s> was rejected by the scenario validators, it would preempt tasks: <%v>",
+				job.Namespace, job.Name, vetoedTasksNames)
+			framework.TraceLogJobf(job, "preempting pods <%v> would place the pod group, but it was not allowed",
+				vetoedTasksNames)
+			common.AnnotateWouldPreempt(ssn, pendingTasks, vetoedTasksNames)
+			smallestFailedJobs.UpdateRepresentative(job)
 		} else {
 			log.InfraLogger.V(3).Infof("Didn't find a preemption strategy for job <%s/%s>",
 				job.Namespace, job.Name)
+			common.ClearWouldPreempt(ssn, job)
+			framework.TraceLogJobf(job, "no pods of the queue can be preempted to place the pod group")
 			smallestFailedJobs.UpdateRepresentative(job)
 		}
 	}
 }
 
+// attemptToPreemptForPreemptor returns whether the preemptor was placed by preempting, with the statement and the
+// names of the preempted tasks, and otherwise the names of the tasks whose preemption would place it but was rejected
+// by the scenario validators.
 func attemptToPreemptForPreemptor(
 	ssn *framework.Session, preemptor *podgroup_info.PodGroupInfo,
-) (bool, *framework.Statement, []string) {
+) (bool, *framework.Statement, []string, []string) {
 	resReq := podgroup_info.GetTasksToAllocateInitResource(preemptor, ssn.SubGroupOrderFn, ssn.TaskOrderFn, false)
 	log.InfraLogger.V(3).Infof(
 		"Attempting to preempt for job: <%v/%v>, priority: <%v>, queue: <%v>, resources: <%v>",
@@ -108,7 +134,8 @@ func attemptToPreemptForPreemptor(
 	if result := ssn.IsNonPreemptibleJobOverQueueQuotaFn(preemptor, preemptorTasks); !result.IsSchedulable {
 		log.InfraLogger.V(3).Infof("Job <%v/%v> would have placed the queue resources over quota",
 			preemptor.Namespace, preemptor.Name)
-		return false, nil, nil
+		framework.TraceLogJobf(preemptor, "not preempting, the pod group would place its queue over quota")
+		return false, nil, nil, nil
 	}
 
 	feasibleNodes := common.FeasibleNodesForJob(maps.Values(ssn.Nodes), preemptor)
@@ -118,16 +145,18 @@ func attemptToPreemptForPreemptor(
 		getOrderedVictimsQueue(ssn, preemptor),
 		framework.Preempt,
 	)
-	return solver.Solve(ssn, preemptor)
+	succeeded, statement, preemptedTasksNames := solver.Solve(ssn, preemptor)
+	return succeeded, statement, preemptedTasksNames, solver.VetoedVictimNames()
 }
 
 func buildFilterFuncForPreempt(ssn *framework.Session, preemptor *podgroup_info.PodGroupInfo) func(*podgroup_info.PodGroupInfo) bool {
 	return func(job *podgroup_info.PodGroupInfo) bool {
-		if !job.IsPreemptibleJob() {
+		elasticExtrasVictim := isElasticExtrasVictim(preemptor, job)
+		if !job.IsPreemptibleJob() && !elasticExtrasVictim {
 			return false
 		}
 
-		if job.Priority >= preemptor.Priority {
+		if job.Priority >= preemptor.Priority && !isGangCompletionVictim(ssn, preemptor, job) && !elasticExtrasVictim {
 			return false
 		}
 
@@ -155,7 +184,7 @@ func buildFilterFuncForPreempt(ssn *framework.Session, preemptor *podgroup_info.
 func getOrderedVictimsQueue(ssn *framework.Session, preemptor *podgroup_info.PodGroupInfo) solvers.GenerateVictimsQueue {
 	return func() *utils.JobsOrderByQueues {
 		filter := buildFilterFuncForPreempt(ssn, preemptor)
-		victimsQueue := utils.GetVictimsQueue(ssn, filter)
+		victimsQueue := utils.GetQueueVictimsQueue(ssn, preemptor.Queue, filter)
 		return victimsQueue
 	}
 }
@@ -0,0 +1,465 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+type gangCompletionTestMetadata struct {
+	integration_tests_utils.TestTopologyMetadata
+	gangCompletionPreemption bool
+}
+
+func TestHandleGangCompletionPreempt(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	testsMetadata := getGangCompletionTestsMetadata()
+	for testNumber, testMetadata := range testsMetadata {
+		t.Logf("Running Test %d: %s", testNumber, testMetadata.TestTopologyBasic.Name)
+
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		ssn.OverrideGangCompletionPreemption(testMetadata.gangCompletionPreemption)
+		preemptAction := preempt.New()
+		preemptAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getGangCompletionTestsMetadata() []gangCompletionTestMetadata {
+	return []gangCompletionTestMetadata{
+		{
+			gangCompletionPreemption: false,
+			TestTopologyMetadata: integration_tests_utils.TestTopologyMetadata{
+				TestTopologyBasic: test_utils.TestTopologyBasic{
+					Name: "Two partially placed gangs deadlock without gang completion preemption",
+					Jobs: []*jobs_fake.TestJobBasic{
+						{
+							Name:                "gang_a",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+						{
+							Name:                "gang_b",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+					},
+					Nodes: map[string]nodes_fake.TestNodeBasic{
+						"node0": {
+							GPUs: 4,
+						},
+					},
+					Queues: []test_utils.TestQueueBasic{
+						{
+							Name:         "queue0",
+							DeservedGPUs: 4,
+						},
+					},
+					TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+						"gang_a-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-2": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_a-3": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_b-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_b-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_b-2": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_b-3": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+					},
+					Mocks: &test_utils.TestMock{
+						CacheRequirements: &test_utils.CacheMocking{},
+					},
+				},
+			},
+		},
+		{
+			gangCompletionPreemption: true,
+			TestTopologyMetadata: integration_tests_utils.TestTopologyMetadata{
+				TestTopologyBasic: test_utils.TestTopologyBasic{
+					Name: "The first of two equally placed gangs completes by preempting the other",
+					Jobs: []*jobs_fake.TestJobBasic{
+						{
+							Name:                "gang_a",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+						{
+							Name:                "gang_b",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+					},
+					Nodes: map[string]nodes_fake.TestNodeBasic{
+						"node0": {
+							GPUs: 4,
+						},
+					},
+					Queues: []test_utils.TestQueueBasic{
+						{
+							Name:         "queue0",
+							DeservedGPUs: 4,
+						},
+					},
+					TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+						"gang_a-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-2": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Pipelined,
+						},
+						"gang_a-3": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Pipelined,
+						},
+						"gang_b-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Releasing,
+						},
+						"gang_b-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Releasing,
+						},
+						"gang_b-2": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_b-3": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+					},
+					Mocks: &test_utils.TestMock{
+						CacheRequirements: &test_utils.CacheMocking{
+							NumberOfCacheEvictions:  2,
+							NumberOfPipelineActions: 2,
+						},
+					},
+				},
+			},
+		},
+		{
+			gangCompletionPreemption: true,
+			TestTopologyMetadata: integration_tests_utils.TestTopologyMetadata{
+				TestTopologyBasic: test_utils.TestTopologyBasic{
+					Name: "The gang closer to completion preempts the other gang",
+					Jobs: []*jobs_fake.TestJobBasic{
+						{
+							Name:                "gang_a",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+						{
+							Name:                "gang_b",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+					},
+					Nodes: map[string]nodes_fake.TestNodeBasic{
+						"node0": {
+							GPUs: 4,
+						},
+					},
+					Queues: []test_utils.TestQueueBasic{
+						{
+							Name:         "queue0",
+							DeservedGPUs: 4,
+						},
+					},
+					TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+						"gang_a-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Releasing,
+						},
+						"gang_a-1": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_a-2": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_a-3": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_b-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_b-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_b-2": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_b-3": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Pipelined,
+						},
+					},
+					Mocks: &test_utils.TestMock{
+						CacheRequirements: &test_utils.CacheMocking{
+							NumberOfCacheEvictions:  1,
+							NumberOfPipelineActions: 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			gangCompletionPreemption: true,
+			TestTopologyMetadata: integration_tests_utils.TestTopologyMetadata{
+				TestTopologyBasic: test_utils.TestTopologyBasic{
+					Name: "Complete jobs are not preempted to complete a gang",
+					Jobs: []*jobs_fake.TestJobBasic{
+						{
+							Name:                "running_job",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+							},
+						},
+						{
+							Name:                "gang_a",
+							RequiredGPUsPerTask: 1,
+							Priority:            constants.PriorityTrainNumber,
+							QueueName:           "queue0",
+							Tasks: []*tasks_fake.TestTaskBasic{
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									NodeName: "node0",
+									State:    pod_status.Running,
+								},
+								{
+									State: pod_status.Pending,
+								},
+								{
+									State: pod_status.Pending,
+								},
+							},
+						},
+					},
+					Nodes: map[string]nodes_fake.TestNodeBasic{
+						"node0": {
+							GPUs: 4,
+						},
+					},
+					Queues: []test_utils.TestQueueBasic{
+						{
+							Name:         "queue0",
+							DeservedGPUs: 4,
+						},
+					},
+					TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+						"running_job-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"running_job-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-0": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-1": {
+							NodeName:     "node0",
+							GPUsRequired: 1,
+							Status:       pod_status.Running,
+						},
+						"gang_a-2": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+						"gang_a-3": {
+							GPUsRequired: 1,
+							Status:       pod_status.Pending,
+						},
+					},
+					Mocks: &test_utils.TestMock{
+						CacheRequirements: &test_utils.CacheMocking{},
+					},
+				},
+			},
+		},
+	}
+}
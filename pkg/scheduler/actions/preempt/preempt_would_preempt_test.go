@@ -0,0 +1,132 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+// annotationsRecorder records the annotation patches of the tasks, by task name.
+type annotationsRecorder struct {
+	cache.Cache
+	patches map[string]map[string]any
+}
+
+func (r *annotationsRecorder) PatchTaskAnnotations(task *pod_info.PodInfo, annotations map[string]any) {
+	r.patches[task.Name] = annotations
+}
+
+func TestPreemptWouldPreemptAnnotation(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name               string
+		rejectScenarios    bool
+		notPreemptible     bool
+		existingAnnotation string
+		expectedPatch      map[string]any
+		expectedPreemptor  pod_status.PodStatus
+	}{
+		{
+			name:              "preemption rejected by the scenario validators",
+			rejectScenarios:   true,
+			expectedPatch:     map[string]any{commonconstants.WouldPreemptAnnotation: "/low_job-0"},
+			expectedPreemptor: pod_status.Pending,
+		},
+		{
+			name:               "preemption rejected for the same victims",
+			rejectScenarios:    true,
+			existingAnnotation: "/low_job-0",
+			expectedPreemptor:  pod_status.Pending,
+		},
+		{
+			name:               "no victims to preempt",
+			notPreemptible:     true,
+			existingAnnotation: "/low_job-0",
+			expectedPatch:      map[string]any{commonconstants.WouldPreemptAnnotation: nil},
+			expectedPreemptor:  pod_status.Pending,
+		},
+		{
+			name:              "preemption allowed",
+			expectedPatch:     map[string]any{commonconstants.WouldPreemptAnnotation: "/low_job-0"},
+			expectedPreemptor: pod_status.Pipelined,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			victimPriority := int32(constants.PriorityTrainNumber)
+			if tt.notPreemptible {
+				victimPriority = constants.PriorityBuildNumber
+			}
+			topology := test_utils.TestTopologyBasic{
+				Name: tt.name,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "low_job",
+						RequiredGPUsPerTask: 1,
+						Priority:            victimPriority,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{NodeName: "node0", State: pod_status.Running},
+						},
+					},
+					{
+						Name:                "high_job",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityBuildNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{State: pod_status.Pending},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 1},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{Name: "queue0", DeservedGPUs: 1},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			}
+
+			ssn := test_utils.BuildSession(topology, controller)
+			recorder := &annotationsRecorder{Cache: ssn.Cache, patches: map[string]map[string]any{}}
+			ssn.Cache = recorder
+			ssn.AddPreemptScenarioValidatorFn(func(api.ScenarioInfo) bool { return !tt.rejectScenarios })
+			preemptor := ssn.PodGroupInfos["high_job"].GetAllPodsMap()["high_job-0"]
+			if tt.existingAnnotation != "" {
+				preemptor.Pod.Annotations[commonconstants.WouldPreemptAnnotation] = tt.existingAnnotation
+			}
+
+			preempt.New().Execute(ssn)
+
+			preemptor = ssn.PodGroupInfos["high_job"].GetAllPodsMap()["high_job-0"]
+			assert.Equal(t, tt.expectedPreemptor, preemptor.Status)
+			assert.Equal(t, tt.expectedPatch, recorder.patches["high_job-0"])
+		})
+	}
+}
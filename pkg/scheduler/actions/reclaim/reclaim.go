@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common/solvers"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -65,6 +66,12 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 		if !ssn.CanReclaimResources(job) {
 			continue
 		}
+		if job.IsPendingOnlyElasticExtras() {
+			log.InfraLogger.V(3).Infof(
+				"Skipping reclaim for job: <%v/%v> - only its opportunistic elastic extras are pending",
+				job.Namespace, job.Name)
+			continue
+		}
 
 		smallestFailedJobs, found := smallestFailedJobsByQueue[job.Queue]
 		if !found {
@@ -81,6 +88,7 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 			}
 		}
 		metrics.IncPodgroupsConsideredByAction()
+		pendingTasks := maps.Values(job.PodStatusIndex[pod_status.Pending])
 		succeeded, statement, reclaimeeTasksNames := ra.attemptToReclaimForSpecificJob(ssn, job)
 		if succeeded {
 			metrics.IncPodgroupScheduledByAction()
@@ -88,12 +96,17 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 				"Reclaimed resources for job <%s/%s>, evicting reclaimee tasks: <%v>.",
 				job.Namespace, job.Name, reclaimeeTasksNames,
 			)
+			for _, task := range pendingTasks {
+				framework.TraceLogf(task, "reclaimed pods <%v> of other queues to place the pod group",
+					reclaimeeTasksNames)
+			}
 			if err := statement.Commit(); err != nil {
 				log.InfraLogger.Errorf("Failed to commit reclaim statement: %v", err)
 			}
 		} else {
 			log.InfraLogger.V(3).Infof("Didn't find a reclaim strategy for job <%s/%s>",
 				job.Namespace, job.Name)
+			framework.TraceLogJobf(job, "no pods of other queues can be reclaimed to place the pod group")
 			smallestFailedJobs.UpdateRepresentative(job)
 		}
 	}
@@ -110,6 +123,9 @@ func (ra *reclaimAction) attemptToReclaimForSpecificJob(
 	ssn.OnJobSolutionStart()
 
 	feasibleNodes := common.FeasibleNodesForJob(maps.Values(ssn.Nodes), reclaimer)
+	if succeeded, statement, shrunkTasksNames := attemptToReclaimByShrinking(ssn, reclaimer, feasibleNodes); succeeded {
+		return true, statement, shrunkTasksNames
+	}
 	solver := solvers.NewJobsSolver(
 		feasibleNodes,
 		ssn.ReclaimScenarioValidatorFn,
@@ -118,6 +134,16 @@ func (ra *reclaimAction) attemptToReclaimForSpecificJob(
 	return solver.Solve(ssn, reclaimer)
 }
 
+// SolveReclaim searches for a reclaim scenario for the reclaimer without committing it. It is registered as the
+// session's reclaim solver and backs Session.SimulateReclaim.
+func SolveReclaim(ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo) (bool, *framework.Statement) {
+	if !ssn.CanReclaimResources(reclaimer) {
+		return false, nil
+	}
+	succeeded, statement, _ := New().attemptToReclaimForSpecificJob(ssn, reclaimer)
+	return succeeded, statement
+}
+
 func getOrderedVictimsQueue(ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo) solvers.GenerateVictimsQueue {
 	return func() *utils.JobsOrderByQueues {
 		jobsOrderedByQueue := utils.NewJobsOrderByQueues(ssn, utils.JobsOrderInitOptions{
@@ -125,6 +151,8 @@ func getOrderedVictimsQueue(ssn *framework.Session, reclaimer *podgroup_info.Pod
 			FilterNonActiveAllocated: true,
 			VictimQueue:              true,
 			MaxJobsQueueDepth:        scheduler_util.QueueCapacityInfinite,
+
+			IncludeCrossPartitionJobs: ssn.CrossPartitionReclaimEnabled(),
 		})
 		jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
 		for _, job := range ssn.PodGroupInfos {
@@ -134,6 +162,10 @@ func getOrderedVictimsQueue(ssn *framework.Session, reclaimer *podgroup_info.Pod
 			if !ssn.ReclaimVictimFilter(reclaimer, job) {
 				continue
 			}
+			if ssn.IsCrossPartitionJob(job) {
+				log.InfraLogger.V(3).Infof("Considering job <%s> of another node pool as a reclaim victim for job <%s>",
+					job.NamespacedName, reclaimer.NamespacedName)
+			}
 			jobs[job.UID] = job
 		}
 
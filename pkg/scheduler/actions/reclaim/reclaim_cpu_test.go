@@ -0,0 +1,226 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestCpuReclaim(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	defer gock.Off()
+	testsMetadata := getCpuReclaimTestsMetadata()
+
+	for testNumber, testMetadata := range testsMetadata {
+		t.Logf("Running test number: %v, test name: %v,", testNumber, testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		reclaimAction := reclaim.New()
+		reclaimAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getCpuReclaimTestsMetadata() []integration_tests_utils.TestTopologyMetadata {
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "CPU only job reclaims CPU from an over quota queue on a GPU node",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                  "running_gpu_job0",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredGPUsPerTask:   1,
+						RequiredCPUsPerTask:   500,
+						RequiredMemoryPerTask: 500 * 10e6,
+						QueueName:             "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                  "running_cpu_job0",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredCPUsPerTask:   3000,
+						RequiredMemoryPerTask: 1000 * 10e6,
+						QueueName:             "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                  "pending_cpu_job1",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredCPUsPerTask:   2000,
+						RequiredMemoryPerTask: 1000 * 10e6,
+						QueueName:             "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs:      2,
+						CPUMillis: 4000,
+						CPUMemory: 4000 * 10e6,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:           "queue0",
+						DeservedGPUs:   2,
+						DeservedCPUs:   test_utils.CreateFloat64Pointer(1000),
+						DeservedMemory: test_utils.CreateFloat64Pointer(2000),
+					},
+					{
+						Name:           "queue1",
+						DeservedGPUs:   0,
+						DeservedCPUs:   test_utils.CreateFloat64Pointer(3000),
+						DeservedMemory: test_utils.CreateFloat64Pointer(2000),
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_gpu_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"running_cpu_job0": {
+						NodeName: "node0",
+						Status:   pod_status.Releasing,
+					},
+					"pending_cpu_job1": {
+						NodeName: "node0",
+						Status:   pod_status.Pipelined,
+					},
+				},
+				ExpectedNodesResources: map[string]test_utils.TestExpectedNodesResources{
+					"node0": {
+						IdleGPUs:      1,
+						ReleasingGPUs: 0,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "CPU only job does not reclaim from a queue within its deserved CPU on a GPU node",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                  "running_gpu_job0",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredGPUsPerTask:   1,
+						RequiredCPUsPerTask:   500,
+						RequiredMemoryPerTask: 500 * 10e6,
+						QueueName:             "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                  "running_cpu_job0",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredCPUsPerTask:   3000,
+						RequiredMemoryPerTask: 1000 * 10e6,
+						QueueName:             "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                  "pending_cpu_job1",
+						Priority:              constants.PriorityTrainNumber,
+						RequiredCPUsPerTask:   2000,
+						RequiredMemoryPerTask: 1000 * 10e6,
+						QueueName:             "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs:      2,
+						CPUMillis: 4000,
+						CPUMemory: 4000 * 10e6,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:           "queue0",
+						DeservedGPUs:   2,
+						DeservedCPUs:   test_utils.CreateFloat64Pointer(3500),
+						DeservedMemory: test_utils.CreateFloat64Pointer(2000),
+					},
+					{
+						Name:           "queue1",
+						DeservedGPUs:   0,
+						DeservedCPUs:   test_utils.CreateFloat64Pointer(500),
+						DeservedMemory: test_utils.CreateFloat64Pointer(2000),
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_gpu_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+					},
+					"running_cpu_job0": {
+						NodeName: "node0",
+						Status:   pod_status.Running,
+					},
+					"pending_cpu_job1": {
+						Status: pod_status.Pending,
+					},
+				},
+				ExpectedNodesResources: map[string]test_utils.TestExpectedNodesResources{
+					"node0": {
+						IdleGPUs:      1,
+						ReleasingGPUs: 0,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestReclaimByShrinking(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name                 string
+		tenantMinGpuMemory   string
+		rejectScenarios      bool
+		expectedTenantStatus pod_status.PodStatus
+		expectedTenantMemory int64
+		expectedReclaimer    pod_status.PodStatus
+	}{
+		{
+			name:                 "best-effort tenant is shrunk instead of evicted",
+			tenantMinGpuMemory:   "250",
+			expectedTenantStatus: pod_status.Running,
+			expectedTenantMemory: 500,
+			expectedReclaimer:    pod_status.Pipelined,
+		},
+		{
+			name:                 "tenant is not shrunk when the scenario validators reject it",
+			tenantMinGpuMemory:   "250",
+			rejectScenarios:      true,
+			expectedTenantStatus: pod_status.Running,
+			expectedTenantMemory: 750,
+			expectedReclaimer:    pod_status.Pending,
+		},
+		{
+			name:                 "tenant is evicted when shrinking does not free enough memory",
+			tenantMinGpuMemory:   "700",
+			expectedTenantStatus: pod_status.Releasing,
+			expectedTenantMemory: 750,
+			expectedReclaimer:    pod_status.Pipelined,
+		},
+		{
+			name:                 "tenant without a minimal GPU memory is evicted",
+			expectedTenantStatus: pod_status.Releasing,
+			expectedTenantMemory: 750,
+			expectedReclaimer:    pod_status.Pipelined,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantAnnotations := map[string]string{}
+			if tt.tenantMinGpuMemory != "" {
+				tenantAnnotations[commonconstants.MinGpuMemory] = tt.tenantMinGpuMemory
+			}
+			topology := test_utils.TestTopologyBasic{
+				Name: tt.name,
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "q0_tenant",
+						RequiredGPUsPerTask: 0.75,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName:    "node0",
+								State:       pod_status.Running,
+								GPUGroups:   []string{"0"},
+								Annotations: tenantAnnotations,
+							},
+						},
+					},
+					{
+						Name:                "q1_reclaimer",
+						RequiredGPUsPerTask: 0.5,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs:      1,
+						GPUMemory: 1000,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 0.5,
+					},
+					{
+						Name:         "queue1",
+						DeservedGPUs: 0.5,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds:      1,
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			}
+
+			ssn := test_utils.BuildSession(topology, controller)
+			var freedGPUs []float64
+			ssn.AddReclaimScenarioValidatorFn(func(scenario api.ScenarioInfo) bool {
+				for _, victim := range scenario.GetVictims() {
+					for _, task := range victim.Tasks {
+						freedGPUs = append(freedGPUs, task.AcceptedResource.GPUs())
+					}
+				}
+				return !tt.rejectScenarios
+			})
+			reclaim.New().Execute(ssn)
+
+			node := ssn.Nodes["node0"]
+			tenant := ssn.PodGroupInfos["q0_tenant"].GetAllPodsMap()["q0_tenant-0"]
+			reclaimer := ssn.PodGroupInfos["q1_reclaimer"].GetAllPodsMap()["q1_reclaimer-0"]
+			assert.Equal(t, tt.expectedTenantStatus, tenant.Status)
+			assert.Equal(t, tt.expectedTenantMemory, node.GetResourceGpuMemory(tenant.ResReq))
+			assert.Equal(t, tt.expectedReclaimer, reclaimer.Status)
+			if tt.expectedReclaimer == pod_status.Pipelined && tt.expectedTenantStatus == pod_status.Running {
+				assert.Equal(t, tenant.GPUGroups, reclaimer.GPUGroups)
+				assert.Equal(t, []float64{0.25}, freedGPUs, "the scenario holds the memory freed by the tenant")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSimulateReclaim(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	framework.RegisterReclaimSolver(reclaim.SolveReclaim)
+
+	// Same topology as the fair share reclaim scenario, without any cache expectations: a simulation must not
+	// evict, bind or pipeline anything.
+	topology := getTestsMetadata()[0].TestTopologyBasic
+	topology.Mocks = nil
+	ssn := test_utils.BuildSession(topology, controller)
+
+	reclaimer := ssn.PodGroupInfos["reclaimer"]
+	var preemptor *pod_info.PodInfo
+	for _, task := range reclaimer.PodStatusIndex[pod_status.Pending] {
+		preemptor = task
+		break
+	}
+
+	plan, err := ssn.SimulateReclaim(preemptor)
+	assert.NoError(t, err)
+	assert.True(t, plan.Fits)
+	assert.Equal(t, "node0", plan.NodeName)
+	assert.Len(t, plan.Victims, 2)
+	for _, victim := range plan.Victims {
+		assert.Contains(t, []string{"q0_running_job2", "q1_running_job2"}, string(victim.Job))
+	}
+	assert.Equal(t, float64(2), plan.FreedResourcesPerNode["node0"].GPUs())
+
+	// The session is left untouched
+	for _, job := range ssn.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if job.UID == "reclaimer" {
+				assert.Equal(t, pod_status.Pending, task.Status)
+			} else {
+				assert.Equal(t, pod_status.Running, task.Status)
+			}
+		}
+	}
+	assert.Equal(t, float64(0), ssn.Nodes["node0"].Releasing.GPUs())
+}
+
+func TestSimulateReclaim_Shrink(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	framework.RegisterReclaimSolver(reclaim.SolveReclaim)
+
+	topology := test_utils.TestTopologyBasic{
+		Name: "shrink simulation",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "q0_tenant",
+				RequiredGPUsPerTask: 0.75,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName:    "node0",
+						State:       pod_status.Running,
+						GPUGroups:   []string{"0"},
+						Annotations: map[string]string{commonconstants.MinGpuMemory: "250"},
+					},
+				},
+			},
+			{
+				Name:                "q1_reclaimer",
+				RequiredGPUsPerTask: 0.5,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue1",
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 1, GPUMemory: 1000},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 0.5},
+			{Name: "queue1", DeservedGPUs: 0.5},
+		},
+	}
+	ssn := test_utils.BuildSession(topology, controller)
+
+	tenant := ssn.PodGroupInfos["q0_tenant"].GetAllPodsMap()["q0_tenant-0"]
+	preemptor := ssn.PodGroupInfos["q1_reclaimer"].GetAllPodsMap()["q1_reclaimer-0"]
+	plan, err := ssn.SimulateReclaim(preemptor)
+	assert.NoError(t, err)
+	assert.True(t, plan.Fits)
+	assert.Equal(t, "node0", plan.NodeName)
+	assert.Equal(t, []string{"0"}, plan.GPUGroups)
+	assert.Empty(t, plan.Victims)
+	assert.Equal(t, []framework.ShrunkTask{{Task: tenant, GpuMemory: 500}}, plan.Shrunk)
+	assert.InDelta(t, 0.25, plan.FreedResourcesPerNode["node0"].GPUs(), 0.001)
+
+	// The tenant keeps its GPU memory in the session
+	assert.Equal(t, pod_status.Running, tenant.Status)
+	assert.Equal(t, int64(750), ssn.Nodes["node0"].GetResourceGpuMemory(tenant.ResReq))
+	assert.Equal(t, pod_status.Pending, preemptor.Status)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	"gopkg.in/h2non/gock.v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+// TestReclaimVictimOrder reclaims from a queue with two running jobs of the same priority: early_submitted_job was
+// submitted before late_submitted_job, but started after it.
+func TestReclaimVictimOrder(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	defer gock.Off()
+
+	tests := []struct {
+		order          conf.VictimOrder
+		reclaimedJobID string
+	}{
+		{order: conf.VictimOrderDefault, reclaimedJobID: "late_submitted_job"},
+		{order: conf.VictimOrderNewestSubmittedFirst, reclaimedJobID: "late_submitted_job"},
+		{order: conf.VictimOrderOldestSubmittedFirst, reclaimedJobID: "early_submitted_job"},
+		{order: conf.VictimOrderNewestStartedFirst, reclaimedJobID: "early_submitted_job"},
+		{order: conf.VictimOrderOldestStartedFirst, reclaimedJobID: "late_submitted_job"},
+	}
+
+	for testNumber, tt := range tests {
+		t.Logf("Running test number: %v, victim order: %v", testNumber, tt.order)
+		topology := getVictimOrderTestTopology(tt.reclaimedJobID)
+		ssn := test_utils.BuildSession(topology, controller)
+		ssn.SchedulerParams.VictimOrder = tt.order
+
+		now := time.Now()
+		setJobTimes(ssn.PodGroupInfos["early_submitted_job"], now.Add(-3*time.Hour), now.Add(-10*time.Minute))
+		setJobTimes(ssn.PodGroupInfos["late_submitted_job"], now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+		reclaimAction := reclaim.New()
+		reclaimAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, topology, ssn)
+	}
+}
+
+func setJobTimes(job *podgroup_info.PodGroupInfo, submitted, started time.Time) {
+	job.CreationTimestamp = metav1.Time{Time: submitted}
+	job.LastStartTimestamp = &started
+}
+
+func getVictimOrderTestTopology(reclaimedJobID string) test_utils.TestTopologyBasic {
+	expectedResults := map[string]test_utils.TestExpectedResultBasic{
+		"early_submitted_job": {NodeName: "node0", GPUsRequired: 1, Status: pod_status.Running},
+		"late_submitted_job":  {NodeName: "node0", GPUsRequired: 1, Status: pod_status.Running},
+		"pending_job":         {NodeName: "node0", GPUsRequired: 1, Status: pod_status.Pipelined},
+	}
+	expectedResults[reclaimedJobID] = test_utils.TestExpectedResultBasic{
+		NodeName: "node0", GPUsRequired: 1, Status: pod_status.Releasing,
+	}
+
+	return test_utils.TestTopologyBasic{
+		Name: "reclaim victim order",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "early_submitted_job",
+				Priority:            constants.PriorityTrainNumber,
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Tasks:               []*tasks_fake.TestTaskBasic{{NodeName: "node0", State: pod_status.Running}},
+			},
+			{
+				Name:                "late_submitted_job",
+				Priority:            constants.PriorityTrainNumber,
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Tasks:               []*tasks_fake.TestTaskBasic{{NodeName: "node0", State: pod_status.Running}},
+			},
+			{
+				Name:                "pending_job",
+				Priority:            constants.PriorityTrainNumber,
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue1",
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 2},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 0},
+			{Name: "queue1", DeservedGPUs: 1},
+		},
+		JobExpectedResults: expectedResults,
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  1,
+				NumberOfPipelineActions: 1,
+			},
+		},
+	}
+}
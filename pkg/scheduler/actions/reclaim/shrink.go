@@ -0,0 +1,252 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+type shrinkableTenant struct {
+	task      *pod_info.PodInfo
+	job       *podgroup_info.PodGroupInfo
+	minMemory int64
+	headroom  int64
+}
+
+// shrinkScenario is the reclaim scenario of a reclaimer that takes GPU memory from shrunk tenants. The victims hold
+// the GPU memory freed by each tenant rather than the tenants' full resources, since the tenants keep running.
+type shrinkScenario struct {
+	reclaimer *podgroup_info.PodGroupInfo
+	victims   map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (s *shrinkScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return s.reclaimer
+}
+
+func (s *shrinkScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+func (s *shrinkScenario) addFreedMemory(tenant *shrinkableTenant, node *node_info.NodeInfo, freedMemory int64) {
+	freed := tenant.task.Clone()
+	freed.ResReq = resource_info.EmptyResourceRequirements()
+	freed.ResReq.GpuResourceRequirement = *resource_info.NewGpuResourceRequirementWithGpus(
+		float64(freedMemory)/float64(node.MemoryOfEveryGpuOnNode), 0)
+	freed.AcceptedResource = freed.ResReq.Clone()
+
+	victim, found := s.victims[tenant.job.UID]
+	if !found {
+		victim = &api.VictimInfo{Job: tenant.job}
+		s.victims[tenant.job.UID] = victim
+	}
+	victim.Tasks = append(victim.Tasks, freed)
+}
+
+// attemptToReclaimByShrinking tries to make room for a GPU sharing reclaimer by shrinking the GPU memory of
+// best-effort tenants from other queues, instead of evicting them. It succeeds only if every task of the reclaimer
+// can be pipelined to a GPU once its tenants are shrunk, and the reclaim scenario validators accept taking the freed
+// memory from the tenants' queues. The reclaimer is pipelined rather than allocated, since the tenants release the
+// memory only once they apply their new allotment.
+func attemptToReclaimByShrinking(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo, feasibleNodes []*node_info.NodeInfo,
+) (bool, *framework.Statement, []string) {
+	tasks := podgroup_info.GetTasksToAllocate(reclaimer, ssn.SubGroupOrderFn, ssn.TaskOrderFn, false)
+	if len(tasks) == 0 {
+		return false, nil, nil
+	}
+	for _, task := range tasks {
+		if !task.IsSharedGPURequest() || task.ResReq.GetNumOfGpuDevices() > 1 {
+			return false, nil, nil
+		}
+	}
+	if result := ssn.IsJobOverQueueCapacityFn(reclaimer, tasks); !result.IsSchedulable {
+		return false, nil, nil
+	}
+
+	stmt := ssn.Statement()
+	scenario := &shrinkScenario{reclaimer: reclaimer, victims: map[common_info.PodGroupID]*api.VictimInfo{}}
+	var shrunkTasksNames []string
+	for _, task := range tasks {
+		shrunk, found := shrinkTenantsForTask(ssn, stmt, scenario, task, feasibleNodes)
+		if !found {
+			stmt.Discard()
+			return false, nil, nil
+		}
+		shrunkTasksNames = append(shrunkTasksNames, shrunk...)
+	}
+	if len(shrunkTasksNames) == 0 {
+		stmt.Discard()
+		return false, nil, nil
+	}
+
+	if !ssn.ReclaimScenarioValidatorFn(scenario) {
+		log.InfraLogger.V(4).Infof("Reclaiming GPU memory from tenants <%v> for job <%s/%s> was rejected",
+			shrunkTasksNames, reclaimer.Namespace, reclaimer.Name)
+		stmt.Discard()
+		return false, nil, nil
+	}
+	return true, stmt, shrunkTasksNames
+}
+
+// shrinkTenantsForTask finds a GPU that can hold the task once its best-effort tenants are shrunk, shrinks them and
+// pipelines the task to the GPU. The memory freed from each tenant is added to the scenario.
+func shrinkTenantsForTask(
+	ssn *framework.Session, stmt *framework.Statement, scenario *shrinkScenario, task *pod_info.PodInfo,
+	feasibleNodes []*node_info.NodeInfo,
+) ([]string, bool) {
+	if err := ssn.PrePredicateFn(task, scenario.reclaimer); err != nil {
+		return nil, false
+	}
+	for _, node := range ssn.OrderedNodesByTask(feasibleNodes, task) {
+		tenantsByGroup := shrinkableTenantsByGpuGroup(ssn, scenario.reclaimer, node)
+		groups := make([]string, 0, len(node.UsedSharedGPUsMemory))
+		for gpuGroup := range node.UsedSharedGPUsMemory {
+			groups = append(groups, gpuGroup)
+		}
+		slices.Sort(groups)
+
+		requestedMemory := node.GetResourceGpuMemory(task.ResReq)
+		for _, gpuGroup := range groups {
+			missingMemory := requestedMemory - (node.UsableGpuMemory(gpuGroup) - node.UsedSharedGPUsMemory[gpuGroup])
+			tenants := tenantsByGroup[gpuGroup]
+			var headroom int64
+			for _, tenant := range tenants {
+				headroom += tenant.headroom
+			}
+			if headroom < missingMemory {
+				continue
+			}
+
+			checkpoint := stmt.Checkpoint()
+			shrunk, err := shrinkTenants(stmt, tenants, missingMemory)
+			if err != nil {
+				log.InfraLogger.Errorf("Failed to shrink tenants of GPU <%s> on node <%s>: %v",
+					gpuGroup, node.Name, err)
+				rollbackShrink(stmt, checkpoint)
+				return nil, false
+			}
+			if !pipelineToGpuGroup(ssn, stmt, task, node, gpuGroup) {
+				rollbackShrink(stmt, checkpoint)
+				continue
+			}
+
+			var shrunkNames []string
+			for _, tenant := range shrunk {
+				scenario.addFreedMemory(tenant.tenant, node, tenant.freedMemory)
+				shrunkNames = append(shrunkNames, tenant.tenant.task.Namespace+"/"+tenant.tenant.task.Name)
+			}
+			return shrunkNames, true
+		}
+	}
+	return nil, false
+}
+
+// pipelineToGpuGroup pipelines the task to the GPU group of the node if the node and the GPU group fit it.
+func pipelineToGpuGroup(
+	ssn *framework.Session, stmt *framework.Statement, task *pod_info.PodInfo, node *node_info.NodeInfo,
+	gpuGroup string,
+) bool {
+	if ssn.IsStalePipeline(task, node.Name) || !ssn.FittingNode(task, node, false) ||
+		!slices.Contains(ssn.FittingGPUs(node, task), gpuGroup) {
+		return false
+	}
+	task.GPUGroups = []string{gpuGroup}
+	if err := stmt.Pipeline(task, node.Name, false); err != nil {
+		log.InfraLogger.V(6).Infof("Failed to pipeline task <%s/%s> to GPU <%s> of node <%s>: %v",
+			task.Namespace, task.Name, gpuGroup, node.Name, err)
+		task.GPUGroups = nil
+		return false
+	}
+	return true
+}
+
+func rollbackShrink(stmt *framework.Statement, checkpoint framework.Checkpoint) {
+	if err := stmt.Rollback(checkpoint); err != nil {
+		log.InfraLogger.Errorf("Failed to roll back shrinking tenants: %v", err)
+	}
+}
+
+type shrunkTenant struct {
+	tenant      *shrinkableTenant
+	freedMemory int64
+}
+
+// shrinkTenants shrinks the tenants with the largest headroom first, until missingMemory MiB are freed.
+func shrinkTenants(stmt *framework.Statement, tenants []*shrinkableTenant, missingMemory int64) ([]shrunkTenant, error) {
+	slices.SortFunc(tenants, func(a, b *shrinkableTenant) int {
+		if diff := cmp.Compare(b.headroom, a.headroom); diff != 0 {
+			return diff
+		}
+		return strings.Compare(string(a.task.UID), string(b.task.UID))
+	})
+
+	var shrunk []shrunkTenant
+	for _, tenant := range tenants {
+		if missingMemory <= 0 {
+			break
+		}
+		freed := min(tenant.headroom, missingMemory)
+		currentMemory := tenant.minMemory + tenant.headroom
+		if err := stmt.Shrink(tenant.task, currentMemory-freed); err != nil {
+			return nil, err
+		}
+		tenant.headroom -= freed
+		missingMemory -= freed
+		shrunk = append(shrunk, shrunkTenant{tenant: tenant, freedMemory: freed})
+	}
+	return shrunk, nil
+}
+
+// shrinkableTenantsByGpuGroup returns the running best-effort tenants of the node's shared GPUs that the reclaimer
+// may take GPU memory from, grouped by GPU group.
+func shrinkableTenantsByGpuGroup(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) map[string][]*shrinkableTenant {
+	tenantsByGroup := map[string][]*shrinkableTenant{}
+	for _, task := range node.PodInfos {
+		if task.Status != pod_status.Running || !task.IsSharedGPUAllocation() || len(task.GPUGroups) != 1 ||
+			len(task.Pod.Spec.ResourceClaims) > 0 {
+			continue
+		}
+		minMemory, bestEffort := task.MinGpuMemory()
+		if !bestEffort {
+			continue
+		}
+		job, found := ssn.PodGroupInfos[task.Job]
+		if !found || job.Queue == reclaimer.Queue || !job.IsPreemptibleJob() ||
+			!ssn.ReclaimVictimFilter(reclaimer, job) {
+			continue
+		}
+
+		sessionTask, found := job.GetAllPodsMap()[task.UID]
+		if !found {
+			continue
+		}
+		headroom := node.GetResourceGpuMemory(sessionTask.ResReq) - minMemory
+		if headroom <= 0 {
+			continue
+		}
+		gpuGroup := task.GPUGroups[0]
+		tenantsByGroup[gpuGroup] = append(tenantsByGroup[gpuGroup], &shrinkableTenant{
+			task:      sessionTask,
+			job:       job,
+			minMemory: minMemory,
+			headroom:  headroom,
+		})
+	}
+	return tenantsByGroup
+}
@@ -30,6 +30,9 @@ func (action *staleGangEviction) Execute(ssn *framework.Session) {
 	log.InfraLogger.V(2).Infof("Enter StaleGangEviction ...")
 	defer log.InfraLogger.V(2).Infof("Leaving StaleGangEviction ...")
 	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
 		if job.IsStale() {
 			handleStaleJob(ssn, job)
 		} else {
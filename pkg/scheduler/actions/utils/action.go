@@ -4,6 +4,10 @@
 package utils
 
 import (
+	"iter"
+	"maps"
+	"slices"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -18,9 +22,23 @@ import (
 func GetVictimsQueue(
 	ssn *framework.Session,
 	filter func(*podgroup_info.PodGroupInfo) bool) *JobsOrderByQueues {
+	return getVictimsQueue(ssn, maps.Values(ssn.PodGroupInfos), filter)
+}
+
+// GetQueueVictimsQueue is GetVictimsQueue for the victims of a single queue, which are looked up in the session's
+// podgroups by queue index rather than by scanning all the podgroups of the session.
+func GetQueueVictimsQueue(
+	ssn *framework.Session, queueID common_info.QueueID,
+	filter func(*podgroup_info.PodGroupInfo) bool) *JobsOrderByQueues {
+	return getVictimsQueue(ssn, slices.Values(ssn.GetPodGroupsByQueue(queueID)), filter)
+}
+
+func getVictimsQueue(
+	ssn *framework.Session, jobs iter.Seq[*podgroup_info.PodGroupInfo],
+	filter func(*podgroup_info.PodGroupInfo) bool) *JobsOrderByQueues {
 	preemptees := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
 
-	for _, job := range ssn.PodGroupInfos {
+	for job := range jobs {
 		atLeastOneAlivePod := false
 		for _, task := range job.GetAllPodsMap() {
 			if !pod_status.IsAliveStatus(task.Status) {
@@ -121,7 +139,7 @@ func getReclaimMessageQueuesDetails(ssn *framework.Session, reclaimeeTask *pod_i
 func GetAllPendingJobs(ssn *framework.Session) map[common_info.PodGroupID]*podgroup_info.PodGroupInfo {
 	pendingJobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
 	for _, job := range ssn.PodGroupInfos {
-		if len(job.PodStatusIndex[pod_status.Pending]) > 0 {
+		if len(job.PodStatusIndex[pod_status.Pending]) > 0 && !ssn.IsCrossPartitionJob(job) {
 			pendingJobs[job.UID] = job
 		}
 	}
@@ -16,11 +16,17 @@ type JobsOrderInitOptions struct {
 	FilterNonActiveAllocated bool
 	VictimQueue              bool
 	MaxJobsQueueDepth        int
+	// IncludeCrossPartitionJobs keeps the jobs of other node pools, which are in the session only as reclaim victims
+	IncludeCrossPartitionJobs bool
 }
 
 func (jobsOrder *JobsOrderByQueues) InitializeWithJobs(
 	jobsToOrder map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) {
 	for _, job := range jobsToOrder {
+		if !jobsOrder.jobsOrderInitOptions.IncludeCrossPartitionJobs && jobsOrder.ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+
 		if jobsOrder.jobsOrderInitOptions.FilterUnready && !job.IsReadyForScheduling() {
 			continue
 		}
@@ -29,6 +35,15 @@ func (jobsOrder *JobsOrderByQueues) InitializeWithJobs(
 			continue
 		}
 
+		if jobsOrder.jobsOrderInitOptions.FilterNonPending && job.IsSchedulingDeadlineExceeded(jobsOrder.ssn.Now()) {
+			continue
+		}
+
+		// Pending jobs are taken only from the queues the running action is dispatched for
+		if jobsOrder.jobsOrderInitOptions.FilterNonPending && !jobsOrder.ssn.IsQueueDispatched(job.Queue) {
+			continue
+		}
+
 		if jobsOrder.jobsOrderInitOptions.FilterNonPreemptible && !job.IsPreemptibleJob() {
 			continue
 		}
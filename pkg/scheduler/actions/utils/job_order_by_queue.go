@@ -191,7 +191,10 @@ func (jobsOrder *JobsOrderByQueues) initializePriorityQueue(job *podgroup_info.P
 	jobsOrder.queueIdToQueueMetadata[job.Queue] = &jobsQueueMetadata{
 		jobsInQueue: scheduler_util.NewPriorityQueue(func(l, r interface{}) bool {
 			if reverseOrder {
-				return !jobsOrder.ssn.JobOrderFn(l, r)
+				if lFirst, decided := elasticExtrasVictimOrder(l, r); decided {
+					return lFirst
+				}
+				return jobsOrder.ssn.VictimOrderFn(l, r)
 			}
 			return jobsOrder.ssn.JobOrderFn(l, r)
 		}, jobsOrder.jobsOrderInitOptions.MaxJobsQueueDepth),
@@ -338,3 +341,18 @@ func (jobsOrder *JobsOrderByQueues) buildFuncOrderBetweenDepartmentsWithJobs(rev
 		return jobsOrder.ssn.QueueOrderFn(lDepartment, rDepartment, lPending, rPending, lVictims, rVictims)
 	}
 }
+
+// elasticExtrasVictimOrder puts the jobs with preemptible elastic extras before the other victims of their queue, so
+// their extras are evicted first. The order is decided only if exactly one of the jobs has such extras.
+func elasticExtrasVictimOrder(l, r interface{}) (bool, bool) {
+	lExtras := hasPreemptibleElasticExtras(l.(*podgroup_info.PodGroupInfo))
+	rExtras := hasPreemptibleElasticExtras(r.(*podgroup_info.PodGroupInfo))
+	if lExtras == rExtras {
+		return false, false
+	}
+	return lExtras, true
+}
+
+func hasPreemptibleElasticExtras(job *podgroup_info.PodGroupInfo) bool {
+	return job.ElasticExtrasPreemptible && job.HasElasticExtras()
+}
@@ -136,6 +136,46 @@ func TestNumericalPriorityWithinSameQueue(t *testing.T) {
 	assert.Equal(t, expectedJobsOrder, actualJobsOrder)
 }
 
+func TestInitializeWithJobs_SkipsTimedOutJobs(t *testing.T) {
+	ssn := newPrioritySession()
+	ssn.Queues = map[common_info.QueueID]*queue_info.QueueInfo{
+		testQueue:      {UID: testQueue, ParentQueue: testDepartment},
+		testDepartment: {UID: testDepartment, ChildQueues: []common_info.QueueID{testQueue}},
+	}
+	newPendingJob := func(name string, deadline time.Time) *podgroup_info.PodGroupInfo {
+		pod := &pod_info.PodInfo{UID: testPod, Status: pod_status.Pending}
+		return &podgroup_info.PodGroupInfo{
+			Name:               name,
+			Queue:              testQueue,
+			SchedulingDeadline: &deadline,
+			PodStatusIndex: map[pod_status.PodStatus]pod_info.PodsMap{
+				pod_status.Pending: {testPod: pod},
+			},
+			PodSets: map[string]*subgroup_info.PodSet{
+				podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 1, nil).
+					WithPodInfos(pod_info.PodsMap{testPod: pod}),
+			},
+		}
+	}
+	ssn.PodGroupInfos = map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+		"0": newPendingJob("timed-out", time.Now().Add(-time.Minute)),
+		"1": newPendingJob("before-deadline", time.Now().Add(time.Hour)),
+	}
+
+	jobsOrderByQueues := NewJobsOrderByQueues(ssn, JobsOrderInitOptions{
+		FilterNonPending:  true,
+		FilterUnready:     true,
+		MaxJobsQueueDepth: scheduler_util.QueueCapacityInfinite,
+	})
+	jobsOrderByQueues.InitializeWithJobs(ssn.PodGroupInfos)
+
+	actualJobs := []string{}
+	for !jobsOrderByQueues.IsEmpty() {
+		actualJobs = append(actualJobs, jobsOrderByQueues.PopNextJob().Name)
+	}
+	assert.Equal(t, []string{"before-deadline"}, actualJobs)
+}
+
 func TestVictimQueue_PopNextJob(t *testing.T) {
 	now := metav1.Time{Time: time.Now()}
 	nowMinus1 := metav1.Time{Time: time.Now().Add(-time.Second)}
@@ -21,8 +21,10 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 
@@ -54,6 +56,10 @@ type ClusterInfo struct {
 	StorageClasses              map[common_info.StorageClassID]*storageclass_info.StorageClassInfo
 	ConfigMaps                  map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo
 	Topologies                  []*kueue.Topology
+	PodDisruptionBudgets        []*policyv1.PodDisruptionBudget
+	// LastCacheUpdate is the oldest of the times at which the watched informers of the cache last delivered a
+	// watched change or a periodic resync. It is zero when unknown.
+	LastCacheUpdate time.Time
 }
 
 func NewClusterInfo() *ClusterInfo {
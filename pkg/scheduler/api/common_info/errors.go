@@ -178,6 +178,11 @@ func (f *FitError) Error() string {
 type FitErrors struct {
 	nodes map[string]*FitError
 	err   string
+
+	// maxNodeErrors caps the number of node errors retained, unlimited when 0. The reasons of the node errors that
+	// are not retained are still kept in omittedNodes, by node name, so they are counted once per node.
+	maxNodeErrors int
+	omittedNodes  map[string][]string
 }
 
 func NewFitErrors() *FitErrors {
@@ -200,13 +205,47 @@ func (f *FitErrors) SetNodeError(nodeName string, err error) {
 		fe = NewFitError("", "", nodeName, err.Error())
 	}
 
-	f.nodes[nodeName] = fe
+	f.setNodeFitError(fe)
+}
+
+// SetMaxNodeErrors caps the number of node errors retained, to bound the memory of the fit errors of tasks that
+// don't fit thousands of nodes. Once the cap is reached, the errors of further nodes are only counted by reason.
+// Unlimited when 0.
+func (f *FitErrors) SetMaxNodeErrors(maxNodeErrors int) {
+	f.maxNodeErrors = maxNodeErrors
 }
 
 func (f *FitErrors) AddNodeErrors(errors *FitErrors) {
-	for nodeName, fitError := range errors.nodes {
-		f.nodes[nodeName] = fitError
+	if f.maxNodeErrors == 0 {
+		f.maxNodeErrors = errors.maxNodeErrors
+	}
+	for _, fitError := range errors.nodes {
+		f.setNodeFitError(fitError)
+	}
+	for nodeName, reasons := range errors.omittedNodes {
+		f.setOmittedNodeReasons(nodeName, reasons)
+	}
+}
+
+func (f *FitErrors) setNodeFitError(fitError *FitError) {
+	_, found := f.nodes[fitError.NodeName]
+	if found || f.maxNodeErrors <= 0 || len(f.nodes) < f.maxNodeErrors {
+		f.nodes[fitError.NodeName] = fitError
+		return
 	}
+	f.setOmittedNodeReasons(fitError.NodeName, fitError.Reasons)
+}
+
+// setOmittedNodeReasons keeps the reasons of a node whose error is not retained. A node that is evaluated again
+// replaces its previous reasons, as a retained node error does, so every node is counted once.
+func (f *FitErrors) setOmittedNodeReasons(nodeName string, reasons []string) {
+	if _, found := f.nodes[nodeName]; found {
+		return
+	}
+	if f.omittedNodes == nil {
+		f.omittedNodes = map[string][]string{}
+	}
+	f.omittedNodes[nodeName] = reasons
 }
 
 func (f *FitErrors) DetailedError() string {
@@ -219,6 +258,9 @@ func (f *FitErrors) DetailedError() string {
 			fmt.Sprintf("\n<%v>: %v.", node.NodeName, strings.Join(node.DetailedReasons, ", ")))
 	}
 	sort.Strings(reasonMessages)
+	if len(f.omittedNodes) > 0 {
+		reasonMessages = append(reasonMessages, fmt.Sprintf("\n<%d more nodes>: omitted.", len(f.omittedNodes)))
+	}
 	return strings.Join(reasonMessages, "")
 }
 
@@ -231,6 +273,11 @@ func (f *FitErrors) Error() string {
 				reasons[reason]++
 			}
 		}
+		for _, nodeReasons := range f.omittedNodes {
+			for _, reason := range nodeReasons {
+				reasons[reason]++
+			}
+		}
 
 		var reasonStrings []string
 		for k, v := range reasons {
@@ -5,6 +5,7 @@ package common_info
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -196,3 +197,89 @@ func TestNewFitErrorInsufficientResource(t *testing.T) {
 		})
 	}
 }
+
+func TestFitErrors_MaxNodeErrors(t *testing.T) {
+	tests := []struct {
+		name                 string
+		maxNodeErrors        int
+		wantNodes            int
+		wantError            string
+		wantDetailedOmission bool
+	}{
+		{
+			name:      "unlimited",
+			wantNodes: 3,
+			wantError: ResourcesWereNotFoundMsg + ": 1 node(s) didn't have enough resources: CPU cores. \n" +
+				"2 node(s) didn't have enough resources: GPUs.",
+		},
+		{
+			name:          "capped",
+			maxNodeErrors: 1,
+			wantNodes:     1,
+			wantError: ResourcesWereNotFoundMsg + ": 1 node(s) didn't have enough resources: CPU cores. \n" +
+				"2 node(s) didn't have enough resources: GPUs.",
+			wantDetailedOmission: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFitErrors()
+			f.SetMaxNodeErrors(tt.maxNodeErrors)
+			f.SetNodeError("node0", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: GPUs"))
+
+			other := NewFitErrors()
+			other.SetNodeError("node1", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: GPUs"))
+			other.SetNodeError("node2", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: CPU cores"))
+			f.AddNodeErrors(other)
+
+			if len(f.nodes) != tt.wantNodes {
+				t.Errorf("retained %d node errors, want %d", len(f.nodes), tt.wantNodes)
+			}
+			if got := f.Error(); got != tt.wantError {
+				t.Errorf("Error() = %v, want %v", got, tt.wantError)
+			}
+			if got := strings.Contains(f.DetailedError(), "<2 more nodes>: omitted."); got != tt.wantDetailedOmission {
+				t.Errorf("DetailedError() = %v, want omitted nodes %v", f.DetailedError(), tt.wantDetailedOmission)
+			}
+		})
+	}
+}
+
+func TestFitErrors_AddNodeErrorsKeepsMaxNodeErrors(t *testing.T) {
+	capped := NewFitErrors()
+	capped.SetMaxNodeErrors(1)
+	capped.SetNodeError("node0", NewFitError("pod", "ns", "", "reason"))
+
+	f := NewFitErrors()
+	f.SetError("pre-predicate failed")
+	f.AddNodeErrors(capped)
+	f.SetNodeError("node1", NewFitError("pod", "ns", "", "reason"))
+
+	if len(f.nodes) != 1 {
+		t.Errorf("retained %d node errors, want 1", len(f.nodes))
+	}
+	if len(f.omittedNodes) != 1 {
+		t.Errorf("omitted %d node errors, want 1", len(f.omittedNodes))
+	}
+}
+
+func TestFitErrors_OmittedNodesCountedOnce(t *testing.T) {
+	f := NewFitErrors()
+	f.SetMaxNodeErrors(1)
+	f.SetNodeError("node0", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: GPUs"))
+	for range 3 {
+		evaluation := NewFitErrors()
+		evaluation.SetNodeError("node1", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: GPUs"))
+		f.AddNodeErrors(evaluation)
+		f.SetNodeError("node2", NewFitError("pod", "ns", "", "node(s) didn't have enough resources: CPU cores"))
+	}
+
+	wantError := ResourcesWereNotFoundMsg + ": 1 node(s) didn't have enough resources: CPU cores. \n" +
+		"2 node(s) didn't have enough resources: GPUs."
+	if got := f.Error(); got != wantError {
+		t.Errorf("Error() = %v, want %v", got, wantError)
+	}
+	if !strings.Contains(f.DetailedError(), "<2 more nodes>: omitted.") {
+		t.Errorf("DetailedError() = %v, want 2 omitted nodes", f.DetailedError())
+	}
+}
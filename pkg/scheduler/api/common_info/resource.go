@@ -0,0 +1,21 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common_info
+
+// Resource is an amount of CPU, memory, GPUs and GPU memory.
+type Resource struct {
+	MilliCPU float64
+	Memory   float64
+	GPU      float64
+	// GPUMemory is in MiB.
+	GPUMemory float64
+}
+
+// Add adds the other resource to the resource.
+func (r *Resource) Add(other Resource) {
+	r.MilliCPU += other.MilliCPU
+	r.Memory += other.Memory
+	r.GPU += other.GPU
+	r.GPUMemory += other.GPUMemory
+}
@@ -4,6 +4,8 @@
 package eviction_info
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -11,4 +13,7 @@ type EvictionMetadata struct {
 	EvictionGangSize int
 	Action           string
 	Preemptor        *types.NamespacedName
+	// CheckpointTimeout, when positive, asks the pod to checkpoint and evicts it only if it is still running after
+	// the timeout.
+	CheckpointTimeout time.Duration
 }
@@ -0,0 +1,55 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+func (ni *NodeInfo) addExclusiveNodeTask(task *pod_info.PodInfo) {
+	if !task.IsExclusiveNodeRequest() || task.Status == pod_status.Releasing {
+		return
+	}
+	if ni.ExclusiveNodeTasks == nil {
+		ni.ExclusiveNodeTasks = map[common_info.PodID]string{}
+	}
+	key := pod_info.PodKey(task.Pod)
+	ni.ExclusiveNodeTasks[key] = string(key)
+}
+
+// PredicateByExclusiveNode returns a fit error if the node is reserved by a task that requires it exclusively, or if
+// the task requires the node exclusively and other non-releasing pods of the scheduler are on it. Pods of other
+// schedulers and scheduler utility pods do not occupy the node.
+func (ni *NodeInfo) PredicateByExclusiveNode(task *pod_info.PodInfo, schedulerName string) *common_info.FitError {
+	taskKey := pod_info.PodKey(task.Pod)
+	for key, exclusiveTask := range ni.ExclusiveNodeTasks {
+		if key != taskKey {
+			return common_info.NewFitError(task.Name, task.Namespace, ni.Name,
+				fmt.Sprintf("node is reserved exclusively by pod %s", exclusiveTask))
+		}
+	}
+
+	if !task.IsExclusiveNodeRequest() {
+		return nil
+	}
+	var occupants []string
+	for key, podInfo := range ni.PodInfos {
+		if key == taskKey || podInfo.Status == pod_status.Releasing || podInfo.Pod == nil ||
+			podInfo.Pod.Spec.SchedulerName != schedulerName || pod_info.IsKaiUtilityPod(podInfo.Pod) {
+			continue
+		}
+		occupants = append(occupants, string(key))
+	}
+	if len(occupants) == 0 {
+		return nil
+	}
+	slices.Sort(occupants)
+	return common_info.NewFitError(task.Name, task.Namespace, ni.Name,
+		fmt.Sprintf("pod requires an exclusive node, but the node runs %d other pods: %v", len(occupants), occupants))
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+const testSchedulerName = "kai-scheduler"
+
+type exclusiveNodeTestPod struct {
+	name          string
+	exclusive     bool
+	releasing     bool
+	schedulerName string
+	utility       bool
+}
+
+func buildExclusiveNodeTestTask(pod exclusiveNodeTestPod) *pod_info.PodInfo {
+	var annotations, labels map[string]string
+	if pod.exclusive {
+		annotations = map[string]string{commonconstants.ExclusiveNode: "true"}
+	}
+	if pod.utility {
+		labels = map[string]string{commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue}
+	}
+	k8sPod := common_info.BuildPod("ns", pod.name, "n1", v1.PodRunning, common_info.BuildResourceList("1000m", "1G"),
+		[]metav1.OwnerReference{}, labels, annotations)
+	k8sPod.Spec.SchedulerName = testSchedulerName
+	if pod.schedulerName != "" {
+		k8sPod.Spec.SchedulerName = pod.schedulerName
+	}
+	if pod.releasing {
+		k8sPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	}
+	return pod_info.NewTaskInfo(k8sPod)
+}
+
+func TestNodeInfo_PredicateByExclusiveNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		podsOnNode     []exclusiveNodeTestPod
+		task           exclusiveNodeTestPod
+		expectFitError bool
+	}{
+		{
+			name: "regular pod on a shared node",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "running"},
+			},
+			task: exclusiveNodeTestPod{name: "task"},
+		},
+		{
+			name:       "exclusive pod on an empty node",
+			podsOnNode: []exclusiveNodeTestPod{},
+			task:       exclusiveNodeTestPod{name: "task", exclusive: true},
+		},
+		{
+			name: "exclusive pod on a node with another pod",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "running"},
+			},
+			task:           exclusiveNodeTestPod{name: "task", exclusive: true},
+			expectFitError: true,
+		},
+		{
+			name: "exclusive pod ignores releasing pods",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "releasing", releasing: true},
+			},
+			task: exclusiveNodeTestPod{name: "task", exclusive: true},
+		},
+		{
+			name: "exclusive pod ignores pods of other schedulers",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "daemon", schedulerName: "default-scheduler"},
+			},
+			task: exclusiveNodeTestPod{name: "task", exclusive: true},
+		},
+		{
+			name: "exclusive pod ignores utility pods",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "reservation", utility: true},
+			},
+			task: exclusiveNodeTestPod{name: "task", exclusive: true},
+		},
+		{
+			name: "exclusive pod already on the node",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "task", exclusive: true},
+			},
+			task: exclusiveNodeTestPod{name: "task", exclusive: true},
+		},
+		{
+			name: "regular pod on a node reserved by an exclusive pod",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "exclusive", exclusive: true},
+			},
+			task:           exclusiveNodeTestPod{name: "task"},
+			expectFitError: true,
+		},
+		{
+			name: "releasing exclusive pod does not reserve the node",
+			podsOnNode: []exclusiveNodeTestPod{
+				{name: "exclusive", exclusive: true, releasing: true},
+			},
+			task: exclusiveNodeTestPod{name: "task"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G")),
+				nodePodAffinityInfo)
+			for _, pod := range tt.podsOnNode {
+				assert.NoError(t, ni.AddTask(buildExclusiveNodeTestTask(pod)))
+			}
+
+			fitError := ni.PredicateByExclusiveNode(buildExclusiveNodeTestTask(tt.task), testSchedulerName)
+			assert.Equal(t, tt.expectFitError, fitError != nil)
+		})
+	}
+}
+
+func TestNodeInfo_RemoveExclusiveNodeTask(t *testing.T) {
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+	nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+	nodePodAffinityInfo.EXPECT().RemovePod(Any()).AnyTimes()
+	ni := NewNodeInfo(common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G")),
+		nodePodAffinityInfo)
+
+	exclusiveTask := buildExclusiveNodeTestTask(exclusiveNodeTestPod{name: "exclusive", exclusive: true})
+	assert.NoError(t, ni.AddTask(exclusiveTask))
+	assert.Len(t, ni.ExclusiveNodeTasks, 1)
+
+	assert.NoError(t, ni.RemoveTask(exclusiveTask))
+	assert.Empty(t, ni.ExclusiveNodeTasks)
+	assert.Nil(t, ni.PredicateByExclusiveNode(buildExclusiveNodeTestTask(exclusiveNodeTestPod{name: "task"}),
+		testSchedulerName))
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// getGpuMemoryBandwidthBudget parses the memory bandwidth budget of every GPU of the node from its
+// gpu-memory-bandwidth-budget annotation. It returns 0, an unlimited budget, if the annotation is missing or malformed.
+func getGpuMemoryBandwidthBudget(node *v1.Node) int64 {
+	value, found := node.Annotations[commonconstants.GpuMemoryBandwidthBudget]
+	if !found || value == "" {
+		return 0
+	}
+	budget, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || budget < 0 {
+		log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+			node.Name, commonconstants.GpuMemoryBandwidthBudget, value)
+		return 0
+	}
+	return budget
+}
+
+// IsGpuGroupOverBandwidthBudget returns true if the memory bandwidth weight of the task would take the GPU group over
+// the bandwidth budget of the node, once its releasing pods are gone and its pipelined pods are running.
+func (ni *NodeInfo) IsGpuGroupOverBandwidthBudget(task *pod_info.PodInfo, gpuGroup string) bool {
+	weight := task.GpuMemoryBandwidthWeight()
+	if ni.GpuMemoryBandwidthBudget <= 0 || weight == 0 {
+		return false
+	}
+	bandwidth := ni.AllocatedSharedGPUsBandwidth[gpuGroup] - ni.ReleasingSharedGPUsBandwidth[gpuGroup]
+	return bandwidth+weight > ni.GpuMemoryBandwidthBudget
+}
+
+// IsTaskOverWholeGpuBandwidthBudget returns true if the memory bandwidth weight of the task alone exceeds the
+// bandwidth budget of a GPU of the node, so the task cannot share any of its GPUs.
+func (ni *NodeInfo) IsTaskOverWholeGpuBandwidthBudget(task *pod_info.PodInfo) bool {
+	return ni.GpuMemoryBandwidthBudget > 0 && task.GpuMemoryBandwidthWeight() > ni.GpuMemoryBandwidthBudget
+}
+
+// BandwidthBudgetFitError returns a fit error if the shared GPUs of the node with enough memory for the fractional
+// task would all exceed the memory bandwidth budget of the node with the task, or if the task exceeds the budget of
+// a GPU by itself, so the task is told apart from one that lacks GPU memory.
+func (ni *NodeInfo) BandwidthBudgetFitError(task *pod_info.PodInfo) *common_info.FitError {
+	if ni.GpuMemoryBandwidthBudget <= 0 || !task.ResReq.IsFractionalRequest() || task.GpuMemoryBandwidthWeight() == 0 {
+		return nil
+	}
+	if ni.IsTaskOverWholeGpuBandwidthBudget(task) {
+		return common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, ni.Name,
+			[]string{"node(s) didn't have enough resources: GPU memory bandwidth budget"},
+			fmt.Sprintf("node(s) have a GPU memory bandwidth budget of %d per GPU, and the pod's bandwidth weight is %d",
+				ni.GpuMemoryBandwidthBudget, task.GpuMemoryBandwidthWeight()))
+	}
+
+	gpusOverBudget := 0
+	for gpuGroup := range ni.UsedSharedGPUsMemory {
+		if ni.IsTaskFitOnGpuGroup(task.ResReq, gpuGroup) && ni.IsGpuGroupOverBandwidthBudget(task, gpuGroup) {
+			gpusOverBudget++
+		}
+	}
+	if gpusOverBudget == 0 {
+		return nil
+	}
+	return common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, ni.Name,
+		[]string{"node(s) didn't have enough resources: GPU memory bandwidth budget"},
+		fmt.Sprintf("node(s) have %d GPU(s) with enough memory for the pod, but the pod's bandwidth weight of %d "+
+			"would exceed their memory bandwidth budget of %d", gpusOverBudget, task.GpuMemoryBandwidthWeight(),
+			ni.GpuMemoryBandwidthBudget))
+}
+
+// HasIdleBandwidthOnGpu returns true if the task can share the GPU group within the memory bandwidth budget of the
+// node right away, without waiting for its releasing pods.
+func (ni *NodeInfo) HasIdleBandwidthOnGpu(task *pod_info.PodInfo, gpuGroup string) bool {
+	weight := task.GpuMemoryBandwidthWeight()
+	return ni.GpuMemoryBandwidthBudget <= 0 || weight == 0 ||
+		ni.AllocatedSharedGPUsBandwidth[gpuGroup]+weight <= ni.GpuMemoryBandwidthBudget
+}
+
+// addGpuBandwidth adds the memory bandwidth weight to the GPU group, leaving the groups of pods without a weight out of
+// the bandwidth maps.
+func addGpuBandwidth(bandwidth map[string]int64, gpuGroup string, weight int64) {
+	if weight == 0 {
+		return
+	}
+	bandwidth[gpuGroup] += weight
+}
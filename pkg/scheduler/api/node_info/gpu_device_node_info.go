@@ -0,0 +1,36 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// getGpuDeviceIds parses the comma separated id of every GPU of the node, ordered by GPU index, from the
+// gpu-device-ids annotation, e.g. as published by a node inventory. A missing annotation means that the GPUs of the
+// node are not known.
+func getGpuDeviceIds(node *v1.Node) []string {
+	value, found := node.Annotations[commonconstants.GpuDeviceIds]
+	if !found || value == "" {
+		return nil
+	}
+
+	deviceIds := strings.Split(value, ",")
+	for i, deviceId := range deviceIds {
+		deviceIds[i] = strings.TrimSpace(deviceId)
+	}
+	return deviceIds
+}
+
+// GetGpuDeviceId returns the id of the GPU with the index on the node, and whether it is known.
+func (ni *NodeInfo) GetGpuDeviceId(gpuIndex int) (string, bool) {
+	if gpuIndex < 0 || gpuIndex >= len(ni.GpuDeviceIds) || ni.GpuDeviceIds[gpuIndex] == "" {
+		return "", false
+	}
+	return ni.GpuDeviceIds[gpuIndex], true
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// getUnhealthyGpus parses the comma separated indexes of the node's unhealthy GPUs, e.g. GPUs with Xid errors, from
+// the unhealthy-gpus annotation that GPU health monitors set. A malformed annotation is ignored.
+func getUnhealthyGpus(node *v1.Node) map[int]bool {
+	value, found := node.Annotations[commonconstants.UnhealthyGpus]
+	if !found || strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	unhealthyGpus := map[int]bool{}
+	for _, gpuIndexStr := range strings.Split(value, ",") {
+		gpuIndex, err := strconv.Atoi(strings.TrimSpace(gpuIndexStr))
+		if err != nil || gpuIndex < 0 {
+			log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+				node.Name, commonconstants.UnhealthyGpus, value)
+			return nil
+		}
+		unhealthyGpus[gpuIndex] = true
+	}
+	return unhealthyGpus
+}
+
+// IsGpuGroupHealthy returns false if the shared GPU group runs on a GPU that is known to be unhealthy. On a node with
+// unhealthy GPUs, a group whose GPU index is unknown may run on one of them, so it is not considered healthy either.
+func (ni *NodeInfo) IsGpuGroupHealthy(gpuGroup string) bool {
+	if len(ni.UnhealthyGpus) == 0 {
+		return true
+	}
+	gpuIndex, found := ni.gpuGroupIndexes[gpuGroup]
+	return found && !ni.UnhealthyGpus[gpuIndex]
+}
+
+// GetLostGpuGroups returns, for every GPU group of the GPU sharing task that the task can no longer run on, why it is
+// lost: the group has no reservation pod on the node anymore, or the GPU that the group reserved is unhealthy.
+func (ni *NodeInfo) GetLostGpuGroups(task *pod_info.PodInfo) map[string]string {
+	if !task.IsSharedGPUAllocation() {
+		return nil
+	}
+
+	reservedGpuGroups := map[string]bool{}
+	for _, podInfo := range ni.PodInfos {
+		if podInfo.Pod != nil && pod_info.IsResourceReservationTask(podInfo.Pod) &&
+			pod_status.IsActiveUsedStatus(podInfo.Status) {
+			reservedGpuGroups[podInfo.Pod.Labels[commonconstants.GPUGroup]] = true
+		}
+	}
+
+	lostGpuGroups := map[string]string{}
+	for _, gpuGroup := range task.GPUGroups {
+		gpuIndex, indexKnown := ni.gpuGroupIndexes[gpuGroup]
+		switch {
+		case !reservedGpuGroups[gpuGroup]:
+			lostGpuGroups[gpuGroup] = "its GPU reservation pod no longer exists"
+		case indexKnown && ni.UnhealthyGpus[gpuIndex]:
+			lostGpuGroups[gpuGroup] = fmt.Sprintf("GPU %d is unhealthy", gpuIndex)
+		}
+	}
+	return lostGpuGroups
+}
+
+// GetNumOfUnhealthyWholeGpus returns the number of unhealthy GPUs of the node that no shared GPU group runs on. They
+// are counted among the idle or releasing GPUs of the node, and are not offered to new shared GPU groups.
+func (ni *NodeInfo) GetNumOfUnhealthyWholeGpus() int {
+	unhealthyGpus := len(ni.UnhealthyGpus)
+	for gpuGroup, gpuIndex := range ni.gpuGroupIndexes {
+		if _, found := ni.UsedSharedGPUsMemory[gpuGroup]; found && ni.UnhealthyGpus[gpuIndex] {
+			unhealthyGpus--
+		}
+	}
+	return max(unhealthyGpus, 0)
+}
+
+// isWholeGpuRequestAllocatable returns whether the whole GPUs that the task requests fit the non-allocated GPUs of the
+// node, other than its unhealthy GPUs that no shared GPU group runs on.
+func (ni *NodeInfo) isWholeGpuRequestAllocatable(
+	task *pod_info.PodInfo, nodeNonAllocatedResources *resource_info.Resource,
+) bool {
+	requestedGpus := task.ResReq.GPUs()
+	return requestedGpus == 0 ||
+		requestedGpus <= nodeNonAllocatedResources.GPUs()-float64(ni.GetNumOfUnhealthyWholeGpus())
+}
+
+// UnhealthyGpusFitError returns a fit error if unhealthy GPUs of the node would otherwise have hosted the task, so the
+// task is told apart from one that lacks GPUs or GPU memory.
+func (ni *NodeInfo) UnhealthyGpusFitError(task *pod_info.PodInfo) *common_info.FitError {
+	if len(ni.UnhealthyGpus) == 0 {
+		return nil
+	}
+	if task.IsRegularGPURequest() {
+		return ni.unhealthyWholeGpusFitError(task)
+	}
+	if !task.ResReq.IsFractionalRequest() {
+		return nil
+	}
+
+	unhealthyGpus := 0
+	for gpuGroup := range ni.UsedSharedGPUsMemory {
+		if !ni.IsGpuGroupHealthy(gpuGroup) && ni.IsTaskFitOnGpuGroup(task.ResReq, gpuGroup) {
+			unhealthyGpus++
+		}
+	}
+	if ni.IsTaskFitOnWholeGpu(task.ResReq) {
+		wholeGpus := int(ni.Idle.GPUs()) + int(ni.Releasing.GPUs())
+		unhealthyGpus += min(ni.GetNumOfUnhealthyWholeGpus(), wholeGpus)
+	}
+	if unhealthyGpus == 0 {
+		return nil
+	}
+	return common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, ni.Name,
+		[]string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		fmt.Sprintf("node(s) have %d unhealthy GPU(s) with enough memory for the pod", unhealthyGpus))
+}
+
+// unhealthyWholeGpusFitError returns a fit error if the whole GPUs that the task requests fit the idle GPUs of the
+// node only when counting its unhealthy GPUs.
+func (ni *NodeInfo) unhealthyWholeGpusFitError(task *pod_info.PodInfo) *common_info.FitError {
+	unhealthyGpus := ni.GetNumOfUnhealthyWholeGpus()
+	if unhealthyGpus == 0 || task.ResReq.GPUs() > ni.Idle.GPUs() || ni.isWholeGpuRequestAllocatable(task, ni.Idle) {
+		return nil
+	}
+	return common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, ni.Name,
+		[]string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		fmt.Sprintf("node(s) have %d unhealthy GPU(s) among the idle GPUs that the pod needs", unhealthyGpus))
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// GpuInterconnectTier ranks the bandwidth of the interconnect between the GPUs of a node. Higher tiers have a higher
+// bandwidth.
+type GpuInterconnectTier int
+
+const (
+	GpuInterconnectUnknown GpuInterconnectTier = iota
+	GpuInterconnectPCIe
+	GpuInterconnectNVLink
+	GpuInterconnectNVSwitch
+
+	MaxGpuInterconnectTier = GpuInterconnectNVSwitch
+)
+
+var gpuInterconnectTiers = map[string]GpuInterconnectTier{
+	"pcie":     GpuInterconnectPCIe,
+	"nvlink":   GpuInterconnectNVLink,
+	"nvswitch": GpuInterconnectNVSwitch,
+}
+
+func (t GpuInterconnectTier) String() string {
+	switch t {
+	case GpuInterconnectPCIe:
+		return "PCIe"
+	case GpuInterconnectNVLink:
+		return "NVLink"
+	case GpuInterconnectNVSwitch:
+		return "NVSwitch"
+	default:
+		return "Unknown"
+	}
+}
+
+// getGpuInterconnectTier parses the interconnect between the GPUs of the node, pcie, nvlink or nvswitch, from the
+// gpu-interconnect annotation. A missing or unknown value means that the interconnect is unknown.
+func getGpuInterconnectTier(node *v1.Node) GpuInterconnectTier {
+	value, found := node.Annotations[commonconstants.GpuInterconnect]
+	if !found || strings.TrimSpace(value) == "" {
+		return GpuInterconnectUnknown
+	}
+
+	tier, found := gpuInterconnectTiers[strings.ToLower(strings.TrimSpace(value))]
+	if !found {
+		log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+			node.Name, commonconstants.GpuInterconnect, value)
+		return GpuInterconnectUnknown
+	}
+	return tier
+}
@@ -4,11 +4,15 @@
 package node_info
 
 import (
+	"cmp"
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 
 	"golang.org/x/exp/maps"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -23,6 +27,14 @@ type GpuSharingNodeInfo struct {
 	UsedSharedGPUsMemory      map[string]int64
 	ReleasingSharedGPUsMemory map[string]int64
 	AllocatedSharedGPUsMemory map[string]int64
+	// AllocatedSharedGPUsTenants and ReleasingSharedGPUsTenants count the fractional pods of each GPU, as the
+	// matching memory maps sum their memory
+	AllocatedSharedGPUsTenants map[string]int
+	ReleasingSharedGPUsTenants map[string]int
+	// AllocatedSharedGPUsBandwidth and ReleasingSharedGPUsBandwidth sum the memory bandwidth weights of the fractional
+	// pods of each GPU
+	AllocatedSharedGPUsBandwidth map[string]int64
+	ReleasingSharedGPUsBandwidth map[string]int64
 }
 
 func newGpuSharingNodeInfo() *GpuSharingNodeInfo {
@@ -32,6 +44,12 @@ func newGpuSharingNodeInfo() *GpuSharingNodeInfo {
 		UsedSharedGPUsMemory:      make(map[string]int64),
 		ReleasingSharedGPUsMemory: make(map[string]int64),
 		AllocatedSharedGPUsMemory: make(map[string]int64),
+
+		AllocatedSharedGPUsTenants: make(map[string]int),
+		ReleasingSharedGPUsTenants: make(map[string]int),
+
+		AllocatedSharedGPUsBandwidth: make(map[string]int64),
+		ReleasingSharedGPUsBandwidth: make(map[string]int64),
 	}
 }
 
@@ -50,6 +68,18 @@ func (g *GpuSharingNodeInfo) Clone() *GpuSharingNodeInfo {
 	for k, v := range g.AllocatedSharedGPUsMemory {
 		gpuSharingNodeInfo.AllocatedSharedGPUsMemory[k] = v
 	}
+	for k, v := range g.AllocatedSharedGPUsTenants {
+		gpuSharingNodeInfo.AllocatedSharedGPUsTenants[k] = v
+	}
+	for k, v := range g.ReleasingSharedGPUsTenants {
+		gpuSharingNodeInfo.ReleasingSharedGPUsTenants[k] = v
+	}
+	for k, v := range g.AllocatedSharedGPUsBandwidth {
+		gpuSharingNodeInfo.AllocatedSharedGPUsBandwidth[k] = v
+	}
+	for k, v := range g.ReleasingSharedGPUsBandwidth {
+		gpuSharingNodeInfo.ReleasingSharedGPUsBandwidth[k] = v
+	}
 
 	return gpuSharingNodeInfo
 }
@@ -93,12 +123,16 @@ func (ni *NodeInfo) addSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo, gp
 		ni.ReleasingSharedGPUsMemory[gpuGroup], ni.AllocatedSharedGPUsMemory[gpuGroup],
 		ni.UsedSharedGPUsMemory[gpuGroup])
 
-	ni.UsedSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
+	ni.UsedSharedGPUsMemory[gpuGroup] += ni.getTaskGpuGroupMemory(task, gpuGroup)
 
 	switch task.Status {
 	case pod_status.Releasing:
-		ni.ReleasingSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
-		ni.AllocatedSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
+		ni.ReleasingSharedGPUsMemory[gpuGroup] += ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.AllocatedSharedGPUsMemory[gpuGroup] += ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.ReleasingSharedGPUsTenants[gpuGroup]++
+		ni.AllocatedSharedGPUsTenants[gpuGroup]++
+		addGpuBandwidth(ni.ReleasingSharedGPUsBandwidth, gpuGroup, task.GpuMemoryBandwidthWeight())
+		addGpuBandwidth(ni.AllocatedSharedGPUsBandwidth, gpuGroup, task.GpuMemoryBandwidthWeight())
 
 		if ni.UsedSharedGPUsMemory[gpuGroup] == ni.ReleasingSharedGPUsMemory[gpuGroup] {
 			// is this the last releasing task for this gpu
@@ -111,16 +145,20 @@ func (ni *NodeInfo) addSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo, gp
 			}
 		}
 	case pod_status.Pipelined:
-		ni.ReleasingSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
+		ni.ReleasingSharedGPUsMemory[gpuGroup] -= ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.ReleasingSharedGPUsTenants[gpuGroup]--
+		addGpuBandwidth(ni.ReleasingSharedGPUsBandwidth, gpuGroup, -task.GpuMemoryBandwidthWeight())
 
-		if ni.UsedSharedGPUsMemory[gpuGroup]-ni.GetResourceGpuMemory(task.ResReq) ==
-			ni.ReleasingSharedGPUsMemory[gpuGroup]+ni.GetResourceGpuMemory(task.ResReq) {
+		if ni.UsedSharedGPUsMemory[gpuGroup]-ni.getTaskGpuGroupMemory(task, gpuGroup) ==
+			ni.ReleasingSharedGPUsMemory[gpuGroup]+ni.getTaskGpuGroupMemory(task, gpuGroup) {
 			ni.Releasing.SubGPUs(1)
 		}
 	default:
-		ni.AllocatedSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
+		ni.AllocatedSharedGPUsMemory[gpuGroup] += ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.AllocatedSharedGPUsTenants[gpuGroup]++
+		addGpuBandwidth(ni.AllocatedSharedGPUsBandwidth, gpuGroup, task.GpuMemoryBandwidthWeight())
 
-		if ni.UsedSharedGPUsMemory[gpuGroup] <= ni.GetResourceGpuMemory(task.ResReq) {
+		if ni.UsedSharedGPUsMemory[gpuGroup] <= ni.getTaskGpuGroupMemory(task, gpuGroup) {
 			// no other fractional was allocated here yet
 			if int(ni.GetNumberOfGPUsInNode()) < int(ni.Idle.GPUs())+ni.getNumberOfUsedGPUs() {
 				ni.Idle.SubGPUs(1)
@@ -167,12 +205,16 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 		ni.ReleasingSharedGPUsMemory[gpuGroup], ni.AllocatedSharedGPUsMemory[gpuGroup],
 		ni.UsedSharedGPUsMemory[gpuGroup])
 
-	ni.UsedSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
+	ni.UsedSharedGPUsMemory[gpuGroup] -= ni.getTaskGpuGroupMemory(task, gpuGroup)
 
 	switch task.Status {
 	case pod_status.Releasing:
-		ni.ReleasingSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
-		ni.AllocatedSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
+		ni.ReleasingSharedGPUsMemory[gpuGroup] -= ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.AllocatedSharedGPUsMemory[gpuGroup] -= ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.ReleasingSharedGPUsTenants[gpuGroup]--
+		ni.AllocatedSharedGPUsTenants[gpuGroup]--
+		addGpuBandwidth(ni.ReleasingSharedGPUsBandwidth, gpuGroup, -task.GpuMemoryBandwidthWeight())
+		addGpuBandwidth(ni.AllocatedSharedGPUsBandwidth, gpuGroup, -task.GpuMemoryBandwidthWeight())
 		log.InfraLogger.V(6).Infof(
 			"Releasing gpuGroup: <%v> releasingSharedGPU: <%v> "+
 				"AllocatedSharedGPUsMemory <%v>, UsedSharedGPUsMemory: <%v>",
@@ -190,7 +232,9 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 			}
 		}
 	case pod_status.Pipelined:
-		ni.ReleasingSharedGPUsMemory[gpuGroup] += ni.GetResourceGpuMemory(task.ResReq)
+		ni.ReleasingSharedGPUsMemory[gpuGroup] += ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.ReleasingSharedGPUsTenants[gpuGroup]++
+		addGpuBandwidth(ni.ReleasingSharedGPUsBandwidth, gpuGroup, task.GpuMemoryBandwidthWeight())
 		log.InfraLogger.V(6).Infof(
 			"Pipelined gpuGroup: <%v> releasingSharedGPU: <%v> "+
 				"AllocatedSharedGPUsMemory <%v>, UsedSharedGPUsMemory: <%v>",
@@ -207,7 +251,9 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 				"AllocatedSharedGPUsMemory <%v>, UsedSharedGPUsMemory: <%v>",
 			gpuGroup, ni.ReleasingSharedGPUsMemory[gpuGroup],
 			ni.AllocatedSharedGPUsMemory[gpuGroup], ni.UsedSharedGPUsMemory[gpuGroup])
-		ni.AllocatedSharedGPUsMemory[gpuGroup] -= ni.GetResourceGpuMemory(task.ResReq)
+		ni.AllocatedSharedGPUsMemory[gpuGroup] -= ni.getTaskGpuGroupMemory(task, gpuGroup)
+		ni.AllocatedSharedGPUsTenants[gpuGroup]--
+		addGpuBandwidth(ni.AllocatedSharedGPUsBandwidth, gpuGroup, -task.GpuMemoryBandwidthWeight())
 
 		if ni.UsedSharedGPUsMemory[gpuGroup] <= 0 {
 			// no other fractional was allocated here yet
@@ -231,14 +277,22 @@ func (ni *NodeInfo) removeSharedTaskResourcesPerPodGroup(task *pod_info.PodInfo,
 }
 
 func (ni *NodeInfo) isPipelinedToReleasingGpu(task *pod_info.PodInfo, gpuGroup string) bool {
-	usedMemoryBeforeRemoval := ni.UsedSharedGPUsMemory[gpuGroup] + ni.GetResourceGpuMemory(task.ResReq)
-	releasingMemoryBeforeRemoval := ni.ReleasingSharedGPUsMemory[gpuGroup] - ni.GetResourceGpuMemory(task.ResReq)
+	usedMemoryBeforeRemoval := ni.UsedSharedGPUsMemory[gpuGroup] + ni.getTaskGpuGroupMemory(task, gpuGroup)
+	releasingMemoryBeforeRemoval := ni.ReleasingSharedGPUsMemory[gpuGroup] - ni.getTaskGpuGroupMemory(task, gpuGroup)
 	usedOriginally0 := ni.UsedSharedGPUsMemory[gpuGroup] == 0
 	releasingOriginally0 := ni.ReleasingSharedGPUsMemory[gpuGroup] == 0
 
 	return (usedMemoryBeforeRemoval == releasingMemoryBeforeRemoval) || (usedOriginally0 && releasingOriginally0)
 }
 
+// getTaskGpuGroupMemory returns the GPU memory, in MiB, that the task takes from the GPU group.
+func (ni *NodeInfo) getTaskGpuGroupMemory(task *pod_info.PodInfo, gpuGroup string) int64 {
+	if memory, found := task.GpuMemorySplit[gpuGroup]; found {
+		return memory
+	}
+	return ni.GetResourceGpuMemory(task.ResReq)
+}
+
 func (ni *NodeInfo) ConsolidateSharedPodInfoToDifferentGPU(ti *pod_info.PodInfo) error {
 	return ni.addTask(ti, true)
 }
@@ -259,7 +313,7 @@ func (ni *NodeInfo) isGpuReleasingFromSharedTasks(gpuGroup string) bool {
 	return false
 }
 
-func (ni *NodeInfo) getNumberOfUsedSharedGPUs() int {
+func (ni *NodeInfo) GetNumberOfUsedSharedGPUs() int {
 	numberOfSharedGPUs := 0
 	for _, sharedGPUs := range ni.UsedSharedGPUsMemory {
 		if sharedGPUs > 0 {
@@ -271,7 +325,7 @@ func (ni *NodeInfo) getNumberOfUsedSharedGPUs() int {
 }
 
 func (ni *NodeInfo) getNumberOfUsedGPUs() int {
-	return int(ni.Used.GPUs()) + ni.getNumberOfUsedSharedGPUs()
+	return int(ni.Used.GPUs()) + ni.GetNumberOfUsedSharedGPUs()
 }
 
 func (ni *NodeInfo) GetNumberOfAllocatedSharedGPUs() int {
@@ -285,6 +339,22 @@ func (ni *NodeInfo) GetNumberOfAllocatedSharedGPUs() int {
 	return numberOfAllocatedSharedGPUs
 }
 
+// GetFragmentedSharedGpuMemory returns the free memory, in MiB, of the node's shared GPUs that is left in pieces too
+// small for a request of minUsefulMemory.
+func (ni *NodeInfo) GetFragmentedSharedGpuMemory(minUsefulMemory int64) int64 {
+	fragmentedMemory := int64(0)
+	for gpuGroup, allocatedSharedGPUs := range ni.AllocatedSharedGPUsMemory {
+		if allocatedSharedGPUs <= 0 {
+			continue
+		}
+		freeMemory := ni.UsableGpuMemory(gpuGroup) - allocatedSharedGPUs
+		if freeMemory > 0 && freeMemory < minUsefulMemory {
+			fragmentedMemory += freeMemory
+		}
+	}
+	return fragmentedMemory
+}
+
 func (ni *NodeInfo) isSharedGpuMarkedAsReleasing(gpuGroup string) bool {
 	isReleasing, found := ni.ReleasingSharedGPUs[gpuGroup]
 	return found && isReleasing
@@ -303,10 +373,10 @@ func (ni *NodeInfo) unmarkSharedGpuAsReleasing(gpuGroup string) {
 func (ni *NodeInfo) getSumOfAvailableSharedGPUs() (float64, int64) {
 	sumOfSharedGPUs := float64(0)
 	sumOfSharedGPUsMemory := int64(0)
-	for _, allocatedSharedGPUs := range ni.AllocatedSharedGPUsMemory {
+	for gpuGroup, allocatedSharedGPUs := range ni.AllocatedSharedGPUsMemory {
 		if allocatedSharedGPUs > 0 {
 			sumOfSharedGPUs += 1 - ni.getGpuMemoryFractionalOnNode(allocatedSharedGPUs)
-			sumOfSharedGPUsMemory += ni.MemoryOfEveryGpuOnNode - allocatedSharedGPUs
+			sumOfSharedGPUsMemory += max(ni.UsableGpuMemory(gpuGroup)-allocatedSharedGPUs, 0)
 		}
 	}
 	return sumOfSharedGPUs, sumOfSharedGPUsMemory
@@ -329,10 +399,28 @@ func (ni *NodeInfo) getGpuMemoryFractionalOnNode(memory int64) float64 {
 	return math.Ceil(exactFraction*100) / 100
 }
 
+// GetTaskGpuUsage returns the number of GPUs and the GPU memory, in MiB, that the task uses on the node. GPU sharing
+// tasks use a fraction of each of their devices, derived from the requested memory for GPU memory requests.
+func (ni *NodeInfo) GetTaskGpuUsage(task *pod_info.PodInfo) (float64, int64) {
+	if task.ResReq.IsFractionalRequest() {
+		devices := max(task.ResReq.GetNumOfGpuDevices(), 1)
+		return ni.getResourceGpuPortion(task.ResReq) * float64(devices), ni.GetResourceGpuMemory(task.ResReq) * devices
+	}
+	gpus := task.ResReq.GetSumGPUs()
+	return gpus, int64(gpus * float64(ni.MemoryOfEveryGpuOnNode))
+}
+
+// HasSharedGpuCapacityFor returns true if the fractional task fits on GPUs of the node that are already shared,
+// without sharing any of the node's whole GPUs.
+func (ni *NodeInfo) HasSharedGpuCapacityFor(task *pod_info.PodInfo) bool {
+	return ni.fractionTaskGpusAllocatableDeviceCount(task) >= max(task.ResReq.GetNumOfGpuDevices(), 1)
+}
+
 func (ni *NodeInfo) fractionTaskGpusAllocatableDeviceCount(pod *pod_info.PodInfo) int64 {
 	matchingGpuGroupsCount := int64(0)
 	for gpuGroup := range ni.UsedSharedGPUsMemory {
-		if ni.IsTaskFitOnGpuGroup(pod.ResReq, gpuGroup) {
+		if ni.IsTaskFitOnGpuGroup(pod.ResReq, gpuGroup) && !ni.IsGpuGroupAtTenantLimit(gpuGroup) &&
+			ni.IsGpuGroupHealthy(gpuGroup) {
 			matchingGpuGroupsCount += 1
 			if matchingGpuGroupsCount >= pod.ResReq.GetNumOfGpuDevices() {
 				return matchingGpuGroupsCount
@@ -343,6 +431,46 @@ func (ni *NodeInfo) fractionTaskGpusAllocatableDeviceCount(pod *pod_info.PodInfo
 	return matchingGpuGroupsCount
 }
 
+// GetGpuMemorySplit returns how the GPU memory of a splittable task can be taken from the idle memory of several
+// shared GPUs of the node, when no single GPU has enough of it. The GPUs with the most idle memory are used first, so
+// the task spans as few GPUs as possible. Only the GPUs that gpuFilter accepts are used, and a nil gpuFilter accepts
+// all of them. It returns nil if the shared GPUs do not have enough idle memory together.
+func (ni *NodeInfo) GetGpuMemorySplit(task *pod_info.PodInfo, gpuFilter func(gpuGroup string) bool) map[string]int64 {
+	if !task.IsSplittableGpuMemoryRequest() {
+		return nil
+	}
+
+	idleMemory := map[string]int64{}
+	for gpuGroup, allocatedMemory := range ni.AllocatedSharedGPUsMemory {
+		if allocatedMemory > 0 && allocatedMemory < ni.UsableGpuMemory(gpuGroup) && ni.hasIdleTenantSlotOnGpu(gpuGroup) &&
+			ni.HasIdleBandwidthOnGpu(task, gpuGroup) && (gpuFilter == nil || gpuFilter(gpuGroup)) {
+			idleMemory[gpuGroup] = ni.UsableGpuMemory(gpuGroup) - allocatedMemory
+		}
+	}
+	gpuGroups := maps.Keys(idleMemory)
+	slices.SortFunc(gpuGroups, func(a, b string) int {
+		if idleMemory[a] != idleMemory[b] {
+			return cmp.Compare(idleMemory[b], idleMemory[a])
+		}
+		return strings.Compare(a, b)
+	})
+
+	remainingMemory := ni.GetResourceGpuMemory(task.ResReq)
+	split := map[string]int64{}
+	for _, gpuGroup := range gpuGroups {
+		if remainingMemory <= 0 {
+			break
+		}
+		memory := min(idleMemory[gpuGroup], remainingMemory)
+		split[gpuGroup] = memory
+		remainingMemory -= memory
+	}
+	if remainingMemory > 0 || len(split) < 2 {
+		return nil
+	}
+	return split
+}
+
 func (ni *NodeInfo) IsTaskFitOnGpuGroup(resourceRequest *resource_info.ResourceRequirements, gpuGroup string) bool {
 	usedMemory := ni.UsedSharedGPUsMemory[gpuGroup]
 	hasEnoughResources := ni.enoughResourcesOnGpu(resourceRequest, gpuGroup)
@@ -364,31 +492,70 @@ func (ni *NodeInfo) EnoughIdleResourcesOnGpu(resources *resource_info.ResourceRe
 		return false
 	}
 	requestedMemory := ni.GetResourceGpuMemory(resources)
-	availableMemory := ni.MemoryOfEveryGpuOnNode - allocatedMemory
-	hasEnough := availableMemory-requestedMemory >= 0
+	availableMemory := ni.UsableGpuMemory(gpuGroup) - allocatedMemory
+	hasEnough := availableMemory-requestedMemory >= 0 && ni.hasIdleTenantSlotOnGpu(gpuGroup)
 
-	log.InfraLogger.V(4).Infof("[IDLE_CHECK] GPU <%s>: TotalMemory=<%d MB>, AllocatedMemory=<%d MB>, RequestedMemory=<%d MB>, AvailableMemory=<%d MB>, EnoughIdle=<%v>",
-		gpuGroup, ni.MemoryOfEveryGpuOnNode, allocatedMemory, requestedMemory, availableMemory, hasEnough)
+	log.InfraLogger.V(4).Infof("[IDLE_CHECK] GPU <%s>: UsableMemory=<%d MB>, AllocatedMemory=<%d MB>, RequestedMemory=<%d MB>, AvailableMemory=<%d MB>, AllocatedTenants=<%d>, EnoughIdle=<%v>",
+		gpuGroup, ni.UsableGpuMemory(gpuGroup), allocatedMemory, requestedMemory, availableMemory,
+		ni.AllocatedSharedGPUsTenants[gpuGroup], hasEnough)
 
 	return hasEnough
 }
 
 func (ni *NodeInfo) enoughResourcesOnGpu(resources *resource_info.ResourceRequirements, gpuGroup string) bool {
-	totalMemory := ni.MemoryOfEveryGpuOnNode
+	usableMemory := ni.UsableGpuMemory(gpuGroup)
 	allocatedMemory := ni.AllocatedSharedGPUsMemory[gpuGroup]
 	releasingMemory := ni.ReleasingSharedGPUsMemory[gpuGroup]
 	requestedMemory := ni.GetResourceGpuMemory(resources)
 
-	// Available = Total - Allocated + Releasing (because releasing memory will become available)
-	availableMemory := totalMemory - allocatedMemory + releasingMemory
+	// Available = Usable - Allocated + Releasing (because releasing memory will become available)
+	availableMemory := usableMemory - allocatedMemory + releasingMemory
 	hasEnough := (availableMemory - requestedMemory) >= 0
 
-	log.InfraLogger.V(4).Infof("[RESOURCE_CHECK] GPU <%s>: TotalMemory=<%d MB>, AllocatedMemory=<%d MB>, ReleasingMemory=<%d MB>, RequestedMemory=<%d MB>, AvailableMemory=<%d MB>, EnoughResources=<%v>",
-		gpuGroup, totalMemory, allocatedMemory, releasingMemory, requestedMemory, availableMemory, hasEnough)
+	log.InfraLogger.V(4).Infof("[RESOURCE_CHECK] GPU <%s>: UsableMemory=<%d MB>, AllocatedMemory=<%d MB>, ReleasingMemory=<%d MB>, RequestedMemory=<%d MB>, AvailableMemory=<%d MB>, EnoughResources=<%v>",
+		gpuGroup, usableMemory, allocatedMemory, releasingMemory, requestedMemory, availableMemory, hasEnough)
 
 	return hasEnough
 }
 
+// IsGpuGroupAtTenantLimit returns true if the GPU group has as many fractional pods as the node allows, once its
+// releasing pods are gone and its pipelined pods are running.
+func (ni *NodeInfo) IsGpuGroupAtTenantLimit(gpuGroup string) bool {
+	if ni.MaxGpuSharingTenants <= 0 {
+		return false
+	}
+	tenants := ni.AllocatedSharedGPUsTenants[gpuGroup] - ni.ReleasingSharedGPUsTenants[gpuGroup]
+	return tenants >= ni.MaxGpuSharingTenants
+}
+
+// TenantLimitFitError returns a fit error if the shared GPUs of the node with enough memory for the fractional task
+// are all at the tenant limit of the node, so the task is told apart from one that lacks GPU memory.
+func (ni *NodeInfo) TenantLimitFitError(task *pod_info.PodInfo) *common_info.FitError {
+	if ni.MaxGpuSharingTenants <= 0 || !task.ResReq.IsFractionalRequest() {
+		return nil
+	}
+
+	gpusAtTenantLimit := 0
+	for gpuGroup := range ni.UsedSharedGPUsMemory {
+		if ni.IsTaskFitOnGpuGroup(task.ResReq, gpuGroup) && ni.IsGpuGroupAtTenantLimit(gpuGroup) {
+			gpusAtTenantLimit++
+		}
+	}
+	if gpusAtTenantLimit == 0 {
+		return nil
+	}
+	return common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, ni.Name,
+		[]string{"node(s) didn't have enough resources: GPU at tenant limit"},
+		fmt.Sprintf("node(s) have %d GPU(s) with enough memory for the pod, but at the limit of %d fractional pods "+
+			"per GPU", gpusAtTenantLimit, ni.MaxGpuSharingTenants))
+}
+
+// hasIdleTenantSlotOnGpu returns true if another fractional pod can share the GPU group right away, without waiting
+// for its releasing pods.
+func (ni *NodeInfo) hasIdleTenantSlotOnGpu(gpuGroup string) bool {
+	return ni.MaxGpuSharingTenants <= 0 || ni.AllocatedSharedGPUsTenants[gpuGroup] < ni.MaxGpuSharingTenants
+}
+
 func (ni *NodeInfo) isAllGpuReleased(gpuGroup string) bool {
 	return ni.AllocatedSharedGPUsMemory[gpuGroup] == ni.ReleasingSharedGPUsMemory[gpuGroup]
 }
@@ -48,6 +48,7 @@ const (
 	DefaultGpuMemory = 100 // The default value is 100 because it allows all the calculation of (memory = fractional * GpuMemory) to work, if it was 0 the result will always be zero too
 	GpuMemoryLabel   = "nvidia.com/gpu.memory"
 	GpuCountLabel    = "nvidia.com/gpu.count"
+	GpuProductLabel  = "nvidia.com/gpu.product"
 	MbToBRatio       = 1000000
 	BitToMib         = 1024 * 1024
 	TibInMib         = 1024 * 1024
@@ -86,9 +87,40 @@ type NodeInfo struct {
 	MemoryOfEveryGpuOnNode int64
 	GpuMemorySynced        bool
 	LegacyMIGTasks         map[common_info.PodID]string
+	// ExclusiveNodeTasks holds the keys of the non-releasing tasks on the node that require it exclusively. While it
+	// is not empty, the node is reserved for them.
+	ExclusiveNodeTasks map[common_info.PodID]string
 
 	PodAffinityInfo pod_affinity.NodePodAffinityInfo
 
+	// GpuNumaNodes holds the NUMA node of each GPU of the node, by GPU index. It is empty if the NUMA topology of the
+	// node is unknown.
+	GpuNumaNodes []int
+	// GpuDeviceIds holds the id of each GPU of the node, e.g. its UUID, by GPU index. It is empty if the GPUs of the
+	// node are not known.
+	GpuDeviceIds    []string
+	gpuGroupIndexes map[string]int
+
+	// GpuMemoryQuantum is the quantum that the GPU memory requests of pods on the node are rounded up to. Nil when
+	// requests are not rounded.
+	GpuMemoryQuantum *conf.GpuMemoryQuantum
+	// MaxGpuSharingTenants is the maximum number of fractional pods that share a GPU of the node. 0 when the tenants
+	// are not limited.
+	MaxGpuSharingTenants int
+	// UnhealthyGpus holds the indexes of the node's GPUs that are known to be unhealthy. GPU sharing pods are not
+	// placed on them.
+	UnhealthyGpus map[int]bool
+	// GpuInterconnectTier is the bandwidth tier of the interconnect between the GPUs of the node.
+	GpuInterconnectTier GpuInterconnectTier
+	// ReservedGpuMemory is the GPU memory, in MiB, kept free on every GPU of the node for system daemons that the
+	// scheduler does not see. 0 when no memory is reserved.
+	ReservedGpuMemory int64
+	// reservedGpuMemoryPerGpu overrides ReservedGpuMemory for single GPUs, by GPU index.
+	reservedGpuMemoryPerGpu map[int]int64
+	// GpuMemoryBandwidthBudget is the memory bandwidth weight that the fractional pods sharing a GPU of the node may
+	// sum up to. 0 when the bandwidth is not limited.
+	GpuMemoryBandwidthBudget int64
+
 	GpuSharingNodeInfo
 }
 
@@ -111,11 +143,23 @@ func NewNodeInfo(node *v1.Node, podAffinityInfo pod_affinity.NodePodAffinityInfo
 		MemoryOfEveryGpuOnNode: gpuMemory,
 		GpuMemorySynced:        exists,
 		LegacyMIGTasks:         map[common_info.PodID]string{},
+		ExclusiveNodeTasks:     map[common_info.PodID]string{},
 
 		GpuSharingNodeInfo: *newGpuSharingNodeInfo(),
 
 		PodAffinityInfo: podAffinityInfo,
+
+		GpuNumaNodes:  getGpuNumaNodes(node),
+		GpuDeviceIds:  getGpuDeviceIds(node),
+		UnhealthyGpus: getUnhealthyGpus(node),
+
+		GpuInterconnectTier: getGpuInterconnectTier(node),
+
+		reservedGpuMemoryPerGpu: getReservedGpuMemoryPerGpu(node),
+
+		GpuMemoryBandwidthBudget: getGpuMemoryBandwidthBudget(node),
 	}
+	nodeInfo.ReservedGpuMemory, _ = getNodeReservedGpuMemory(node)
 	numTasks := node.Status.Allocatable[v1.ResourcePods]
 	nodeInfo.MaxTaskNum = int(numTasks.Value())
 
@@ -263,10 +307,17 @@ func (ni *NodeInfo) isTaskStorageAllocatableOnReleasingOrIdle(task *pod_info.Pod
 }
 
 func (ni *NodeInfo) FittingError(task *pod_info.PodInfo, isGangTask bool) *common_info.FitError {
+	if fitError := ni.BandwidthBudgetFitError(task); fitError != nil {
+		return fitError
+	}
+	if fitError := ni.UnhealthyGpusFitError(task); fitError != nil {
+		return fitError
+	}
+
 	enoughResources := ni.lessEqualTaskToNodeResources(task.ResReq, ni.Idle)
 	if !enoughResources {
 		totalUsed := ni.Used.Clone()
-		totalUsed.AddGPUs(float64(ni.getNumberOfUsedSharedGPUs()))
+		totalUsed.AddGPUs(float64(ni.GetNumberOfUsedSharedGPUs()))
 		totalCapability := ni.Allocatable.Clone()
 
 		requestedResources := task.ResReq.Clone()
@@ -295,6 +346,11 @@ func (ni *NodeInfo) FittingError(task *pod_info.PodInfo, isGangTask bool) *commo
 		return fitError
 	}
 
+	// The tenant limit is only reported once the other resources of the node fit the task
+	if fitError := ni.TenantLimitFitError(task); fitError != nil {
+		return fitError
+	}
+
 	allocatable, err := ni.isTaskStorageAllocatable(task)
 	if !allocatable {
 		return common_info.NewFitErrorByReasons(task.Name, task.Namespace, ni.Name, err, err.Error())
@@ -344,7 +400,8 @@ func (ni *NodeInfo) isTaskAllocatableOnNonAllocatedResources(
 	task *pod_info.PodInfo, nodeNonAllocatedResources *resource_info.Resource,
 ) bool {
 	if task.IsRegularGPURequest() || task.IsMigProfileRequest() {
-		return ni.lessEqualTaskToNodeResources(task.ResReq, nodeNonAllocatedResources)
+		return ni.lessEqualTaskToNodeResources(task.ResReq, nodeNonAllocatedResources) &&
+			ni.isWholeGpuRequestAllocatable(task, nodeNonAllocatedResources)
 	}
 
 	if !task.ResReq.BaseResource.LessEqual(&nodeNonAllocatedResources.BaseResource) {
@@ -355,12 +412,16 @@ func (ni *NodeInfo) isTaskAllocatableOnNonAllocatedResources(
 		return false
 	}
 	nodeIdleOrReleasingWholeGpus := int64(math.Floor(nodeNonAllocatedResources.GPUs()))
+	nodeIdleOrReleasingWholeGpus = max(nodeIdleOrReleasingWholeGpus-int64(ni.GetNumOfUnhealthyWholeGpus()), 0)
+	if !ni.IsTaskFitOnWholeGpu(task.ResReq) {
+		nodeIdleOrReleasingWholeGpus = 0
+	}
 	nodeNonAllocatedResourcesMatchingSharedGpus := ni.fractionTaskGpusAllocatableDeviceCount(task)
 	if nodeIdleOrReleasingWholeGpus+nodeNonAllocatedResourcesMatchingSharedGpus >= task.ResReq.GetNumOfGpuDevices() {
 		return true
 	}
 
-	return false
+	return ni.GetGpuMemorySplit(task, nil) != nil
 }
 
 func (ni *NodeInfo) shouldAddTaskResources(task *pod_info.PodInfo) bool {
@@ -393,10 +454,12 @@ func (ni *NodeInfo) addTask(task *pod_info.PodInfo, allowTaskToExistOnDifferentG
 	if task.IsLegacyMIGtask {
 		ni.LegacyMIGTasks[task.UID] = string(pod_info.PodKey(task.Pod))
 	}
+	ni.addExclusiveNodeTask(task)
 
 	ni.addTaskResources(task)
 	ni.addTaskStorage(task)
 	ni.PodAffinityInfo.AddPod(task.Pod)
+	ni.recordGpuGroupIndex(task)
 	return nil
 }
 
@@ -480,6 +543,7 @@ func (ni *NodeInfo) RemoveTask(ti *pod_info.PodInfo) error {
 			ti.Namespace, ti.Name, ni.Name)
 	}
 	delete(ni.PodInfos, key)
+	delete(ni.ExclusiveNodeTasks, key)
 	if ni.Node == nil {
 		return fmt.Errorf("node is nil during remove task, node name: <%v>", ni.Name)
 	}
@@ -621,9 +685,84 @@ func (ni *NodeInfo) GetNumberOfGPUsInNode() int64 {
 	return int64(numberOfGPUs)
 }
 
+// GetGpuModel returns the model of the node's GPUs, as published by GPU feature discovery, or an empty string if the
+// node has no GPU model label.
+func (ni *NodeInfo) GetGpuModel() string {
+	return ni.Node.Labels[GpuProductLabel]
+}
+
+// PredicateByGpuModel checks that the node's GPU model is one of the models requested by the task. A requested model
+// matches the node's GPU model either exactly or as a dash separated part of it, so "H100" matches
+// "NVIDIA-H100-80GB-HBM3". availableModels is only used to explain the fit error.
+func (ni *NodeInfo) PredicateByGpuModel(task *pod_info.PodInfo, availableModels []string) error {
+	requestedModels := task.RequestedGpuModels()
+	if len(requestedModels) == 0 {
+		return nil
+	}
+
+	nodeModel := ni.GetGpuModel()
+	for _, requestedModel := range requestedModels {
+		if isGpuModelMatch(nodeModel, requestedModel) {
+			return nil
+		}
+	}
+
+	if nodeModel == "" {
+		nodeModel = "unknown"
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, ni.Name,
+		fmt.Sprintf("node GPU model %s is not one of the requested GPU models %v. Available GPU models: %v",
+			nodeModel, requestedModels, availableModels))
+}
+
+// PredicateByGpuDevicePlugin returns a fit error for tasks that require GPUs if the node is labeled with GPUs but
+// does not advertise a capacity and an allocatable of the GPU device plugin resource, as happens until the device plugin
+// of a new or restarted node registers. Pods bound to such nodes hang until the device plugin is ready.
+func (ni *NodeInfo) PredicateByGpuDevicePlugin(task *pod_info.PodInfo, gpuResourceName v1.ResourceName) error {
+	if !task.IsRequireAnyKindOfGPU() || task.IsMigProfileRequest() || ni.IsMIGEnabled() {
+		return nil
+	}
+	if gpuCount, err := ni.getNodeGpuCountLabelValue(); err != nil || gpuCount <= 0 {
+		return nil
+	}
+
+	capacity := ni.Node.Status.Capacity[gpuResourceName]
+	allocatable := ni.Node.Status.Allocatable[gpuResourceName]
+	if capacity.IsZero() || allocatable.IsZero() {
+		return common_info.NewFitError(task.Name, task.Namespace, ni.Name, "GPU device plugin not ready")
+	}
+	return nil
+}
+
+// IsUnderPressure returns true if the node reports the MemoryPressure or DiskPressure condition.
+func (ni *NodeInfo) IsUnderPressure() bool {
+	for _, condition := range ni.Node.Status.Conditions {
+		if (condition.Type == v1.NodeMemoryPressure || condition.Type == v1.NodeDiskPressure) &&
+			condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// HasGpuModel returns true if the node's GPU model matches the given model, as defined by PredicateByGpuModel.
+func (ni *NodeInfo) HasGpuModel(model string) bool {
+	return isGpuModelMatch(ni.GetGpuModel(), model)
+}
+
+func isGpuModelMatch(nodeModel, requestedModel string) bool {
+	if nodeModel == "" {
+		return false
+	}
+	nodeModel = "-" + strings.ToUpper(nodeModel) + "-"
+	return strings.Contains(nodeModel, "-"+strings.ToUpper(requestedModel)+"-")
+}
+
+// GetResourceGpuMemory returns the GPU memory, in MiB, that the request takes from each of its GPUs on the node. GPU
+// memory requests are rounded up to the GPU memory quantum of the node.
 func (ni *NodeInfo) GetResourceGpuMemory(res *resource_info.ResourceRequirements) int64 {
 	if res.GpuMemory() > 0 {
-		return res.GpuMemory()
+		return ni.GpuMemoryQuantum.Round(res.GpuMemory(), ni.MemoryOfEveryGpuOnNode)
 	} else {
 		return int64(res.GpuFractionalPortion() * float64(ni.MemoryOfEveryGpuOnNode))
 	}
@@ -631,7 +770,7 @@ func (ni *NodeInfo) GetResourceGpuMemory(res *resource_info.ResourceRequirements
 
 func (ni *NodeInfo) getResourceGpuPortion(res *resource_info.ResourceRequirements) float64 {
 	if res.GpuMemory() > 0 {
-		return ni.getGpuMemoryFractionalOnNode(res.GpuMemory())
+		return ni.getGpuMemoryFractionalOnNode(ni.GetResourceGpuMemory(res))
 	}
 	return res.GpuFractionalPortion()
 }
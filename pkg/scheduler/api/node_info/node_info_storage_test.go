@@ -119,6 +119,7 @@ func TestNodeInfoStorage_AddPod(t *testing.T) {
 			pod1Info.UID: pod1Info,
 		},
 		LegacyMIGTasks:         map[common_info.PodID]string{},
+		ExclusiveNodeTasks:     map[common_info.PodID]string{},
 		MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 		GpuSharingNodeInfo:     *newGpuSharingNodeInfo(),
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{
@@ -41,6 +41,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storagecapacity_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 )
 
 const (
@@ -121,6 +122,7 @@ func TestNodeInfo_AddPod(t *testing.T) {
 			"c1/p2": pod_info.NewTaskInfo(pod2),
 		},
 		LegacyMIGTasks:              map[common_info.PodID]string{},
+		ExclusiveNodeTasks:          map[common_info.PodID]string{},
 		MemoryOfEveryGpuOnNode:      DefaultGpuMemory,
 		GpuSharingNodeInfo:          *newGpuSharingNodeInfo(),
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -170,6 +172,7 @@ func TestNodeInfo_RemovePod(t *testing.T) {
 			"c1/p3": pod3PodInfo,
 		},
 		LegacyMIGTasks:              map[common_info.PodID]string{},
+		ExclusiveNodeTasks:          map[common_info.PodID]string{},
 		MemoryOfEveryGpuOnNode:      DefaultGpuMemory,
 		GpuSharingNodeInfo:          *newGpuSharingNodeInfo(),
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -229,6 +232,7 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
@@ -236,6 +240,8 @@ func TestAddRemovePods(t *testing.T) {
 					sharingMaps.ReleasingSharedGPUsMemory["1"] = 50
 					sharingMaps.UsedSharedGPUsMemory["1"] = 50
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 50
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 1
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 1
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -248,12 +254,15 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
 					sharingMaps.ReleasingSharedGPUsMemory["1"] = 0
 					sharingMaps.UsedSharedGPUsMemory["1"] = 0
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 0
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 0
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 0
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -293,6 +302,7 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
@@ -302,6 +312,9 @@ func TestAddRemovePods(t *testing.T) {
 					sharingMaps.UsedSharedGPUsMemory["1"] = 50
 					sharingMaps.UsedSharedGPUsMemory["2"] = 50
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 50
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 1
+					sharingMaps.ReleasingSharedGPUsTenants["2"] = -1
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 1
 
 					return sharingMaps
 				}(),
@@ -315,6 +328,7 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
@@ -323,6 +337,9 @@ func TestAddRemovePods(t *testing.T) {
 					sharingMaps.UsedSharedGPUsMemory["1"] = 0
 					sharingMaps.UsedSharedGPUsMemory["2"] = 0
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 0
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 0
+					sharingMaps.ReleasingSharedGPUsTenants["2"] = 0
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 0
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -372,12 +389,15 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
 					sharingMaps.ReleasingSharedGPUsMemory["1"] = 0
 					sharingMaps.UsedSharedGPUsMemory["1"] = 120
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 70
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 0
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 2
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -390,12 +410,15 @@ func TestAddRemovePods(t *testing.T) {
 				Allocatable:            common_info.BuildResourceWithGpu("8000m", "10G", "1"),
 				PodInfos:               map[common_info.PodID]*pod_info.PodInfo{},
 				LegacyMIGTasks:         map[common_info.PodID]string{},
+				ExclusiveNodeTasks:     map[common_info.PodID]string{},
 				MemoryOfEveryGpuOnNode: DefaultGpuMemory,
 				GpuSharingNodeInfo: func() GpuSharingNodeInfo {
 					sharingMaps := *newGpuSharingNodeInfo()
 					sharingMaps.ReleasingSharedGPUsMemory["1"] = 0
 					sharingMaps.UsedSharedGPUsMemory["1"] = 0
 					sharingMaps.AllocatedSharedGPUsMemory["1"] = 0
+					sharingMaps.ReleasingSharedGPUsTenants["1"] = 0
+					sharingMaps.AllocatedSharedGPUsTenants["1"] = 0
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
@@ -1072,3 +1095,890 @@ func Test_isMigResource(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeInfo_PredicateByGpuModel(t *testing.T) {
+	availableModels := []string{"NVIDIA-A100-SXM4-80GB", "NVIDIA-H100-80GB-HBM3"}
+	tests := []struct {
+		name          string
+		nodeModel     string
+		podAnnotation string
+		expectFit     bool
+	}{
+		{
+			name:      "no requested model",
+			nodeModel: "NVIDIA-A100-SXM4-80GB",
+			expectFit: true,
+		},
+		{
+			name:          "exact model",
+			nodeModel:     "NVIDIA-H100-80GB-HBM3",
+			podAnnotation: "NVIDIA-H100-80GB-HBM3",
+			expectFit:     true,
+		},
+		{
+			name:          "short model name",
+			nodeModel:     "NVIDIA-H100-80GB-HBM3",
+			podAnnotation: "h100",
+			expectFit:     true,
+		},
+		{
+			name:          "one of several models",
+			nodeModel:     "NVIDIA-H100-80GB-HBM3",
+			podAnnotation: "H200, H100",
+			expectFit:     true,
+		},
+		{
+			name:          "different model",
+			nodeModel:     "NVIDIA-A100-SXM4-80GB",
+			podAnnotation: "H100,H200",
+		},
+		{
+			name:          "partial token does not match",
+			nodeModel:     "NVIDIA-H100-80GB-HBM3",
+			podAnnotation: "H10",
+		},
+		{
+			name:          "node without a GPU model",
+			podAnnotation: "H100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
+			if tt.nodeModel != "" {
+				node.Labels = map[string]string{GpuProductLabel: tt.nodeModel}
+			}
+			ni := NewNodeInfo(node, nil)
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending,
+				common_info.BuildResourceList("1000m", "1G"), []metav1.OwnerReference{}, nil,
+				map[string]string{commonconstants.GpuModel: tt.podAnnotation})
+			task := pod_info.NewTaskInfo(pod)
+
+			err := ni.PredicateByGpuModel(task, availableModels)
+			if tt.expectFit {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "NVIDIA-H100-80GB-HBM3")
+		})
+	}
+}
+
+func TestNodeInfo_PredicateByGpuDevicePlugin(t *testing.T) {
+	tests := []struct {
+		name            string
+		gpuCountLabel   string
+		capacityGPUs    string
+		allocatableGPUs string
+		podGPUs         string
+		gpuResourceName v1.ResourceName
+		expectFit       bool
+	}{
+		{
+			name:            "device plugin registered",
+			gpuCountLabel:   "4",
+			capacityGPUs:    "4",
+			allocatableGPUs: "4",
+			podGPUs:         "1",
+			expectFit:       true,
+		},
+		{
+			name:          "device plugin not registered",
+			gpuCountLabel: "4",
+			podGPUs:       "1",
+		},
+		{
+			name:          "no allocatable GPUs",
+			gpuCountLabel: "4",
+			capacityGPUs:  "4",
+			podGPUs:       "1",
+		},
+		{
+			name:          "cpu only pod",
+			gpuCountLabel: "4",
+			expectFit:     true,
+		},
+		{
+			name:      "node without gpu count label",
+			podGPUs:   "1",
+			expectFit: true,
+		},
+		{
+			name:            "custom resource name",
+			gpuCountLabel:   "4",
+			capacityGPUs:    "4",
+			allocatableGPUs: "4",
+			podGPUs:         "1",
+			gpuResourceName: "example.com/gpu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
+			node.Status.Allocatable = common_info.BuildResourceList("8000m", "10G")
+			if tt.gpuCountLabel != "" {
+				node.Labels = map[string]string{commonconstants.GpuCountLabel: tt.gpuCountLabel}
+			}
+			if tt.capacityGPUs != "" {
+				node.Status.Capacity[commonconstants.GpuResource] = resource.MustParse(tt.capacityGPUs)
+			}
+			if tt.allocatableGPUs != "" {
+				node.Status.Allocatable[commonconstants.GpuResource] = resource.MustParse(tt.allocatableGPUs)
+			}
+			ni := NewNodeInfo(node, nil)
+			resources := common_info.BuildResourceList("1000m", "1G")
+			if tt.podGPUs != "" {
+				resources = common_info.BuildResourceListWithGPU("1000m", "1G", tt.podGPUs)
+			}
+			task := pod_info.NewTaskInfo(common_info.BuildPod("ns", "p1", "", v1.PodPending, resources,
+				[]metav1.OwnerReference{}, nil, nil))
+			gpuResourceName := tt.gpuResourceName
+			if gpuResourceName == "" {
+				gpuResourceName = commonconstants.GpuResource
+			}
+
+			err := ni.PredicateByGpuDevicePlugin(task, gpuResourceName)
+			if tt.expectFit {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "GPU device plugin not ready")
+		})
+	}
+}
+
+func TestNodeInfo_IsGpuGroupNumaAligned(t *testing.T) {
+	tests := []struct {
+		name              string
+		gpuNumaNodes      string
+		reservedGpuIndex  string
+		gpuGroup          string
+		podNumaNode       string
+		expectNumaAligned bool
+	}{
+		{
+			name:              "gpu on the pod's numa node",
+			gpuNumaNodes:      "0,0,1,1",
+			reservedGpuIndex:  "2",
+			gpuGroup:          "group-a",
+			podNumaNode:       "1",
+			expectNumaAligned: true,
+		},
+		{
+			name:             "gpu on another numa node",
+			gpuNumaNodes:     "0,0,1,1",
+			reservedGpuIndex: "2",
+			gpuGroup:         "group-a",
+			podNumaNode:      "0",
+		},
+		{
+			name:             "pod without a numa node",
+			gpuNumaNodes:     "0,0,1,1",
+			reservedGpuIndex: "2",
+			gpuGroup:         "group-a",
+		},
+		{
+			name:             "node without gpu numa nodes",
+			reservedGpuIndex: "2",
+			gpuGroup:         "group-a",
+			podNumaNode:      "1",
+		},
+		{
+			name:             "invalid gpu numa nodes",
+			gpuNumaNodes:     "0,0,one,1",
+			reservedGpuIndex: "2",
+			gpuGroup:         "group-a",
+			podNumaNode:      "1",
+		},
+		{
+			name:             "gpu index not reserved yet",
+			gpuNumaNodes:     "0,0,1,1",
+			reservedGpuIndex: "-1",
+			gpuGroup:         "group-a",
+			podNumaNode:      "1",
+		},
+		{
+			name:             "unknown gpu group",
+			gpuNumaNodes:     "0,0,1,1",
+			reservedGpuIndex: "2",
+			gpuGroup:         "group-b",
+			podNumaNode:      "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
+			if tt.gpuNumaNodes != "" {
+				node.Annotations = map[string]string{commonconstants.GpuNumaNodes: tt.gpuNumaNodes}
+			}
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+
+			reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-n1-abcde", "n1",
+				v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+				map[string]string{
+					commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+					commonconstants.GPUGroup:     "group-a",
+				},
+				map[string]string{commonconstants.ReservedGpuIndex: tt.reservedGpuIndex})
+			assert.NoError(t, ni.AddTask(pod_info.NewTaskInfo(reservationPod)))
+
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending,
+				common_info.BuildResourceList("1000m", "1G"), []metav1.OwnerReference{}, nil,
+				map[string]string{commonconstants.NumaNode: tt.podNumaNode})
+			task := pod_info.NewTaskInfo(pod)
+
+			assert.Equal(t, tt.expectNumaAligned, ni.IsGpuGroupNumaAligned(task, tt.gpuGroup))
+		})
+	}
+}
+
+func buildGpuMemorySplitNode(t *testing.T, allocatedSharedGPUsMemory map[string]int64) *NodeInfo {
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+	nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+	nodePodAffinityInfo.EXPECT().RemovePod(Any()).AnyTimes()
+	gpus := len(allocatedSharedGPUsMemory)
+	ni := NewNodeInfo(common_info.BuildNode("n1",
+		common_info.BuildResourceListWithGPU("8000m", "10G", strconv.Itoa(gpus))), nodePodAffinityInfo)
+	ni.MemoryOfEveryGpuOnNode = 1000
+	for gpuGroup, allocatedMemory := range allocatedSharedGPUsMemory {
+		ni.UsedSharedGPUsMemory[gpuGroup] = allocatedMemory
+		ni.AllocatedSharedGPUsMemory[gpuGroup] = allocatedMemory
+	}
+	ni.Idle.SubGPUs(float64(gpus))
+	return ni
+}
+
+func buildGpuMemoryPod(name string, status v1.PodPhase, annotations map[string]string) *v1.Pod {
+	return common_info.BuildPod("ns", name, "n1", status, common_info.BuildResourceList("1000m", "1G"),
+		[]metav1.OwnerReference{}, nil, annotations)
+}
+
+func TestNodeInfo_GetGpuMemorySplit(t *testing.T) {
+	tests := []struct {
+		name                      string
+		allocatedSharedGPUsMemory map[string]int64
+		podAnnotations            map[string]string
+		expectedSplit             map[string]int64
+		expectAllocatable         bool
+	}{
+		{
+			name:                      "spans the gpus with the most idle memory first",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 400, "group-b": 600, "group-c": 900},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "900",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectedSplit:     map[string]int64{"group-a": 600, "group-b": 300},
+			expectAllocatable: true,
+		},
+		{
+			name:                      "equally idle gpus are taken by name",
+			allocatedSharedGPUsMemory: map[string]int64{"group-b": 500, "group-a": 500, "group-c": 500},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "800",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectedSplit:     map[string]int64{"group-a": 500, "group-b": 300},
+			expectAllocatable: true,
+		},
+		{
+			name:                      "fraction request",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 500, "group-b": 500},
+			podAnnotations: map[string]string{
+				commonconstants.GpuFraction:         "0.8",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectedSplit:     map[string]int64{"group-a": 500, "group-b": 300},
+			expectAllocatable: true,
+		},
+		{
+			name:                      "not splittable",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 400, "group-b": 600},
+			podAnnotations:            map[string]string{commonconstants.GpuMemory: "900"},
+		},
+		{
+			name:                      "not enough idle memory on all gpus together",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 400, "group-b": 600, "group-c": 900},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "1200",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+		},
+		{
+			name:                      "fits on a single gpu",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 400, "group-b": 600},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "500",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectAllocatable: true,
+		},
+		{
+			name:                      "multi device request",
+			allocatedSharedGPUsMemory: map[string]int64{"group-a": 400, "group-b": 600, "group-c": 600},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:              "500",
+				commonconstants.GpuFractionsNumDevices: "2",
+				commonconstants.SplittableGpuMemory:    "true",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ni := buildGpuMemorySplitNode(t, tt.allocatedSharedGPUsMemory)
+			task := pod_info.NewTaskInfo(buildGpuMemoryPod("p1", v1.PodPending, tt.podAnnotations))
+
+			assert.Equal(t, tt.expectedSplit, ni.GetGpuMemorySplit(task, nil))
+			assert.Equal(t, tt.expectAllocatable, ni.isTaskAllocatableOnNonAllocatedResources(task, ni.Idle))
+		})
+	}
+}
+
+func TestNodeInfo_AddRemoveGpuMemorySplitTask(t *testing.T) {
+	ni := buildGpuMemorySplitNode(t, map[string]int64{"group-a": 400, "group-b": 600})
+	idleGpus := ni.Idle.GPUs()
+
+	task := pod_info.NewTaskInfo(buildGpuMemoryPod("p1", v1.PodRunning, map[string]string{
+		commonconstants.GpuMemory:            "900",
+		commonconstants.SplittableGpuMemory:  "true",
+		commonconstants.ReceivedResourceType: string(pod_info.ReceivedTypeFraction),
+		commonconstants.GpuMemorySplit:       "group-a:600,group-b:300",
+	}))
+	assert.Equal(t, []string{"group-a", "group-b"}, task.GPUGroups)
+
+	assert.NoError(t, ni.AddTask(task))
+	assert.Equal(t, map[string]int64{"group-a": 1000, "group-b": 900}, ni.AllocatedSharedGPUsMemory)
+	assert.Equal(t, map[string]int64{"group-a": 1000, "group-b": 900}, ni.UsedSharedGPUsMemory)
+	assert.Equal(t, idleGpus, ni.Idle.GPUs())
+
+	assert.NoError(t, ni.RemoveTask(task))
+	assert.Equal(t, map[string]int64{"group-a": 400, "group-b": 600}, ni.AllocatedSharedGPUsMemory)
+	assert.Equal(t, map[string]int64{"group-a": 400, "group-b": 600}, ni.UsedSharedGPUsMemory)
+	assert.Equal(t, idleGpus, ni.Idle.GPUs())
+}
+
+func TestNodeInfo_GpuMemoryQuantum(t *testing.T) {
+	tests := []struct {
+		name               string
+		quantum            string
+		gpuMemory          string
+		expectedGpuMemory  int64
+		expectedGpuPortion float64
+		expectFit          bool
+	}{
+		{
+			name:               "no quantum",
+			gpuMemory:          "250",
+			expectedGpuMemory:  250,
+			expectedGpuPortion: 0.25,
+			expectFit:          true,
+		},
+		{
+			name:               "rounded up to a memory quantum",
+			quantum:            "100Mi",
+			gpuMemory:          "250",
+			expectedGpuMemory:  300,
+			expectedGpuPortion: 0.3,
+			expectFit:          true,
+		},
+		{
+			name:               "rounded up beyond the idle memory of the gpu",
+			quantum:            "200Mi",
+			gpuMemory:          "250",
+			expectedGpuMemory:  400,
+			expectedGpuPortion: 0.4,
+		},
+		{
+			name:               "request on the quantum",
+			quantum:            "1/4",
+			gpuMemory:          "250",
+			expectedGpuMemory:  250,
+			expectedGpuPortion: 0.25,
+			expectFit:          true,
+		},
+		{
+			name:               "rounded up to a fraction of the gpu",
+			quantum:            "1/4",
+			gpuMemory:          "260",
+			expectedGpuMemory:  500,
+			expectedGpuPortion: 0.5,
+		},
+		{
+			name:               "rounded request does not exceed the gpu memory",
+			quantum:            "300Mi",
+			gpuMemory:          "950",
+			expectedGpuMemory:  1000,
+			expectedGpuPortion: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ni := buildGpuMemorySplitNode(t, map[string]int64{"group-a": 700})
+			quantum, err := conf.ParseGpuMemoryQuantum(tt.quantum)
+			assert.NoError(t, err)
+			ni.GpuMemoryQuantum = quantum
+			task := pod_info.NewTaskInfo(buildGpuMemoryPod("p1", v1.PodPending,
+				map[string]string{commonconstants.GpuMemory: tt.gpuMemory}))
+
+			assert.Equal(t, tt.expectedGpuMemory, ni.GetResourceGpuMemory(task.ResReq))
+			assert.Equal(t, tt.expectedGpuPortion, ni.getResourceGpuPortion(task.ResReq))
+			assert.Equal(t, tt.expectFit, ni.IsTaskFitOnGpuGroup(task.ResReq, "group-a"))
+		})
+	}
+}
+
+func TestNodeInfo_MaxGpuSharingTenants(t *testing.T) {
+	tests := []struct {
+		name                 string
+		maxGpuSharingTenants int
+		tenantStatuses       []pod_status.PodStatus
+		gpuMemory            string
+		expectAtTenantLimit  bool
+		expectEnoughIdle     bool
+		expectedFitReasons   []string
+	}{
+		{
+			name:             "tenants are not limited",
+			tenantStatuses:   []pod_status.PodStatus{pod_status.Running, pod_status.Running, pod_status.Running},
+			gpuMemory:        "200",
+			expectEnoughIdle: true,
+		},
+		{
+			name:                 "gpu below the tenant limit",
+			maxGpuSharingTenants: 3,
+			tenantStatuses:       []pod_status.PodStatus{pod_status.Running, pod_status.Running},
+			gpuMemory:            "200",
+			expectEnoughIdle:     true,
+		},
+		{
+			name:                 "gpu at the tenant limit",
+			maxGpuSharingTenants: 3,
+			tenantStatuses:       []pod_status.PodStatus{pod_status.Running, pod_status.Running, pod_status.Running},
+			gpuMemory:            "200",
+			expectAtTenantLimit:  true,
+			expectedFitReasons:   []string{"node(s) didn't have enough resources: GPU at tenant limit"},
+		},
+		{
+			name:                 "releasing tenant frees a slot once released",
+			maxGpuSharingTenants: 3,
+			tenantStatuses:       []pod_status.PodStatus{pod_status.Running, pod_status.Running, pod_status.Releasing},
+			gpuMemory:            "200",
+		},
+		{
+			name:                 "pipelined tenant takes the slot of a releasing tenant",
+			maxGpuSharingTenants: 3,
+			tenantStatuses: []pod_status.PodStatus{
+				pod_status.Running, pod_status.Running, pod_status.Releasing, pod_status.Pipelined,
+			},
+			gpuMemory:           "200",
+			expectAtTenantLimit: true,
+			expectedFitReasons:  []string{"node(s) didn't have enough resources: GPU at tenant limit"},
+		},
+		{
+			name:                 "gpu at the tenant limit without enough memory for the pod",
+			maxGpuSharingTenants: 3,
+			tenantStatuses:       []pod_status.PodStatus{pod_status.Running, pod_status.Running, pod_status.Running},
+			gpuMemory:            "900",
+			expectAtTenantLimit:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(common_info.BuildNode("n1",
+				common_info.BuildResourceListWithGPU("8000m", "10G", "1")), nodePodAffinityInfo)
+			ni.MemoryOfEveryGpuOnNode = 1000
+			ni.MaxGpuSharingTenants = tt.maxGpuSharingTenants
+			for index, status := range tt.tenantStatuses {
+				tenant := pod_info.NewTaskInfo(buildGpuMemoryPod(fmt.Sprintf("tenant-%d", index), v1.PodRunning,
+					map[string]string{
+						commonconstants.GpuMemory:            "100",
+						commonconstants.ReceivedResourceType: string(pod_info.ReceivedTypeFraction),
+					}))
+				tenant.GPUGroups = []string{"group-a"}
+				tenant.Status = status
+				assert.NoError(t, ni.AddTask(tenant))
+			}
+			task := pod_info.NewTaskInfo(buildGpuMemoryPod("pending", v1.PodPending,
+				map[string]string{commonconstants.GpuMemory: tt.gpuMemory}))
+
+			assert.Equal(t, tt.expectAtTenantLimit, ni.IsGpuGroupAtTenantLimit("group-a"))
+			assert.Equal(t, tt.expectEnoughIdle, ni.EnoughIdleResourcesOnGpu(task.ResReq, "group-a"))
+			fitError := ni.TenantLimitFitError(task)
+			if tt.expectedFitReasons == nil {
+				assert.Nil(t, fitError)
+			} else {
+				assert.Contains(t, fitError.Reasons, tt.expectedFitReason)
+			}
+		})
+	}
+}
+
+func TestNodeInfo_FittingErrorAtTenantLimit(t *testing.T) {
+	tests := []struct {
+		name              string
+		podCPU            string
+		expectedFitReason string
+	}{
+		{
+			name:              "pod that otherwise fits the node",
+			podCPU:            "1000m",
+			expectedFitReason: "node(s) didn't have enough resources: GPU at tenant limit",
+		},
+		{
+			name:              "pod without enough CPU on the node",
+			podCPU:            "9000m",
+			expectedFitReason: "node(s) didn't have enough resources: CPU cores",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(common_info.BuildNode("n1",
+				common_info.BuildResourceListWithGPU("8000m", "10G", "1")), nodePodAffinityInfo)
+			ni.MemoryOfEveryGpuOnNode = 1000
+			ni.MaxGpuSharingTenants = 1
+			tenant := pod_info.NewTaskInfo(buildGpuMemoryPod("tenant", v1.PodRunning,
+				map[string]string{
+					commonconstants.GpuMemory:            "100",
+					commonconstants.ReceivedResourceType: string(pod_info.ReceivedTypeFraction),
+				}))
+			tenant.GPUGroups = []string{"group-a"}
+			assert.NoError(t, ni.AddTask(tenant))
+			task := pod_info.NewTaskInfo(common_info.BuildPod("ns", "pending", "", v1.PodPending,
+				common_info.BuildResourceList(tt.podCPU, "1G"), []metav1.OwnerReference{}, nil,
+				map[string]string{commonconstants.GpuMemory: "200"}))
+
+			fitError := ni.FittingError(task, false)
+			if assert.NotNil(t, fitError) {
+				assert.Contains(t, fitError.Reasons, tt.expectedFitReason)
+			}
+		})
+	}
+}
+
+func TestNodeInfo_ReservedGpuMemory(t *testing.T) {
+	tests := []struct {
+		name                     string
+		annotations              map[string]string
+		defaultReservedGpuMemory int64
+		expectedUsableMemory     map[string]int64
+		expectWholeGpuFit        bool
+	}{
+		{
+			name: "no reserved memory",
+			expectedUsableMemory: map[string]int64{
+				"group-a": 1000, "group-b": 1000, pod_info.WholeGpuIndicator: 1000,
+			},
+			expectWholeGpuFit: true,
+		},
+		{
+			name:                     "node pool default",
+			defaultReservedGpuMemory: 100,
+			expectedUsableMemory: map[string]int64{
+				"group-a": 900, "group-b": 900, pod_info.WholeGpuIndicator: 900,
+			},
+			expectWholeGpuFit: true,
+		},
+		{
+			name:                     "node annotation overrides the node pool default",
+			annotations:              map[string]string{commonconstants.ReservedGpuMemory: "300"},
+			defaultReservedGpuMemory: 100,
+			expectedUsableMemory: map[string]int64{
+				"group-a": 700, "group-b": 700, pod_info.WholeGpuIndicator: 700,
+			},
+		},
+		{
+			name: "gpu annotation overrides the node reservation of a single gpu",
+			annotations: map[string]string{
+				commonconstants.ReservedGpuMemory:       "100",
+				commonconstants.ReservedGpuMemoryPerGpu: "1=400",
+			},
+			expectedUsableMemory: map[string]int64{
+				"group-a": 900, "group-b": 600, pod_info.WholeGpuIndicator: 600,
+			},
+		},
+		{
+			name: "invalid annotations are ignored",
+			annotations: map[string]string{
+				commonconstants.ReservedGpuMemory:       "-100",
+				commonconstants.ReservedGpuMemoryPerGpu: "1:400",
+			},
+			defaultReservedGpuMemory: 100,
+			expectedUsableMemory: map[string]int64{
+				"group-a": 900, "group-b": 900, pod_info.WholeGpuIndicator: 900,
+			},
+			expectWholeGpuFit: true,
+		},
+		{
+			name:        "reserved memory larger than the gpu",
+			annotations: map[string]string{commonconstants.ReservedGpuMemory: "2000"},
+			expectedUsableMemory: map[string]int64{
+				"group-a": 0, "group-b": 0, pod_info.WholeGpuIndicator: 0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "10G", "4"))
+			node.Annotations = tt.annotations
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+			ni.MemoryOfEveryGpuOnNode = 1000
+			ni.SetDefaultReservedGpuMemory(tt.defaultReservedGpuMemory)
+			for gpuIndex, gpuGroup := range []string{"group-a", "group-b"} {
+				reservationPod := common_info.BuildPod("kai-resource-reservation",
+					"gpu-reservation-n1-"+gpuGroup, "n1", v1.PodRunning, common_info.BuildResourceList("0", "0"),
+					[]metav1.OwnerReference{},
+					map[string]string{
+						commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+						commonconstants.GPUGroup:     gpuGroup,
+					},
+					map[string]string{commonconstants.ReservedGpuIndex: strconv.Itoa(gpuIndex)})
+				assert.NoError(t, ni.AddTask(pod_info.NewTaskInfo(reservationPod)))
+			}
+			task := pod_info.NewTaskInfo(buildGpuMemoryPod("pending", v1.PodPending,
+				map[string]string{commonconstants.GpuMemory: "800"}))
+
+			for gpuGroup, expectedUsableMemory := range tt.expectedUsableMemory {
+				assert.Equal(t, expectedUsableMemory, ni.UsableGpuMemory(gpuGroup), gpuGroup)
+			}
+			assert.Equal(t, tt.expectWholeGpuFit, ni.IsTaskFitOnWholeGpu(task.ResReq))
+			assert.Equal(t, tt.expectWholeGpuFit, ni.IsTaskAllocatable(task))
+		})
+	}
+}
+
+func TestNodeInfo_UnhealthyGpus(t *testing.T) {
+	tests := []struct {
+		name                     string
+		unhealthyGpus            string
+		unknownGroupIndex        bool
+		gpuMemory                string
+		wholeGpus                string
+		expectGroupHealthy       bool
+		expectUnhealthyWholeGpus int
+		expectAllocatable        bool
+		expectedFitReasons       []string
+	}{
+		{
+			name:               "all gpus healthy",
+			gpuMemory:          "800",
+			expectGroupHealthy: true,
+			expectAllocatable:  true,
+		},
+		{
+			name:                     "unhealthy gpu of the shared group",
+			unhealthyGpus:            "0",
+			gpuMemory:                "100",
+			expectUnhealthyWholeGpus: 0,
+			expectAllocatable:        true,
+			expectedFitReasons:       []string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		},
+		{
+			name:                     "unhealthy idle gpu",
+			unhealthyGpus:            "1",
+			gpuMemory:                "800",
+			expectGroupHealthy:       true,
+			expectUnhealthyWholeGpus: 1,
+			expectAllocatable:        false,
+			expectedFitReasons:       []string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		},
+		{
+			name:                     "all gpus unhealthy",
+			unhealthyGpus:            "0, 1",
+			gpuMemory:                "100",
+			expectUnhealthyWholeGpus: 1,
+			expectAllocatable:        false,
+			expectedFitReasons:       []string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		},
+		{
+			name:                     "unknown gpu of the shared group on a node with unhealthy gpus",
+			unhealthyGpus:            "1",
+			unknownGroupIndex:        true,
+			gpuMemory:                "100",
+			expectUnhealthyWholeGpus: 1,
+			expectAllocatable:        false,
+			expectedFitReasons:       []string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		},
+		{
+			name:               "unknown gpu of the shared group on a healthy node",
+			unknownGroupIndex:  true,
+			gpuMemory:          "100",
+			expectGroupHealthy: true,
+			expectAllocatable:  true,
+		},
+		{
+			name:              "whole gpu request on a healthy idle gpu",
+			unhealthyGpus:     "0",
+			wholeGpus:         "1",
+			expectAllocatable: true,
+		},
+		{
+			name:                     "whole gpu request on an unhealthy idle gpu",
+			unhealthyGpus:            "1",
+			wholeGpus:                "1",
+			expectGroupHealthy:       true,
+			expectUnhealthyWholeGpus: 1,
+			expectAllocatable:        false,
+			expectedFitReasons:       []string{"node(s) didn't have enough resources: unhealthy GPUs"},
+		},
+		{
+			name:               "invalid annotation is ignored",
+			unhealthyGpus:      "0,one",
+			gpuMemory:          "800",
+			expectGroupHealthy: true,
+			expectAllocatable:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "10G", "2"))
+			node.Annotations = map[string]string{commonconstants.UnhealthyGpus: tt.unhealthyGpus}
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+			ni.MemoryOfEveryGpuOnNode = 1000
+
+			reservationAnnotations := map[string]string{commonconstants.ReservedGpuIndex: "0"}
+			if tt.unknownGroupIndex {
+				reservationAnnotations = nil
+			}
+			reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-n1-abcde", "n1",
+				v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+				map[string]string{
+					commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+					commonconstants.GPUGroup:     "group-a",
+				},
+				reservationAnnotations)
+			assert.NoError(t, ni.AddTask(pod_info.NewTaskInfo(reservationPod)))
+			tenant := pod_info.NewTaskInfo(buildGpuMemoryPod("tenant", v1.PodRunning,
+				map[string]string{
+					commonconstants.GpuMemory:            "500",
+					commonconstants.ReceivedResourceType: string(pod_info.ReceivedTypeFraction),
+				}))
+			tenant.GPUGroups = []string{"group-a"}
+			assert.NoError(t, ni.AddTask(tenant))
+			task := pod_info.NewTaskInfo(buildGpuMemoryPod("pending", v1.PodPending,
+				map[string]string{commonconstants.GpuMemory: tt.gpuMemory}))
+			if tt.wholeGpus != "" {
+				task = pod_info.NewTaskInfo(common_info.BuildPod("ns", "pending", "", v1.PodPending,
+					common_info.BuildResourceListWithGPU("1", "1G", tt.wholeGpus), []metav1.OwnerReference{},
+					nil, nil))
+			}
+
+			assert.Equal(t, tt.expectGroupHealthy, ni.IsGpuGroupHealthy("group-a"))
+			assert.Equal(t, tt.expectUnhealthyWholeGpus, ni.GetNumOfUnhealthyWholeGpus())
+			assert.Equal(t, tt.expectAllocatable, ni.IsTaskAllocatable(task))
+			fitError := ni.UnhealthyGpusFitError(task)
+			if tt.expectedFitReasons == nil {
+				assert.Nil(t, fitError)
+			} else {
+				assert.Contains(t, fitError.Reasons, tt.expectedFitReason)
+			}
+		})
+	}
+}
+
+func TestNodeInfo_GpuInterconnectTier(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedTier GpuInterconnectTier
+	}{
+		{
+			name:         "no annotation",
+			expectedTier: GpuInterconnectUnknown,
+		},
+		{
+			name:         "pcie",
+			annotations:  map[string]string{commonconstants.GpuInterconnect: "pcie"},
+			expectedTier: GpuInterconnectPCIe,
+		},
+		{
+			name:         "nvlink",
+			annotations:  map[string]string{commonconstants.GpuInterconnect: "NVLink"},
+			expectedTier: GpuInterconnectNVLink,
+		},
+		{
+			name:         "nvswitch",
+			annotations:  map[string]string{commonconstants.GpuInterconnect: " nvswitch "},
+			expectedTier: GpuInterconnectNVSwitch,
+		},
+		{
+			name:         "invalid annotation is ignored",
+			annotations:  map[string]string{commonconstants.GpuInterconnect: "infiniband"},
+			expectedTier: GpuInterconnectUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "10G", "8"))
+			node.Annotations = tt.annotations
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+			assert.Equal(t, tt.expectedTier, ni.GpuInterconnectTier)
+		})
+	}
+}
+
+func TestNodeInfo_GetGpuDeviceId(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		gpuIndex         int
+		expectedDeviceId string
+		expectedFound    bool
+	}{
+		{
+			name:     "no annotation",
+			gpuIndex: 0,
+		},
+		{
+			name:             "known GPU",
+			annotations:      map[string]string{commonconstants.GpuDeviceIds: "GPU-aaaa, GPU-bbbb"},
+			gpuIndex:         1,
+			expectedDeviceId: "GPU-bbbb",
+			expectedFound:    true,
+		},
+		{
+			name:        "GPU index beyond the annotation",
+			annotations: map[string]string{commonconstants.GpuDeviceIds: "GPU-aaaa"},
+			gpuIndex:    1,
+		},
+		{
+			name:        "empty id",
+			annotations: map[string]string{commonconstants.GpuDeviceIds: ",GPU-bbbb"},
+			gpuIndex:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "10G", "2"))
+			node.Annotations = tt.annotations
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(NewController(t))
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+			deviceId, found := ni.GetGpuDeviceId(tt.gpuIndex)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedDeviceId, deviceId)
+		})
+	}
+}
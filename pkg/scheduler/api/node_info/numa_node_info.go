@@ -0,0 +1,82 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// getGpuNumaNodes parses the comma separated NUMA node of every GPU of the node, ordered by GPU index, from the
+// gpu-numa-nodes annotation. A missing or malformed annotation means that the GPUs' NUMA topology is unknown.
+func getGpuNumaNodes(node *v1.Node) []int {
+	value, found := node.Annotations[commonconstants.GpuNumaNodes]
+	if !found || value == "" {
+		return nil
+	}
+
+	var numaNodes []int
+	for _, numaNodeStr := range strings.Split(value, ",") {
+		numaNode, err := strconv.Atoi(strings.TrimSpace(numaNodeStr))
+		if err != nil || numaNode < 0 {
+			log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+				node.Name, commonconstants.GpuNumaNodes, value)
+			return nil
+		}
+		numaNodes = append(numaNodes, numaNode)
+	}
+	return numaNodes
+}
+
+// recordGpuGroupIndex remembers the GPU index that a GPU sharing reservation pod reserved for its GPU group.
+func (ni *NodeInfo) recordGpuGroupIndex(task *pod_info.PodInfo) {
+	if task.Pod == nil || !pod_info.IsResourceReservationTask(task.Pod) {
+		return
+	}
+	gpuGroup, found := task.Pod.Labels[commonconstants.GPUGroup]
+	if !found {
+		return
+	}
+	gpuIndex, err := strconv.Atoi(task.Pod.Annotations[commonconstants.ReservedGpuIndex])
+	if err != nil || gpuIndex < 0 {
+		return
+	}
+	if ni.gpuGroupIndexes == nil {
+		ni.gpuGroupIndexes = map[string]int{}
+	}
+	ni.gpuGroupIndexes[gpuGroup] = gpuIndex
+}
+
+// GetGpuGroupIndex returns the index of the GPU that a shared GPU group runs on, as reserved by its resource
+// reservation pod, and whether it is known.
+func (ni *NodeInfo) GetGpuGroupIndex(gpuGroup string) (int, bool) {
+	gpuIndex, found := ni.gpuGroupIndexes[gpuGroup]
+	return gpuIndex, found
+}
+
+// GetGpuGroupNumaNode returns the NUMA node of the GPU that a shared GPU group runs on, and whether it is known.
+func (ni *NodeInfo) GetGpuGroupNumaNode(gpuGroup string) (int, bool) {
+	gpuIndex, found := ni.gpuGroupIndexes[gpuGroup]
+	if !found || gpuIndex >= len(ni.GpuNumaNodes) {
+		return 0, false
+	}
+	return ni.GpuNumaNodes[gpuIndex], true
+}
+
+// IsGpuGroupNumaAligned returns whether the shared GPU group runs on a GPU attached to the NUMA node of the task's
+// CPUs. It is false whenever either NUMA node is unknown.
+func (ni *NodeInfo) IsGpuGroupNumaAligned(task *pod_info.PodInfo, gpuGroup string) bool {
+	taskNumaNode, found := task.NumaNode()
+	if !found {
+		return false
+	}
+	gpuNumaNode, found := ni.GetGpuGroupNumaNode(gpuGroup)
+	return found && gpuNumaNode == taskNumaNode
+}
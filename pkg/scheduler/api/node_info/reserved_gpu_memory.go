@@ -0,0 +1,92 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// getNodeReservedGpuMemory parses the GPU memory, in MiB, that the reserved-gpu-memory annotation keeps free on every
+// GPU of the node. It returns false if the annotation is missing or malformed.
+func getNodeReservedGpuMemory(node *v1.Node) (int64, bool) {
+	value, found := node.Annotations[commonconstants.ReservedGpuMemory]
+	if !found || value == "" {
+		return 0, false
+	}
+	memory, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || memory < 0 {
+		log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+			node.Name, commonconstants.ReservedGpuMemory, value)
+		return 0, false
+	}
+	return memory, true
+}
+
+// getReservedGpuMemoryPerGpu parses the comma separated <gpu index>=<MiB> pairs of the reserved-gpu-memory-per-gpu
+// annotation, which override the reserved GPU memory of the node for single GPUs. A malformed annotation is ignored.
+func getReservedGpuMemoryPerGpu(node *v1.Node) map[int]int64 {
+	value, found := node.Annotations[commonconstants.ReservedGpuMemoryPerGpu]
+	if !found || value == "" {
+		return nil
+	}
+
+	reservedMemory := map[int]int64{}
+	for _, pair := range strings.Split(value, ",") {
+		indexStr, memoryStr, found := strings.Cut(pair, "=")
+		gpuIndex, indexErr := strconv.Atoi(strings.TrimSpace(indexStr))
+		memory, memoryErr := strconv.ParseInt(strings.TrimSpace(memoryStr), 10, 64)
+		if !found || indexErr != nil || memoryErr != nil || gpuIndex < 0 || memory < 0 {
+			log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+				node.Name, commonconstants.ReservedGpuMemoryPerGpu, value)
+			return nil
+		}
+		reservedMemory[gpuIndex] = memory
+	}
+	return reservedMemory
+}
+
+// SetDefaultReservedGpuMemory sets the GPU memory, in MiB, reserved on every GPU of the node, unless the node
+// overrides it with the reserved-gpu-memory annotation.
+func (ni *NodeInfo) SetDefaultReservedGpuMemory(memory int64) {
+	if _, found := getNodeReservedGpuMemory(ni.Node); found {
+		return
+	}
+	ni.ReservedGpuMemory = memory
+}
+
+// UsableGpuMemory returns the GPU memory, in MiB, that pods may use on the GPU of the shared GPU group, once the
+// reserved GPU memory is subtracted. A GPU group whose GPU index is unknown, e.g. the WholeGpuIndicator of a GPU that
+// is not shared yet, may land on any GPU of the node, so the largest reservation of the node's GPUs applies to it.
+func (ni *NodeInfo) UsableGpuMemory(gpuGroup string) int64 {
+	return max(ni.MemoryOfEveryGpuOnNode-ni.gpuGroupReservedMemory(gpuGroup), 0)
+}
+
+// IsTaskFitOnWholeGpu returns true if the GPU memory that the request takes from each of its GPUs fits the usable
+// memory of a GPU of the node that is not shared yet.
+func (ni *NodeInfo) IsTaskFitOnWholeGpu(resourceRequest *resource_info.ResourceRequirements) bool {
+	return ni.GetResourceGpuMemory(resourceRequest) <= ni.UsableGpuMemory(pod_info.WholeGpuIndicator)
+}
+
+func (ni *NodeInfo) gpuGroupReservedMemory(gpuGroup string) int64 {
+	if gpuIndex, found := ni.gpuGroupIndexes[gpuGroup]; found {
+		if memory, found := ni.reservedGpuMemoryPerGpu[gpuIndex]; found {
+			return memory
+		}
+		return ni.ReservedGpuMemory
+	}
+
+	reservedMemory := ni.ReservedGpuMemory
+	for _, memory := range ni.reservedGpuMemoryPerGpu {
+		reservedMemory = max(reservedMemory, memory)
+	}
+	return reservedMemory
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_info
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// PipelineAge is the node a pod was pipelined to and the number of consecutive cycles it was pipelined to it.
+type PipelineAge struct {
+	NodeName string
+	Cycles   int
+}
+
+// PipelineAges tracks across scheduling cycles the pods that were pipelined at the end of every session, since a
+// pipeline holds its reservation only for the session that made it and is made again by the next sessions. It is safe
+// for concurrent use.
+type PipelineAges struct {
+	mutex sync.Mutex
+	ages  map[common_info.PodID]PipelineAge
+}
+
+func NewPipelineAges() *PipelineAges {
+	return &PipelineAges{
+		ages: map[common_info.PodID]PipelineAge{},
+	}
+}
+
+// Get returns the pipeline age of the pod, if it was pipelined at the end of the last session.
+func (pa *PipelineAges) Get(podUID common_info.PodID) (PipelineAge, bool) {
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	age, found := pa.ages[podUID]
+	return age, found
+}
+
+// Set replaces the tracked pipelines, dropping the pods that were allocated, released or are gone.
+func (pa *PipelineAges) Set(ages map[common_info.PodID]PipelineAge) {
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	pa.ages = ages
+}
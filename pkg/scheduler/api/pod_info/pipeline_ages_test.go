@@ -0,0 +1,27 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package pod_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func TestPipelineAges(t *testing.T) {
+	pipelineAges := NewPipelineAges()
+	_, found := pipelineAges.Get("pod0")
+	assert.False(t, found)
+
+	pipelineAges.Set(map[common_info.PodID]PipelineAge{"pod0": {NodeName: "node0", Cycles: 2}})
+	age, found := pipelineAges.Get("pod0")
+	assert.True(t, found)
+	assert.Equal(t, PipelineAge{NodeName: "node0", Cycles: 2}, age)
+
+	pipelineAges.Set(map[common_info.PodID]PipelineAge{"pod1": {NodeName: "node1", Cycles: 1}})
+	_, found = pipelineAges.Get("pod0")
+	assert.False(t, found, "pods that are not pipelined anymore are forgotten")
+}
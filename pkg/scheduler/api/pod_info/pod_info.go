@@ -20,7 +20,10 @@ limitations under the License.
 package pod_info
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -77,18 +80,32 @@ type PodInfo struct {
 	ResourceReceivedType ResourceReceivedType
 
 	// ResReq are the minimal resources that needed to launch a pod. (includes init containers resources)
-	ResReq           *resource_info.ResourceRequirements
+	ResReq *resource_info.ResourceRequirements
+	// InitResReq are the resources of the most demanding init container, with the GPU memory of the init-gpu-memory
+	// annotation. Init containers run before the main containers, so ResReq is the max of both and not their sum.
+	InitResReq       *resource_info.ResourceRequirements
 	AcceptedResource *resource_info.ResourceRequirements
+	// OriginalResReq is the request of the pod when the scheduler adjusted ResReq, e.g. to rightsize the pod. Nil
+	// when ResReq is the request of the pod. The pod keeps its request, which is enforced outside the scheduler.
+	OriginalResReq *resource_info.ResourceRequirements
 
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
 
 	GPUGroups []string
+	// GpuMemorySplit is the GPU memory, in MiB, taken from each of the GPU groups of a single device pod whose memory
+	// spans several GPUs. It is nil for pods that take their whole request from every GPU group.
+	GpuMemorySplit map[string]int64
 
 	NodeName        string
 	Status          pod_status.PodStatus
 	IsVirtualStatus bool
 	IsLegacyMIGtask bool
 
+	// PipelineAge is the number of consecutive scheduling cycles, up to the last one, that the pod was pipelined to
+	// PipelinedNodeName without being allocated. 0 when the pod was not pipelined in the last cycle.
+	PipelineAge       int
+	PipelinedNodeName string
+
 	BindRequest *bindrequest_info.BindRequestInfo
 
 	ResourceClaimInfo bindrequest_info.ResourceClaimInfo
@@ -184,6 +201,7 @@ func NewTaskInfoWithBindRequest(pod *v1.Pod, bindRequest *bindrequest_info.BindR
 		IsLegacyMIGtask:                false,
 		Pod:                            pod,
 		ResReq:                         initResreq,
+		InitResReq:                     getInitContainersResourceRequest(pod),
 		AcceptedResource:               resource_info.EmptyResourceRequirements(),
 		GPUGroups:                      []string{},
 		ResourceRequestType:            RequestTypeRegular,
@@ -199,7 +217,7 @@ func NewTaskInfoWithBindRequest(pod *v1.Pod, bindRequest *bindrequest_info.BindR
 }
 
 func (pi *PodInfo) Clone() *PodInfo {
-	return &PodInfo{
+	clone := &PodInfo{
 		UID:                  pi.UID,
 		Job:                  pi.Job,
 		Name:                 pi.Name,
@@ -211,14 +229,24 @@ func (pi *PodInfo) Clone() *PodInfo {
 		ResReq:               pi.ResReq.Clone(),
 		AcceptedResource:     pi.AcceptedResource.Clone(),
 		GPUGroups:            pi.GPUGroups,
+		GpuMemorySplit:       pi.GpuMemorySplit,
 		ResourceClaimInfo:    pi.ResourceClaimInfo.Clone(),
 		ResourceRequestType:  pi.ResourceRequestType,
 		ResourceReceivedType: pi.ResourceReceivedType,
 		IsVirtualStatus:      pi.IsVirtualStatus,
 		IsLegacyMIGtask:      pi.IsLegacyMIGtask,
+		PipelineAge:          pi.PipelineAge,
+		PipelinedNodeName:    pi.PipelinedNodeName,
 		storageClaims:        pi.storageClaims,
 		ownedStorageClaims:   pi.ownedStorageClaims,
 	}
+	if pi.InitResReq != nil {
+		clone.InitResReq = pi.InitResReq.Clone()
+	}
+	if pi.OriginalResReq != nil {
+		clone.OriginalResReq = pi.OriginalResReq.Clone()
+	}
+	return clone
 }
 
 func (pi PodInfo) String() string {
@@ -266,6 +294,72 @@ func (pi *PodInfo) IsSharedGPUAllocation() bool {
 	return pi.IsFractionAllocation()
 }
 
+// RequestedGpuModels returns the GPU models the pod can run on, taken from its comma separated gpu-model annotation.
+// An empty result means that the pod can run on any GPU model.
+func (pi *PodInfo) RequestedGpuModels() []string {
+	var models []string
+	for _, model := range strings.Split(pi.Pod.Annotations[commonconstants.GpuModel], ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+// NumaNode returns the NUMA node that the pod's CPUs are placed on, as hinted by its numa-node annotation, and
+// whether the pod has such a hint.
+func (pi *PodInfo) NumaNode() (int, bool) {
+	numaNode, err := strconv.Atoi(pi.Pod.Annotations[commonconstants.NumaNode])
+	if err != nil || numaNode < 0 {
+		return 0, false
+	}
+	return numaNode, true
+}
+
+// MinGpuMemory returns the minimal GPU memory, in MiB, that a best-effort GPU sharing pod can run with, and whether
+// the pod is best-effort. Best-effort pods can be shrunk down to this memory instead of being evicted.
+func (pi *PodInfo) MinGpuMemory() (int64, bool) {
+	minGpuMemory, err := strconv.ParseInt(pi.Pod.Annotations[commonconstants.MinGpuMemory], 10, 64)
+	if err != nil || minGpuMemory <= 0 {
+		return 0, false
+	}
+	return minGpuMemory, true
+}
+
+// GpuMemoryBandwidthWeight returns the weight of the pod's GPU memory bandwidth use, from its
+// gpu-memory-bandwidth-weight annotation, which the fractional tenants of a GPU sum up against the bandwidth budget
+// of the node's GPUs. Pods without a valid weight weigh 0.
+func (pi *PodInfo) GpuMemoryBandwidthWeight() int64 {
+	if pi.Pod == nil {
+		return 0
+	}
+	weight, err := strconv.ParseInt(pi.Pod.Annotations[commonconstants.GpuMemoryBandwidthWeight], 10, 64)
+	if err != nil || weight < 0 {
+		return 0
+	}
+	return weight
+}
+
+// IsSplittableGpuMemoryRequest returns whether the pod opted in to take the memory of its single GPU device from
+// several shared GPUs, for frameworks that can span their memory across devices.
+func (pi *PodInfo) IsSplittableGpuMemoryRequest() bool {
+	return pi.IsFractionCandidate() && pi.ResReq.GetNumOfGpuDevices() <= 1 && pi.Pod != nil &&
+		pi.Pod.Annotations[commonconstants.SplittableGpuMemory] == "true"
+}
+
+// SetGpuMemoryRequest makes the pod request gpuMemory MiB of each of its GPU devices.
+func (pi *PodInfo) SetGpuMemoryRequest(gpuMemory int64) {
+	pi.ResReq.GpuResourceRequirement = *resource_info.NewGpuResourceRequirementWithMultiFraction(
+		max(pi.ResReq.GetNumOfGpuDevices(), 1), 0, gpuMemory)
+	pi.ResourceRequestType = RequestTypeGpuMemory
+}
+
+// IsExclusiveNodeRequest returns whether the pod requires a node with no other pods of the scheduler, whatever its
+// resource requests are.
+func (pi *PodInfo) IsExclusiveNodeRequest() bool {
+	return pi.Pod != nil && pi.Pod.Annotations[commonconstants.ExclusiveNode] == "true"
+}
+
 func (pi *PodInfo) IsCPUOnlyRequest() bool {
 	return !pi.IsRequireAnyKindOfGPU()
 }
@@ -302,12 +396,9 @@ func getPodResourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
 	result := getPodResourceWithoutInitContainers(pod)
 
 	// take max_resource(sum_pod, any_init_container)
-	for _, container := range pod.Spec.InitContainers {
-		err := result.SetMaxResource(resource_info.RequirementsFromResourceList(container.Resources.Requests))
-		if err != nil {
-			log.InfraLogger.Errorf("Failed to calculate pod required resources for pod %s/%s. Error: %s",
-				pod.Namespace, pod.Name, err.Error())
-		}
+	if err := result.SetMaxResource(getInitContainersResourceRequest(pod)); err != nil {
+		log.InfraLogger.Errorf("Failed to calculate pod required resources for pod %s/%s. Error: %s",
+			pod.Namespace, pod.Name, err.Error())
 	}
 
 	if pod.Spec.Overhead != nil {
@@ -318,6 +409,20 @@ func getPodResourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
 	return result
 }
 
+// getInitContainersResourceRequest returns the max of the resource requests of the pod's init containers, which run
+// one at a time.
+func getInitContainersResourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
+	result := resource_info.EmptyResourceRequirements()
+	for _, container := range pod.Spec.InitContainers {
+		err := result.SetMaxResource(resource_info.RequirementsFromResourceList(container.Resources.Requests))
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to calculate init containers required resources for pod %s/%s. Error: %s",
+				pod.Namespace, pod.Name, err.Error())
+		}
+	}
+	return result
+}
+
 // getPodResourceWithoutInitContainers returns Pod's resource request, it does not contain
 // init containers' resource request.
 func getPodResourceWithoutInitContainers(pod *v1.Pod) *resource_info.ResourceRequirements {
@@ -376,6 +481,7 @@ func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindR
 	} else {
 		pi.GPUGroups = resources.GetGpuGroups(pi.Pod)
 	}
+	pi.GpuMemorySplit = pi.getGpuMemorySplit(bindRequest)
 
 	if bindRequest != nil && len(bindRequest.BindRequest.Spec.ReceivedResourceType) > 0 {
 		pi.ResourceReceivedType = ResourceReceivedType(bindRequest.BindRequest.Spec.ReceivedResourceType)
@@ -411,12 +517,101 @@ func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindR
 		}
 	}
 
+	if pi.IsFractionCandidate() {
+		allotment, err := strconv.ParseInt(pi.Pod.Annotations[commonconstants.GpuMemoryAllotment], 10, 64)
+		if err == nil && allotment > 0 {
+			pi.SetGpuMemoryRequest(allotment)
+		}
+	}
+
+	pi.updateInitGpuMemoryRequest()
+	pi.updateRightsizedResources(bindRequest)
+
 	pi.updateLegacyMigResourceRequestFromAnnotations()
 	if len(pi.ResReq.MigResources()) > 0 {
 		pi.ResourceRequestType = RequestTypeMigInstance
 	}
 }
 
+// updateRightsizedResources accounts a pod that the scheduler rightsized at the requirements it was scheduled against,
+// recorded in the rightsized-resources annotation of its bind request, and kept on the pod once bound. The GPU
+// requirement of the pod is not rightsized. The request of the pod is kept in OriginalResReq.
+func (pi *PodInfo) updateRightsizedResources(bindRequest *bindrequest_info.BindRequestInfo) {
+	value, found := pi.Pod.Annotations[commonconstants.RightsizedResources]
+	if !found && bindRequest != nil {
+		value, found = bindRequest.BindRequest.Annotations[commonconstants.RightsizedResources]
+	}
+	if !found {
+		return
+	}
+
+	var resourceList v1.ResourceList
+	if err := json.Unmarshal([]byte(value), &resourceList); err != nil {
+		log.InfraLogger.V(2).Warnf("Failed to parse the %s annotation <%s> of pod <%s/%s>: %v",
+			commonconstants.RightsizedResources, value, pi.Namespace, pi.Name, err)
+		return
+	}
+	rightsized := resource_info.RequirementsFromResourceList(resourceList)
+	pi.OriginalResReq = pi.ResReq
+	pi.ResReq = &resource_info.ResourceRequirements{
+		GpuResourceRequirement: *pi.ResReq.GpuResourceRequirement.Clone(),
+		BaseResource:           rightsized.BaseResource,
+	}
+}
+
+// updateInitGpuMemoryRequest sets the GPU memory that the init containers of a GPU sharing pod need, from its
+// init-gpu-memory annotation. Pods requesting GPU memory are sized to the larger of their init and main GPU memory, as
+// Kubernetes does for the other resources. Pods requesting a GPU fraction keep their fraction, and are only placed on
+// GPUs that also have the init GPU memory available.
+func (pi *PodInfo) updateInitGpuMemoryRequest() {
+	initGpuMemory, err := strconv.ParseInt(pi.Pod.Annotations[commonconstants.InitGpuMemory], 10, 64)
+	if err != nil || initGpuMemory <= 0 || !pi.IsFractionCandidate() {
+		return
+	}
+
+	if pi.InitResReq == nil {
+		pi.InitResReq = resource_info.EmptyResourceRequirements()
+	}
+	pi.InitResReq.GpuResourceRequirement = *resource_info.NewGpuResourceRequirementWithMultiFraction(
+		max(pi.ResReq.GetNumOfGpuDevices(), 1), 0, initGpuMemory)
+	if pi.IsMemoryRequest() && initGpuMemory > pi.ResReq.GpuMemory() {
+		pi.SetGpuMemoryRequest(initGpuMemory)
+	}
+}
+
+// getGpuMemorySplit returns the GPU memory split of the pod, taken from its bind request while the pod is being bound
+// and from the pod itself afterwards. A split that does not match the GPU groups of the pod is ignored.
+func (pi *PodInfo) getGpuMemorySplit(bindRequest *bindrequest_info.BindRequestInfo) map[string]int64 {
+	value := pi.Pod.Annotations[commonconstants.GpuMemorySplit]
+	if bindRequest != nil {
+		if bindRequestValue, found := bindRequest.BindRequest.Annotations[commonconstants.GpuMemorySplit]; found {
+			value = bindRequestValue
+		}
+	}
+	if value == "" {
+		return nil
+	}
+
+	split, err := resources.ParseGpuMemorySplit(value)
+	if err != nil {
+		log.InfraLogger.V(2).Warnf("Pod <%s/%s> has an invalid %s annotation <%s>, ignoring it: %v",
+			pi.Namespace, pi.Name, commonconstants.GpuMemorySplit, value, err)
+		return nil
+	}
+	if len(pi.GPUGroups) == 0 {
+		pi.GPUGroups = slices.Sorted(maps.Keys(split))
+	}
+	if len(split) != len(pi.GPUGroups) {
+		return nil
+	}
+	for _, gpuGroup := range pi.GPUGroups {
+		if _, found := split[gpuGroup]; !found {
+			return nil
+		}
+	}
+	return split
+}
+
 // updateLegacyMigResourceRequestFromAnnotations updates the mig resource request of legacy MIG pods
 func (pi *PodInfo) updateLegacyMigResourceRequestFromAnnotations() {
 	for annotationName, annotationValue := range pi.Pod.Annotations {
@@ -464,6 +464,42 @@ func TestPodInfo_updatePodAdditionalFields(t *testing.T) {
 				IsChiefPod:          true,
 			},
 		},
+		{
+			"Gpu fraction request shrunk to its gpu memory allotment",
+			podFields{
+				Job:       common_info.FakePogGroupId,
+				Name:      "p1",
+				Namespace: "ns1",
+				Status:    pod_status.Running,
+				Pod: common_info.BuildPod("ns1", "p1", "node1", v1.PodRunning,
+					common_info.BuildResourceList("2000m", "2G"),
+					nil,
+					map[string]string{},
+					map[string]string{
+						common_info.GPUFraction:            "0.5",
+						commonconstants.MinGpuMemory:       "256",
+						commonconstants.GpuMemoryAllotment: "512",
+					}),
+			},
+			expected{
+				Resreq: &resource_info.ResourceRequirements{
+					GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithMultiFraction(
+						1, 0, 512),
+					BaseResource: *resource_info.EmptyBaseResource(),
+				},
+				InitResreq: &resource_info.ResourceRequirements{
+					GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithMultiFraction(
+						1, 0, 512),
+					BaseResource: *resource_info.EmptyBaseResource(),
+				},
+				AcceptedResource:    nil,
+				ResourceRequestType: "GpuMemory",
+				GPUGroups:           nil,
+				SelectedMigProfile:  "",
+				IsBound:             false,
+				IsChiefPod:          true,
+			},
+		},
 		{
 			"Get ReceivedResourceType from annotation",
 			podFields{
@@ -521,6 +557,138 @@ func TestPodInfo_updatePodAdditionalFields(t *testing.T) {
 	}
 }
 
+func TestPodInfo_GpuMemorySplit(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podLabels              map[string]string
+		podAnnotations         map[string]string
+		bindRequestAnnotations map[string]string
+		expectedGPUGroups      []string
+		expectedSplit          map[string]int64
+	}{
+		{
+			name:              "no split",
+			podLabels:         map[string]string{commonconstants.GPUGroup: "group-a"},
+			podAnnotations:    map[string]string{commonconstants.GpuMemory: "900"},
+			expectedGPUGroups: []string{"group-a"},
+		},
+		{
+			name: "split of a bound pod",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:      "900",
+				commonconstants.GpuMemorySplit: "group-b:300,group-a:600",
+			},
+			expectedGPUGroups: []string{"group-a", "group-b"},
+			expectedSplit:     map[string]int64{"group-a": 600, "group-b": 300},
+		},
+		{
+			name:           "split of a pod being bound",
+			podAnnotations: map[string]string{commonconstants.GpuMemory: "900"},
+			bindRequestAnnotations: map[string]string{
+				commonconstants.GpuMemorySplit: "group-a:600,group-b:300",
+			},
+			expectedGPUGroups: []string{"group-a", "group-b"},
+			expectedSplit:     map[string]int64{"group-a": 600, "group-b": 300},
+		},
+		{
+			name:      "split that does not match the gpu groups",
+			podLabels: map[string]string{commonconstants.GPUGroup: "group-c"},
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:      "900",
+				commonconstants.GpuMemorySplit: "group-a:600,group-b:300",
+			},
+			expectedGPUGroups: []string{"group-c"},
+		},
+		{
+			name: "invalid split",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:      "900",
+				commonconstants.GpuMemorySplit: "group-a:600,group-b",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := common_info.BuildPod("ns1", "p1", "node1", v1.PodRunning,
+				common_info.BuildResourceList("2000m", "2G"), nil, tt.podLabels, tt.podAnnotations)
+			var bindRequest *bindrequest_info.BindRequestInfo
+			if tt.bindRequestAnnotations != nil {
+				bindRequest = &bindrequest_info.BindRequestInfo{
+					BindRequest: &schedulingv1alpha2.BindRequest{},
+				}
+				bindRequest.BindRequest.Annotations = tt.bindRequestAnnotations
+			}
+
+			pi := NewTaskInfoWithBindRequest(pod, bindRequest)
+			assert.DeepEqual(t, tt.expectedGPUGroups, pi.GPUGroups)
+			assert.DeepEqual(t, tt.expectedSplit, pi.GpuMemorySplit)
+		})
+	}
+}
+
+func TestPodInfo_RightsizedResources(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podAnnotations         map[string]string
+		bindRequestAnnotations map[string]string
+		expectedMilliCPU       float64
+		expectedMemory         float64
+		expectRightsized       bool
+	}{
+		{
+			name:             "not rightsized",
+			expectedMilliCPU: 2000,
+			expectedMemory:   2000000000,
+		},
+		{
+			name: "rightsized bound pod",
+			podAnnotations: map[string]string{
+				commonconstants.RightsizedResources: `{"cpu":"500m","memory":"1G"}`,
+			},
+			expectedMilliCPU: 500,
+			expectedMemory:   1000000000,
+			expectRightsized: true,
+		},
+		{
+			name: "rightsized pod being bound",
+			bindRequestAnnotations: map[string]string{
+				commonconstants.RightsizedResources: `{"cpu":"1"}`,
+			},
+			expectedMilliCPU: 1000,
+			expectRightsized: true,
+		},
+		{
+			name:             "invalid rightsized resources",
+			podAnnotations:   map[string]string{commonconstants.RightsizedResources: "cpu=1"},
+			expectedMilliCPU: 2000,
+			expectedMemory:   2000000000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := common_info.BuildPod("ns1", "p1", "node1", v1.PodRunning,
+				common_info.BuildResourceList("2000m", "2G"), nil, nil, tt.podAnnotations)
+			var bindRequest *bindrequest_info.BindRequestInfo
+			if tt.bindRequestAnnotations != nil {
+				bindRequest = &bindrequest_info.BindRequestInfo{
+					BindRequest: &schedulingv1alpha2.BindRequest{},
+				}
+				bindRequest.BindRequest.Annotations = tt.bindRequestAnnotations
+			}
+
+			pi := NewTaskInfoWithBindRequest(pod, bindRequest)
+			assert.Equal(t, tt.expectedMilliCPU, pi.ResReq.Cpu())
+			assert.Equal(t, tt.expectedMemory, pi.ResReq.Memory())
+			assert.Equal(t, tt.expectRightsized, pi.OriginalResReq != nil)
+			if tt.expectRightsized {
+				assert.Equal(t, 2000.0, pi.OriginalResReq.Cpu())
+			}
+		})
+	}
+}
+
 func TestGetPodStorageClaims(t *testing.T) {
 	pod := &PodInfo{
 		UID:                "pod-uid",
@@ -583,3 +751,79 @@ func TestGetPodStorageClaims(t *testing.T) {
 	assert.Equal(t, 1, len(pod.GetOwnedStorageClaims()))
 	assert.Equal(t, "owned-pvc-name", pod.GetOwnedStorageClaims()[ownedClaimKey].Name)
 }
+
+func TestPodInfo_InitContainersRequirements(t *testing.T) {
+	tests := []struct {
+		name                  string
+		annotations           map[string]string
+		mainRequests          v1.ResourceList
+		initRequests          v1.ResourceList
+		expectedGpus          float64
+		expectedGpuMemory     int64
+		expectedInitGpus      float64
+		expectedInitGpuMemory int64
+	}{
+		{
+			name: "init GPU memory larger than the main GPU memory",
+			annotations: map[string]string{
+				GpuMemoryAnnotationName:       "2000",
+				commonconstants.InitGpuMemory: "3000",
+			},
+			mainRequests:          common_info.BuildResourceList("1000m", "1G"),
+			expectedGpuMemory:     3000,
+			expectedInitGpuMemory: 3000,
+		},
+		{
+			name: "init GPU memory smaller than the main GPU memory",
+			annotations: map[string]string{
+				GpuMemoryAnnotationName:       "2000",
+				commonconstants.InitGpuMemory: "1000",
+			},
+			mainRequests:          common_info.BuildResourceList("1000m", "1G"),
+			expectedGpuMemory:     2000,
+			expectedInitGpuMemory: 1000,
+		},
+		{
+			name: "GPU fraction keeps its fraction",
+			annotations: map[string]string{
+				common_info.GPUFraction:       "0.5",
+				commonconstants.InitGpuMemory: "3000",
+			},
+			mainRequests:          common_info.BuildResourceList("1000m", "1G"),
+			expectedGpus:          0.5,
+			expectedInitGpuMemory: 3000,
+		},
+		{
+			name: "init GPU memory is ignored for whole GPU pods",
+			annotations: map[string]string{
+				commonconstants.InitGpuMemory: "3000",
+			},
+			mainRequests: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			expectedGpus: 1,
+		},
+		{
+			name:             "init containers GPUs are the max of init and main, not their sum",
+			mainRequests:     common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			initRequests:     common_info.BuildResourceListWithGPU("1000m", "1G", "2"),
+			expectedGpus:     2,
+			expectedInitGpus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := common_info.BuildPod("ns1", "p1", "", v1.PodPending, tt.mainRequests, nil, nil, tt.annotations)
+			if tt.initRequests != nil {
+				pod.Spec.InitContainers = []v1.Container{
+					{Resources: v1.ResourceRequirements{Requests: tt.initRequests}},
+				}
+			}
+
+			pi := NewTaskInfo(pod)
+			assert.Equal(t, tt.expectedGpus, pi.ResReq.GPUs())
+			assert.Equal(t, tt.expectedGpuMemory, pi.ResReq.GpuMemory())
+			assert.Equal(t, tt.expectedInitGpus, pi.InitResReq.GPUs())
+			assert.Equal(t, tt.expectedInitGpuMemory, pi.InitResReq.GpuMemory())
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storageclaim_info"
 )
@@ -28,6 +29,24 @@ func schedulingConstraintsSignature(pod *v1.Pod, storageClaims map[storageclaim_
 		hash.Write([]byte(fmt.Sprintf("%v", pod.Spec.NodeSelector)))
 	}
 
+	// GPU model
+	hash.Write([]byte(pod.Annotations[commonconstants.GpuModel]))
+
+	// Exclusive node
+	if exclusiveNode, found := pod.Annotations[commonconstants.ExclusiveNode]; found {
+		hash.Write([]byte(commonconstants.ExclusiveNode + "=" + exclusiveNode))
+	}
+
+	// Local NVMe scratch
+	if nvmeScratch, found := pod.Annotations[commonconstants.LocalNvmeScratch]; found {
+		hash.Write([]byte(commonconstants.LocalNvmeScratch + "=" + nvmeScratch))
+	}
+
+	// Runtime class
+	if pod.Spec.RuntimeClassName != nil {
+		hash.Write([]byte("runtimeClassName=" + *pod.Spec.RuntimeClassName))
+	}
+
 	// Affinity
 	if pod.Spec.Affinity != nil {
 		hash.Write([]byte(pod.Spec.Affinity.String()))
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storageclaim_info"
 )
@@ -245,6 +246,43 @@ func TestPodSchedulingConstraintsSignature_InitContainerPorts(t *testing.T) {
 	assert.NotEqualf(t, key, newKey, "Expected init container ports to affect signature, got same")
 }
 
+func TestPodSchedulingConstraintsSignature_ExclusiveNode(t *testing.T) {
+	pod := getRandomPod()
+
+	podInfo := NewTaskInfo(&pod)
+	key := podInfo.GetSchedulingConstraintsSignature()
+
+	pod.Annotations = map[string]string{commonconstants.ExclusiveNode: "true"}
+	newPodInfo := NewTaskInfo(&pod)
+	newKey := newPodInfo.GetSchedulingConstraintsSignature()
+	assert.NotEqualf(t, key, newKey, "Expected exclusive node to affect signature, got same")
+}
+
+func TestPodSchedulingConstraintsSignature_LocalNvmeScratch(t *testing.T) {
+	pod := getRandomPod()
+	pod.Annotations = map[string]string{commonconstants.LocalNvmeScratch: "100Gi"}
+
+	podInfo := NewTaskInfo(&pod)
+	key := podInfo.GetSchedulingConstraintsSignature()
+
+	pod.Annotations[commonconstants.LocalNvmeScratch] = "200Gi"
+	newPodInfo := NewTaskInfo(&pod)
+	newKey := newPodInfo.GetSchedulingConstraintsSignature()
+	assert.NotEqualf(t, key, newKey, "Expected local NVMe scratch to affect signature, got same")
+}
+
+func TestPodSchedulingConstraintsSignature_RuntimeClassName(t *testing.T) {
+	pod := getRandomPod()
+
+	podInfo := NewTaskInfo(&pod)
+	key := podInfo.GetSchedulingConstraintsSignature()
+
+	pod.Spec.RuntimeClassName = pointer.String("kata")
+	newPodInfo := NewTaskInfo(&pod)
+	newKey := newPodInfo.GetSchedulingConstraintsSignature()
+	assert.NotEqualf(t, key, newKey, "Expected runtime class name to affect signature, got same")
+}
+
 // GenerateRandomString generates a random string of given length
 func randomString(length int) string {
 	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
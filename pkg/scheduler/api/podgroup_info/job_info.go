@@ -90,6 +90,25 @@ type PodGroupInfo struct {
 
 	StalenessInfo
 
+	// SchedulingTimeout is the time the job may stay pending before the scheduler gives up on it, set from the job's
+	// scheduling timeout annotation. Nil if the job has no scheduling timeout.
+	SchedulingTimeout *time.Duration
+	// PendingSince is the time the scheduler first saw the job pending, kept in the pending-since annotation of the
+	// pod group so it survives scheduler restarts. Nil until a session sees the job pending with a scheduling timeout.
+	PendingSince *time.Time
+	// SchedulingDeadline is the time by which the job must be scheduled, set by the session to its scheduling timeout
+	// counted from the time the scheduler first saw the job pending. Nil if the job has no scheduling timeout.
+	SchedulingDeadline *time.Time
+	// SchedulingTimedOut is set when the session gave up scheduling the job because its deadline passed.
+	SchedulingTimedOut bool
+	// ElasticExtrasPreemptible is set from the elastic annotation of the pod group. The tasks of such a job beyond
+	// the minimum available of its sub-groups are placed only on free resources, and are preempted before any other
+	// victim of their queue, by jobs of any priority that are still gathering their gang.
+	ElasticExtrasPreemptible bool
+	// LastGpus are the GPUs that the job's pods last ran on, by pod name, set from the last-gpu-indexes annotation
+	// of the pod group. GPU sharing pods prefer these GPUs when they are scheduled again.
+	LastGpus map[string]LastGpus
+
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
 
 	// inner cache
@@ -186,6 +205,29 @@ func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 		}
 	}
 
+	if pg.Annotations[commonconstants.SchedulingTimeout] != "" {
+		timeout, err := time.ParseDuration(pg.Annotations[commonconstants.SchedulingTimeout])
+		if err != nil || timeout <= 0 {
+			log.InfraLogger.V(2).Warnf("Invalid scheduling timeout <%s> for podgroup <%s>, ignoring it",
+				pg.Annotations[commonconstants.SchedulingTimeout], pgi.NamespacedName)
+		} else {
+			pgi.SchedulingTimeout = &timeout
+		}
+	}
+
+	if pg.Annotations[commonconstants.PendingSinceTimeStamp] != "" {
+		pendingSince, err := time.Parse(time.RFC3339, pg.Annotations[commonconstants.PendingSinceTimeStamp])
+		if err != nil {
+			log.InfraLogger.V(7).Warnf("Failed to parse pending since timestamp for podgroup <%s> err: %v",
+				pgi.NamespacedName, err)
+		} else {
+			pgi.PendingSince = &pendingSince
+		}
+	}
+
+	pgi.ElasticExtrasPreemptible = pg.Annotations[commonconstants.ElasticPodGroup] == "true"
+	pgi.setLastGpus(pg.Annotations)
+
 	log.InfraLogger.V(7).Infof(
 		"SetPodGroup. podGroupName=<%s>, PodGroupUID=<%s> pgi.PodGroupIndex=<%d>",
 		pgi.Name, pgi.PodGroupUID)
@@ -221,7 +263,7 @@ func (pgi *PodGroupInfo) addTaskIndex(ti *pod_info.PodInfo) {
 		pgi.activeAllocatedCount = ptr.To(*pgi.activeAllocatedCount + 1)
 	}
 
-	pgi.invalidateTasksCache()
+	pgi.InvalidateTasksCache()
 }
 
 func (pgi *PodGroupInfo) AddTaskInfo(ti *pod_info.PodInfo) {
@@ -267,11 +309,12 @@ func (pgi *PodGroupInfo) deleteTaskIndex(ti *pod_info.PodInfo) {
 			delete(pgi.PodStatusIndex, ti.Status)
 		}
 
-		pgi.invalidateTasksCache()
+		pgi.InvalidateTasksCache()
 	}
 }
 
-func (pgi *PodGroupInfo) invalidateTasksCache() {
+// InvalidateTasksCache drops the cached tasks to allocate, for changes that do not go through UpdateTaskStatus.
+func (pgi *PodGroupInfo) InvalidateTasksCache() {
 	pgi.tasksToAllocate = nil
 	pgi.tasksToAllocateInitResource = nil
 }
@@ -404,6 +447,23 @@ func (pgi *PodGroupInfo) IsElastic() bool {
 	return false
 }
 
+// HasElasticExtras returns true if the job has more active allocated tasks than the minimum available of one of its
+// sub-groups.
+func (pgi *PodGroupInfo) HasElasticExtras() bool {
+	for _, podSet := range pgi.PodSets {
+		if podSet.GetNumActiveAllocatedTasks() > int(podSet.GetMinAvailable()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPendingOnlyElasticExtras returns true if the gang of an elastic job with preemptible extras is satisfied, so its
+// pending tasks are extras that are placed only on free resources and never preempt or reclaim for.
+func (pgi *PodGroupInfo) IsPendingOnlyElasticExtras() bool {
+	return pgi.ElasticExtrasPreemptible && pgi.IsGangSatisfied()
+}
+
 func (pgi *PodGroupInfo) IsStale() bool {
 	if pgi.PodStatusIndex[pod_status.Succeeded] != nil {
 		return false
@@ -430,6 +490,15 @@ func (pgi *PodGroupInfo) IsGangSatisfied() bool {
 	return true
 }
 
+// IsSchedulingDeadlineExceeded returns true if the job's scheduling deadline passed before the job ever started
+// and while its gang is not satisfied. Jobs that started once are not timed out, even if they are pending again.
+func (pgi *PodGroupInfo) IsSchedulingDeadlineExceeded(now time.Time) bool {
+	if pgi.SchedulingDeadline == nil || pgi.LastStartTimestamp != nil {
+		return false
+	}
+	return now.After(*pgi.SchedulingDeadline) && !pgi.IsGangSatisfied()
+}
+
 func (pgi *PodGroupInfo) ShouldPipelineJob() bool {
 	for _, podSet := range pgi.PodSets {
 		hasPipelinedTask := false
@@ -465,6 +534,8 @@ func (pgi *PodGroupInfo) CloneWithTasks(tasks []*pod_info.PodInfo) *PodGroupInfo
 		Queue:     pgi.Queue,
 		Priority:  pgi.Priority,
 
+		ElasticExtrasPreemptible: pgi.ElasticExtrasPreemptible,
+
 		Allocated: resource_info.EmptyResource(),
 
 		JobFitErrors:   make(enginev2alpha2.UnschedulableExplanations, 0),
@@ -490,6 +561,15 @@ func (pgi *PodGroupInfo) CloneWithTasks(tasks []*pod_info.PodInfo) *PodGroupInfo
 	}
 
 	pgi.CreationTimestamp.DeepCopyInto(&info.CreationTimestamp)
+	if pgi.SchedulingTimeout != nil {
+		info.SchedulingTimeout = ptr.To(*pgi.SchedulingTimeout)
+	}
+	if pgi.PendingSince != nil {
+		info.PendingSince = ptr.To(*pgi.PendingSince)
+	}
+	if pgi.SchedulingDeadline != nil {
+		info.SchedulingDeadline = ptr.To(*pgi.SchedulingDeadline)
+	}
 
 	for _, podSet := range pgi.PodSets {
 		info.PodSets[podSet.GetName()] = subgroup_info.NewPodSet(
@@ -568,6 +648,15 @@ func (pgi *PodGroupInfo) generateSchedulingConstraintsSignature() common_info.Sc
 		hash.Write([]byte(signature))
 	}
 
+	// The max tasks per node limit of the podgroup constrains where its pods fit, like the constraints of the pods.
+	if pgi.PodGroup != nil {
+		for _, annotation := range []string{commonconstants.MaxTasksPerNode, commonconstants.MaxTasksPerNodePolicy} {
+			if value, found := pgi.PodGroup.Annotations[annotation]; found {
+				hash.Write([]byte(annotation + "=" + value))
+			}
+		}
+	}
+
 	return common_info.SchedulingConstraintsSignature(fmt.Sprintf("%x", hash.Sum(nil)))
 }
 
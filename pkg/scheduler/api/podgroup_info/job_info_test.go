@@ -20,11 +20,14 @@ limitations under the License.
 package podgroup_info
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
@@ -1051,3 +1054,97 @@ func TestPodGroupInfo_IsStale(t *testing.T) {
 		}
 	}
 }
+
+func TestPodGroupInfo_SchedulingDeadline(t *testing.T) {
+	creationTime := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		timeout          string
+		started          bool
+		now              time.Time
+		expectedTimeout  *time.Duration
+		expectedExceeded bool
+	}{
+		{
+			name: "no scheduling timeout",
+			now:  creationTime.Add(time.Hour),
+		},
+		{
+			name:            "before the deadline",
+			timeout:         "30m",
+			now:             creationTime.Add(10 * time.Minute),
+			expectedTimeout: ptr.To(30 * time.Minute),
+		},
+		{
+			name:             "after the deadline",
+			timeout:          "30m",
+			now:              creationTime.Add(time.Hour),
+			expectedTimeout:  ptr.To(30 * time.Minute),
+			expectedExceeded: true,
+		},
+		{
+			name:            "job that started once",
+			timeout:         "30m",
+			started:         true,
+			now:             creationTime.Add(time.Hour),
+			expectedTimeout: ptr.To(30 * time.Minute),
+		},
+		{
+			name:    "invalid scheduling timeout is ignored",
+			timeout: "soon",
+			now:     creationTime.Add(time.Hour),
+		},
+		{
+			name:    "negative scheduling timeout is ignored",
+			timeout: "-30m",
+			now:     creationTime.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pg := &v2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "pg",
+					Namespace:         "ns",
+					CreationTimestamp: metav1.NewTime(creationTime),
+					Annotations:       map[string]string{},
+				},
+			}
+			if tt.timeout != "" {
+				pg.Annotations[commonconstants.SchedulingTimeout] = tt.timeout
+			}
+			if tt.started {
+				pg.Annotations[commonconstants.LastStartTimeStamp] = creationTime.Format(time.RFC3339)
+			}
+			pg.Annotations[commonconstants.PendingSinceTimeStamp] = creationTime.Format(time.RFC3339)
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{UID: "1", Namespace: "ns", Name: "task1"},
+				Status:     v1.PodStatus{Phase: v1.PodPending},
+			}
+			pgi := NewPodGroupInfo("pg", pod_info.NewTaskInfo(pod))
+			pgi.SetPodGroup(pg)
+
+			if tt.expectedTimeout == nil {
+				if pgi.SchedulingTimeout != nil {
+					t.Errorf("expected no scheduling timeout, got %v", *pgi.SchedulingTimeout)
+				}
+				return
+			}
+			if pgi.SchedulingTimeout == nil || *pgi.SchedulingTimeout != *tt.expectedTimeout {
+				t.Errorf("expected scheduling timeout %v, got %v", *tt.expectedTimeout, pgi.SchedulingTimeout)
+				return
+			}
+			if pgi.SchedulingDeadline != nil {
+				t.Errorf("expected the scheduling deadline to be left to the session, got %v", *pgi.SchedulingDeadline)
+			}
+			if pgi.PendingSince == nil || !pgi.PendingSince.Equal(creationTime) {
+				t.Errorf("expected pending since %v, got %v", creationTime, pgi.PendingSince)
+			}
+			pgi.SchedulingDeadline = ptr.To(pgi.PendingSince.Add(*pgi.SchedulingTimeout))
+			if exceeded := pgi.IsSchedulingDeadlineExceeded(tt.now); exceeded != tt.expectedExceeded {
+				t.Errorf("expected deadline exceeded to be %v, got %v", tt.expectedExceeded, exceeded)
+			}
+		})
+	}
+}
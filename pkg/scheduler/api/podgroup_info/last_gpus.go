@@ -0,0 +1,59 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"encoding/json"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// LastGpus are the GPUs of a node that a pod of the job last ran on, by their index on the node, so they are known
+// when the pod is recreated.
+type LastGpus struct {
+	NodeName   string `json:"nodeName"`
+	GpuIndexes []int  `json:"gpuIndexes"`
+}
+
+// GetLastGpus returns the GPUs that the job's pod with the given name last ran on, and whether they are known.
+func (pgi *PodGroupInfo) GetLastGpus(podName string) (LastGpus, bool) {
+	lastGpus, found := pgi.LastGpus[podName]
+	return lastGpus, found
+}
+
+// SetLastGpus records the GPUs that the job's pod with the given name runs on.
+func (pgi *PodGroupInfo) SetLastGpus(podName string, lastGpus LastGpus) {
+	if pgi.LastGpus == nil {
+		pgi.LastGpus = map[string]LastGpus{}
+	}
+	pgi.LastGpus[podName] = lastGpus
+}
+
+// LastGpusAnnotationValue returns the last GPUs of the job's pods as the value of the pod group's last-gpu-indexes
+// annotation, or an empty string when none is known.
+func (pgi *PodGroupInfo) LastGpusAnnotationValue() string {
+	if len(pgi.LastGpus) == 0 {
+		return ""
+	}
+	value, err := json.Marshal(pgi.LastGpus)
+	if err != nil {
+		return ""
+	}
+	return string(value)
+}
+
+func (pgi *PodGroupInfo) setLastGpus(annotations map[string]string) {
+	value := annotations[commonconstants.LastGpuIndexes]
+	if value == "" {
+		return
+	}
+	lastGpus := map[string]LastGpus{}
+	if err := json.Unmarshal([]byte(value), &lastGpus); err != nil {
+		log.InfraLogger.V(2).Warnf("Invalid last gpu indexes <%s> for podgroup <%s>, ignoring them",
+			value, pgi.NamespacedName)
+		return
+	}
+	pgi.LastGpus = lastGpus
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestLastGpus(t *testing.T) {
+	job := NewPodGroupInfo("pg1")
+	assert.Equal(t, "", job.LastGpusAnnotationValue())
+
+	job.SetLastGpus("pod-1", LastGpus{NodeName: "node0", GpuIndexes: []int{2}})
+	job.SetLastGpus("pod-0", LastGpus{NodeName: "node0", GpuIndexes: []int{0, 1}})
+	value := job.LastGpusAnnotationValue()
+	assert.Equal(t,
+		`{"pod-0":{"nodeName":"node0","gpuIndexes":[0,1]},"pod-1":{"nodeName":"node0","gpuIndexes":[2]}}`, value)
+
+	recreated := NewPodGroupInfo("pg1")
+	recreated.SetPodGroup(&enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pg1",
+			Annotations: map[string]string{commonconstants.LastGpuIndexes: value},
+		},
+	})
+	lastGpus, found := recreated.GetLastGpus("pod-0")
+	assert.True(t, found)
+	assert.Equal(t, LastGpus{NodeName: "node0", GpuIndexes: []int{0, 1}}, lastGpus)
+
+	invalid := NewPodGroupInfo("pg1")
+	invalid.SetPodGroup(&enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pg1",
+			Annotations: map[string]string{commonconstants.LastGpuIndexes: "pod-0=node0:0"},
+		},
+	})
+	_, found = invalid.GetLastGpus("pod-0")
+	assert.False(t, found)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+// PendingCause tells whether the pending pods of a pod group wait for their queue's quota or for cluster capacity.
+type PendingCause string
+
+const (
+	// BlockedByQuota means that the pod group does not fit the quota, limits or running jobs of its queue, so it
+	// would not be scheduled even on an empty cluster.
+	BlockedByQuota PendingCause = "BlockedByQuota"
+
+	// InsufficientCapacity means that the pod group fits its queue, but no nodes have room for its pods.
+	InsufficientCapacity PendingCause = "InsufficientCapacity"
+
+	// PendingCauseCondition is the pod condition that holds the pending cause of an unschedulable pod in its reason.
+	PendingCauseCondition v1.PodConditionType = "kai.scheduler/PendingCause"
+)
+
+var quotaReasons = map[enginev2alpha2.UnschedulableReason]bool{
+	enginev2alpha2.NonPreemptibleOverQuota: true,
+	enginev2alpha2.OverLimit:               true,
+	enginev2alpha2.MaxRunningJobsReached:   true,
+	enginev2alpha2.StrictOverQuota:         true,
+}
+
+// PendingCause returns why the pending pods of the pod group were not scheduled in the session, from the results of
+// the queue capacity checks and of fitting its pods to the nodes. False is returned if the pod group has no pending
+// pods, or if it is pending for another reason, such as a dependency or its topology constraints.
+func (pgi *PodGroupInfo) PendingCause() (PendingCause, bool) {
+	if pgi.GetNumPendingTasks() == 0 {
+		return "", false
+	}
+	for _, fitError := range pgi.JobFitErrors {
+		if quotaReasons[fitError.Reason] {
+			return BlockedByQuota, true
+		}
+	}
+	for _, fitError := range pgi.JobFitErrors {
+		if fitError.Reason == PodSchedulingErrors {
+			return InsufficientCapacity, true
+		}
+	}
+	if len(pgi.JobFitErrors) == 0 && len(pgi.NodesFitErrors) > 0 {
+		return InsufficientCapacity, true
+	}
+	return "", false
+}
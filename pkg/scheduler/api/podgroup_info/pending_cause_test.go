@@ -0,0 +1,94 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+func TestPendingCause(t *testing.T) {
+	tests := []struct {
+		name          string
+		phase         v1.PodPhase
+		jobFitErrors  []enginev2alpha2.UnschedulableReason
+		nodeFitErrors bool
+		expectedCause PendingCause
+		expectedFound bool
+	}{
+		{
+			name:         "no pending pods",
+			phase:        v1.PodRunning,
+			jobFitErrors: []enginev2alpha2.UnschedulableReason{enginev2alpha2.OverLimit},
+		},
+		{
+			name:          "over the queue limit",
+			phase:         v1.PodPending,
+			jobFitErrors:  []enginev2alpha2.UnschedulableReason{enginev2alpha2.OverLimit},
+			expectedCause: BlockedByQuota,
+			expectedFound: true,
+		},
+		{
+			name:  "strict over quota after failing to fit the nodes",
+			phase: v1.PodPending,
+			jobFitErrors: []enginev2alpha2.UnschedulableReason{
+				PodSchedulingErrors, enginev2alpha2.StrictOverQuota,
+			},
+			expectedCause: BlockedByQuota,
+			expectedFound: true,
+		},
+		{
+			name:          "pods do not fit the nodes",
+			phase:         v1.PodPending,
+			jobFitErrors:  []enginev2alpha2.UnschedulableReason{PodSchedulingErrors},
+			expectedCause: InsufficientCapacity,
+			expectedFound: true,
+		},
+		{
+			name:          "node fit errors only",
+			phase:         v1.PodPending,
+			nodeFitErrors: true,
+			expectedCause: InsufficientCapacity,
+			expectedFound: true,
+		},
+		{
+			name:         "waiting for a dependency",
+			phase:        v1.PodPending,
+			jobFitErrors: []enginev2alpha2.UnschedulableReason{enginev2alpha2.WaitingForDependency},
+		},
+		{
+			name:  "not tried yet",
+			phase: v1.PodPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeName := ""
+			if tt.phase == v1.PodRunning {
+				nodeName = "node0"
+			}
+			pod := common_info.BuildPod("ns", "pod", nodeName, tt.phase, common_info.BuildResourceList("1", "1G"),
+				nil, nil, nil)
+			task := pod_info.NewTaskInfo(pod)
+			job := NewPodGroupInfo("job", task)
+			for _, reason := range tt.jobFitErrors {
+				job.SetJobFitError(reason, string(reason), nil)
+			}
+			if tt.nodeFitErrors {
+				job.SetTaskFitError(task, common_info.NewFitErrors())
+			}
+
+			cause, found := job.PendingCause()
+			assert.Equal(t, tt.expectedCause, cause)
+			assert.Equal(t, tt.expectedFound, found)
+		})
+	}
+}
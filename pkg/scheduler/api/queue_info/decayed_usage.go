@@ -0,0 +1,79 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_info
+
+import (
+	"maps"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// minDecayedUsage is the usage below which a queue's accumulated usage is dropped, so deleted queues are forgotten.
+const minDecayedUsage = 1e-9
+
+// DecayedUsage accumulates the resource usage of queues across scheduling cycles as an exponentially weighted moving
+// average, so that the weight of a usage sample halves every half-life. It is safe for concurrent use.
+type DecayedUsage struct {
+	mutex      sync.Mutex
+	queues     map[common_info.QueueID]QueueUsage
+	lastUpdate time.Time
+}
+
+func NewDecayedUsage() *DecayedUsage {
+	return &DecayedUsage{
+		queues: map[common_info.QueueID]QueueUsage{},
+	}
+}
+
+// Update decays the accumulated usage by the time passed since the previous update, blends in the usage sampled at
+// now and returns a copy of the result. The first update takes the sampled usage as is.
+func (du *DecayedUsage) Update(usage *ClusterUsage, now time.Time, halfLife time.Duration) *ClusterUsage {
+	du.mutex.Lock()
+	defer du.mutex.Unlock()
+
+	sampleWeight := 1.0
+	if !du.lastUpdate.IsZero() && halfLife > 0 {
+		elapsed := max(now.Sub(du.lastUpdate), 0)
+		sampleWeight = 1 - math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+	}
+	du.lastUpdate = now
+
+	for _, queueUsage := range du.queues {
+		for resource, value := range queueUsage {
+			queueUsage[resource] = value * (1 - sampleWeight)
+		}
+	}
+	if usage != nil {
+		for queueID, sampledUsage := range usage.Queues {
+			if _, found := du.queues[queueID]; !found {
+				du.queues[queueID] = QueueUsage{}
+			}
+			for resource, value := range sampledUsage {
+				du.queues[queueID][resource] += value * sampleWeight
+			}
+		}
+	}
+
+	result := NewClusterUsage()
+	for queueID, queueUsage := range du.queues {
+		if isNegligibleUsage(queueUsage) {
+			delete(du.queues, queueID)
+			continue
+		}
+		result.Queues[queueID] = maps.Clone(queueUsage)
+	}
+	return result
+}
+
+func isNegligibleUsage(usage QueueUsage) bool {
+	for _, value := range usage {
+		if value >= minDecayedUsage {
+			return false
+		}
+	}
+	return true
+}
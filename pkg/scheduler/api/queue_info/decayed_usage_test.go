@@ -0,0 +1,104 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_info
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func TestDecayedUsage_Update(t *testing.T) {
+	halfLife := time.Hour
+	start := time.Now()
+	gpuUsage := func(usages map[common_info.QueueID]float64) *ClusterUsage {
+		usage := NewClusterUsage()
+		for queueID, value := range usages {
+			usage.Queues[queueID] = QueueUsage{commonconstants.GpuResource: value}
+		}
+		return usage
+	}
+
+	tests := []struct {
+		name     string
+		updates  []*ClusterUsage
+		times    []time.Time
+		expected map[common_info.QueueID]float64
+	}{
+		{
+			name:     "first update takes the sampled usage",
+			updates:  []*ClusterUsage{gpuUsage(map[common_info.QueueID]float64{"q1": 8})},
+			times:    []time.Time{start},
+			expected: map[common_info.QueueID]float64{"q1": 8},
+		},
+		{
+			name: "usage is blended by the elapsed half-lives",
+			updates: []*ClusterUsage{
+				gpuUsage(map[common_info.QueueID]float64{"q1": 8}),
+				gpuUsage(map[common_info.QueueID]float64{"q1": 0, "q2": 4}),
+			},
+			times:    []time.Time{start, start.Add(halfLife)},
+			expected: map[common_info.QueueID]float64{"q1": 4, "q2": 2},
+		},
+		{
+			name: "missing queues decay",
+			updates: []*ClusterUsage{
+				gpuUsage(map[common_info.QueueID]float64{"q1": 8}),
+				NewClusterUsage(),
+			},
+			times:    []time.Time{start, start.Add(2 * halfLife)},
+			expected: map[common_info.QueueID]float64{"q1": 2},
+		},
+		{
+			name: "no elapsed time keeps the accumulated usage",
+			updates: []*ClusterUsage{
+				gpuUsage(map[common_info.QueueID]float64{"q1": 8}),
+				gpuUsage(map[common_info.QueueID]float64{"q1": 0}),
+			},
+			times:    []time.Time{start, start},
+			expected: map[common_info.QueueID]float64{"q1": 8},
+		},
+		{
+			name: "negligible usage is forgotten",
+			updates: []*ClusterUsage{
+				gpuUsage(map[common_info.QueueID]float64{"q1": 1}),
+				NewClusterUsage(),
+			},
+			times:    []time.Time{start, start.Add(100 * halfLife)},
+			expected: map[common_info.QueueID]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decayedUsage := NewDecayedUsage()
+			var result *ClusterUsage
+			for i, usage := range tt.updates {
+				result = decayedUsage.Update(usage, tt.times[i], halfLife)
+			}
+
+			assert.Len(t, result.Queues, len(tt.expected))
+			for queueID, expected := range tt.expected {
+				assert.InDelta(t, expected, result.Queues[queueID][commonconstants.GpuResource], 1e-9)
+			}
+		})
+	}
+}
+
+func TestDecayedUsage_UpdateReturnsCopy(t *testing.T) {
+	decayedUsage := NewDecayedUsage()
+	usage := NewClusterUsage()
+	usage.Queues["q1"] = QueueUsage{v1.ResourceCPU: 2}
+
+	result := decayedUsage.Update(usage, time.Now(), time.Hour)
+	result.Queues["q1"][v1.ResourceCPU] = 100
+
+	result = decayedUsage.Update(nil, time.Now(), time.Hour)
+	assert.InDelta(t, 2, result.Queues["q1"][v1.ResourceCPU], 0.01)
+}
@@ -30,16 +30,24 @@ import (
 )
 
 type QueueInfo struct {
-	UID               common_info.QueueID
-	Name              string
-	ParentQueue       common_info.QueueID
-	ChildQueues       []common_info.QueueID
-	Resources         QueueQuota
-	ResourceUsage     QueueUsage
-	Priority          int
-	CreationTimestamp metav1.Time
-	PreemptMinRuntime *metav1.Duration
-	ReclaimMinRuntime *metav1.Duration
+	UID                   common_info.QueueID
+	Name                  string
+	ParentQueue           common_info.QueueID
+	ChildQueues           []common_info.QueueID
+	Resources             QueueQuota
+	ResourceUsage         QueueUsage
+	Priority              int
+	PriorityClass         int
+	CreationTimestamp     metav1.Time
+	PreemptMinRuntime     *metav1.Duration
+	ReclaimMinRuntime     *metav1.Duration
+	PreemptionGracePeriod *metav1.Duration
+	PreemptionPolicy      enginev2.QueuePreemptionPolicy
+	OverQuotaPolicy       enginev2.QueueOverQuotaPolicy
+	OverQuotaDeadline     *metav1.Duration
+	MaxRunningJobs        *int
+	GuaranteeWeight       *int
+	NodeSelector          map[string]string
 }
 
 func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
@@ -53,16 +61,29 @@ func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
 		priority = *queue.Spec.Priority
 	}
 
+	priorityClass := 0
+	if queue.Spec.PriorityClass != nil {
+		priorityClass = *queue.Spec.PriorityClass
+	}
+
 	return &QueueInfo{
-		UID:               common_info.QueueID(queue.Name),
-		Name:              queueName,
-		ParentQueue:       common_info.QueueID(queue.Spec.ParentQueue),
-		ChildQueues:       []common_info.QueueID{}, // ToDo: Calculate from queue status once we reflect it there
-		Resources:         getQueueQuota(*queue),
-		Priority:          priority,
-		CreationTimestamp: queue.CreationTimestamp,
-		PreemptMinRuntime: queue.Spec.PreemptMinRuntime,
-		ReclaimMinRuntime: queue.Spec.ReclaimMinRuntime,
+		UID:                   common_info.QueueID(queue.Name),
+		Name:                  queueName,
+		ParentQueue:           common_info.QueueID(queue.Spec.ParentQueue),
+		ChildQueues:           []common_info.QueueID{}, // ToDo: Calculate from queue status once we reflect it there
+		Resources:             getQueueQuota(*queue),
+		Priority:              priority,
+		PriorityClass:         priorityClass,
+		CreationTimestamp:     queue.CreationTimestamp,
+		PreemptMinRuntime:     queue.Spec.PreemptMinRuntime,
+		ReclaimMinRuntime:     queue.Spec.ReclaimMinRuntime,
+		PreemptionGracePeriod: queue.Spec.PreemptionGracePeriod,
+		PreemptionPolicy:      queue.Spec.PreemptionPolicy,
+		OverQuotaPolicy:       queue.Spec.OverQuotaPolicy,
+		OverQuotaDeadline:     queue.Spec.OverQuotaDeadline,
+		MaxRunningJobs:        queue.Spec.MaxRunningJobs,
+		GuaranteeWeight:       queue.Spec.GuaranteeWeight,
+		NodeSelector:          queue.Spec.NodeSelector,
 	}
 }
 
@@ -83,8 +104,31 @@ func getQueueQuota(queue enginev2.Queue) QueueQuota {
 	}
 
 	return QueueQuota{
-		GPU:    ResourceQuota(queue.Spec.Resources.GPU),
-		CPU:    ResourceQuota(queue.Spec.Resources.CPU),
-		Memory: ResourceQuota(queue.Spec.Resources.Memory),
+		GPU:      ResourceQuota(queue.Spec.Resources.GPU),
+		CPU:      ResourceQuota(queue.Spec.Resources.CPU),
+		Memory:   ResourceQuota(queue.Spec.Resources.Memory),
+		GpuTypes: getGpuTypeQuotas(queue.Spec.Resources.GpuTypes),
+	}
+}
+
+func getGpuTypeQuotas(gpuTypes map[string]enginev2.GpuTypeResource) map[string]GpuTypeQuota {
+	if len(gpuTypes) == 0 {
+		return nil
+	}
+
+	quotas := make(map[string]GpuTypeQuota, len(gpuTypes))
+	for gpuType, resource := range gpuTypes {
+		quota := GpuTypeQuota{
+			Quota: commonconstants.UnlimitedResourceQuantity,
+			Limit: commonconstants.UnlimitedResourceQuantity,
+		}
+		if resource.Quota != nil {
+			quota.Quota = *resource.Quota
+		}
+		if resource.Limit != nil {
+			quota.Limit = *resource.Limit
+		}
+		quotas[gpuType] = quota
 	}
+	return quotas
 }
@@ -0,0 +1,51 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_info
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// Loan is the part of the deserved quota of a lender queue that a sibling borrower queue uses, while the lender does
+// not.
+type Loan struct {
+	Borrower  common_info.QueueID
+	Lender    common_info.QueueID
+	Resources QueueUsage
+}
+
+// QueueLoans tracks across scheduling cycles the time since which each lender queue is starved: it has jobs waiting
+// for its lent quota. It is safe for concurrent use.
+type QueueLoans struct {
+	mutex        sync.Mutex
+	starvedSince map[common_info.QueueID]time.Time
+}
+
+func NewQueueLoans() *QueueLoans {
+	return &QueueLoans{
+		starvedSince: map[common_info.QueueID]time.Time{},
+	}
+}
+
+// Update records the lenders that are starved at now, forgetting the lenders that are not starved anymore. It returns
+// a copy of the time since which each starved lender is starved.
+func (ql *QueueLoans) Update(starvedLenders []common_info.QueueID, now time.Time) map[common_info.QueueID]time.Time {
+	ql.mutex.Lock()
+	defer ql.mutex.Unlock()
+
+	starvedSince := make(map[common_info.QueueID]time.Time, len(starvedLenders))
+	for _, lender := range starvedLenders {
+		since, found := ql.starvedSince[lender]
+		if !found {
+			since = now
+		}
+		starvedSince[lender] = since
+	}
+	ql.starvedSince = starvedSince
+	return maps.Clone(starvedSince)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_info
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func TestQueueLoans_Update(t *testing.T) {
+	start := time.Now()
+	queueLoans := NewQueueLoans()
+	starvedSince := queueLoans.Update([]common_info.QueueID{"q1"}, start)
+	assert.Equal(t, map[common_info.QueueID]time.Time{"q1": start}, starvedSince)
+
+	starvedSince = queueLoans.Update([]common_info.QueueID{"q1", "q3"}, start.Add(time.Minute))
+	assert.Equal(t, map[common_info.QueueID]time.Time{"q1": start, "q3": start.Add(time.Minute)}, starvedSince)
+
+	starvedSince = queueLoans.Update([]common_info.QueueID{"q3"}, start.Add(2*time.Minute))
+	assert.Equal(t, map[common_info.QueueID]time.Time{"q3": start.Add(time.Minute)}, starvedSince)
+
+	starvedSince = queueLoans.Update([]common_info.QueueID{"q1"}, start.Add(3*time.Minute))
+	assert.Equal(t, map[common_info.QueueID]time.Time{"q1": start.Add(3 * time.Minute)}, starvedSince)
+}
@@ -12,6 +12,8 @@ type QueueQuota struct {
 	GPU    ResourceQuota `json:"gpu,omitempty"`
 	CPU    ResourceQuota `json:"cpu,omitempty"`
 	Memory ResourceQuota `json:"memory,omitempty"`
+	// GpuTypes holds the GPU quotas of the queue per GPU model, keyed by the GPU model.
+	GpuTypes map[string]GpuTypeQuota `json:"gpuTypes,omitempty"`
 }
 
 type ResourceQuota struct {
@@ -23,6 +25,13 @@ type ResourceQuota struct {
 	Limit float64 `json:"limit"`
 }
 
+// GpuTypeQuota is the GPU quota and limit of a queue for a single GPU model. Unset values are
+// commonconstants.UnlimitedResourceQuantity.
+type GpuTypeQuota struct {
+	Quota float64 `json:"deserved"`
+	Limit float64 `json:"limit"`
+}
+
 type QueueUsage map[v1.ResourceName]float64
 
 type ClusterUsage struct {
@@ -9,6 +9,8 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 )
 
+// ScenarioInfo is a set of victims to evict, for the scenario validators. The preemptor is nil for scenarios that only
+// move the victims to other nodes, such as the drains of node consolidation.
 type ScenarioInfo interface {
 	GetPreemptor() *podgroup_info.PodGroupInfo
 	GetVictims() map[common_info.PodGroupID]*VictimInfo
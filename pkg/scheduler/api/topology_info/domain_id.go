@@ -0,0 +1,22 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology_info
+
+import (
+	"strings"
+
+	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
+)
+
+// CalcDomainId returns the id of the topology domain at the leaf level that a node with the labels belongs to. The id
+// joins the node's label values of the leaf level and of all the levels above it with dots, so equally named domains
+// under different parent domains have different ids.
+func CalcDomainId(leafLevelIndex int, levels []kueuev1alpha1.TopologyLevel, nodeLabels map[string]string) string {
+	domainsNames := make([]string, leafLevelIndex+1)
+	for levelIndex := leafLevelIndex; levelIndex >= 0; levelIndex-- {
+		levelLabel := levels[levelIndex].NodeLabel
+		domainsNames[levelIndex] = nodeLabels[levelLabel]
+	}
+	return strings.Join(domainsNames, ".")
+}
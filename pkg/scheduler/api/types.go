@@ -20,7 +20,10 @@ limitations under the License.
 package api
 
 import (
+	"time"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
@@ -46,6 +49,47 @@ type VictimFilterFn func(pendingJob *podgroup_info.PodGroupInfo, victim *podgrou
 // ScenarioValidatorFn is a function which determines the validity of a scenario.
 type ScenarioValidatorFn func(scenario ScenarioInfo) bool
 
+// CommitInfo holds the tasks of the operations a statement is about to commit.
+type CommitInfo struct {
+	Evicted   []*pod_info.PodInfo
+	Pipelined []*pod_info.PodInfo
+	Allocated []*pod_info.PodInfo
+	Shrunk    []*pod_info.PodInfo
+}
+
+// CommitValidatorFn is consulted before a statement commits its operations to the cache. Returning false vetoes the
+// commit, with reason explaining why.
+type CommitValidatorFn func(commit CommitInfo) (valid bool, reason string)
+
+// AllocationUsageEventType tells whether an AllocationUsageEvent starts or stops the use of resources by a pod.
+type AllocationUsageEventType string
+
+const (
+	AllocationStarted AllocationUsageEventType = "Allocated"
+	AllocationStopped AllocationUsageEventType = "Released"
+)
+
+// AllocationUsageEvent describes resources that a committed statement allocated to a pod or released from it.
+type AllocationUsageEvent struct {
+	Type      AllocationUsageEventType
+	Timestamp time.Time
+	Queue     common_info.QueueID
+	Job       common_info.PodGroupID
+	Namespace string
+	Name      string
+	UID       common_info.PodID
+	NodeName  string
+	// Resources are the resources requested by the pod.
+	Resources *resource_info.ResourceRequirements
+	// GPUs is the number of GPUs used by the pod on the node, a fraction of a GPU per device for GPU sharing pods.
+	GPUs float64
+	// GPUMemory is the GPU memory used by the pod on the node, in MiB.
+	GPUMemory int64
+}
+
+// AllocationUsageFn is called after a statement commits, with the allocations the commit started and stopped.
+type AllocationUsageFn func(events []AllocationUsageEvent)
+
 // QueueResource is a function which returns the resource of a queue.
 type QueueResource func(*queue_info.QueueInfo) *resource_info.ResourceRequirements
 
@@ -58,6 +102,9 @@ type IsTaskAllocationOverCapacityFn func(task *pod_info.PodInfo, job *podgroup_i
 // GpuOrderFn is used to get priority score for a gpu for a particular task.
 type GpuOrderFn func(*pod_info.PodInfo, *node_info.NodeInfo, string) (float64, error)
 
+// GpuFilterFn is used to exclude a gpu from the gpus a particular task may be placed on. It returns false to exclude it.
+type GpuFilterFn func(*pod_info.PodInfo, *node_info.NodeInfo, string) bool
+
 // NodeOrderFn is used to get priority score for a node for a particular task.
 type NodeOrderFn func(*pod_info.PodInfo, *node_info.NodeInfo) (float64, error)
 
@@ -71,6 +118,14 @@ type OnJobSolutionStartFn func()
 // BindRequestMutateFn allows plugins to add annotations before BindRequest creation.
 type BindRequestMutateFn func(pod *pod_info.PodInfo, nodeName string) map[string]string
 
+// TaskResourceMutateFn returns the resource requirements to schedule a pending task against instead of its request,
+// e.g. rightsized to the actual usage of the task, or nil to keep the requirements of the task.
+type TaskResourceMutateFn func(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements
+
+// GpuDeviceIdFn returns the id of the physical GPU with the index on the node, e.g. its UUID from a node inventory, or
+// an empty string if unknown.
+type GpuDeviceIdFn func(node *node_info.NodeInfo, gpuIndex int) string
+
 type SchedulableResult struct {
 	IsSchedulable bool
 	Reason        v2alpha2.UnschedulableReason
@@ -82,6 +82,31 @@ func GetJobOverMaxAllowedMessageForQueue(
 		queueName, resourceNameStr, details)
 }
 
+func GetJobOverGpuTypeLimitMessageForQueue(queueName, gpuType string, limit, used, requested float64) string {
+	return fmt.Sprintf("%s quota has reached the allowable limit of %s GPUs. "+
+		"Limit is %s %s GPUs, currently %s %s GPUs allocated and workload requested %s GPUs",
+		queueName, gpuType,
+		resource_info.HumanizeResource(limit, 1), gpuType,
+		resource_info.HumanizeResource(used, 1), gpuType,
+		resource_info.HumanizeResource(requested, 1))
+}
+
+func GetBuildOverGpuTypeQuotaMessageForQueue(queueName, gpuType string, quota, used, requested float64) string {
+	return fmt.Sprintf("Non-preemptible workload is over quota. "+
+		"Workload requested %s %s GPUs, but %s %s quota is %s GPUs, "+
+		"while %s %s GPUs are already allocated for non-preemptible pods. "+
+		"Use a preemptible workload to go over quota.",
+		resource_info.HumanizeResource(requested, 1), gpuType,
+		queueName, gpuType, resource_info.HumanizeResource(quota, 1),
+		resource_info.HumanizeResource(used, 1), gpuType)
+}
+
+func GetJobOverMaxRunningJobsMessageForQueue(queueName string, maxRunningJobs, runningJobs int) string {
+	return fmt.Sprintf("%s has reached its limit of %d running workloads, currently %d workloads are running. "+
+		"The workload will be scheduled once a running workload of the queue completes.",
+		queueName, maxRunningJobs, runningJobs)
+}
+
 func GetGangEvictionMessage(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo) string {
 	if len(job.GetSubGroups()) == 1 {
 		if defaultSubgroup, found := job.GetSubGroups()[podgroup_info.DefaultSubGroup]; found {
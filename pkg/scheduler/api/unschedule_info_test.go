@@ -98,4 +98,13 @@ var _ = Describe("UnscheduledInfo", func() {
 		})
 	})
 
+	Context("GetJobOverGpuTypeLimitMessageForQueue", func() {
+		It("should name the exhausted GPU type", func() {
+			message := GetJobOverGpuTypeLimitMessageForQueue("gpu-queue", "H100", 4, 3, 2)
+
+			expected := "gpu-queue quota has reached the allowable limit of H100 GPUs. Limit is 4 H100 GPUs, currently 3 H100 GPUs allocated and workload requested 2 GPUs"
+			Expect(message).To(Equal(expected))
+		})
+	})
+
 })
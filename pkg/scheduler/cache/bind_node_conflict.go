@@ -0,0 +1,41 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// ErrBindNodeConflict is returned by Bind when the target node changed since the snapshot in a way that prevents
+// binding to it, so the pod may still be bound to another node.
+var ErrBindNodeConflict = errors.New("bind target node changed since the snapshot")
+
+// checkBindNode returns ErrBindNodeConflict if the node was deleted, cordoned or became not ready according to the
+// informer cache. Other lookup errors do not block the bind.
+func (sc *SchedulerCache) checkBindNode(hostname string) error {
+	node, err := sc.nodeLister.Get(hostname)
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("%w: node %s was deleted", ErrBindNodeConflict, hostname)
+	}
+	if err != nil {
+		log.InfraLogger.V(4).Warnf("Failed to get node %s before binding: %v", hostname, err)
+		return nil
+	}
+
+	if node.Spec.Unschedulable {
+		return fmt.Errorf("%w: node %s was cordoned", ErrBindNodeConflict, hostname)
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady && condition.Status != v1.ConditionTrue {
+			return fmt.Errorf("%w: node %s is not ready", ErrBindNodeConflict, hostname)
+		}
+	}
+	return nil
+}
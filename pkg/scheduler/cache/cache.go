@@ -21,6 +21,7 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -29,6 +30,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -43,12 +45,15 @@ import (
 	enginelisters "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/listers/scheduling/v2alpha2"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	draversionawareclient "github.com/NVIDIA/KAI-scheduler/pkg/common/resources/dra_version_aware_client"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/bindrequest_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/cluster_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/cluster_info/data_lister"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/evictor"
@@ -92,6 +97,7 @@ type SchedulerCacheParams struct {
 	AllowConsolidatingReclaim   bool
 	NumOfStatusRecordingWorkers int
 	UpdatePodEvictionCondition  bool
+	MaxSnapshotStaleness        time.Duration
 }
 
 type SchedulerCache struct {
@@ -103,14 +109,20 @@ type SchedulerCache struct {
 	kubeAiSchedulerInformerFactory kubeaischedulerinfo.SharedInformerFactory
 	kueueInformerFactory           kueue.SharedInformerFactory
 	podLister                      listv1.PodLister
+	nodeLister                     listv1.NodeLister
 	podGroupLister                 enginelisters.PodGroupLister
 	clusterInfo                    *cluster_info.ClusterInfo
 	usageLister                    *usagedb.UsageLister
+	decayedQueueUsage              *queue_info.DecayedUsage
+	queueLoans                     *queue_info.QueueLoans
+	pipelineAges                   *pod_info.PipelineAges
+	freshness                      *cacheFreshness
 
 	schedulingNodePoolParams *conf.SchedulingNodePoolParams
 
-	Evictor       evictor.Interface
-	StatusUpdater status_updater.Interface
+	Evictor           evictor.Interface
+	CheckpointEvictor *evictor.CheckpointEvictor
+	StatusUpdater     status_updater.Interface
 
 	detailedFitErrors      bool
 	restrictNodeScheduling bool
@@ -132,6 +144,9 @@ func newSchedulerCache(schedulerCacheParams *SchedulerCacheParams) *SchedulerCac
 		kubeClient:               draversionawareclient.NewDRAAwareClient(schedulerCacheParams.KubeClient),
 		kubeAiSchedulerClient:    schedulerCacheParams.KAISchedulerClient,
 		kueueClient:              schedulerCacheParams.KueueClient,
+		decayedQueueUsage:        queue_info.NewDecayedUsage(),
+		queueLoans:               queue_info.NewQueueLoans(),
+		pipelineAges:             pod_info.NewPipelineAges(),
 	}
 
 	schedulerName := schedulerCacheParams.SchedulerName
@@ -145,27 +160,48 @@ func newSchedulerCache(schedulerCacheParams *SchedulerCacheParams) *SchedulerCac
 	recorder := broadcaster.NewRecorder(kubeaischedulerschema.Scheme, v1.EventSource{Component: schedulerName})
 
 	sc.Evictor = evictor.New(sc.kubeClient, schedulerCacheParams.UpdatePodEvictionCondition)
+	sc.CheckpointEvictor = evictor.NewCheckpointEvictor(sc.kubeClient, sc.Evictor)
 
 	sc.StatusUpdater = status_updater.New(
 		sc.kubeClient, sc.kubeAiSchedulerClient, recorder, schedulerCacheParams.NumOfStatusRecordingWorkers,
 		sc.detailedFitErrors, sc.schedulingNodePoolParams.NodePoolLabelKey,
 	)
 
-	sc.informerFactory = informers.NewSharedInformerFactory(sc.kubeClient, 0)
-	sc.kubeAiSchedulerInformerFactory = kubeaischedulerinfo.NewSharedInformerFactory(sc.kubeAiSchedulerClient, 0)
+	var kubeInformerOptions []informers.SharedInformerOption
+	var kubeAiSchedulerInformerOptions []kubeaischedulerinfo.SharedInformerOption
+	freshnessResyncPeriod := schedulerCacheParams.MaxSnapshotStaleness / freshnessResyncsPerThreshold
+	if freshnessResyncPeriod > 0 {
+		kubeInformerOptions = append(kubeInformerOptions, informers.WithCustomResyncConfig(
+			map[metav1.Object]time.Duration{&v1.Pod{}: freshnessResyncPeriod, &v1.Node{}: freshnessResyncPeriod}))
+		kubeAiSchedulerInformerOptions = append(kubeAiSchedulerInformerOptions,
+			kubeaischedulerinfo.WithCustomResyncConfig(
+				map[metav1.Object]time.Duration{&enginev2alpha2.PodGroup{}: freshnessResyncPeriod}))
+	}
+	sc.informerFactory = informers.NewSharedInformerFactoryWithOptions(sc.kubeClient, 0, kubeInformerOptions...)
+	sc.kubeAiSchedulerInformerFactory = kubeaischedulerinfo.NewSharedInformerFactoryWithOptions(
+		sc.kubeAiSchedulerClient, 0, kubeAiSchedulerInformerOptions...)
 	sc.kueueInformerFactory = kueue.NewSharedInformerFactory(sc.kueueClient, 0)
 
 	sc.internalPlugins = k8splugins.InitializeInternalPlugins(sc.kubeClient, sc.informerFactory, sc.SnapshotSharedLister())
 
 	sc.podLister = sc.informerFactory.Core().V1().Pods().Lister()
+	sc.nodeLister = sc.informerFactory.Core().V1().Nodes().Lister()
 	sc.podGroupLister = sc.kubeAiSchedulerInformerFactory.Scheduling().V2alpha2().PodGroups().Lister()
+	if freshnessResyncPeriod > 0 {
+		sc.freshness = newCacheFreshness(map[string]freshnessSource{
+			"pods":      sc.informerFactory.Core().V1().Pods().Informer(),
+			"nodes":     sc.informerFactory.Core().V1().Nodes().Informer(),
+			"podgroups": sc.kubeAiSchedulerInformerFactory.Scheduling().V2alpha2().PodGroups().Informer(),
+		}, freshnessResyncPeriod)
+	}
 
 	if schedulerCacheParams.UsageDBClient != nil {
 		sc.usageLister = usagedb.NewUsageLister(schedulerCacheParams.UsageDBClient, nil, nil, nil)
 	}
 
 	clusterInfo, err := cluster_info.New(sc.informerFactory, sc.kubeAiSchedulerInformerFactory, sc.kueueInformerFactory, sc.usageLister, sc.schedulingNodePoolParams,
-		sc.restrictNodeScheduling, &sc.K8sClusterPodAffinityInfo, sc.scheduleCSIStorage, sc.fullHierarchyFairness, sc.StatusUpdater)
+		sc.restrictNodeScheduling, &sc.K8sClusterPodAffinityInfo, sc.scheduleCSIStorage, sc.fullHierarchyFairness, sc.StatusUpdater,
+		schedulerName)
 
 	if err != nil {
 		log.InfraLogger.Errorf("Failed to create cluster info object: %v", err)
@@ -183,6 +219,10 @@ func (sc *SchedulerCache) Snapshot() (*api.ClusterInfo, error) {
 		log.InfraLogger.Errorf("Error during snapshot: %v", err)
 		return nil, err
 	}
+	if sc.freshness != nil {
+		snapshot.LastCacheUpdate = sc.freshness.lastUpdate(time.Now())
+	}
+	setPipelineAges(snapshot, sc.pipelineAges)
 
 	if cleanErr := sc.cleanStaleBindRequest(snapshot.BindRequests, snapshot.BindRequestsForDeletedNodes); cleanErr != nil {
 		log.InfraLogger.V(2).Warnf("Failed to clean stale bind requests: %v", cleanErr)
@@ -230,6 +270,14 @@ func (sc *SchedulerCache) Evict(evictedPod *v1.Pod, evictedPodGroup *podgroup_in
 		return fmt.Errorf("received an eviction attempt for a terminated task: <%v/%v>", pod.Namespace, pod.Name)
 	}
 
+	// A pod that was asked to checkpoint keeps running until it terminates or its checkpoint deadline passes, and is
+	// evicted by the checkpoint evictor then, so it is neither asked again nor evicted right away.
+	if status, found := sc.CheckpointEvictor.Status(pod.UID); found && status == evictor.CheckpointRequested {
+		log.InfraLogger.V(4).Infof("Pod %s/%s is checkpointing before eviction, not evicting it again",
+			pod.Namespace, pod.Name)
+		return nil
+	}
+
 	sc.evict(pod, podGroup, evictionMetadata, message)
 	return nil
 }
@@ -244,7 +292,12 @@ func (sc *SchedulerCache) evict(evictedPod *v1.Pod, evictedPodGroup *enginev2alp
 
 		log.InfraLogger.V(6).Infof("Evicting pod %v/%v, reason: %v, message: %v",
 			evictedPod.Namespace, evictedPod.Name, status.Preempted, message)
-		err := sc.Evictor.Evict(evictedPod, message)
+		var err error
+		if evictionMetadata.CheckpointTimeout > 0 {
+			err = sc.CheckpointEvictor.Evict(evictedPod, message, evictionMetadata.CheckpointTimeout)
+		} else {
+			err = sc.Evictor.Evict(evictedPod, message)
+		}
 		if err != nil {
 			log.InfraLogger.Errorf("Failed to evict pod: %v/%v, error: %v", evictedPod.Namespace, evictedPod.Name, err)
 		}
@@ -265,6 +318,10 @@ func (sc *SchedulerCache) WaitForWorkers(stopCh <-chan struct{}) {
 
 // Bind binds task to the target host.
 func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string) error {
+	if err := sc.checkBindNode(hostname); err != nil {
+		return err
+	}
+
 	startTime := time.Now()
 	defer metrics.UpdateTaskBindDuration(startTime)
 	sc.StatusUpdater.PreBind(taskInfo.Pod)
@@ -280,6 +337,10 @@ func (sc *SchedulerCache) Bind(taskInfo *pod_info.PodInfo, hostname string, bind
 	if len(labelsPatch) > 0 {
 		sc.StatusUpdater.PatchPodLabels(taskInfo.Pod, labelsPatch)
 	}
+	annotationsPatch := allocationAnnotationsChange(taskInfo.Pod.Annotations, bindRequestAnnotations)
+	if len(annotationsPatch) > 0 {
+		sc.StatusUpdater.PatchPodAnnotations(taskInfo.Pod, annotationsPatch)
+	}
 
 	return sc.StatusUpdater.Bound(taskInfo.Pod, hostname, nil, sc.getNodPoolName())
 }
@@ -336,6 +397,32 @@ func (sc *SchedulerCache) getNodPoolName() string {
 	return "default"
 }
 
+// allocationAnnotations are the bind request annotations that describe the GPUs and resources allocated to the pod,
+// and are kept on the pod once it is bound, so the pod is accounted by them in the following snapshots.
+var allocationAnnotations = []string{
+	commonconstants.GpuGroupsAnnotation,
+	commonconstants.GpuMemorySplit,
+	commonconstants.QuantizedGpuMemory,
+	commonconstants.RightsizedResources,
+}
+
+// allocationAnnotationsChange returns the patch that sets the pod's allocation annotations to the ones of the bind
+// request, removing them from pods that are bound again without them.
+func allocationAnnotationsChange(currentAnnotations, bindRequestAnnotations map[string]string) map[string]any {
+	annotations := map[string]any{}
+	for _, key := range allocationAnnotations {
+		current, hasCurrent := currentAnnotations[key]
+		value, hasValue := bindRequestAnnotations[key]
+		switch {
+		case hasValue && value != current:
+			annotations[key] = value
+		case !hasValue && hasCurrent:
+			annotations[key] = nil
+		}
+	}
+	return annotations
+}
+
 func (sc *SchedulerCache) nodePoolLabelsChange(currentLabels map[string]string) map[string]any {
 	labels := map[string]any{}
 	if sc.schedulingNodePoolParams.NodePoolLabelKey == "" {
@@ -366,6 +453,46 @@ func (sc *SchedulerCache) TaskPipelined(task *pod_info.PodInfo, message string)
 	sc.StatusUpdater.Pipelined(task.Pod, message)
 }
 
+// TaskGpuGroupsLost records on the task's pod that GPU groups it runs on were lost.
+func (sc *SchedulerCache) TaskGpuGroupsLost(task *pod_info.PodInfo, message string) {
+	sc.StatusUpdater.GpuGroupsLost(task.Pod, message)
+}
+
+// TaskForceAllocated records on the task's pod that it was manually placed, as an audit record of the override.
+func (sc *SchedulerCache) TaskForceAllocated(task *pod_info.PodInfo, message string) {
+	sc.StatusUpdater.ForceAllocated(task.Pod, message)
+}
+
+// PatchTaskAnnotations merges the annotations into the task's pod. A nil value removes the annotation.
+func (sc *SchedulerCache) PatchTaskAnnotations(task *pod_info.PodInfo, annotations map[string]any) {
+	sc.StatusUpdater.PatchPodAnnotations(task.Pod, annotations)
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=patch
+
+// PatchNodeAnnotations merges the annotations into the node. A nil value removes the annotation.
+func (sc *SchedulerCache) PatchNodeAnnotations(node *v1.Node, annotations map[string]any) {
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to create patch for node annotations <%s>: %v", node.Name, err)
+		return
+	}
+
+	sc.workersWaitGroup.Add(1)
+	go func() {
+		defer sc.workersWaitGroup.Done()
+		_, err := sc.kubeClient.CoreV1().Nodes().Patch(
+			context.Background(), node.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to patch node annotations <%s>: %v", node.Name, err)
+		}
+	}()
+}
+
 // +kubebuilder:rbac:groups="scheduling.run.ai",resources=bindrequests,verbs=delete
 
 // Clean Stale BindRequest
@@ -431,6 +558,37 @@ func (sc *SchedulerCache) InternalK8sPlugins() *k8splugins.K8sPlugins {
 	return sc.internalPlugins
 }
 
+// DecayedQueueUsage returns the queue usage accumulated with decay across scheduling sessions.
+func (sc *SchedulerCache) DecayedQueueUsage() *queue_info.DecayedUsage {
+	return sc.decayedQueueUsage
+}
+
+// QueueLoans returns the loans between queues tracked across scheduling sessions.
+func (sc *SchedulerCache) QueueLoans() *queue_info.QueueLoans {
+	return sc.queueLoans
+}
+
+// PipelineAges returns the pipelines of pods tracked across scheduling sessions.
+func (sc *SchedulerCache) PipelineAges() *pod_info.PipelineAges {
+	return sc.pipelineAges
+}
+
+// setPipelineAges sets the pipeline age of the pending pods of the snapshot that were pipelined at the end of the last
+// session.
+func setPipelineAges(snapshot *api.ClusterInfo, pipelineAges *pod_info.PipelineAges) {
+	for _, job := range snapshot.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Pending {
+				continue
+			}
+			if age, found := pipelineAges.Get(task.UID); found {
+				task.PipelineAge = age.Cycles
+				task.PipelinedNodeName = age.NodeName
+			}
+		}
+	}
+}
+
 // GetDataLister returns the DataLister from the cluster info
 func (sc *SchedulerCache) GetDataLister() data_lister.DataLister {
 	selector, err := sc.schedulingNodePoolParams.GetLabelSelector()
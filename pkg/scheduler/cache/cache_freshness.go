@@ -0,0 +1,83 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// freshnessResyncsPerThreshold is the number of informer resyncs within the staleness threshold, so that a single
+// late resync does not make the cache stale.
+const freshnessResyncsPerThreshold = 2
+
+// freshnessSource is the part of a shared informer that the freshness of the cache is tracked by.
+type freshnessSource interface {
+	AddEventHandlerWithResyncPeriod(
+		handler cache.ResourceEventHandler, resyncPeriod time.Duration) (cache.ResourceEventHandlerRegistration, error)
+	GetStore() cache.Store
+}
+
+// cacheFreshness tracks when the watched informers last delivered an event, either a watched change or a periodic
+// resync. The reflector of an informer only resyncs while its list and watch succeed, so the events of a broken watch
+// stop even on an idle cluster. A watch that is connected but lags behind the API server keeps resyncing, and is not
+// detected.
+//
+// The resync period of the pod, node and podgroup informers is shortened to a fraction of the staleness threshold, so
+// every handler registered on these informers, not only the freshness handler, receives a periodic Update event for
+// each of their objects.
+type cacheFreshness struct {
+	mutex       sync.Mutex
+	sources     map[string]freshnessSource
+	lastResyncs map[string]time.Time
+}
+
+func newCacheFreshness(sources map[string]freshnessSource, resyncPeriod time.Duration) *cacheFreshness {
+	f := &cacheFreshness{
+		sources:     sources,
+		lastResyncs: map[string]time.Time{},
+	}
+	for name, source := range sources {
+		if _, err := source.AddEventHandlerWithResyncPeriod(f.eventHandler(name), resyncPeriod); err != nil {
+			log.InfraLogger.Errorf("Failed to track the freshness of the %s informer: %v", name, err)
+		}
+	}
+	return f
+}
+
+func (f *cacheFreshness) eventHandler(name string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { f.observe(name, time.Now()) },
+		UpdateFunc: func(any, any) { f.observe(name, time.Now()) },
+		DeleteFunc: func(any) { f.observe(name, time.Now()) },
+	}
+}
+
+func (f *cacheFreshness) observe(name string, now time.Time) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.lastResyncs[name] = now
+}
+
+// lastUpdate returns the oldest of the times at which the informers last delivered an event. Informers without
+// objects have nothing to resync, so they are considered updated, as are informers the first time they are observed.
+func (f *cacheFreshness) lastUpdate(now time.Time) time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	var oldest time.Time
+	for name, source := range f.sources {
+		if _, found := f.lastResyncs[name]; !found || len(source.GetStore().ListKeys()) == 0 {
+			f.lastResyncs[name] = now
+		}
+		if oldest.IsZero() || f.lastResyncs[name].Before(oldest) {
+			oldest = f.lastResyncs[name]
+		}
+	}
+	return oldest
+}
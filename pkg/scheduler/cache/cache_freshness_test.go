@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakeFreshnessSource struct {
+	store        cache.Store
+	handler      cache.ResourceEventHandler
+	resyncPeriod time.Duration
+}
+
+func newFakeFreshnessSource(objects ...any) *fakeFreshnessSource {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, object := range objects {
+		_ = store.Add(object)
+	}
+	return &fakeFreshnessSource{store: store}
+}
+
+func (s *fakeFreshnessSource) AddEventHandlerWithResyncPeriod(
+	handler cache.ResourceEventHandler, resyncPeriod time.Duration) (cache.ResourceEventHandlerRegistration, error) {
+	s.handler = handler
+	s.resyncPeriod = resyncPeriod
+	return nil, nil
+}
+
+func (s *fakeFreshnessSource) GetStore() cache.Store {
+	return s.store
+}
+
+func TestCacheFreshness_LastUpdate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns1"}}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}}
+	pods := newFakeFreshnessSource(pod)
+	nodes := newFakeFreshnessSource(node)
+	podGroups := newFakeFreshnessSource()
+	freshness := newCacheFreshness(
+		map[string]freshnessSource{"pods": pods, "nodes": nodes, "podgroups": podGroups}, time.Minute)
+	assert.Equal(t, time.Minute, pods.resyncPeriod)
+
+	assert.Equal(t, start, freshness.lastUpdate(start), "informers are updated when first observed")
+
+	assert.Equal(t, start, freshness.lastUpdate(start.Add(time.Minute)), "no informer resynced")
+
+	freshness.observe("pods", start.Add(2*time.Minute))
+	assert.Equal(t, start, freshness.lastUpdate(start.Add(2*time.Minute)), "nodes did not resync")
+
+	freshness.observe("nodes", start.Add(3*time.Minute))
+	assert.Equal(t, start.Add(2*time.Minute), freshness.lastUpdate(start.Add(3*time.Minute)),
+		"pods last resynced before nodes, and podgroups have nothing to resync")
+
+	before := time.Now()
+	pods.handler.OnUpdate(pod, pod)
+	nodes.handler.OnUpdate(node, node)
+	assert.False(t, freshness.lastUpdate(time.Now()).Before(before),
+		"resync events of the informers update the cache")
+}
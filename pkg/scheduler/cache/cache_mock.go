@@ -16,6 +16,7 @@ import (
 	eviction_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	pod_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	podgroup_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	queue_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	data_lister "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/cluster_info/data_lister"
 	plugins "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal/plugins"
 	gomock "go.uber.org/mock/gomock"
@@ -63,6 +64,20 @@ func (mr *MockCacheMockRecorder) Bind(podInfo, hostname, bindRequestAnnotations
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockCache)(nil).Bind), podInfo, hostname, bindRequestAnnotations)
 }
 
+// DecayedQueueUsage mocks base method.
+func (m *MockCache) DecayedQueueUsage() *queue_info.DecayedUsage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecayedQueueUsage")
+	ret0, _ := ret[0].(*queue_info.DecayedUsage)
+	return ret0
+}
+
+// DecayedQueueUsage indicates an expected call of DecayedQueueUsage.
+func (mr *MockCacheMockRecorder) DecayedQueueUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecayedQueueUsage", reflect.TypeOf((*MockCache)(nil).DecayedQueueUsage))
+}
+
 // Evict mocks base method.
 func (m *MockCache) Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo, evictionMetadata eviction_info.EvictionMetadata, message string) error {
 	m.ctrl.T.Helper()
@@ -133,6 +148,58 @@ func (mr *MockCacheMockRecorder) KubeInformerFactory() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeInformerFactory", reflect.TypeOf((*MockCache)(nil).KubeInformerFactory))
 }
 
+// PatchNodeAnnotations mocks base method.
+func (m *MockCache) PatchNodeAnnotations(node *v1.Node, annotations map[string]any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PatchNodeAnnotations", node, annotations)
+}
+
+// PatchNodeAnnotations indicates an expected call of PatchNodeAnnotations.
+func (mr *MockCacheMockRecorder) PatchNodeAnnotations(node, annotations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchNodeAnnotations", reflect.TypeOf((*MockCache)(nil).PatchNodeAnnotations), node, annotations)
+}
+
+// PatchTaskAnnotations mocks base method.
+func (m *MockCache) PatchTaskAnnotations(task *pod_info.PodInfo, annotations map[string]any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PatchTaskAnnotations", task, annotations)
+}
+
+// PatchTaskAnnotations indicates an expected call of PatchTaskAnnotations.
+func (mr *MockCacheMockRecorder) PatchTaskAnnotations(task, annotations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTaskAnnotations", reflect.TypeOf((*MockCache)(nil).PatchTaskAnnotations), task, annotations)
+}
+
+// QueueLoans mocks base method.
+func (m *MockCache) QueueLoans() *queue_info.QueueLoans {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueLoans")
+	ret0, _ := ret[0].(*queue_info.QueueLoans)
+	return ret0
+}
+
+// QueueLoans indicates an expected call of QueueLoans.
+func (mr *MockCacheMockRecorder) QueueLoans() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueLoans", reflect.TypeOf((*MockCache)(nil).QueueLoans))
+}
+
+// PipelineAges mocks base method.
+func (m *MockCache) PipelineAges() *pod_info.PipelineAges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineAges")
+	ret0, _ := ret[0].(*pod_info.PipelineAges)
+	return ret0
+}
+
+// PipelineAges indicates an expected call of PipelineAges.
+func (mr *MockCacheMockRecorder) PipelineAges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineAges", reflect.TypeOf((*MockCache)(nil).PipelineAges))
+}
+
 // RecordJobStatusEvent mocks base method.
 func (m *MockCache) RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error {
 	m.ctrl.T.Helper()
@@ -188,6 +255,30 @@ func (mr *MockCacheMockRecorder) SnapshotSharedLister() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotSharedLister", reflect.TypeOf((*MockCache)(nil).SnapshotSharedLister))
 }
 
+// TaskForceAllocated mocks base method.
+func (m *MockCache) TaskForceAllocated(task *pod_info.PodInfo, message string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TaskForceAllocated", task, message)
+}
+
+// TaskForceAllocated indicates an expected call of TaskForceAllocated.
+func (mr *MockCacheMockRecorder) TaskForceAllocated(task, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskForceAllocated", reflect.TypeOf((*MockCache)(nil).TaskForceAllocated), task, message)
+}
+
+// TaskGpuGroupsLost mocks base method.
+func (m *MockCache) TaskGpuGroupsLost(task *pod_info.PodInfo, message string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TaskGpuGroupsLost", task, message)
+}
+
+// TaskGpuGroupsLost indicates an expected call of TaskGpuGroupsLost.
+func (mr *MockCacheMockRecorder) TaskGpuGroupsLost(task, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskGpuGroupsLost", reflect.TypeOf((*MockCache)(nil).TaskGpuGroupsLost), task, message)
+}
+
 // TaskPipelined mocks base method.
 func (m *MockCache) TaskPipelined(task *pod_info.PodInfo, message string) {
 	m.ctrl.T.Helper()
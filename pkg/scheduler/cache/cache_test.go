@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -38,7 +39,11 @@ import (
 	kubeaischedulerfake "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
 	fakeschedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2/fake"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	kueuefake "sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
 )
@@ -100,6 +105,147 @@ var _ = Describe("Cache", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Context("bind of a pod with GPU groups", func() {
+			It("should annotate the pod with its GPU groups", func() {
+				pod := &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod-1",
+						Namespace: "namespace-1",
+						UID:       types.UID("pod-uid"),
+						Annotations: map[string]string{
+							commonconstants.GpuGroupsAnnotation: "old-group",
+						},
+					},
+					Status: v1.PodStatus{
+						Phase: v1.PodPending,
+					},
+				}
+				objects := []runtime.Object{
+					&v1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "node-1",
+						},
+					},
+					pod,
+				}
+				cache, stopCh := setupCacheWithObjects(true, objects)
+				defer close(stopCh)
+
+				taskInfo := pod_info.NewTaskInfo(pod)
+				taskInfo.GPUGroups = []string{"group-a"}
+				err := cache.Bind(taskInfo, "node-1", map[string]string{
+					commonconstants.GpuGroupsAnnotation: "group-a",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				kubeClient := cache.(*SchedulerCache).kubeClient
+				Eventually(func() string {
+					boundPod, err := kubeClient.CoreV1().Pods("namespace-1").Get(
+						context.TODO(), "pod-1", metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return boundPod.Annotations[commonconstants.GpuGroupsAnnotation]
+				}).Should(Equal("group-a"))
+
+				kubeAiSchedulerClient := cache.(*SchedulerCache).kubeAiSchedulerClient
+				bindRequest, err := kubeAiSchedulerClient.SchedulingV1alpha2().BindRequests("namespace-1").Get(
+					context.TODO(), "pod-1", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bindRequest.Annotations).To(HaveKeyWithValue(commonconstants.GpuGroupsAnnotation, "group-a"))
+			})
+		})
+	})
+
+	Describe("Bind to a changed node", func() {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod-1",
+				Namespace: "namespace-1",
+				UID:       types.UID("pod-uid"),
+			},
+			Status: v1.PodStatus{
+				Phase: v1.PodPending,
+			},
+		}
+
+		DescribeTable("should return a node conflict error without creating a bind request",
+			func(nodes []runtime.Object) {
+				cache, stopCh := setupCacheWithObjects(true, append(nodes, pod.DeepCopy()))
+				defer close(stopCh)
+
+				err := cache.Bind(pod_info.NewTaskInfo(pod.DeepCopy()), "node-1", map[string]string{})
+				Expect(err).To(MatchError(ErrBindNodeConflict))
+
+				kubeAiSchedulerClient := cache.(*SchedulerCache).kubeAiSchedulerClient
+				bindRequests, err := kubeAiSchedulerClient.SchedulingV1alpha2().BindRequests("namespace-1").List(
+					context.TODO(), metav1.ListOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bindRequests.Items).To(BeEmpty())
+			},
+			Entry("deleted node", []runtime.Object{}),
+			Entry("cordoned node", []runtime.Object{&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Spec:       v1.NodeSpec{Unschedulable: true},
+			}}),
+			Entry("not ready node", []runtime.Object{&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionFalse},
+				}},
+			}}),
+		)
+
+		It("should bind to a ready node", func() {
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				}},
+			}
+			cache, stopCh := setupCacheWithObjects(true, []runtime.Object{node, pod.DeepCopy()})
+			defer close(stopCh)
+
+			err := cache.Bind(pod_info.NewTaskInfo(pod.DeepCopy()), "node-1", map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Evict a checkpointing pod", func() {
+		It("should not evict the pod again while it checkpoints", func() {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod-1",
+					Namespace: "namespace-1",
+					UID:       types.UID("pod-uid"),
+				},
+				Status: v1.PodStatus{
+					Phase: v1.PodRunning,
+				},
+			}
+			podGroup := &enginev2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pg-1",
+					Namespace: "namespace-1",
+				},
+			}
+			cache, stopCh := setupCacheWithObjects(true, []runtime.Object{pod}, podGroup)
+			defer close(stopCh)
+			podGroupInfo := &podgroup_info.PodGroupInfo{Name: "pg-1", Namespace: "namespace-1"}
+
+			err := cache.Evict(pod, podGroupInfo,
+				eviction_info.EvictionMetadata{CheckpointTimeout: time.Minute}, "")
+			Expect(err).NotTo(HaveOccurred())
+			cache.WaitForWorkers(stopCh)
+
+			err = cache.Evict(pod, podGroupInfo, eviction_info.EvictionMetadata{}, "")
+			Expect(err).NotTo(HaveOccurred())
+			cache.WaitForWorkers(stopCh)
+
+			currentPod, err := cache.(*SchedulerCache).kubeClient.CoreV1().Pods("namespace-1").Get(
+				context.Background(), "pod-1", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(currentPod.Annotations).To(HaveKey(commonconstants.CheckpointRequested))
+		})
 	})
 
 	Describe("Stale BindRequests Cleanup", func() {
@@ -246,11 +392,12 @@ func setupCacheWithObjects(snapshot bool, objects []runtime.Object, kaiScheduler
 	kueueClient := kueuefake.NewSimpleClientset()
 
 	cache := New(&SchedulerCacheParams{
-		KubeClient:            kubeClient,
-		KAISchedulerClient:    kubeAiSchedulerClient,
-		KueueClient:           kueueClient,
-		NodePoolParams:        &conf.SchedulingNodePoolParams{},
-		FullHierarchyFairness: true,
+		KubeClient:                  kubeClient,
+		KAISchedulerClient:          kubeAiSchedulerClient,
+		KueueClient:                 kueueClient,
+		NodePoolParams:              &conf.SchedulingNodePoolParams{},
+		FullHierarchyFairness:       true,
+		NumOfStatusRecordingWorkers: 1,
 	})
 
 	stopCh := make(chan struct{})
@@ -264,3 +411,83 @@ func setupCacheWithObjects(snapshot bool, objects []runtime.Object, kaiScheduler
 
 	return cache, stopCh
 }
+
+func TestAllocationAnnotationsChange(t *testing.T) {
+	tests := []struct {
+		name                   string
+		currentAnnotations     map[string]string
+		bindRequestAnnotations map[string]string
+		expectedPatch          map[string]any
+	}{
+		{
+			name:                   "pod without GPU groups",
+			bindRequestAnnotations: map[string]string{},
+			expectedPatch:          map[string]any{},
+		},
+		{
+			name:                   "first bind with GPU groups",
+			bindRequestAnnotations: map[string]string{commonconstants.GpuGroupsAnnotation: "group-a"},
+			expectedPatch:          map[string]any{commonconstants.GpuGroupsAnnotation: "group-a"},
+		},
+		{
+			name:                   "rebind to the same GPU groups",
+			currentAnnotations:     map[string]string{commonconstants.GpuGroupsAnnotation: "group-a"},
+			bindRequestAnnotations: map[string]string{commonconstants.GpuGroupsAnnotation: "group-a"},
+			expectedPatch:          map[string]any{},
+		},
+		{
+			name:                   "rebind to other GPU groups",
+			currentAnnotations:     map[string]string{commonconstants.GpuGroupsAnnotation: "group-a"},
+			bindRequestAnnotations: map[string]string{commonconstants.GpuGroupsAnnotation: "group-b"},
+			expectedPatch:          map[string]any{commonconstants.GpuGroupsAnnotation: "group-b"},
+		},
+		{
+			name:                   "rebind without GPU groups removes the annotation",
+			currentAnnotations:     map[string]string{commonconstants.GpuGroupsAnnotation: "group-a"},
+			bindRequestAnnotations: map[string]string{},
+			expectedPatch:          map[string]any{commonconstants.GpuGroupsAnnotation: nil},
+		},
+		{
+			name: "first bind with a GPU memory split",
+			bindRequestAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a,group-b",
+				commonconstants.GpuMemorySplit:      "group-a:600,group-b:400",
+			},
+			expectedPatch: map[string]any{
+				commonconstants.GpuGroupsAnnotation: "group-a,group-b",
+				commonconstants.GpuMemorySplit:      "group-a:600,group-b:400",
+			},
+		},
+		{
+			name: "rebind without a GPU memory split removes it",
+			currentAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a,group-b",
+				commonconstants.GpuMemorySplit:      "group-a:600,group-b:400",
+			},
+			bindRequestAnnotations: map[string]string{commonconstants.GpuGroupsAnnotation: "group-c"},
+			expectedPatch: map[string]any{
+				commonconstants.GpuGroupsAnnotation: "group-c",
+				commonconstants.GpuMemorySplit:      nil,
+			},
+		},
+		{
+			name:                   "bind with rightsized resources",
+			bindRequestAnnotations: map[string]string{commonconstants.RightsizedResources: `{"cpu":"500m"}`},
+			expectedPatch:          map[string]any{commonconstants.RightsizedResources: `{"cpu":"500m"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := allocationAnnotationsChange(tt.currentAnnotations, tt.bindRequestAnnotations)
+			if len(patch) != len(tt.expectedPatch) {
+				t.Fatalf("expected patch %v, got %v", tt.expectedPatch, patch)
+			}
+			for key, value := range tt.expectedPatch {
+				if patch[key] != value {
+					t.Errorf("expected %s to be %v, got %v", key, value, patch[key])
+				}
+			}
+		})
+	}
+}
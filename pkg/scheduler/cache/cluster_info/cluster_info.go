@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
@@ -44,6 +45,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/utils"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
@@ -60,6 +62,7 @@ type ClusterInfo struct {
 	nodePoolSelector         labels.Selector
 	fairnessLevelType        FairnessLevelType
 	collectUsageData         bool
+	schedulerName            string
 }
 
 type FairnessLevelType string
@@ -80,6 +83,7 @@ func New(
 	includeCSIStorageObjects bool,
 	fullHierarchyFairness bool,
 	podGroupSync status_updater.PodGroupsSync,
+	schedulerName string,
 ) (*ClusterInfo, error) {
 	indexers := cache.Indexers{
 		podByPodGroupIndexerName: podByPodGroupIndexer,
@@ -108,6 +112,7 @@ func New(
 		fairnessLevelType:        fairnessLevelType,
 		podGroupSync:             podGroupSync,
 		collectUsageData:         usageLister != nil,
+		schedulerName:            schedulerName,
 	}, nil
 }
 
@@ -174,6 +179,11 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		return nil, err
 	}
 
+	snapshot.PodDisruptionBudgets, err = c.snapshotPodDisruptionBudgets()
+	if err != nil {
+		return nil, err
+	}
+
 	if c.includeCSIStorageObjects {
 		log.InfraLogger.V(7).Infof("Advanced CSI scheduling enabled - snapshotting CSI storage objects")
 
@@ -232,6 +242,11 @@ func (c *ClusterInfo) snapshotNodes(
 	for _, node := range nodes {
 		podAffinityInfo := NewK8sNodePodAffinityInfo(node, clusterPodAffinityInfo)
 		resultNodes[node.Name] = node_info.NewNodeInfo(node, podAffinityInfo)
+		if c.nodePoolParams != nil {
+			resultNodes[node.Name].GpuMemoryQuantum = c.nodePoolParams.GpuMemoryQuantum
+			resultNodes[node.Name].MaxGpuSharingTenants = c.nodePoolParams.MaxGpuSharingTenants
+			resultNodes[node.Name].SetDefaultReservedGpuMemory(c.nodePoolParams.ReservedGpuMemory)
+		}
 	}
 
 	return resultNodes, nil
@@ -314,6 +329,7 @@ func (c *ClusterInfo) snapshotPodGroups(
 	podGroups = c.filterUnassignedPodGroups(podGroups)
 
 	result := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	skippedPods := 0
 	for _, podGroup := range podGroups {
 		podGroupID := common_info.PodGroupID(podGroup.Name)
 		podGroupInfo := podgroup_info.NewPodGroupInfo(podGroupID)
@@ -339,11 +355,19 @@ func (c *ClusterInfo) snapshotPodGroups(
 			if !ok {
 				log.InfraLogger.Errorf("Snapshot podGroups: Error getting pod from rawPod: %c", rawPod)
 			}
+			if !c.isPodForScheduler(pod) {
+				log.InfraLogger.V(4).Infof("Skipping pod <%s/%s> of podgroup <%s/%s> - scheduler name <%s> "+
+					"does not match <%s>", pod.Namespace, pod.Name, podGroup.Namespace, podGroup.Name,
+					pod.Spec.SchedulerName, c.schedulerName)
+				skippedPods++
+				continue
+			}
 			podInfo := c.getPodInfo(pod, existingPods)
 			podGroupInfo.AddTaskInfo(podInfo)
 		}
 		result[common_info.PodGroupID(podGroup.Name)] = podGroupInfo
 	}
+	metrics.AddPodsSkippedBySchedulerName(skippedPods)
 
 	return result, nil
 }
@@ -413,6 +437,14 @@ func (c *ClusterInfo) snapshotTopologies() ([]*kueue.Topology, error) {
 	return topologies, nil
 }
 
+func (c *ClusterInfo) snapshotPodDisruptionBudgets() ([]*policyv1.PodDisruptionBudget, error) {
+	pdbs, err := c.dataLister.ListPodDisruptionBudgets()
+	if err != nil {
+		return nil, fmt.Errorf("error listing poddisruptionbudgets: %w", err)
+	}
+	return pdbs, nil
+}
+
 func getDefaultPriority(dataLister data_lister.DataLister) (int32, error) {
 	defaultPriority, found := int32(50), false
 	priorityClasses, err := dataLister.ListPriorityClasses()
@@ -480,6 +512,12 @@ func (c *ClusterInfo) filterUnassignedPodGroups(podGroups []*enginev2alpha2.PodG
 	return assignedPodGroups
 }
 
+// isPodForScheduler returns false for pods assigned to another scheduler. Such pods still take up node resources,
+// but are not scheduled by this scheduler. An empty scheduler name matches all pods.
+func (c *ClusterInfo) isPodForScheduler(pod *v1.Pod) bool {
+	return c.schedulerName == "" || pod.Spec.SchedulerName == c.schedulerName
+}
+
 func (c *ClusterInfo) isPodGroupUpForScheduler(podGroup *enginev2alpha2.PodGroup) bool {
 	if utils.GetSchedulingBackoffValue(podGroup.Spec.SchedulingBackoff) == utils.NoSchedulingBackoff {
 		return true
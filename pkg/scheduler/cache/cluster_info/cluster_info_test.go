@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	v12 "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -949,10 +950,11 @@ func TestBindRequests(t *testing.T) {
 
 func TestSnapshotPodGroups(t *testing.T) {
 	tests := map[string]struct {
-		objs      []runtime.Object
-		kubeObjs  []runtime.Object
-		kueueObjs []runtime.Object
-		results   []*podgroup_info.PodGroupInfo
+		objs          []runtime.Object
+		kubeObjs      []runtime.Object
+		kueueObjs     []runtime.Object
+		schedulerName string
+		results       []*podgroup_info.PodGroupInfo
 	}{
 		"BasicUsage": {
 			objs: []runtime.Object{
@@ -990,6 +992,60 @@ func TestSnapshotPodGroups(t *testing.T) {
 				},
 			},
 		},
+		"SkipPodsOfOtherSchedulers": {
+			objs: []runtime.Object{
+				&enginev2alpha2.PodGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "podGroup-0",
+						UID:  "ABC",
+					},
+					Spec: enginev2alpha2.PodGroupSpec{
+						Queue: "queue-0",
+					},
+				},
+			},
+			kubeObjs: []runtime.Object{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "kai-pod",
+						UID:  "kai-pod",
+						Annotations: map[string]string{
+							commonconstants.PodGroupAnnotationForPod: "podGroup-0",
+						},
+					},
+					Spec: corev1.PodSpec{
+						SchedulerName: "kai-scheduler",
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "default-pod",
+						UID:  "default-pod",
+						Annotations: map[string]string{
+							commonconstants.PodGroupAnnotationForPod: "podGroup-0",
+						},
+					},
+					Spec: corev1.PodSpec{
+						SchedulerName: "default-scheduler",
+					},
+				},
+			},
+			schedulerName: "kai-scheduler",
+			results: []*podgroup_info.PodGroupInfo{
+				{
+					Name:  "podGroup-0",
+					Queue: "queue-0",
+					PodSets: map[string]*subgroup_info.PodSet{
+						podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 1, nil).
+							WithPodInfos(pod_info.PodsMap{
+								"kai-pod": {
+									UID: "kai-pod",
+								},
+							}),
+					},
+				},
+			},
+		},
 		"NotExistingQueue": {
 			objs: []runtime.Object{
 				&enginev2alpha2.PodGroup{
@@ -1213,6 +1269,7 @@ func TestSnapshotPodGroups(t *testing.T) {
 				kueueObjects:        test.kueueObjs,
 			},
 		)
+		clusterInfo.schedulerName = test.schedulerName
 		predefinedQueue := &queue_info.QueueInfo{Name: "queue-0"}
 		existingPods := map[common_info.PodID]*pod_info.PodInfo{}
 		podGroups, err := clusterInfo.snapshotPodGroups(
@@ -2122,7 +2179,7 @@ func TestNewClusterInfoErrorPartitionSelector(t *testing.T) {
 		NodePoolLabelKey:   "@!A",
 		NodePoolLabelValue: "!@#",
 	}
-	_, err := New(informerFactory, kubeAiSchedulerInformerFactory, kueueInformerFactory, nil, params, false, clusterPodAffinityInfo, false, true, nil)
+	_, err := New(informerFactory, kubeAiSchedulerInformerFactory, kueueInformerFactory, nil, params, false, clusterPodAffinityInfo, false, true, nil, "")
 
 	assert.NotNil(t, err)
 }
@@ -2154,7 +2211,7 @@ func TestNewClusterInfoAddIndexerFails(t *testing.T) {
 	clusterPodAffinityInfo.EXPECT().AddNode(gomock.Any(), gomock.Any()).AnyTimes()
 
 	_, err = New(informerFactory, kubeAiSchedulerInformerFactory, kueueInformerFactory, nil, nil, false,
-		clusterPodAffinityInfo, false, true, nil)
+		clusterPodAffinityInfo, false, true, nil, "")
 	assert.NotNil(t, err, "Expected error for conflicting indexers")
 }
 
@@ -2195,7 +2252,7 @@ func newClusterInfoTestsInner(t *testing.T, kubeObjects, kaiSchedulerObjects, ku
 	usageLister := usagedb.NewUsageLister(&fakeUsageClient, ptr.To(10*time.Microsecond), ptr.To(10*time.Second), ptr.To(10*time.Second))
 
 	clusterInfo, _ := New(informerFactory, kubeAiSchedulerInformerFactory, kueueInformerFactory, usageLister, nodePoolParams, false,
-		clusterPodAffinityInfo, true, fullHierarchyFairness, nil)
+		clusterPodAffinityInfo, true, fullHierarchyFairness, nil, "")
 
 	stopCh := context.Background().Done()
 	informerFactory.Start(stopCh)
@@ -2214,6 +2271,20 @@ func newFakeClients(kubernetesObjects, kaiSchedulerObjects, kueueObjects []runti
 	return fake.NewSimpleClientset(kubernetesObjects...), kubeAiSchedulerClientFake.NewSimpleClientset(kaiSchedulerObjects...), kueuefake.NewSimpleClientset(kueueObjects...)
 }
 
+func TestSnapshotPodDisruptionBudgets(t *testing.T) {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: "ns"},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{kubeObjects: []runtime.Object{pdb}})
+
+	snapshot, err := clusterInfo.Snapshot()
+	assert.NoError(t, err)
+	assert.Len(t, snapshot.PodDisruptionBudgets, 1)
+	assert.Equal(t, "serving", snapshot.PodDisruptionBudgets[0].Name)
+	assert.Equal(t, int32(1), snapshot.PodDisruptionBudgets[0].Status.DisruptionsAllowed)
+}
+
 func TestSnapshotPodsInPartition(t *testing.T) {
 	clusterObjects := []runtime.Object{
 		&corev1.Node{
@@ -18,8 +18,9 @@ import (
 	queue_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
-	v10 "k8s.io/api/scheduling/v1"
-	v11 "k8s.io/api/storage/v1"
+	v10 "k8s.io/api/policy/v1"
+	v11 "k8s.io/api/scheduling/v1"
+	v12 "k8s.io/api/storage/v1"
 	v1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 )
 
@@ -48,10 +49,10 @@ func (m *MockDataLister) EXPECT() *MockDataListerMockRecorder {
 }
 
 // GetPriorityClassByName mocks base method.
-func (m *MockDataLister) GetPriorityClassByName(name string) (*v10.PriorityClass, error) {
+func (m *MockDataLister) GetPriorityClassByName(name string) (*v11.PriorityClass, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPriorityClassByName", name)
-	ret0, _ := ret[0].(*v10.PriorityClass)
+	ret0, _ := ret[0].(*v11.PriorityClass)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -78,10 +79,10 @@ func (mr *MockDataListerMockRecorder) ListBindRequests() *gomock.Call {
 }
 
 // ListCSIDrivers mocks base method.
-func (m *MockDataLister) ListCSIDrivers() ([]*v11.CSIDriver, error) {
+func (m *MockDataLister) ListCSIDrivers() ([]*v12.CSIDriver, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListCSIDrivers")
-	ret0, _ := ret[0].([]*v11.CSIDriver)
+	ret0, _ := ret[0].([]*v12.CSIDriver)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -93,10 +94,10 @@ func (mr *MockDataListerMockRecorder) ListCSIDrivers() *gomock.Call {
 }
 
 // ListCSIStorageCapacities mocks base method.
-func (m *MockDataLister) ListCSIStorageCapacities() ([]*v11.CSIStorageCapacity, error) {
+func (m *MockDataLister) ListCSIStorageCapacities() ([]*v12.CSIStorageCapacity, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListCSIStorageCapacities")
-	ret0, _ := ret[0].([]*v11.CSIStorageCapacity)
+	ret0, _ := ret[0].([]*v12.CSIStorageCapacity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -167,6 +168,21 @@ func (mr *MockDataListerMockRecorder) ListPodByIndex(index, value any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodByIndex", reflect.TypeOf((*MockDataLister)(nil).ListPodByIndex), index, value)
 }
 
+// ListPodDisruptionBudgets mocks base method.
+func (m *MockDataLister) ListPodDisruptionBudgets() ([]*v10.PodDisruptionBudget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodDisruptionBudgets")
+	ret0, _ := ret[0].([]*v10.PodDisruptionBudget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodDisruptionBudgets indicates an expected call of ListPodDisruptionBudgets.
+func (mr *MockDataListerMockRecorder) ListPodDisruptionBudgets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodDisruptionBudgets", reflect.TypeOf((*MockDataLister)(nil).ListPodDisruptionBudgets))
+}
+
 // ListPodGroups mocks base method.
 func (m *MockDataLister) ListPodGroups() ([]*v2alpha2.PodGroup, error) {
 	m.ctrl.T.Helper()
@@ -198,10 +214,10 @@ func (mr *MockDataListerMockRecorder) ListPods() *gomock.Call {
 }
 
 // ListPriorityClasses mocks base method.
-func (m *MockDataLister) ListPriorityClasses() ([]*v10.PriorityClass, error) {
+func (m *MockDataLister) ListPriorityClasses() ([]*v11.PriorityClass, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListPriorityClasses")
-	ret0, _ := ret[0].([]*v10.PriorityClass)
+	ret0, _ := ret[0].([]*v11.PriorityClass)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -243,10 +259,10 @@ func (mr *MockDataListerMockRecorder) ListResourceUsage() *gomock.Call {
 }
 
 // ListStorageClasses mocks base method.
-func (m *MockDataLister) ListStorageClasses() ([]*v11.StorageClass, error) {
+func (m *MockDataLister) ListStorageClasses() ([]*v12.StorageClass, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStorageClasses")
-	ret0, _ := ret[0].([]*v11.StorageClass)
+	ret0, _ := ret[0].([]*v12.StorageClass)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -5,6 +5,7 @@ package data_lister
 
 import (
 	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
 	scheduling "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
 	"sigs.k8s.io/kueue/apis/kueue/v1alpha1"
@@ -30,5 +31,6 @@ type DataLister interface {
 	ListBindRequests() ([]*schedulingv1alpha2.BindRequest, error)
 	ListConfigMaps() ([]*v1.ConfigMap, error)
 	ListTopologies() ([]*v1alpha1.Topology, error)
+	ListPodDisruptionBudgets() ([]*policy.PodDisruptionBudget, error)
 	ListResourceUsage() (*queue_info.ClusterUsage, error)
 }
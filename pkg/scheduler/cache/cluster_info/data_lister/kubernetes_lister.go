@@ -7,11 +7,13 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
 	v14 "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/informers"
 	listv1 "k8s.io/client-go/listers/core/v1"
+	policylistv1 "k8s.io/client-go/listers/policy/v1"
 	schedv1 "k8s.io/client-go/listers/scheduling/v1"
 	v12 "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/tools/cache"
@@ -39,6 +41,7 @@ type k8sLister struct {
 	queueLister    schedlistv2.QueueLister
 	pcLister       schedv1.PriorityClassLister
 	cmLister       listv1.ConfigMapLister
+	pdbLister      policylistv1.PodDisruptionBudgetLister
 	usageLister    *usagedb.UsageLister
 
 	pvcLister             listv1.PersistentVolumeClaimLister
@@ -71,6 +74,7 @@ func New(
 		queueLister:    kubeAiSchedulerInformerFactory.Scheduling().V2().Queues().Lister(),
 		pcLister:       informerFactory.Scheduling().V1().PriorityClasses().Lister(),
 		cmLister:       informerFactory.Core().V1().ConfigMaps().Lister(),
+		pdbLister:      informerFactory.Policy().V1().PodDisruptionBudgets().Lister(),
 		usageLister:    usageLister,
 
 		pvcLister:             informerFactory.Core().V1().PersistentVolumeClaims().Lister(),
@@ -173,3 +177,9 @@ func (k *k8sLister) ListConfigMaps() ([]*v1.ConfigMap, error) {
 func (k *k8sLister) ListTopologies() ([]*kueue.Topology, error) {
 	return k.kueueTopologyLister.List(labels.Everything())
 }
+
+// +kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets,verbs=get;list;watch
+
+func (k *k8sLister) ListPodDisruptionBudgets() ([]*policy.PodDisruptionBudget, error) {
+	return k.pdbLister.List(labels.Everything())
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package evictor
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	defaultCheckpointPollInterval = time.Second
+	// finishedCheckpointRetention is how long the status of a finished checkpoint eviction is kept.
+	finishedCheckpointRetention = 10 * time.Minute
+)
+
+type CheckpointStatus string
+
+const (
+	// CheckpointRequested means the pod was asked to checkpoint and the eviction waits for it to terminate.
+	CheckpointRequested CheckpointStatus = "CheckpointRequested"
+	// CheckpointSelfTerminated means the pod terminated by itself before the timeout, so it was not deleted.
+	CheckpointSelfTerminated CheckpointStatus = "SelfTerminated"
+	// CheckpointTimedOut means the pod did not terminate before the timeout and was evicted.
+	CheckpointTimedOut CheckpointStatus = "TimedOut"
+	// CheckpointNotSignaled means the pod could not be asked to checkpoint and was evicted right away.
+	CheckpointNotSignaled CheckpointStatus = "NotSignaled"
+	// CheckpointFailed means the pod could not be evicted.
+	CheckpointFailed CheckpointStatus = "Failed"
+)
+
+type checkpointEviction struct {
+	status     CheckpointStatus
+	finishedAt time.Time
+}
+
+// CheckpointEvictor evicts pods that support checkpointing by first asking them to checkpoint and terminate, and
+// evicting them only if they are still running after a timeout. The wait happens in the background, so an eviction
+// never blocks the scheduling cycle.
+type CheckpointEvictor struct {
+	evictor      Interface
+	kubeClient   kubernetes.Interface
+	pollInterval time.Duration
+
+	mutex     sync.Mutex
+	evictions map[types.UID]*checkpointEviction
+	waitGroup sync.WaitGroup
+}
+
+func NewCheckpointEvictor(kubeClient kubernetes.Interface, evictor Interface) *CheckpointEvictor {
+	return &CheckpointEvictor{
+		evictor:      evictor,
+		kubeClient:   kubeClient,
+		pollInterval: defaultCheckpointPollInterval,
+		evictions:    map[types.UID]*checkpointEviction{},
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;patch
+
+// Evict signals the pod to checkpoint and returns, evicting the pod in the background if it is still running after
+// the timeout. A pod that is already checkpointing is not signaled again. If the pod cannot be signaled, it is
+// evicted right away.
+func (ce *CheckpointEvictor) Evict(pod *v1.Pod, message string, timeout time.Duration) error {
+	if !ce.startEviction(pod.UID) {
+		log.InfraLogger.V(4).Infof("Pod %s/%s is already checkpointing before eviction", pod.Namespace, pod.Name)
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	if err := ce.signal(pod, deadline); err != nil {
+		log.InfraLogger.Warningf("Failed to signal pod %s/%s to checkpoint, evicting it: %v",
+			pod.Namespace, pod.Name, err)
+		return ce.evict(pod, message, CheckpointNotSignaled)
+	}
+
+	log.InfraLogger.V(3).Infof("Requested pod %s/%s to checkpoint before eviction, deadline: %v",
+		pod.Namespace, pod.Name, deadline)
+	ce.waitGroup.Add(1)
+	go func() {
+		defer ce.waitGroup.Done()
+		ce.waitAndEvict(pod, message, timeout)
+	}()
+	return nil
+}
+
+// Status returns the status of the last checkpoint eviction of the pod.
+func (ce *CheckpointEvictor) Status(podUID types.UID) (CheckpointStatus, bool) {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	eviction, found := ce.evictions[podUID]
+	if !found {
+		return "", false
+	}
+	return eviction.status, true
+}
+
+func (ce *CheckpointEvictor) startEviction(podUID types.UID) bool {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	for uid, eviction := range ce.evictions {
+		if eviction.status != CheckpointRequested && time.Since(eviction.finishedAt) > finishedCheckpointRetention {
+			delete(ce.evictions, uid)
+		}
+	}
+
+	if eviction, found := ce.evictions[podUID]; found && eviction.status == CheckpointRequested {
+		return false
+	}
+	ce.evictions[podUID] = &checkpointEviction{status: CheckpointRequested}
+	return true
+}
+
+func (ce *CheckpointEvictor) finishEviction(podUID types.UID, status CheckpointStatus) {
+	ce.mutex.Lock()
+	defer ce.mutex.Unlock()
+
+	ce.evictions[podUID] = &checkpointEviction{status: status, finishedAt: time.Now()}
+}
+
+func (ce *CheckpointEvictor) signal(pod *v1.Pod, deadline time.Time) error {
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				commonconstants.CheckpointRequested: deadline.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = ce.kubeClient.CoreV1().Pods(pod.Namespace).Patch(
+		context.Background(), pod.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (ce *CheckpointEvictor) waitAndEvict(pod *v1.Pod, message string, timeout time.Duration) {
+	err := wait.PollUntilContextTimeout(context.Background(), ce.pollInterval, timeout, true,
+		func(ctx context.Context) (bool, error) {
+			return ce.isTerminated(ctx, pod), nil
+		})
+	if err == nil {
+		log.InfraLogger.V(3).Infof("Pod %s/%s terminated after checkpoint request, skipping eviction",
+			pod.Namespace, pod.Name)
+		ce.finishEviction(pod.UID, CheckpointSelfTerminated)
+		return
+	}
+
+	log.InfraLogger.V(3).Infof("Pod %s/%s did not terminate within %v of the checkpoint request, evicting it",
+		pod.Namespace, pod.Name, timeout)
+	if err = ce.evict(pod, message, CheckpointTimedOut); err != nil {
+		log.InfraLogger.Errorf("Failed to evict pod: %v/%v, error: %v", pod.Namespace, pod.Name, err)
+	}
+}
+
+func (ce *CheckpointEvictor) evict(pod *v1.Pod, message string, status CheckpointStatus) error {
+	if err := ce.evictor.Evict(pod, message); err != nil {
+		ce.finishEviction(pod.UID, CheckpointFailed)
+		return err
+	}
+	ce.finishEviction(pod.UID, status)
+	return nil
+}
+
+// isTerminated returns true if the pod is gone, replaced by another pod with the same name, being deleted, or done.
+func (ce *CheckpointEvictor) isTerminated(ctx context.Context, pod *v1.Pod) bool {
+	currentPod, err := ce.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.IsNotFound(err)
+	}
+	return currentPod.UID != pod.UID || currentPod.DeletionTimestamp != nil ||
+		currentPod.Status.Phase == v1.PodSucceeded || currentPod.Status.Phase == v1.PodFailed
+}
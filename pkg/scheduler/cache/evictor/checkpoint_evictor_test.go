@@ -0,0 +1,108 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package evictor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+type recordingEvictor struct {
+	mutex   sync.Mutex
+	evicted []string
+}
+
+func (re *recordingEvictor) Evict(pod *v1.Pod, _ string) error {
+	re.mutex.Lock()
+	defer re.mutex.Unlock()
+	re.evicted = append(re.evicted, pod.Name)
+	return nil
+}
+
+func TestCheckpointEvictor_Evict(t *testing.T) {
+	tests := []struct {
+		name            string
+		podExists       bool
+		selfTerminate   bool
+		evictTwice      bool
+		expectedStatus  CheckpointStatus
+		expectedEvicted []string
+	}{
+		{
+			name:            "pod that terminates after the checkpoint request is not evicted",
+			podExists:       true,
+			selfTerminate:   true,
+			expectedStatus:  CheckpointSelfTerminated,
+			expectedEvicted: nil,
+		},
+		{
+			name:            "pod still running after the timeout is evicted",
+			podExists:       true,
+			expectedStatus:  CheckpointTimedOut,
+			expectedEvicted: []string{"p1"},
+		},
+		{
+			name:            "checkpointing pod is not evicted again",
+			podExists:       true,
+			evictTwice:      true,
+			expectedStatus:  CheckpointTimedOut,
+			expectedEvicted: []string{"p1"},
+		},
+		{
+			name:            "pod that cannot be signaled is evicted right away",
+			podExists:       false,
+			expectedStatus:  CheckpointNotSignaled,
+			expectedEvicted: []string{"p1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns1", UID: "p1-uid"}}
+			var objects []runtime.Object
+			if tt.podExists {
+				objects = append(objects, pod.DeepCopy())
+			}
+			kubeClient := fake.NewSimpleClientset(objects...)
+			innerEvictor := &recordingEvictor{}
+			checkpointEvictor := NewCheckpointEvictor(kubeClient, innerEvictor)
+			checkpointEvictor.pollInterval = 5 * time.Millisecond
+
+			timeout := 100 * time.Millisecond
+			assert.NoError(t, checkpointEvictor.Evict(pod, "preempted", timeout))
+			if tt.evictTwice {
+				assert.NoError(t, checkpointEvictor.Evict(pod, "preempted", timeout))
+			}
+
+			if tt.podExists {
+				signaledPod, err := kubeClient.CoreV1().Pods("ns1").Get(context.Background(), "p1", metav1.GetOptions{})
+				assert.NoError(t, err)
+				assert.Contains(t, signaledPod.Annotations, commonconstants.CheckpointRequested)
+				status, found := checkpointEvictor.Status(pod.UID)
+				assert.True(t, found)
+				assert.Equal(t, CheckpointRequested, status)
+			}
+			if tt.selfTerminate {
+				assert.NoError(t, kubeClient.CoreV1().Pods("ns1").Delete(
+					context.Background(), "p1", metav1.DeleteOptions{}))
+			}
+
+			checkpointEvictor.waitGroup.Wait()
+			status, found := checkpointEvictor.Status(pod.UID)
+			assert.True(t, found)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedEvicted, innerEvictor.evicted)
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/cluster_info/data_lister"
 	k8splugins "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal/plugins"
 )
@@ -41,10 +42,17 @@ type Cache interface {
 	Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo, evictionMetadata eviction_info.EvictionMetadata, message string) error
 	RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error
 	TaskPipelined(task *pod_info.PodInfo, message string)
+	TaskGpuGroupsLost(task *pod_info.PodInfo, message string)
+	TaskForceAllocated(task *pod_info.PodInfo, message string)
+	PatchTaskAnnotations(task *pod_info.PodInfo, annotations map[string]any)
+	PatchNodeAnnotations(node *v1.Node, annotations map[string]any)
 	KubeClient() kubernetes.Interface
 	KubeInformerFactory() informers.SharedInformerFactory
 	SnapshotSharedLister() k8sframework.NodeInfoLister
 	InternalK8sPlugins() *k8splugins.K8sPlugins
 	WaitForWorkers(stopCh <-chan struct{})
 	GetDataLister() data_lister.DataLister
+	DecayedQueueUsage() *queue_info.DecayedUsage
+	QueueLoans() *queue_info.QueueLoans
+	PipelineAges() *pod_info.PipelineAges
 }
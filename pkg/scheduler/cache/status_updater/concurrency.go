@@ -34,6 +34,10 @@ func (su *defaultStatusUpdater) keyForPodLabelsPayload(name, namespace string, u
 	return updatePayloadKey(types.NamespacedName{Name: name, Namespace: namespace}.String() + "_" + string(uid) + "-Labels")
 }
 
+func (su *defaultStatusUpdater) keyForPodAnnotationsPayload(name, namespace string, uid types.UID) updatePayloadKey {
+	return updatePayloadKey(types.NamespacedName{Name: name, Namespace: namespace}.String() + "_" + string(uid) + "-Annotations")
+}
+
 func (su *defaultStatusUpdater) processPayload(ctx context.Context, payload *updatePayload) {
 	updateData, found := su.loadInflightUpdate(payload)
 	if !found {
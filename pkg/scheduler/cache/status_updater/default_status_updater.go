@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -158,6 +160,14 @@ func (su *defaultStatusUpdater) Pipelined(pod *v1.Pod, message string) {
 	su.recorder.Eventf(pod, v1.EventTypeNormal, "Pipelined", message)
 }
 
+func (su *defaultStatusUpdater) GpuGroupsLost(pod *v1.Pod, message string) {
+	su.recorder.Eventf(pod, v1.EventTypeWarning, "GpuGroupLost", message)
+}
+
+func (su *defaultStatusUpdater) ForceAllocated(pod *v1.Pod, message string) {
+	su.recorder.Eventf(pod, v1.EventTypeNormal, "ForceAllocated", message)
+}
+
 func (su *defaultStatusUpdater) PatchPodLabels(pod *v1.Pod, labels map[string]any) {
 	log.InfraLogger.V(6).Infof("Patching pod labels for %s/%s", pod.Namespace, pod.Name)
 
@@ -185,6 +195,58 @@ func (su *defaultStatusUpdater) PatchPodLabels(pod *v1.Pod, labels map[string]an
 	)
 }
 
+// PatchPodAnnotations asynchronously merges the annotations into the pod. A nil value removes the annotation.
+// Annotations of a patch that is still in flight for the pod are kept, so patches from the same cycle do not
+// override each other.
+func (su *defaultStatusUpdater) PatchPodAnnotations(pod *v1.Pod, annotations map[string]any) {
+	log.InfraLogger.V(6).Infof("Patching pod annotations for %s/%s", pod.Namespace, pod.Name)
+
+	key := su.keyForPodAnnotationsPayload(pod.Name, pod.Namespace, pod.UID)
+	mergedAnnotations := su.inFlightPodAnnotations(key)
+	maps.Copy(mergedAnnotations, annotations)
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": mergedAnnotations,
+		},
+	})
+
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to create patch for pod annotations <%s/%s>: %v",
+			pod.Namespace, pod.Name, err)
+		return
+	}
+
+	su.pushToUpdateQueue(
+		&updatePayload{
+			key:        key,
+			objectType: podType,
+		},
+		&inflightUpdate{
+			object:    pod,
+			patchData: patchBytes,
+		},
+	)
+}
+
+func (su *defaultStatusUpdater) inFlightPodAnnotations(key updatePayloadKey) map[string]any {
+	annotations := map[string]any{}
+	data, found := su.inFlightPods.Load(key)
+	if !found {
+		return annotations
+	}
+
+	var patch struct {
+		Metadata struct {
+			Annotations map[string]any `json:"annotations"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(data.(*inflightUpdate).patchData, &patch); err != nil {
+		return annotations
+	}
+	maps.Copy(annotations, patch.Metadata.Annotations)
+	return annotations
+}
+
 func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error {
 	var err error
 	var patchData []byte
@@ -196,7 +258,12 @@ func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroup
 	}
 
 	updatePodgroupStatus := false
-	if job.GetNumPendingTasks() > 0 || job.GetNumGatedTasks() > 0 {
+	if job.SchedulingTimedOut {
+		if err := su.recordUnschedulablePodsEvents(job); err != nil {
+			return err
+		}
+		updatePodgroupStatus = su.markPodGroupSchedulingTimedOut(job)
+	} else if job.GetNumPendingTasks() > 0 || job.GetNumGatedTasks() > 0 {
 		if !job.IsReadyForScheduling() {
 			su.recordJobNotReadyEvent(job)
 			return nil
@@ -224,17 +291,22 @@ func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroup
 	return nil
 }
 
-func (su *defaultStatusUpdater) markTaskUnschedulable(pod *v1.Pod, message string, updatePodCondition bool) error {
+func (su *defaultStatusUpdater) markTaskUnschedulable(pod *v1.Pod, message string, updatePodCondition bool,
+	pendingCauseCondition *v1.PodCondition) error {
 	log.InfraLogger.V(6).Infof("setting message for task: %v", pod.Name)
 	su.recorder.Eventf(pod, v1.EventTypeWarning, v1.PodReasonUnschedulable, message)
 
 	if updatePodCondition {
-		if err := su.updatePodCondition(pod, &v1.PodCondition{
+		conditions := []*v1.PodCondition{{
 			Type:    v1.PodScheduled,
 			Status:  v1.ConditionFalse,
 			Reason:  v1.PodReasonUnschedulable,
 			Message: message,
-		}); err != nil {
+		}}
+		if pendingCauseCondition != nil {
+			conditions = append(conditions, pendingCauseCondition)
+		}
+		if err := su.updatePodCondition(pod, conditions...); err != nil {
 			return err
 		}
 	}
@@ -242,6 +314,26 @@ func (su *defaultStatusUpdater) markTaskUnschedulable(pod *v1.Pod, message strin
 	return nil
 }
 
+// pendingCauseCondition returns the pending cause condition of an unschedulable pod of the job, telling whether the
+// pod is blocked by its queue's quota or waits for cluster capacity. When the cause is unknown, the condition of a pod
+// that had a cause before is cleared, and nil is returned for other pods.
+func pendingCauseCondition(pod *v1.Pod, job *podgroup_info.PodGroupInfo, message string) *v1.PodCondition {
+	if cause, found := job.PendingCause(); found {
+		return &v1.PodCondition{
+			Type:    podgroup_info.PendingCauseCondition,
+			Status:  v1.ConditionTrue,
+			Reason:  string(cause),
+			Message: message,
+		}
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == podgroup_info.PendingCauseCondition && condition.Status == v1.ConditionTrue {
+			return &v1.PodCondition{Type: podgroup_info.PendingCauseCondition, Status: v1.ConditionFalse}
+		}
+	}
+	return nil
+}
+
 func (su *defaultStatusUpdater) recordStaleJobEvent(job *podgroup_info.PodGroupInfo) {
 	subGroupMessages := ""
 
@@ -298,32 +390,54 @@ func (su *defaultStatusUpdater) markPodGroupUnschedulable(job *podgroup_info.Pod
 	})
 }
 
-func (su *defaultStatusUpdater) updatePodCondition(pod *v1.Pod, condition *v1.PodCondition) error {
-	log.InfraLogger.V(6).Infof(
-		"Updating pod condition for %s/%s to (%s==%s)",
-		pod.Namespace, pod.Name, condition.Type, condition.Status)
-	if k8s_internal.UpdatePodCondition(&pod.Status, condition) {
-		statusPatchBaseObject := v1.PodStatus{}
-		statusPatchBaseObject.Conditions = []v1.PodCondition{*condition}
-		podStatusPatchBytes, err := json.Marshal(statusPatchBaseObject)
-		if err != nil {
-			return err
-		}
+func (su *defaultStatusUpdater) markPodGroupSchedulingTimedOut(job *podgroup_info.PodGroupInfo) bool {
+	message := strings.TrimSpace(job.JobFitErrors.String())
+	su.recorder.Event(job.PodGroup, v1.EventTypeWarning, string(enginev2alpha2.SchedulingTimedOut), message)
 
-		patchData := []byte(fmt.Sprintf(`{"status":%s}`, string(podStatusPatchBytes)))
+	return su.updatePodGroupSchedulingCondition(job.PodGroup, &enginev2alpha2.SchedulingCondition{
+		Type:     enginev2alpha2.SchedulingTimedOut,
+		NodePool: utils.GetNodePoolNameFromLabels(job.PodGroup.Labels, su.nodePoolLabelKey),
+		Reason:   string(enginev2alpha2.SchedulingDeadlineExceeded),
+		Message:  message,
+		Status:   v1.ConditionTrue,
+		Reasons:  job.JobFitErrors,
+	})
+}
 
-		su.pushToUpdateQueue(
-			&updatePayload{
-				key:        su.keyForPodStatusPayload(pod.Name, pod.Namespace, pod.UID),
-				objectType: podType,
-			},
-			&inflightUpdate{
-				object:       pod,
-				patchData:    patchData,
-				subResources: []string{"status"},
-			},
-		)
+func (su *defaultStatusUpdater) updatePodCondition(pod *v1.Pod, conditions ...*v1.PodCondition) error {
+	var updatedConditions []v1.PodCondition
+	for _, condition := range conditions {
+		log.InfraLogger.V(6).Infof(
+			"Updating pod condition for %s/%s to (%s==%s)",
+			pod.Namespace, pod.Name, condition.Type, condition.Status)
+		if k8s_internal.UpdatePodCondition(&pod.Status, condition) {
+			updatedConditions = append(updatedConditions, *condition)
+		}
+	}
+	if len(updatedConditions) == 0 {
+		return nil
+	}
+
+	statusPatchBaseObject := v1.PodStatus{}
+	statusPatchBaseObject.Conditions = updatedConditions
+	podStatusPatchBytes, err := json.Marshal(statusPatchBaseObject)
+	if err != nil {
+		return err
 	}
+
+	patchData := []byte(fmt.Sprintf(`{"status":%s}`, string(podStatusPatchBytes)))
+
+	su.pushToUpdateQueue(
+		&updatePayload{
+			key:        su.keyForPodStatusPayload(pod.Name, pod.Namespace, pod.UID),
+			objectType: podType,
+		},
+		&inflightUpdate{
+			object:       pod,
+			patchData:    patchData,
+			subResources: []string{"status"},
+		},
+	)
 	return nil
 }
 
@@ -348,7 +462,8 @@ func (su *defaultStatusUpdater) recordUnschedulablePodsEvents(job *podgroup_info
 		msg = su.addNodePoolPrefixIfNeeded(job, msg)
 		log.InfraLogger.V(6).Infof("setting message for task: %v, %v", taskInfo.Name, msg)
 		updatePodCondition := utils.GetMarkUnschedulableValue(job.PodGroup.Spec.MarkUnschedulable)
-		if err := su.markTaskUnschedulable(taskInfo.Pod, msg, updatePodCondition); err != nil {
+		if err := su.markTaskUnschedulable(taskInfo.Pod, msg, updatePodCondition,
+			pendingCauseCondition(taskInfo.Pod, job, msg)); err != nil {
 			errs = append(errs, fmt.Errorf("failed to update unschedulable task status <%s/%s>: %v",
 				taskInfo.Namespace, taskInfo.Name, err))
 		}
@@ -361,7 +476,9 @@ func (su *defaultStatusUpdater) updatePodGroupAnnotations(job *podgroup_info.Pod
 	old := job.PodGroup.DeepCopy()
 	updatedStaleTime := setPodGroupStaleTimeStamp(job.PodGroup, job.StalenessInfo.TimeStamp)
 	updatedStartTime := setPodGroupLastStartTimeStamp(job.PodGroup, job.LastStartTimestamp)
-	if !updatedStaleTime && !updatedStartTime {
+	updatedLastGpus := setPodGroupLastGpus(job.PodGroup, job.LastGpusAnnotationValue())
+	updatedPendingSince := setPodGroupPendingSince(job.PodGroup, job.PendingSince)
+	if !updatedStaleTime && !updatedStartTime && !updatedLastGpus && !updatedPendingSince {
 		return nil, nil
 	}
 
@@ -462,6 +579,33 @@ func setPodGroupLastStartTimeStamp(podGroup *enginev2alpha2.PodGroup, startTimeS
 	return true
 }
 
+func setPodGroupLastGpus(podGroup *enginev2alpha2.PodGroup, lastGpus string) bool {
+	if lastGpus == "" || podGroup.Annotations[commonconstants.LastGpuIndexes] == lastGpus {
+		return false
+	}
+	if podGroup.Annotations == nil {
+		podGroup.Annotations = make(map[string]string)
+	}
+	podGroup.Annotations[commonconstants.LastGpuIndexes] = lastGpus
+	return true
+}
+
+// setPodGroupPendingSince records the time the job was first seen pending, once, so its scheduling deadline keeps
+// counting from it across scheduler restarts. A valid recorded time is never moved.
+func setPodGroupPendingSince(podGroup *enginev2alpha2.PodGroup, pendingSince *time.Time) bool {
+	if pendingSince == nil {
+		return false
+	}
+	if _, err := time.Parse(time.RFC3339, podGroup.Annotations[commonconstants.PendingSinceTimeStamp]); err == nil {
+		return false
+	}
+	if podGroup.Annotations == nil {
+		podGroup.Annotations = make(map[string]string)
+	}
+	podGroup.Annotations[commonconstants.PendingSinceTimeStamp] = pendingSince.UTC().Format(time.RFC3339)
+	return true
+}
+
 func setPodGroupSchedulingCondition(podGroup *enginev2alpha2.PodGroup, schedulingCondition *enginev2alpha2.SchedulingCondition) bool {
 	currentSchedulingConditionIndex := utils.GetSchedulingConditionIndex(podGroup, schedulingCondition.NodePool)
 	lastSchedulingCondition := utils.GetLastSchedulingCondition(podGroup)
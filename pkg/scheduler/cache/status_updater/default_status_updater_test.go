@@ -482,6 +482,45 @@ func TestUpdatePodGroupStaleTimeStamp(t *testing.T) {
 	}
 }
 
+func TestSetPodGroupPendingSince(t *testing.T) {
+	for _, test := range []struct {
+		name               string
+		annotations        map[string]string
+		pendingSince       *time.Time
+		expectedAnnotation string
+		expectedUpdated    bool
+	}{
+		{
+			name: "job not seen pending",
+		},
+		{
+			name:               "job seen pending for the first time",
+			pendingSince:       getTimePointer("2021-01-01T00:00:00Z"),
+			expectedAnnotation: "2021-01-01T00:00:00Z",
+			expectedUpdated:    true,
+		},
+		{
+			name:               "recorded time is not moved",
+			annotations:        map[string]string{commonconstants.PendingSinceTimeStamp: "2020-01-01T00:00:00Z"},
+			pendingSince:       getTimePointer("2021-01-01T00:00:00Z"),
+			expectedAnnotation: "2020-01-01T00:00:00Z",
+		},
+		{
+			name:               "invalid recorded time is replaced",
+			annotations:        map[string]string{commonconstants.PendingSinceTimeStamp: "quick brown fox"},
+			pendingSince:       getTimePointer("2021-01-01T00:00:00Z"),
+			expectedAnnotation: "2021-01-01T00:00:00Z",
+			expectedUpdated:    true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			assert.Equal(t, test.expectedUpdated, setPodGroupPendingSince(podGroup, test.pendingSince))
+			assert.Equal(t, test.expectedAnnotation, podGroup.Annotations[commonconstants.PendingSinceTimeStamp])
+		})
+	}
+}
+
 func getTimePointer(ts string) *time.Time {
 	t, err := time.Parse(time.RFC3339, ts)
 	if err != nil {
@@ -814,3 +853,122 @@ func waitForIncrease(callCount *int) error {
 	}
 	return errors.New("update calls did not increase")
 }
+
+func TestDefaultStatusUpdater_PatchPodAnnotationsMergesInFlightPatch(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeAiSchedClient := kubeaischedfake.NewSimpleClientset()
+	recorder := record.NewFakeRecorder(100)
+	// no update workers, so the patches stay in flight
+	statusUpdater := New(kubeClient, kubeAiSchedClient, recorder, 0, false, nodePoolLabelKey)
+
+	stopCh := make(chan struct{})
+	statusUpdater.Run(stopCh)
+	defer close(stopCh)
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", UID: "pod-uid"}}
+	statusUpdater.PatchPodAnnotations(pod, map[string]any{"first": "1", "second": "1"})
+	statusUpdater.PatchPodAnnotations(pod, map[string]any{"second": "2", "removed": nil})
+
+	key := statusUpdater.keyForPodAnnotationsPayload(pod.Name, pod.Namespace, pod.UID)
+	assert.Equal(t, map[string]any{"first": "1", "second": "2", "removed": nil},
+		statusUpdater.inFlightPodAnnotations(key))
+}
+
+func TestDefaultStatusUpdater_RecordJobStatusEvent_SchedulingTimedOut(t *testing.T) {
+	jobInfos, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+		Name:         "test-job",
+		Namespace:    "test-ns",
+		QueueName:    "test-queue",
+		MinAvailable: ptr.To(int32(1)),
+		Tasks: []*tasks_fake.TestTaskBasic{
+			{
+				Name:  "test-task",
+				State: pod_status.Pending,
+			},
+		},
+	}})
+	job := jobInfos["test-job"]
+	job.SchedulingTimedOut = true
+	job.JobFitErrors = enginev2alpha2.UnschedulableExplanations{{
+		Reason:  enginev2alpha2.SchedulingDeadlineExceeded,
+		Message: "Job was not scheduled before its scheduling deadline",
+	}}
+
+	kubeClient := fake.NewSimpleClientset()
+	kubeAiSchedClient := kubeaischedfake.NewSimpleClientset(job.PodGroup)
+	recorder := record.NewFakeRecorder(100)
+	// no update workers, so the updates stay in flight
+	statusUpdater := New(kubeClient, kubeAiSchedClient, recorder, 0, false, nodePoolLabelKey)
+	stopCh := make(chan struct{})
+	statusUpdater.Run(stopCh)
+	defer close(stopCh)
+
+	assert.NoError(t, statusUpdater.RecordJobStatusEvent(job))
+
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{
+		"Warning Unschedulable SchedulingDeadlineExceeded: Job was not scheduled before its scheduling deadline\n",
+		"Warning SchedulingTimedOut SchedulingDeadlineExceeded: Job was not scheduled before its scheduling deadline",
+	}, events)
+
+	conditions := job.PodGroup.Status.SchedulingConditions
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, enginev2alpha2.SchedulingTimedOut, conditions[0].Type)
+	assert.Equal(t, job.JobFitErrors, conditions[0].Reasons)
+	inFlightPodGroups := 0
+	statusUpdater.inFlightPodGroups.Range(func(key, value any) bool {
+		inFlightPodGroups += 1
+		return true
+	})
+	assert.Equal(t, 1, inFlightPodGroups)
+}
+
+func TestDefaultStatusUpdater_RecordJobStatusEvent_PendingCause(t *testing.T) {
+	jobInfos, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+		Name:         "test-job",
+		Namespace:    "test-ns",
+		QueueName:    "test-queue",
+		MinAvailable: ptr.To(int32(1)),
+		Tasks: []*tasks_fake.TestTaskBasic{
+			{
+				Name:  "test-task",
+				State: pod_status.Pending,
+			},
+		},
+	}})
+	job := jobInfos["test-job"]
+	job.PodGroup.Spec.MarkUnschedulable = ptr.To(true)
+	job.JobFitErrors = enginev2alpha2.UnschedulableExplanations{{
+		Reason:  enginev2alpha2.OverLimit,
+		Message: "test-queue quota has reached the allowable limit of GPUs",
+	}}
+
+	kubeClient := fake.NewSimpleClientset()
+	kubeAiSchedClient := kubeaischedfake.NewSimpleClientset(job.PodGroup)
+	recorder := record.NewFakeRecorder(100)
+	// no update workers, so the updates stay in flight
+	statusUpdater := New(kubeClient, kubeAiSchedClient, recorder, 0, false, nodePoolLabelKey)
+	stopCh := make(chan struct{})
+	statusUpdater.Run(stopCh)
+	defer close(stopCh)
+
+	assert.NoError(t, statusUpdater.RecordJobStatusEvent(job))
+
+	pod := job.GetAllPodsMap()["test-job-0"].Pod
+	conditionReasons := map[v1.PodConditionType]string{}
+	for _, condition := range pod.Status.Conditions {
+		conditionReasons[condition.Type] = condition.Reason
+	}
+	assert.Equal(t, map[v1.PodConditionType]string{
+		v1.PodScheduled:                     v1.PodReasonUnschedulable,
+		podgroup_info.PendingCauseCondition: string(podgroup_info.BlockedByQuota),
+	}, conditionReasons)
+
+	job.JobFitErrors = nil
+	assert.Equal(t, &v1.PodCondition{Type: podgroup_info.PendingCauseCondition, Status: v1.ConditionFalse},
+		pendingCauseCondition(pod, job, ""))
+}
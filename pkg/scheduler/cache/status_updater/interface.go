@@ -18,10 +18,14 @@ type PodGroupsSync interface {
 type Interface interface {
 	PodGroupsSync
 	Evicted(evictedPodGroup *enginev2alpha2.PodGroup, evictionMetadata eviction_info.EvictionMetadata, message string)
+	// PreBind and Bound may be called concurrently for different pods, as the binds of a batch run concurrently.
 	PreBind(pod *v1.Pod)
 	Bound(pod *v1.Pod, hostname string, bindError error, nodePoolName string) error
 	Pipelined(pod *v1.Pod, message string)
+	GpuGroupsLost(pod *v1.Pod, message string)
+	ForceAllocated(pod *v1.Pod, message string)
 	PatchPodLabels(pod *v1.Pod, labels map[string]interface{})
+	PatchPodAnnotations(pod *v1.Pod, annotations map[string]interface{})
 	RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error
 
 	Run(stopCh <-chan struct{})
@@ -0,0 +1,32 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conf
+
+import "fmt"
+
+// GpuGroupLossPolicy defines how running GPU sharing pods whose GPU groups were lost, e.g. because the GPU failed
+// after the pods were bound, are remediated.
+type GpuGroupLossPolicy string
+
+const (
+	// GpuGroupLossNone leaves the pods as they are.
+	GpuGroupLossNone GpuGroupLossPolicy = "None"
+	// GpuGroupLossEvict evicts the pods, so they are rescheduled on healthy GPUs.
+	GpuGroupLossEvict GpuGroupLossPolicy = "Evict"
+	// GpuGroupLossMark annotates the pods with their lost GPU groups and records an event on them, for an operator
+	// or a controller to act on.
+	GpuGroupLossMark GpuGroupLossPolicy = "Mark"
+)
+
+// ParseGpuGroupLossPolicy parses a GPU group loss policy. An empty value is GpuGroupLossNone.
+func ParseGpuGroupLossPolicy(value string) (GpuGroupLossPolicy, error) {
+	switch policy := GpuGroupLossPolicy(value); policy {
+	case "", GpuGroupLossNone:
+		return GpuGroupLossNone, nil
+	case GpuGroupLossEvict, GpuGroupLossMark:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown policy %q, expected one of %s, %s or %s", value, GpuGroupLossNone,
+		GpuGroupLossEvict, GpuGroupLossMark)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const mib = 1024 * 1024
+
+// GpuMemoryQuantum is the quantum that the GPU memory requests of pods are rounded up to, so pods sharing a GPU take
+// its memory in slots of equal size and fragment it less. Either a fixed amount of memory, or a fraction of the GPU.
+type GpuMemoryQuantum struct {
+	// MemoryMiB is a fixed quantum, in MiB
+	MemoryMiB int64
+	// GpuSlots divides the memory of every GPU into this many equal slots, e.g. 7 for a quantum of 1/7 of the GPU
+	GpuSlots int64
+}
+
+// ParseGpuMemoryQuantum parses a quantum given either as a quantity of memory, e.g. "1Gi", or as a fraction of the
+// GPU, e.g. "1/7". An empty value means no quantization.
+func ParseGpuMemoryQuantum(value string) (*GpuMemoryQuantum, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if numerator, denominator, isFraction := strings.Cut(value, "/"); isFraction {
+		slots, err := strconv.ParseInt(strings.TrimSpace(denominator), 10, 64)
+		if strings.TrimSpace(numerator) != "1" || err != nil || slots <= 0 {
+			return nil, fmt.Errorf("invalid gpu memory quantum %q: fractions must be of the form 1/N", value)
+		}
+		return &GpuMemoryQuantum{GpuSlots: slots}, nil
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gpu memory quantum %q: %w", value, err)
+	}
+	if quantity.Value() < mib {
+		return nil, fmt.Errorf("invalid gpu memory quantum %q: must be at least 1Mi", value)
+	}
+	return &GpuMemoryQuantum{MemoryMiB: quantity.Value() / mib}, nil
+}
+
+// Round returns the GPU memory request, in MiB, rounded up to the quantum on a GPU with the given memory. The rounded
+// request never exceeds the memory of the GPU, so rounding never prevents a pod from fitting a GPU that its request
+// fits. Requests that do not fit the GPU to begin with are not rounded.
+func (q *GpuMemoryQuantum) Round(memory, gpuMemory int64) int64 {
+	if q == nil || memory <= 0 || memory > gpuMemory {
+		return memory
+	}
+
+	var rounded int64
+	if q.GpuSlots > 0 {
+		slots := (memory*q.GpuSlots + gpuMemory - 1) / gpuMemory
+		rounded = slots * gpuMemory / q.GpuSlots
+	} else {
+		rounded = (memory + q.MemoryMiB - 1) / q.MemoryMiB * q.MemoryMiB
+	}
+	return min(rounded, gpuMemory)
+}
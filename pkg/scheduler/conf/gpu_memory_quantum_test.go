@@ -0,0 +1,123 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGpuMemoryQuantum(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           string
+		expectedQuantum *GpuMemoryQuantum
+		expectError     bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:            "memory",
+			value:           "1Gi",
+			expectedQuantum: &GpuMemoryQuantum{MemoryMiB: 1024},
+		},
+		{
+			name:            "fraction of the gpu",
+			value:           "1/7",
+			expectedQuantum: &GpuMemoryQuantum{GpuSlots: 7},
+		},
+		{
+			name:        "fraction that is not of the form 1/N",
+			value:       "2/7",
+			expectError: true,
+		},
+		{
+			name:        "zero slots",
+			value:       "1/0",
+			expectError: true,
+		},
+		{
+			name:        "memory smaller than 1Mi",
+			value:       "1Ki",
+			expectError: true,
+		},
+		{
+			name:        "invalid memory",
+			value:       "one-gig",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantum, err := ParseGpuMemoryQuantum(tt.value)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedQuantum, quantum)
+		})
+	}
+}
+
+func TestGpuMemoryQuantum_Round(t *testing.T) {
+	tests := []struct {
+		name           string
+		quantum        *GpuMemoryQuantum
+		memory         int64
+		gpuMemory      int64
+		expectedMemory int64
+	}{
+		{
+			name:           "no quantum",
+			memory:         1500,
+			gpuMemory:      40960,
+			expectedMemory: 1500,
+		},
+		{
+			name:           "memory quantum",
+			quantum:        &GpuMemoryQuantum{MemoryMiB: 1024},
+			memory:         1500,
+			gpuMemory:      40960,
+			expectedMemory: 2048,
+		},
+		{
+			name:           "fraction quantum",
+			quantum:        &GpuMemoryQuantum{GpuSlots: 7},
+			memory:         1500,
+			gpuMemory:      40960,
+			expectedMemory: 5851,
+		},
+		{
+			name:           "last slot of a fraction quantum takes the whole gpu",
+			quantum:        &GpuMemoryQuantum{GpuSlots: 7},
+			memory:         40000,
+			gpuMemory:      40960,
+			expectedMemory: 40960,
+		},
+		{
+			name:           "capped at the gpu memory",
+			quantum:        &GpuMemoryQuantum{MemoryMiB: 3000},
+			memory:         40000,
+			gpuMemory:      40960,
+			expectedMemory: 40960,
+		},
+		{
+			name:           "request larger than the gpu is not rounded",
+			quantum:        &GpuMemoryQuantum{MemoryMiB: 1024},
+			memory:         50000,
+			gpuMemory:      40960,
+			expectedMemory: 50000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMemory, tt.quantum.Round(tt.memory, tt.gpuMemory))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conf
+
+import "fmt"
+
+// GpuSharingNodePressurePolicy defines which GPUs of a node under memory or disk pressure are kept from new
+// fractional tenants.
+type GpuSharingNodePressurePolicy string
+
+const (
+	// GpuSharingNodePressureNone ignores node pressure for GPU sharing.
+	GpuSharingNodePressureNone GpuSharingNodePressurePolicy = "None"
+	// GpuSharingNodePressureSharedGpus keeps new fractional tenants off the GPUs that are already shared, while GPUs
+	// that are not shared yet may still be used.
+	GpuSharingNodePressureSharedGpus GpuSharingNodePressurePolicy = "SharedGpus"
+	// GpuSharingNodePressureAllGpus keeps new fractional tenants off all the GPUs of the node.
+	GpuSharingNodePressureAllGpus GpuSharingNodePressurePolicy = "AllGpus"
+)
+
+// ParseGpuSharingNodePressurePolicy parses a GPU sharing node pressure policy. An empty value is GpuSharingNodePressureNone.
+func ParseGpuSharingNodePressurePolicy(value string) (GpuSharingNodePressurePolicy, error) {
+	switch policy := GpuSharingNodePressurePolicy(value); policy {
+	case "", GpuSharingNodePressureNone:
+		return GpuSharingNodePressureNone, nil
+	case GpuSharingNodePressureSharedGpus, GpuSharingNodePressureAllGpus:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown policy %q, expected one of %s, %s or %s", value, GpuSharingNodePressureNone,
+		GpuSharingNodePressureSharedGpus, GpuSharingNodePressureAllGpus)
+}
@@ -29,19 +29,39 @@ import (
 )
 
 type SchedulerParams struct {
-	SchedulerName                     string                    `json:"schedulerName,omitempty"`
-	RestrictSchedulingNodes           bool                      `json:"restrictSchedulingNodes,omitempty"`
-	PartitionParams                   *SchedulingNodePoolParams `json:"partitionParams,omitempty"`
-	MaxNumberConsolidationPreemptees  int                       `json:"maxNumberConsolidationPreemptees,omitempty"`
-	ScheduleCSIStorage                bool                      `json:"scheduleCSIStorage,omitempty"`
-	UseSchedulingSignatures           bool                      `json:"useSchedulingSignatures,omitempty"`
-	FullHierarchyFairness             bool                      `json:"fullHierarchyFairness,omitempty"`
-	AllowConsolidatingReclaim         bool                      `json:"allowConsolidatingReclaim,omitempty"`
-	NumOfStatusRecordingWorkers       int                       `json:"numOfStatusRecordingWorkers,omitempty"`
-	GlobalDefaultStalenessGracePeriod time.Duration             `json:"globalDefaultStalenessGracePeriod,omitempty"`
-	SchedulePeriod                    time.Duration             `json:"schedulePeriod,omitempty"`
-	DetailedFitErrors                 bool                      `json:"detailedFitErrors,omitempty"`
-	UpdatePodEvictionCondition        bool                      `json:"updatePodEvictionCondition,omitempty"`
+	SchedulerName                     string                       `json:"schedulerName,omitempty"`
+	RestrictSchedulingNodes           bool                         `json:"restrictSchedulingNodes,omitempty"`
+	PartitionParams                   *SchedulingNodePoolParams    `json:"partitionParams,omitempty"`
+	MaxNumberConsolidationPreemptees  int                          `json:"maxNumberConsolidationPreemptees,omitempty"`
+	ScheduleCSIStorage                bool                         `json:"scheduleCSIStorage,omitempty"`
+	UseSchedulingSignatures           bool                         `json:"useSchedulingSignatures,omitempty"`
+	FullHierarchyFairness             bool                         `json:"fullHierarchyFairness,omitempty"`
+	AllowConsolidatingReclaim         bool                         `json:"allowConsolidatingReclaim,omitempty"`
+	NumOfStatusRecordingWorkers       int                          `json:"numOfStatusRecordingWorkers,omitempty"`
+	GlobalDefaultStalenessGracePeriod time.Duration                `json:"globalDefaultStalenessGracePeriod,omitempty"`
+	SchedulePeriod                    time.Duration                `json:"schedulePeriod,omitempty"`
+	DetailedFitErrors                 bool                         `json:"detailedFitErrors,omitempty"`
+	UpdatePodEvictionCondition        bool                         `json:"updatePodEvictionCondition,omitempty"`
+	NodeScoringWorkers                int                          `json:"nodeScoringWorkers,omitempty"`
+	CheckpointEvictionTimeout         time.Duration                `json:"checkpointEvictionTimeout,omitempty"`
+	RandomizeTopNodes                 bool                         `json:"randomizeTopNodes,omitempty"`
+	TopNodesScoreEpsilon              float64                      `json:"topNodesScoreEpsilon,omitempty"`
+	AllowNodeConsolidation            bool                         `json:"allowNodeConsolidation,omitempty"`
+	NodeConsolidationThreshold        float64                      `json:"nodeConsolidationThreshold,omitempty"`
+	MaxSnapshotStaleness              time.Duration                `json:"maxSnapshotStaleness,omitempty"`
+	MaxBindFallbackAttempts           int                          `json:"maxBindFallbackAttempts,omitempty"`
+	GangCompletionPreemption          bool                         `json:"gangCompletionPreemption,omitempty"`
+	NodeMismatchEvictionGracePeriod   time.Duration                `json:"nodeMismatchEvictionGracePeriod,omitempty"`
+	NodeScoringBudget                 time.Duration                `json:"nodeScoringBudget,omitempty"`
+	NodeScoringSampleSize             int                          `json:"nodeScoringSampleSize,omitempty"`
+	MaxFitErrorsPerTask               int                          `json:"maxFitErrorsPerTask,omitempty"`
+	IncrementalNodeRescoring          bool                         `json:"incrementalNodeRescoring,omitempty"`
+	GpuSharingNodePressurePolicy      GpuSharingNodePressurePolicy `json:"gpuSharingNodePressurePolicy,omitempty"`
+	DeferWholeGpuFragmentation        bool                         `json:"deferWholeGpuFragmentation,omitempty"`
+	GpuGroupLossPolicy                GpuGroupLossPolicy           `json:"gpuGroupLossPolicy,omitempty"`
+	VictimOrder                       VictimOrder                  `json:"victimOrder,omitempty"`
+	CheckpointAwareVictimOrder        bool                         `json:"checkpointAwareVictimOrder,omitempty"`
+	StalePipelineCycles               int                          `json:"stalePipelineCycles,omitempty"`
 }
 
 // SchedulerConfiguration defines the configuration of scheduler.
@@ -55,10 +75,32 @@ type SchedulerConfiguration struct {
 	// QueueDepthPerAction max number of jobs to try for action per queue
 	QueueDepthPerAction map[string]int `yaml:"queueDepthPerAction,omitempty" json:"queueDepthPerAction,omitempty"`
 
+	// QueueActionOrder overrides, per queue name, the order of the listed actions for the jobs of the queue and of
+	// its child queues. The listed actions keep their positions in Actions, but take them in the given order.
+	QueueActionOrder map[string][]string `yaml:"queueActionOrder,omitempty" json:"queueActionOrder,omitempty"`
+
+	// RightsizingQueues names the queues whose pending tasks may be scheduled against the resource requirements
+	// returned by the TaskResourceMutateFns of the plugins, along with the child queues of the named queues.
+	RightsizingQueues []string `yaml:"rightsizingQueues,omitempty" json:"rightsizingQueues,omitempty"`
+
+	// PreemptionFences are priority thresholds that decide whether a job may be preempted before the preempt victim
+	// filters of the plugins are consulted.
+	PreemptionFences *PreemptionFences `yaml:"preemptionFences,omitempty" json:"preemptionFences,omitempty"`
+
 	// UsageDBConfig defines configuration for the usage db client
 	UsageDBConfig *usagedbapi.UsageDBConfig `yaml:"usageDBConfig,omitempty" json:"usageDBConfig,omitempty"`
 }
 
+// PreemptionFences defines priority thresholds of the preempt action. Each fence is disabled when it is not set.
+type PreemptionFences struct {
+	// NonPreemptibleAbove is the priority above which jobs are never preempted, whatever the priority of the
+	// preemptor.
+	NonPreemptibleAbove *int32 `yaml:"nonPreemptibleAbove,omitempty" json:"nonPreemptibleAbove,omitempty"`
+	// CanPreemptBelow is the priority at or above which jobs may preempt any job with a lower priority, without
+	// consulting the preempt victim filters of the plugins. It does not override NonPreemptibleAbove.
+	CanPreemptBelow *int32 `yaml:"canPreemptBelow,omitempty" json:"canPreemptBelow,omitempty"`
+}
+
 // Tier defines plugin tier
 type Tier struct {
 	Plugins []PluginOption `yaml:"plugins" json:"plugins"`
@@ -89,6 +131,19 @@ type PluginOption struct {
 type SchedulingNodePoolParams struct {
 	NodePoolLabelKey   string
 	NodePoolLabelValue string
+	// CrossPartitionReclaimNodePools are the node pools whose jobs may be reclaimed by jobs of this partition. The
+	// nodes, pod groups and queues of these node pools are added to the session, but their jobs are only considered as
+	// reclaim victims. Empty by default, which keeps the session scoped to the partition.
+	CrossPartitionReclaimNodePools []string
+	// GpuMemoryQuantum is the quantum that the GPU memory requests of pods are rounded up to on the nodes of the node
+	// pool. Nil by default, which keeps the requests as they are.
+	GpuMemoryQuantum *GpuMemoryQuantum
+	// MaxGpuSharingTenants is the maximum number of fractional pods that share a GPU on the nodes of the node pool.
+	// 0 by default, which does not limit the tenants of a GPU.
+	MaxGpuSharingTenants int
+	// ReservedGpuMemory is the GPU memory, in MiB, kept free on every GPU of the nodes of the node pool for system
+	// daemons. Nodes may override it with annotations. 0 by default, which reserves no memory.
+	ReservedGpuMemory int64
 }
 
 func (s *SchedulingNodePoolParams) GetLabelSelector() (labels.Selector, error) {
@@ -101,6 +156,10 @@ func (s *SchedulingNodePoolParams) GetLabelSelector() (labels.Selector, error) {
 		operator = selection.Equals
 		vals = []string{s.NodePoolLabelValue}
 	}
+	if s.CrossPartitionReclaimEnabled() {
+		operator = selection.In
+		vals = append(vals, s.CrossPartitionReclaimNodePools...)
+	}
 
 	requirement, err := labels.NewRequirement(s.NodePoolLabelKey, operator, vals)
 	if err != nil {
@@ -116,3 +175,19 @@ func (s *SchedulingNodePoolParams) GetLabels() map[string]string {
 	}
 	return map[string]string{s.NodePoolLabelKey: s.NodePoolLabelValue}
 }
+
+// CrossPartitionReclaimEnabled returns true if jobs of other node pools may be reclaimed by jobs of this partition.
+// Cross partition reclaim requires the partition to have a node pool label value.
+func (s *SchedulingNodePoolParams) CrossPartitionReclaimEnabled() bool {
+	return s != nil && s.NodePoolLabelKey != "" && s.NodePoolLabelValue != "" &&
+		len(s.CrossPartitionReclaimNodePools) > 0
+}
+
+// IsCrossPartition returns true if the labels of a node or a pod group place it in one of the cross partition reclaim
+// node pools rather than in this partition.
+func (s *SchedulingNodePoolParams) IsCrossPartition(objectLabels map[string]string) bool {
+	if !s.CrossPartitionReclaimEnabled() {
+		return false
+	}
+	return objectLabels[s.NodePoolLabelKey] != s.NodePoolLabelValue
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conf
+
+import "fmt"
+
+// VictimOrder defines which jobs of a queue are taken first as victims of preemption and reclaim, among the jobs that
+// the job order does not tell apart, e.g. jobs of the same priority.
+type VictimOrder string
+
+const (
+	// VictimOrderDefault takes the jobs in the reverse of their allocation order, the most recently submitted first.
+	VictimOrderDefault VictimOrder = "Default"
+	// VictimOrderNewestSubmittedFirst takes the most recently submitted jobs first, to protect long running work.
+	VictimOrderNewestSubmittedFirst VictimOrder = "NewestSubmittedFirst"
+	// VictimOrderOldestSubmittedFirst takes the earliest submitted jobs first, so every job gets its turn.
+	VictimOrderOldestSubmittedFirst VictimOrder = "OldestSubmittedFirst"
+	// VictimOrderNewestStartedFirst takes the most recently started jobs first, to protect long running work.
+	VictimOrderNewestStartedFirst VictimOrder = "NewestStartedFirst"
+	// VictimOrderOldestStartedFirst takes the earliest started jobs first, so every job gets its turn.
+	VictimOrderOldestStartedFirst VictimOrder = "OldestStartedFirst"
+)
+
+// ParseVictimOrder parses a victim order. An empty value is VictimOrderDefault.
+func ParseVictimOrder(value string) (VictimOrder, error) {
+	switch order := VictimOrder(value); order {
+	case "", VictimOrderDefault:
+		return VictimOrderDefault, nil
+	case VictimOrderNewestSubmittedFirst, VictimOrderOldestSubmittedFirst, VictimOrderNewestStartedFirst,
+		VictimOrderOldestStartedFirst:
+		return order, nil
+	}
+	return "", fmt.Errorf("unknown victim order %q, expected one of %s, %s, %s, %s or %s", value, VictimOrderDefault,
+		VictimOrderNewestSubmittedFirst, VictimOrderOldestSubmittedFirst, VictimOrderNewestStartedFirst,
+		VictimOrderOldestStartedFirst)
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// queueOrderedActions are the actions that take their jobs from the queues, and can be reordered per queue.
+var queueOrderedActions = []ActionType{Allocate, Consolidation, Reclaim, Preempt}
+
+// ActionDispatch is an action to run for the jobs of a set of queues.
+type ActionDispatch struct {
+	Action Action
+	// queues are the queues whose jobs the action schedules. Nil for all the queues.
+	queues map[common_info.QueueID]bool
+}
+
+// Execute runs the action of the dispatch for the jobs of its queues only.
+func (d ActionDispatch) Execute(ssn *Session) {
+	ssn.dispatchedQueues = d.queues
+	defer func() { ssn.dispatchedQueues = nil }()
+	d.Action.Execute(ssn)
+}
+
+// IsQueueDispatched returns true if the running action schedules the jobs of the queue.
+func (ssn *Session) IsQueueDispatched(queueID common_info.QueueID) bool {
+	return ssn.dispatchedQueues == nil || ssn.dispatchedQueues[queueID]
+}
+
+// DispatchActions returns the actions to run in the session, in order. Without a queue action order, every action
+// runs once for all the queues. The queues with a queue action order take the positions of the listed actions in the
+// order they list them. At such a position, the action of the global order runs first for the queues that take it,
+// followed by the other actions taken at the position, in the global order.
+func (ssn *Session) DispatchActions(actions []Action) []ActionDispatch {
+	queueOrders := ssn.queueActionOrders(actions)
+
+	var dispatches []ActionDispatch
+	for position, action := range actions {
+		queuesByAction := map[ActionType]map[common_info.QueueID]bool{}
+		reordered := false
+		for queueID := range ssn.Queues {
+			actionName := action.Name()
+			if order, found := queueOrders[queueID]; found {
+				actionName = actionAtPosition(actions, order, position)
+			}
+			reordered = reordered || actionName != action.Name()
+			if queuesByAction[actionName] == nil {
+				queuesByAction[actionName] = map[common_info.QueueID]bool{}
+			}
+			queuesByAction[actionName][queueID] = true
+		}
+
+		if !reordered {
+			dispatches = append(dispatches, ActionDispatch{Action: action})
+			continue
+		}
+		if queues, found := queuesByAction[action.Name()]; found {
+			dispatches = append(dispatches, ActionDispatch{Action: action, queues: queues})
+		}
+		for _, otherAction := range actions {
+			queues, found := queuesByAction[otherAction.Name()]
+			if !found || otherAction.Name() == action.Name() {
+				continue
+			}
+			dispatches = append(dispatches, ActionDispatch{Action: otherAction, queues: queues})
+			delete(queuesByAction, otherAction.Name())
+		}
+	}
+	return dispatches
+}
+
+// queueActionOrders returns the action order of every queue that has one, by its own name or the name of its
+// closest ancestor. Orders that do not list each of their actions exactly once, or list actions that are not
+// configured exactly once, are ignored.
+func (ssn *Session) queueActionOrders(actions []Action) map[common_info.QueueID][]ActionType {
+	if ssn.Config == nil || len(ssn.Config.QueueActionOrder) == 0 {
+		return nil
+	}
+
+	queueOrders := map[common_info.QueueID][]ActionType{}
+	for queueID := range ssn.Queues {
+		for queue := ssn.Queues[queueID]; queue != nil; queue = ssn.Queues[queue.ParentQueue] {
+			actionNames, found := ssn.Config.QueueActionOrder[queue.Name]
+			if !found {
+				continue
+			}
+			order := make([]ActionType, 0, len(actionNames))
+			for _, actionName := range actionNames {
+				order = append(order, ActionType(actionName))
+			}
+			if err := validateQueueActionOrder(actions, order); err != nil {
+				log.InfraLogger.V(2).Warnf("Ignoring the action order of queue <%s>: %v", queue.Name, err)
+				break
+			}
+			queueOrders[queueID] = order
+			break
+		}
+	}
+	return queueOrders
+}
+
+// actionAtPosition returns the action that a queue with the given action order takes at a position of the global
+// action order.
+func actionAtPosition(actions []Action, order []ActionType, position int) ActionType {
+	if !slices.Contains(order, actions[position].Name()) {
+		return actions[position].Name()
+	}
+	index := 0
+	for _, action := range actions[:position] {
+		if slices.Contains(order, action.Name()) {
+			index++
+		}
+	}
+	return order[index]
+}
+
+func validateQueueActionOrder(actions []Action, order []ActionType) error {
+	for index, actionName := range order {
+		if !slices.Contains(queueOrderedActions, actionName) {
+			return fmt.Errorf("action %q cannot be ordered per queue", actionName)
+		}
+		if slices.Contains(order[:index], actionName) {
+			return fmt.Errorf("action %q is listed more than once", actionName)
+		}
+		occurrences := 0
+		for _, action := range actions {
+			if action.Name() == actionName {
+				occurrences++
+			}
+		}
+		if occurrences != 1 {
+			return fmt.Errorf("action %q is configured %d times in the actions of the scheduler", actionName,
+				occurrences)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+type fakeDispatchAction struct {
+	name ActionType
+}
+
+func (a *fakeDispatchAction) Name() ActionType { return a.name }
+func (a *fakeDispatchAction) Execute(*Session) {}
+
+func TestSession_DispatchActions(t *testing.T) {
+	actions := []Action{
+		&fakeDispatchAction{name: Allocate},
+		&fakeDispatchAction{name: Consolidation},
+		&fakeDispatchAction{name: Reclaim},
+		&fakeDispatchAction{name: Preempt},
+		&fakeDispatchAction{name: StaleGangEviction},
+	}
+	type expectedDispatch struct {
+		action ActionType
+		queues map[common_info.QueueID]bool
+	}
+
+	tests := []struct {
+		name               string
+		queueActionOrder   map[string][]string
+		expectedDispatches []expectedDispatch
+	}{
+		{
+			name: "no queue action order",
+			expectedDispatches: []expectedDispatch{
+				{action: Allocate}, {action: Consolidation}, {action: Reclaim}, {action: Preempt},
+				{action: StaleGangEviction},
+			},
+		},
+		{
+			name:             "order of a department applies to its child queues",
+			queueActionOrder: map[string][]string{"department": {"preempt", "reclaim"}},
+			expectedDispatches: []expectedDispatch{
+				{action: Allocate},
+				{action: Consolidation},
+				{action: Reclaim, queues: map[common_info.QueueID]bool{"other-queue": true}},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"department": true, "queue": true}},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"other-queue": true}},
+				{action: Reclaim, queues: map[common_info.QueueID]bool{"department": true, "queue": true}},
+				{action: StaleGangEviction},
+			},
+		},
+		{
+			name: "order of a queue overrides the order of its department",
+			queueActionOrder: map[string][]string{
+				"department": {"preempt", "reclaim"},
+				"queue":      {"reclaim", "preempt"},
+			},
+			expectedDispatches: []expectedDispatch{
+				{action: Allocate},
+				{action: Consolidation},
+				{action: Reclaim, queues: map[common_info.QueueID]bool{"other-queue": true, "queue": true}},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"department": true}},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"other-queue": true, "queue": true}},
+				{action: Reclaim, queues: map[common_info.QueueID]bool{"department": true}},
+				{action: StaleGangEviction},
+			},
+		},
+		{
+			name:             "orders of non consecutive actions",
+			queueActionOrder: map[string][]string{"queue": {"preempt", "allocate"}},
+			expectedDispatches: []expectedDispatch{
+				{action: Allocate, queues: map[common_info.QueueID]bool{"department": true, "other-queue": true}},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"queue": true}},
+				{action: Consolidation},
+				{action: Reclaim},
+				{action: Preempt, queues: map[common_info.QueueID]bool{"department": true, "other-queue": true}},
+				{action: Allocate, queues: map[common_info.QueueID]bool{"queue": true}},
+				{action: StaleGangEviction},
+			},
+		},
+		{
+			name: "invalid orders are ignored",
+			queueActionOrder: map[string][]string{
+				"department":  {"preempt", "preempt"},
+				"other-queue": {"stalegangeviction", "allocate"},
+			},
+			expectedDispatches: []expectedDispatch{
+				{action: Allocate}, {action: Consolidation}, {action: Reclaim}, {action: Preempt},
+				{action: StaleGangEviction},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{
+				Config: &conf.SchedulerConfiguration{QueueActionOrder: tt.queueActionOrder},
+				Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+					"department":  {UID: "department", Name: "department"},
+					"queue":       {UID: "queue", Name: "queue", ParentQueue: "department"},
+					"other-queue": {UID: "other-queue", Name: "other-queue"},
+				},
+			}
+
+			var dispatches []expectedDispatch
+			for _, dispatch := range ssn.DispatchActions(actions) {
+				dispatches = append(dispatches, expectedDispatch{action: dispatch.Action.Name(), queues: dispatch.queues})
+			}
+			assert.Equal(t, tt.expectedDispatches, dispatches)
+		})
+	}
+}
+
+func TestActionDispatch_Execute(t *testing.T) {
+	ssn := &Session{}
+	var dispatchedDuringExecution map[common_info.QueueID]bool
+	action := &fakeExecutingAction{execute: func(ssn *Session) {
+		dispatchedDuringExecution = map[common_info.QueueID]bool{
+			"queue":       ssn.IsQueueDispatched("queue"),
+			"other-queue": ssn.IsQueueDispatched("other-queue"),
+		}
+	}}
+
+	ActionDispatch{Action: action, queues: map[common_info.QueueID]bool{"queue": true}}.Execute(ssn)
+
+	assert.Equal(t, map[common_info.QueueID]bool{"queue": true, "other-queue": false}, dispatchedDuringExecution)
+	assert.True(t, ssn.IsQueueDispatched("other-queue"))
+}
+
+type fakeExecutingAction struct {
+	execute func(ssn *Session)
+}
+
+func (a *fakeExecutingAction) Name() ActionType     { return Reclaim }
+func (a *fakeExecutingAction) Execute(ssn *Session) { a.execute(ssn) }
+
+func TestSession_validateQueueActionOrders(t *testing.T) {
+	RegisterAction(&fakeDispatchAction{name: Reclaim})
+	RegisterAction(&fakeDispatchAction{name: Preempt})
+
+	tests := []struct {
+		name             string
+		actions          string
+		queueActionOrder map[string][]string
+		expectedErrors   []string
+	}{
+		{
+			name:             "valid order",
+			actions:          "reclaim, preempt",
+			queueActionOrder: map[string][]string{"queue": {"preempt", "reclaim"}},
+		},
+		{
+			name:             "action that is not configured",
+			actions:          "reclaim",
+			queueActionOrder: map[string][]string{"queue": {"preempt", "reclaim"}},
+			expectedErrors: []string{
+				`invalid action order of queue "queue": action "preempt" is configured 0 times`,
+			},
+		},
+		{
+			name:    "actions that cannot be ordered and duplicate actions",
+			actions: "reclaim, preempt",
+			queueActionOrder: map[string][]string{
+				"queue":       {"preempt", "reclaim", "preempt"},
+				"other-queue": {"stalegangeviction"},
+			},
+			expectedErrors: []string{
+				`invalid action order of queue "other-queue": action "stalegangeviction" cannot be ordered per queue`,
+				`invalid action order of queue "queue": action "preempt" is listed more than once`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{Config: &conf.SchedulerConfiguration{
+				Actions:          tt.actions,
+				QueueActionOrder: tt.queueActionOrder,
+			}}
+
+			errs := ssn.validateQueueActionOrders()
+			var errorMessages []string
+			for _, err := range errs {
+				errorMessages = append(errorMessages, err.Error())
+			}
+			assert.Len(t, errorMessages, len(tt.expectedErrors))
+			for index, expectedError := range tt.expectedErrors {
+				assert.Contains(t, errorMessages[index], expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+// allocationUsageStore keeps the pods reported as allocated across sessions, so they are reported as released once
+// they complete or leave the cluster without being evicted by the scheduler.
+type allocationUsageStore struct {
+	mutex       sync.Mutex
+	allocations map[common_info.PodID]api.AllocationUsageEvent
+}
+
+var allocationUsages = newAllocationUsageStore()
+
+func newAllocationUsageStore() *allocationUsageStore {
+	return &allocationUsageStore{allocations: map[common_info.PodID]api.AllocationUsageEvent{}}
+}
+
+func (s *allocationUsageStore) track(events []api.AllocationUsageEvent) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, event := range events {
+		if event.Type == api.AllocationStarted {
+			s.allocations[event.UID] = event
+		} else {
+			delete(s.allocations, event.UID)
+		}
+	}
+}
+
+// releasedAllocations returns a Released event, stamped with timestamp, for each tracked pod that is no longer in the
+// session or has completed, and stops tracking them.
+func (s *allocationUsageStore) releasedAllocations(
+	ssn *Session, timestamp time.Time,
+) []api.AllocationUsageEvent {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var events []api.AllocationUsageEvent
+	for uid, allocation := range s.allocations {
+		if job, found := ssn.PodGroupInfos[allocation.Job]; found {
+			task, found := job.GetAllPodsMap()[uid]
+			if found && task.Status != pod_status.Succeeded && task.Status != pod_status.Failed {
+				continue
+			}
+		}
+		allocation.Type = api.AllocationStopped
+		allocation.Timestamp = timestamp
+		events = append(events, allocation)
+		delete(s.allocations, uid)
+	}
+	return events
+}
+
+// reportReleasedAllocations reports the tracked pods that completed or left the cluster since the last session.
+func (ssn *Session) reportReleasedAllocations() {
+	if len(ssn.AllocationUsageFns) == 0 {
+		return
+	}
+	usage := ssn.newAllocationUsageRecorder()
+	usage.events = allocationUsages.releasedAllocations(ssn, usage.timestamp)
+	usage.report()
+}
+
+// allocationUsageRecorder collects the allocations started and stopped by a statement commit, to report them to the
+// registered AllocationUsageFns once the commit is done.
+type allocationUsageRecorder struct {
+	ssn       *Session
+	timestamp time.Time
+	events    []api.AllocationUsageEvent
+}
+
+func (ssn *Session) newAllocationUsageRecorder() *allocationUsageRecorder {
+	return &allocationUsageRecorder{ssn: ssn, timestamp: time.Now()}
+}
+
+func (r *allocationUsageRecorder) record(
+	eventType api.AllocationUsageEventType, task *pod_info.PodInfo, node *node_info.NodeInfo,
+) {
+	if len(r.ssn.AllocationUsageFns) == 0 || node == nil {
+		return
+	}
+
+	event := api.AllocationUsageEvent{
+		Type:      eventType,
+		Timestamp: r.timestamp,
+		Job:       task.Job,
+		Namespace: task.Namespace,
+		Name:      task.Name,
+		UID:       task.UID,
+		NodeName:  node.Name,
+		Resources: task.ResReq.Clone(),
+	}
+	if job, found := r.ssn.PodGroupInfos[task.Job]; found {
+		event.Queue = job.Queue
+	}
+	event.GPUs, event.GPUMemory = node.GetTaskGpuUsage(task)
+	r.events = append(r.events, event)
+}
+
+func (r *allocationUsageRecorder) report() {
+	if len(r.events) == 0 {
+		return
+	}
+	allocationUsages.track(r.events)
+	for _, auf := range r.ssn.AllocationUsageFns {
+		auf(r.events)
+	}
+}
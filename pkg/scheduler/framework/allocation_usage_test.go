@@ -0,0 +1,214 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestStatement_Commit_AllocationUsage(t *testing.T) {
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:    pod_status.Running,
+						NodeName: "node0",
+					},
+				},
+			},
+			{
+				Name:                "pending_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue1",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State: pod_status.Pending,
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs:      2,
+				GPUMemory: 1000,
+			},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockCache.EXPECT().Bind(gomock.Any(), "node0", gomock.Any()).Return(nil)
+
+	var reported [][]api.AllocationUsageEvent
+	ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	ssn.AddAllocationUsageFn(func(events []api.AllocationUsageEvent) {
+		reported = append(reported, events)
+	})
+	s := ssn.Statement()
+
+	runningTask := jobsInfoMap["running_job0"].GetAllPodsMap()["running_job0-0"]
+	pendingTask := jobsInfoMap["pending_job0"].GetAllPodsMap()["pending_job0-0"]
+	assert.NoError(t, s.Evict(runningTask, "eviction message", eviction_info.EvictionMetadata{
+		Action:           "action",
+		EvictionGangSize: 1,
+	}))
+	assert.NoError(t, s.Allocate(pendingTask, "node0"))
+	assert.Empty(t, reported, "usage is reported only on commit")
+
+	assert.NoError(t, s.Commit())
+	assert.Len(t, reported, 1)
+	events := reported[0]
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, api.AllocationStopped, events[0].Type)
+	assert.Equal(t, runningTask.UID, events[0].UID)
+	assert.Equal(t, "queue0", string(events[0].Queue))
+	assert.Equal(t, "node0", events[0].NodeName)
+	assert.Equal(t, float64(1), events[0].GPUs)
+	assert.Equal(t, int64(1000), events[0].GPUMemory)
+
+	assert.Equal(t, api.AllocationStarted, events[1].Type)
+	assert.Equal(t, pendingTask.UID, events[1].UID)
+	assert.Equal(t, "queue1", string(events[1].Queue))
+	assert.Equal(t, "node0", events[1].NodeName)
+	assert.Equal(t, float64(1), events[1].GPUs)
+	assert.Equal(t, events[0].Timestamp, events[1].Timestamp)
+}
+
+func TestSession_Evict_AllocationUsage(t *testing.T) {
+	gpuMemory := uint64(250)
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:              "running_job0",
+				RequiredGpuMemory: gpuMemory,
+				QueueName:         "queue0",
+				Priority:          constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:     pod_status.Running,
+						NodeName:  "node0",
+						GPUGroups: []string{"0"},
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs:      1,
+				GPUMemory: 1000,
+			},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	var events []api.AllocationUsageEvent
+	ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	ssn.AddAllocationUsageFn(func(reported []api.AllocationUsageEvent) {
+		events = append(events, reported...)
+	})
+
+	task := jobsInfoMap["running_job0"].GetAllPodsMap()["running_job0-0"]
+	assert.NoError(t, ssn.Evict(task, "eviction message", eviction_info.EvictionMetadata{Action: "action"}))
+
+	assert.Len(t, events, 1)
+	assert.Equal(t, api.AllocationStopped, events[0].Type)
+	assert.Equal(t, 0.25, events[0].GPUs)
+	assert.Equal(t, int64(gpuMemory), events[0].GPUMemory)
+}
+
+func TestSession_ReportReleasedAllocations(t *testing.T) {
+	allocationUsages = newAllocationUsageStore()
+	defer func() { allocationUsages = newAllocationUsageStore() }()
+
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:    pod_status.Running,
+						NodeName: "node0",
+					},
+				},
+			},
+			{
+				Name:                "succeeded_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:    pod_status.Succeeded,
+						NodeName: "node0",
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 2,
+			},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+	allocationUsages.track([]api.AllocationUsageEvent{
+		{Type: api.AllocationStarted, Job: "running_job0", UID: "running_job0-0", NodeName: "node0", GPUs: 1},
+		{Type: api.AllocationStarted, Job: "succeeded_job0", UID: "succeeded_job0-0", NodeName: "node0", GPUs: 1},
+		{Type: api.AllocationStarted, Job: "deleted_job0", UID: "deleted_job0-0", NodeName: "node0", GPUs: 1},
+	})
+
+	var events []api.AllocationUsageEvent
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	ssn.AddAllocationUsageFn(func(reported []api.AllocationUsageEvent) {
+		events = append(events, reported...)
+	})
+	ssn.reportReleasedAllocations()
+
+	var released []string
+	for _, event := range events {
+		assert.Equal(t, api.AllocationStopped, event.Type)
+		assert.Equal(t, "node0", event.NodeName)
+		assert.Equal(t, float64(1), event.GPUs)
+		released = append(released, string(event.UID))
+	}
+	assert.ElementsMatch(t, []string{"succeeded_job0-0", "deleted_job0-0"}, released)
+
+	events = nil
+	ssn.reportReleasedAllocations()
+	assert.Empty(t, events, "released pods are reported once")
+	assert.Contains(t, allocationUsages.allocations, common_info.PodID("running_job0-0"))
+}
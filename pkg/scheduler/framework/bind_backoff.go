@@ -0,0 +1,144 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+const (
+	initialBindBackoff = 2 * time.Second
+	maxBindBackoff     = 5 * time.Minute
+	bindBackoffPath    = "/get-bind-backoff"
+
+	unknownBindFailureReason      = "Unknown"
+	nodeConflictBindFailureReason = "NodeConflict"
+)
+
+// BindBackoff is the backoff state of a pod whose binds failed.
+type BindBackoff struct {
+	Namespace   string        `json:"namespace"`
+	Name        string        `json:"name"`
+	Failures    int           `json:"failures"`
+	LastReason  string        `json:"lastReason"`
+	LastError   string        `json:"lastError"`
+	LastFailure time.Time     `json:"lastFailure"`
+	Backoff     time.Duration `json:"backoff"`
+	RetryAfter  time.Time     `json:"retryAfter"`
+}
+
+// bindBackoffStore tracks failed binds per pod across sessions, so a pod that repeatedly fails to bind is retried
+// with an exponentially increasing delay instead of every scheduling cycle.
+type bindBackoffStore struct {
+	mutex    sync.Mutex
+	backoffs map[common_info.PodID]*BindBackoff
+	now      func() time.Time
+}
+
+var bindBackoffs = newBindBackoffStore()
+
+func newBindBackoffStore() *bindBackoffStore {
+	return &bindBackoffStore{
+		backoffs: map[common_info.PodID]*BindBackoff{},
+		now:      time.Now,
+	}
+}
+
+// recordFailure doubles the pod's backoff, starting from initialBindBackoff and up to maxBindBackoff, and returns the
+// reason of the failure.
+func (s *bindBackoffStore) recordFailure(pod *pod_info.PodInfo, bindError error) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backoff, found := s.backoffs[pod.UID]
+	if !found {
+		backoff = &BindBackoff{Namespace: pod.Namespace, Name: pod.Name}
+		s.backoffs[pod.UID] = backoff
+	}
+
+	now := s.now()
+	backoff.Failures++
+	backoff.LastReason = bindFailureReason(bindError)
+	backoff.LastError = bindError.Error()
+	backoff.LastFailure = now
+	backoff.Backoff = min(initialBindBackoff<<min(backoff.Failures-1, 30), maxBindBackoff)
+	backoff.RetryAfter = now.Add(backoff.Backoff)
+	return backoff.LastReason
+}
+
+func (s *bindBackoffStore) reset(podUID common_info.PodID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.backoffs, podUID)
+}
+
+// inBackoff returns the time left until the pod may be bound again, or false if the pod is not in backoff.
+func (s *bindBackoffStore) inBackoff(podUID common_info.PodID) (time.Duration, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backoff, found := s.backoffs[podUID]
+	if !found {
+		return 0, false
+	}
+	remaining := backoff.RetryAfter.Sub(s.now())
+	return remaining, remaining > 0
+}
+
+// prune drops the pods that were not retried for maxBindBackoff after their backoff expired, such as pods that were
+// deleted or bound by another scheduler.
+func (s *bindBackoffStore) prune() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	for podUID, backoff := range s.backoffs {
+		if now.Sub(backoff.RetryAfter) > maxBindBackoff {
+			delete(s.backoffs, podUID)
+		}
+	}
+}
+
+// serveBackoffs serves the backoff state of all pods whose binds failed, by pod UID.
+func (s *bindBackoffStore) serveBackoffs(w http.ResponseWriter, _ *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s.backoffs); err != nil {
+		http.Error(w, "Failed to encode bind backoff", http.StatusInternalServerError)
+	}
+}
+
+// bindBackoffPrePredicate rejects pods whose bind backoff did not expire yet.
+func bindBackoffPrePredicate(task *pod_info.PodInfo) error {
+	remaining, found := bindBackoffs.inBackoff(task.UID)
+	if !found {
+		return nil
+	}
+	return fmt.Errorf("pod %s/%s failed to bind recently and will be retried in %v",
+		task.Namespace, task.Name, remaining.Round(time.Second))
+}
+
+func bindFailureReason(bindError error) string {
+	if isNodeConflictBindError(bindError) {
+		return nodeConflictBindFailureReason
+	}
+	if reason := errors.ReasonForError(bindError); reason != "" {
+		return string(reason)
+	}
+	return unknownBindFailureReason
+}
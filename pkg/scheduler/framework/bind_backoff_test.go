@@ -0,0 +1,161 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+)
+
+func TestBindBackoff(t *testing.T) {
+	tests := []struct {
+		name            string
+		failures        int
+		elapsed         time.Duration
+		expectedBackoff time.Duration
+		expectedBlocked bool
+	}{
+		{
+			name:            "first failure",
+			failures:        1,
+			expectedBackoff: initialBindBackoff,
+			expectedBlocked: true,
+		},
+		{
+			name:            "backoff doubles with each failure",
+			failures:        3,
+			expectedBackoff: 4 * initialBindBackoff,
+			expectedBlocked: true,
+		},
+		{
+			name:            "backoff is capped",
+			failures:        20,
+			expectedBackoff: maxBindBackoff,
+			expectedBlocked: true,
+		},
+		{
+			name:            "pod is retried once the backoff expires",
+			failures:        2,
+			elapsed:         2 * initialBindBackoff,
+			expectedBackoff: 2 * initialBindBackoff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			store := newBindBackoffStore()
+			store.now = func() time.Time { return now }
+			pod := &pod_info.PodInfo{UID: "p1", Namespace: "ns", Name: "pod"}
+
+			for range tt.failures {
+				store.recordFailure(pod, fmt.Errorf("bind failed"))
+			}
+			now = now.Add(tt.elapsed)
+
+			assert.Equal(t, tt.failures, store.backoffs[pod.UID].Failures)
+			assert.Equal(t, tt.expectedBackoff, store.backoffs[pod.UID].Backoff)
+			remaining, blocked := store.inBackoff(pod.UID)
+			assert.Equal(t, tt.expectedBlocked, blocked)
+			if blocked {
+				assert.Equal(t, tt.expectedBackoff-tt.elapsed, remaining)
+			}
+		})
+	}
+}
+
+func TestBindBackoff_ResetAndPrune(t *testing.T) {
+	now := time.Now()
+	store := newBindBackoffStore()
+	store.now = func() time.Time { return now }
+	bound := &pod_info.PodInfo{UID: "bound"}
+	deleted := &pod_info.PodInfo{UID: "deleted"}
+	failing := &pod_info.PodInfo{UID: "failing"}
+
+	store.recordFailure(bound, fmt.Errorf("bind failed"))
+	store.recordFailure(deleted, fmt.Errorf("bind failed"))
+	store.reset(bound.UID)
+	_, blocked := store.inBackoff(bound.UID)
+	assert.False(t, blocked, "successful bind resets the backoff")
+
+	now = now.Add(initialBindBackoff + maxBindBackoff)
+	store.recordFailure(failing, fmt.Errorf("bind failed"))
+	now = now.Add(initialBindBackoff)
+	store.prune()
+
+	assert.NotContains(t, store.backoffs, deleted.UID)
+	assert.Contains(t, store.backoffs, failing.UID)
+}
+
+func TestBindFailureReason(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedReason string
+	}{
+		{
+			name:           "conflict",
+			err:            errors.NewConflict(schema.GroupResource{Resource: "bindrequests"}, "pod", fmt.Errorf("conflict")),
+			expectedReason: "Conflict",
+		},
+		{
+			name:           "webhook rejection",
+			err:            errors.NewForbidden(schema.GroupResource{Resource: "bindrequests"}, "pod", fmt.Errorf("denied")),
+			expectedReason: "Forbidden",
+		},
+		{
+			name:           "joined with a status update error",
+			err:            fmt.Errorf("%w; %w", errors.NewConflict(schema.GroupResource{}, "pod", nil), fmt.Errorf("x")),
+			expectedReason: "Conflict",
+		},
+		{
+			name:           "node conflict",
+			err:            fmt.Errorf("%w: node is not ready", cache.ErrBindNodeConflict),
+			expectedReason: nodeConflictBindFailureReason,
+		},
+		{
+			name:           "not an API error",
+			err:            fmt.Errorf("connection refused"),
+			expectedReason: unknownBindFailureReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedReason, bindFailureReason(tt.err))
+		})
+	}
+}
+
+func TestBindBackoff_PrePredicateAndServe(t *testing.T) {
+	bindBackoffs = newBindBackoffStore()
+	defer func() { bindBackoffs = newBindBackoffStore() }()
+	pod := &pod_info.PodInfo{UID: "p1", Namespace: "ns", Name: "pod"}
+	ssn := &Session{}
+
+	assert.NoError(t, ssn.PrePredicateFn(pod, nil))
+	bindBackoffs.recordFailure(pod, errors.NewConflict(schema.GroupResource{}, "pod", fmt.Errorf("conflict")))
+	assert.Error(t, ssn.PrePredicateFn(pod, nil))
+
+	recorder := httptest.NewRecorder()
+	bindBackoffs.serveBackoffs(recorder, httptest.NewRequest(http.MethodGet, bindBackoffPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var backoffs map[common_info.PodID]BindBackoff
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &backoffs))
+	assert.Equal(t, 1, backoffs[pod.UID].Failures)
+	assert.Equal(t, "Conflict", backoffs[pod.UID].LastReason)
+	assert.Equal(t, initialBindBackoff, backoffs[pod.UID].Backoff)
+}
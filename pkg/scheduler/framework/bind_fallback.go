@@ -0,0 +1,124 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// isNodeConflictBindError returns whether a bind failed because its node changed since the snapshot, so the pod may
+// still be bound to another node.
+func isNodeConflictBindError(err error) bool {
+	return errors.Is(err, cache.ErrBindNodeConflict)
+}
+
+// nodeConflict is a task whose bind to node failed with a node conflict. index is the position of the task in the
+// batch it was bound in.
+type nodeConflict struct {
+	index int
+	task  *pod_info.PodInfo
+	node  *node_info.NodeInfo
+	err   error
+}
+
+// bindOnFallbackNodes binds the tasks, whose binds failed with a node conflict, to the next best nodes that fit them,
+// up to MaxBindFallbackAttempts times. The tasks retried in an attempt are bound together with BindPodBatch. The
+// conflicts are returned with the final bind error of their task, nil if a fallback bind succeeded, and the tasks that
+// were not bound are left unallocated.
+func (s *Statement) bindOnFallbackNodes(conflicts []nodeConflict) []nodeConflict {
+	excludedNodes := make([]map[string]bool, len(conflicts))
+	pending := make([]int, len(conflicts))
+	for i := range conflicts {
+		excludedNodes[i] = map[string]bool{}
+		pending[i] = i
+	}
+
+	for attempt := 0; len(pending) > 0; attempt++ {
+		var retried []int
+		for _, i := range pending {
+			conflict := &conflicts[i]
+			task := conflict.task
+			log.InfraLogger.V(2).Warnf("Failed to bind task <%v/%v> to node <%v> due to a node conflict: %v",
+				task.Namespace, task.Name, conflict.node.Name, conflict.err)
+			excludedNodes[i][conflict.node.Name] = true
+			s.cleanupFailedAllocation(task)
+
+			if attempt >= s.ssn.SchedulerParams.MaxBindFallbackAttempts || !s.canBindOnFallbackNode(task) {
+				continue
+			}
+			node := s.fallbackBindNode(task, excludedNodes[i])
+			if node == nil {
+				log.InfraLogger.V(4).Infof("No fallback node to bind task <%v/%v> to", task.Namespace, task.Name)
+				continue
+			}
+			if err := s.allocateInSession(task, node.Name); err != nil {
+				s.cleanupFailedAllocation(task)
+				continue
+			}
+
+			log.InfraLogger.V(3).Infof("Binding task <%v/%v> to fallback node <%v>, attempt %d",
+				task.Namespace, task.Name, node.Name, attempt+1)
+			conflict.node = node
+			retried = append(retried, i)
+		}
+
+		tasks := make([]*pod_info.PodInfo, len(retried))
+		for j, i := range retried {
+			tasks[j] = conflicts[i].task
+		}
+		bindErrors := s.ssn.BindPodBatch(tasks)
+
+		pending = nil
+		for j, i := range retried {
+			conflict := &conflicts[i]
+			conflict.err = bindErrors[j]
+			if conflict.err == nil {
+				continue
+			}
+			if isNodeConflictBindError(conflict.err) {
+				pending = append(pending, i)
+				continue
+			}
+			log.InfraLogger.Errorf("Failed to bind task <%v/%v>. Error: %v",
+				conflict.task.Namespace, conflict.task.Name, conflict.err)
+			s.cleanupFailedAllocation(conflict.task)
+		}
+	}
+	return conflicts
+}
+
+// canBindOnFallbackNode returns whether the task's placement only depends on the fitting of its node. GPU sharing
+// tasks are placed on specific GPUs and tasks of topology constrained jobs on specific domains, so they are left for
+// the next session. Pods placed by ForceAllocate are pinned to their node by an admin.
+func (s *Statement) canBindOnFallbackNode(task *pod_info.PodInfo) bool {
+	if task.IsSharedGPURequest() || s.ssn.forceAllocatedPods[task.UID] {
+		return false
+	}
+	job, found := s.ssn.PodGroupInfos[task.Job]
+	return found && job.TopologyConstraint == nil
+}
+
+// fallbackBindNode returns the best node of the task's queue, other than the excluded nodes, that the task can be
+// allocated on, or nil if there is none.
+func (s *Statement) fallbackBindNode(task *pod_info.PodInfo, excludedNodes map[string]bool) *node_info.NodeInfo {
+	job := s.ssn.PodGroupInfos[task.Job]
+	var candidates []*node_info.NodeInfo
+	for _, node := range s.ssn.NodesForQueue(job.Queue) {
+		if !excludedNodes[node.Name] {
+			candidates = append(candidates, node)
+		}
+	}
+
+	for _, node := range s.ssn.OrderedNodesByTask(candidates, task) {
+		if node.IsTaskAllocatable(task) && s.ssn.FittingNode(task, node, false) {
+			return node
+		}
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestStatement_Commit_BindFallback(t *testing.T) {
+	nodeConflict := fmt.Errorf("%w: node is not ready", cache.ErrBindNodeConflict)
+
+	tests := []struct {
+		name                    string
+		nodes                   map[string]nodes_fake.TestNodeBasic
+		fractional              bool
+		maxBindFallbackAttempts int
+		bindErrors              map[string]error
+		expectedBinds           []string
+		expectedNode            string
+		expectError             bool
+	}{
+		{
+			name: "binds to the next node after a node conflict",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+			},
+			maxBindFallbackAttempts: 2,
+			bindErrors:              map[string]error{"node0": nodeConflict},
+			expectedBinds:           []string{"node0", "node1"},
+			expectedNode:            "node1",
+		},
+		{
+			name: "fallback disabled",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+			},
+			bindErrors:    map[string]error{"node0": nodeConflict},
+			expectedBinds: []string{"node0"},
+			expectError:   true,
+		},
+		{
+			name: "other bind errors are not retried",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+			},
+			maxBindFallbackAttempts: 2,
+			bindErrors:              map[string]error{"node0": fmt.Errorf("connection refused")},
+			expectedBinds:           []string{"node0"},
+			expectError:             true,
+		},
+		{
+			name: "no other node fits the task",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 0},
+			},
+			maxBindFallbackAttempts: 2,
+			bindErrors:              map[string]error{"node0": nodeConflict},
+			expectedBinds:           []string{"node0"},
+			expectError:             true,
+		},
+		{
+			name: "fallback attempts are bounded",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+				"node2": {GPUs: 2},
+			},
+			maxBindFallbackAttempts: 1,
+			bindErrors:              map[string]error{"node0": nodeConflict, "node1": nodeConflict, "node2": nodeConflict},
+			expectedBinds:           []string{"node0", "node1"},
+			expectError:             true,
+		},
+		{
+			name: "gpu sharing tasks are not retried",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+			},
+			fractional:              true,
+			maxBindFallbackAttempts: 2,
+			bindErrors:              map[string]error{"node0": nodeConflict},
+			expectedBinds:           []string{"node0"},
+			expectError:             true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &jobs_fake.TestJobBasic{
+				Name:                "pending_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State: pod_status.Pending,
+					},
+				},
+			}
+			if tt.fractional {
+				job.RequiredGPUsPerTask = 0.5
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{job})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(tt.nodes, tasksToNodeMap, nil)
+
+			var binds []string
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *pod_info.PodInfo, hostname string, _ map[string]string) error {
+					binds = append(binds, hostname)
+					return tt.bindErrors[hostname]
+				}).AnyTimes()
+
+			ssn := &Session{
+				UID:           "1",
+				Cache:         mockCache,
+				PodGroupInfos: jobsInfoMap,
+				Nodes:         nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{
+					MaxBindFallbackAttempts: tt.maxBindFallbackAttempts,
+				},
+			}
+			defer func() { bindBackoffs = newBindBackoffStore() }()
+
+			task := jobsInfoMap["pending_job0"].GetAllPodsMap()["pending_job0-0"]
+			if tt.fractional {
+				task.GPUGroups = []string{"0"}
+			}
+			s := ssn.Statement()
+			assert.NoError(t, s.Allocate(task, "node0"))
+
+			err := s.Commit()
+			assert.Equal(t, tt.expectError, err != nil)
+			assert.Equal(t, tt.expectedBinds, binds)
+
+			committedTask := jobsInfoMap["pending_job0"].GetAllPodsMap()["pending_job0-0"]
+			for nodeName, node := range nodesInfoMap {
+				_, found := node.PodInfos[pod_info.PodKey(committedTask.Pod)]
+				assert.Equal(t, nodeName == tt.expectedNode, found, "task on node %s", nodeName)
+			}
+			if tt.expectError {
+				assert.Equal(t, pod_status.Pending, committedTask.Status)
+			} else {
+				assert.Equal(t, pod_status.Binding, committedTask.Status)
+				assert.Equal(t, tt.expectedNode, committedTask.NodeName)
+			}
+		})
+	}
+}
+
+func TestStatement_Commit_BindsAllocationsInBatch(t *testing.T) {
+	const numTasks = 3
+	nodeConflict := fmt.Errorf("%w: node is not ready", cache.ErrBindNodeConflict)
+
+	job := &jobs_fake.TestJobBasic{
+		Name:                "pending_job0",
+		RequiredGPUsPerTask: 1,
+		QueueName:           "queue0",
+		Priority:            constants.PriorityTrainNumber,
+	}
+	for range numTasks {
+		job.Tasks = append(job.Tasks, &tasks_fake.TestTaskBasic{State: pod_status.Pending})
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{job})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: numTasks},
+		"node1": {GPUs: numTasks},
+	}, tasksToNodeMap, nil)
+
+	// Every bind waits for the other binds of its batch, so the commit only completes if the batches run concurrently.
+	var mutex sync.Mutex
+	binds := map[string]int{}
+	arrived := map[string]chan struct{}{"node0": make(chan struct{}), "node1": make(chan struct{})}
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ *pod_info.PodInfo, hostname string, _ map[string]string) error {
+			mutex.Lock()
+			binds[hostname]++
+			if binds[hostname] == numTasks {
+				close(arrived[hostname])
+			}
+			mutex.Unlock()
+
+			select {
+			case <-arrived[hostname]:
+			case <-time.After(5 * time.Second):
+				return fmt.Errorf("bind to %s was not batched", hostname)
+			}
+			if hostname == "node0" {
+				return nodeConflict
+			}
+			return nil
+		}).Times(2 * numTasks)
+
+	ssn := &Session{
+		UID:           "1",
+		Cache:         mockCache,
+		PodGroupInfos: jobsInfoMap,
+		Nodes:         nodesInfoMap,
+		SchedulerParams: conf.SchedulerParams{
+			MaxBindFallbackAttempts: 1,
+		},
+	}
+	defer func() { bindBackoffs = newBindBackoffStore() }()
+
+	s := ssn.Statement()
+	for _, task := range jobsInfoMap["pending_job0"].GetAllPodsMap() {
+		assert.NoError(t, s.Allocate(task, "node0"))
+	}
+
+	assert.NoError(t, s.Commit())
+	assert.Equal(t, map[string]int{"node0": numTasks, "node1": numTasks}, binds)
+	for _, task := range jobsInfoMap["pending_job0"].GetAllPodsMap() {
+		assert.Equal(t, pod_status.Binding, task.Status)
+		assert.Equal(t, "node1", task.NodeName)
+	}
+}
+
+func TestStatement_Commit_RollsBackBatchOnBindFailure(t *testing.T) {
+	const numTasks = 3
+	job := &jobs_fake.TestJobBasic{
+		Name:                "pending_job0",
+		RequiredGPUsPerTask: 1,
+		QueueName:           "queue0",
+		Priority:            constants.PriorityTrainNumber,
+	}
+	for range numTasks {
+		job.Tasks = append(job.Tasks, &tasks_fake.TestTaskBasic{State: pod_status.Pending})
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{job})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: numTasks},
+	}, tasksToNodeMap, nil)
+
+	var mutex sync.Mutex
+	var evicted []string
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(pod *pod_info.PodInfo, _ string, _ map[string]string) error {
+			if pod.Name == "pending_job0-1" {
+				return fmt.Errorf("connection refused")
+			}
+			return nil
+		}).Times(numTasks)
+	mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(pod *v1.Pod, _ *podgroup_info.PodGroupInfo, _ eviction_info.EvictionMetadata, _ string) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			evicted = append(evicted, pod.Name)
+			return nil
+		}).Times(numTasks - 1)
+
+	ssn := &Session{
+		UID:           "1",
+		Cache:         mockCache,
+		PodGroupInfos: jobsInfoMap,
+		Nodes:         nodesInfoMap,
+	}
+	defer func() { bindBackoffs = newBindBackoffStore() }()
+
+	s := ssn.Statement()
+	for _, task := range jobsInfoMap["pending_job0"].GetAllPodsMap() {
+		assert.NoError(t, s.Allocate(task, "node0"))
+	}
+
+	assert.ErrorContains(t, s.Commit(), "connection refused")
+	assert.ElementsMatch(t, []string{"pending_job0-0", "pending_job0-2"}, evicted)
+	for _, task := range jobsInfoMap["pending_job0"].GetAllPodsMap() {
+		assert.Equal(t, pod_status.Pending, task.Status)
+		assert.Equal(t, "", task.NodeName)
+	}
+	assert.Empty(t, nodesInfoMap["node0"].PodInfos)
+}
+
+func TestStatement_Commit_MissingNodeCleansUpBatch(t *testing.T) {
+	job := &jobs_fake.TestJobBasic{
+		Name:                "pending_job0",
+		RequiredGPUsPerTask: 1,
+		QueueName:           "queue0",
+		Priority:            constants.PriorityTrainNumber,
+		Tasks: []*tasks_fake.TestTaskBasic{
+			{State: pod_status.Pending},
+			{State: pod_status.Pending},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{job})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 1},
+		"node1": {GPUs: 1},
+	}, tasksToNodeMap, nil)
+
+	controller := gomock.NewController(t)
+	ssn := &Session{
+		UID:           "1",
+		Cache:         cache.NewMockCache(controller),
+		PodGroupInfos: jobsInfoMap,
+		Nodes:         nodesInfoMap,
+	}
+
+	s := ssn.Statement()
+	tasks := jobsInfoMap["pending_job0"].GetAllPodsMap()
+	assert.NoError(t, s.Allocate(tasks["pending_job0-0"], "node0"))
+	assert.NoError(t, s.Allocate(tasks["pending_job0-1"], "node1"))
+	delete(nodesInfoMap, "node1")
+
+	err := s.Commit()
+	assert.ErrorContains(t, err, "pending_job0-1")
+	assert.ErrorContains(t, err, "node <node1> doesn't exist on cluster")
+	for _, task := range tasks {
+		assert.Equal(t, pod_status.Pending, task.Status)
+	}
+	assert.Empty(t, nodesInfoMap["node0"].PodInfos)
+}
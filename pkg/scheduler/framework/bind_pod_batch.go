@@ -0,0 +1,50 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+// maxConcurrentBinds bounds the number of binds that BindPodBatch runs at once.
+const maxConcurrentBinds = 16
+
+// BindPodBatch binds the pods to their nodes like BindPod, running up to maxConcurrentBinds binds at once, which
+// saves the serial round trips to the API server when binding the tasks of a large gang. The bind request annotations
+// are built and the session is updated in the order of the pods, outside the concurrent binds. A pod that fails to
+// bind does not stop the other binds, and the returned errors hold the error of every pod, nil for bound pods.
+// Cache.Bind is safe to run concurrently for different pods: the status updater only touches the bound pod, and queues
+// its updates through a sync.Map and a channel.
+func (ssn *Session) BindPodBatch(pods []*pod_info.PodInfo) []error {
+	bindRequestAnnotations := make([]map[string]string, len(pods))
+	for i, pod := range pods {
+		bindRequestAnnotations[i] = ssn.MutateBindRequestAnnotations(pod, pod.NodeName)
+	}
+
+	bindErrors := make([]error, len(pods))
+	indices := make(chan int, len(pods))
+	for i := range pods {
+		indices <- i
+	}
+	close(indices)
+
+	wg := sync.WaitGroup{}
+	for range min(maxConcurrentBinds, len(pods)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				bindErrors[i] = ssn.Cache.Bind(pods[i], pods[i].NodeName, bindRequestAnnotations[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, pod := range pods {
+		bindErrors[i] = ssn.completeBind(pod, bindErrors[i])
+	}
+	return bindErrors
+}
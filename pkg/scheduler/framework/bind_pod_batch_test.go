@@ -0,0 +1,72 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestBindPodBatch(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "gang_job0",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Allocated, NodeName: "node0"},
+				{State: pod_status.Allocated, NodeName: "node1"},
+				{State: pod_status.Allocated, NodeName: "node2"},
+			},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 1}, "node1": {GPUs: 1}, "node2": {GPUs: 1},
+	}, tasksToNodeMap, nil)
+
+	bindErr := errors.New("bind request rejected")
+	var mutex sync.Mutex
+	binds := map[string]string{}
+	controller := gomock.NewController(t)
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(pod *pod_info.PodInfo, hostname string, _ map[string]string) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			binds[pod.Name] = hostname
+			if hostname == "node1" {
+				return bindErr
+			}
+			return nil
+		}).Times(3)
+
+	ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	defer func() { bindBackoffs = newBindBackoffStore() }()
+
+	tasks := jobsInfoMap["gang_job0"].GetAllPodsMap()
+	pods := []*pod_info.PodInfo{tasks["gang_job0-0"], tasks["gang_job0-1"], tasks["gang_job0-2"]}
+	bindErrors := ssn.BindPodBatch(pods)
+
+	assert.Equal(t, []error{nil, bindErr, nil}, bindErrors)
+	assert.Equal(t, map[string]string{
+		"gang_job0-0": "node0", "gang_job0-1": "node1", "gang_job0-2": "node2",
+	}, binds)
+	assert.Equal(t, pod_status.Binding, tasks["gang_job0-0"].Status)
+	assert.Equal(t, pod_status.Allocated, tasks["gang_job0-1"].Status)
+	assert.Equal(t, pod_status.Binding, tasks["gang_job0-2"].Status)
+	assert.Empty(t, ssn.BindPodBatch(nil))
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+// FnName names a kind of session function that plugins register in OnSessionOpen.
+type FnName string
+
+const (
+	GPUOrderFnName                           FnName = "GPUOrderFn"
+	GpuFilterFnName                          FnName = "GpuFilterFn"
+	NodePreOrderFnName                       FnName = "NodePreOrderFn"
+	NodeOrderFnName                          FnName = "NodeOrderFn"
+	PrePredicateFnName                       FnName = "PrePredicateFn"
+	SubsetNodesFnName                        FnName = "SubsetNodesFn"
+	PredicateFnName                          FnName = "PredicateFn"
+	JobOrderFnName                           FnName = "JobOrderFn"
+	TaskOrderFnName                          FnName = "TaskOrderFn"
+	SubGroupsOrderFnName                     FnName = "SubGroupsOrderFn"
+	QueueOrderFnName                         FnName = "QueueOrderFn"
+	OnJobSolutionStartFnName                 FnName = "OnJobSolutionStartFn"
+	GetQueueAllocatedResourcesFnName         FnName = "GetQueueAllocatedResourcesFn"
+	GetQueueDeservedResourcesFnName          FnName = "GetQueueDeservedResourcesFn"
+	GetQueueFairShareFnName                  FnName = "GetQueueFairShareFn"
+	PreemptVictimFilterFnName                FnName = "PreemptVictimFilterFn"
+	ReclaimVictimFilterFnName                FnName = "ReclaimVictimFilterFn"
+	CanReclaimResourcesFnName                FnName = "CanReclaimResourcesFn"
+	ReclaimScenarioValidatorFnName           FnName = "ReclaimScenarioValidatorFn"
+	PreemptScenarioValidatorFnName           FnName = "PreemptScenarioValidatorFn"
+	CommitValidatorFnName                    FnName = "CommitValidatorFn"
+	AllocationUsageFnName                    FnName = "AllocationUsageFn"
+	BindRequestMutateFnName                  FnName = "BindRequestMutateFn"
+	TaskResourceMutateFnName                 FnName = "TaskResourceMutateFn"
+	GpuDeviceIdFnName                        FnName = "GpuDeviceIdFn"
+	IsNonPreemptibleJobOverQueueQuotaFnName  FnName = "IsNonPreemptibleJobOverQueueQuotaFn"
+	IsJobOverCapacityFnName                  FnName = "IsJobOverCapacityFn"
+	IsTaskAllocationOnNodeOverCapacityFnName FnName = "IsTaskAllocationOnNodeOverCapacityFn"
+	EventHandlerFnName                       FnName = "EventHandler"
+	HttpHandlerFnName                        FnName = "HttpHandler"
+)
+
+// requiredFns are the functions that the scheduling actions cannot work without.
+var requiredFns = []FnName{QueueOrderFnName, JobOrderFnName}
+
+// FnsClaimer is implemented by plugins that declare the functions they register in OnSessionOpen, so that
+// Session.ValidateConfig can catch a plugin that silently registered less than it should.
+type FnsClaimer interface {
+	ClaimedFns() []FnName
+}
+
+func (ssn *Session) recordRegisteredFn(fn FnName) {
+	if ssn.registeredFns == nil {
+		ssn.registeredFns = map[string]map[FnName]int{}
+	}
+	if ssn.registeredFns[ssn.registeringPlugin] == nil {
+		ssn.registeredFns[ssn.registeringPlugin] = map[FnName]int{}
+	}
+	ssn.registeredFns[ssn.registeringPlugin][fn]++
+}
+
+// ValidateConfig checks that the plugin chain of the scheduler configuration is usable: every configured plugin
+// exists and registered the functions it claims, the functions required by the actions were registered, the queue
+// depth is configured for known actions only, and the queue action orders reorder configured actions. All the
+// problems found are returned in a single error.
+func (ssn *Session) ValidateConfig() error {
+	if ssn.Config == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, tier := range ssn.Config.Tiers {
+		for _, pluginOption := range tier.Plugins {
+			if _, found := GetPluginBuilder(pluginOption.Name); !found {
+				errs = append(errs, fmt.Errorf("plugin %q is configured but does not exist", pluginOption.Name))
+			}
+		}
+	}
+
+	pluginNames := maps.Keys(ssn.plugins)
+	slices.Sort(pluginNames)
+	for _, pluginName := range pluginNames {
+		claimer, ok := ssn.plugins[pluginName].(FnsClaimer)
+		if !ok {
+			continue
+		}
+		for _, fn := range claimer.ClaimedFns() {
+			if ssn.registeredFns[pluginName][fn] == 0 {
+				errs = append(errs, fmt.Errorf("plugin %q claims to register a %s but did not", pluginName, fn))
+			}
+		}
+	}
+
+	// A session opened without any plugin is a bare session, as used by tools and tests, and has nothing to order by.
+	if len(ssn.plugins) > 0 {
+		for _, fn := range requiredFns {
+			if !ssn.isFnRegistered(fn) {
+				errs = append(errs, fmt.Errorf("no configured plugin registers a %s", fn))
+			}
+		}
+	}
+
+	actionNames := maps.Keys(ssn.Config.QueueDepthPerAction)
+	slices.Sort(actionNames)
+	for _, actionName := range actionNames {
+		if _, found := GetAction(actionName); !found {
+			errs = append(errs, fmt.Errorf("queue depth is configured for unknown action %q", actionName))
+		}
+	}
+
+	errs = append(errs, ssn.validateQueueActionOrders()...)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid scheduler configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (ssn *Session) validateQueueActionOrders() []error {
+	if len(ssn.Config.QueueActionOrder) == 0 || ssn.Config.Actions == "" {
+		return nil
+	}
+	var actions []Action
+	for _, actionName := range strings.Split(ssn.Config.Actions, ",") {
+		if action, found := GetAction(strings.TrimSpace(actionName)); found {
+			actions = append(actions, action)
+		}
+	}
+
+	var errs []error
+	queueNames := maps.Keys(ssn.Config.QueueActionOrder)
+	slices.Sort(queueNames)
+	for _, queueName := range queueNames {
+		order := make([]ActionType, 0, len(ssn.Config.QueueActionOrder[queueName]))
+		for _, actionName := range ssn.Config.QueueActionOrder[queueName] {
+			order = append(order, ActionType(actionName))
+		}
+		if err := validateQueueActionOrder(actions, order); err != nil {
+			errs = append(errs, fmt.Errorf("invalid action order of queue %q: %w", queueName, err))
+		}
+	}
+	return errs
+}
+
+func (ssn *Session) isFnRegistered(fn FnName) bool {
+	for _, fns := range ssn.registeredFns {
+		if fns[fn] > 0 {
+			return true
+		}
+	}
+	return false
+}
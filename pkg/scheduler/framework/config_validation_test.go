@@ -0,0 +1,144 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+type fakeValidationPlugin struct {
+	name string
+	open func(ssn *Session)
+}
+
+var closedValidationPlugins []string
+
+func (p *fakeValidationPlugin) Name() string               { return p.name }
+func (p *fakeValidationPlugin) OnSessionOpen(ssn *Session) { p.open(ssn) }
+func (p *fakeValidationPlugin) OnSessionClose(*Session) {
+	closedValidationPlugins = append(closedValidationPlugins, p.name)
+}
+
+type fakeClaimingPlugin struct {
+	fakeValidationPlugin
+	claims []FnName
+}
+
+func (p *fakeClaimingPlugin) ClaimedFns() []FnName { return p.claims }
+
+type fakeValidationAction struct{}
+
+func (a *fakeValidationAction) Name() ActionType { return "fakevalidationaction" }
+func (a *fakeValidationAction) Execute(*Session) {}
+
+func TestSession_ValidateConfig(t *testing.T) {
+	RegisterAction(&fakeValidationAction{})
+	RegisterPluginBuilder("fake-ordering", func(map[string]string) Plugin {
+		return &fakeValidationPlugin{name: "fake-ordering", open: func(ssn *Session) {
+			ssn.AddQueueOrderFn(func(_, _ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo,
+				_, _ []*podgroup_info.PodGroupInfo) int {
+				return 0
+			})
+			ssn.AddJobOrderFn(func(_, _ interface{}) int { return 0 })
+		}}
+	})
+	RegisterPluginBuilder("fake-claiming", func(map[string]string) Plugin {
+		return &fakeClaimingPlugin{
+			fakeValidationPlugin: fakeValidationPlugin{name: "fake-claiming", open: func(ssn *Session) {
+				ssn.AddTaskOrderFn(func(_, _ interface{}) int { return 0 })
+			}},
+			claims: []FnName{TaskOrderFnName},
+		}
+	})
+	RegisterPluginBuilder("fake-lazy", func(map[string]string) Plugin {
+		return &fakeClaimingPlugin{
+			fakeValidationPlugin: fakeValidationPlugin{name: "fake-lazy", open: func(*Session) {}},
+			claims:               []FnName{PredicateFnName},
+		}
+	})
+
+	tests := []struct {
+		name                string
+		plugins             []string
+		queueDepthPerAction map[string]int
+		expectedErrors      []string
+		expectedClosed      []string
+	}{
+		{
+			name:    "valid configuration",
+			plugins: []string{"fake-ordering", "fake-claiming"},
+			queueDepthPerAction: map[string]int{
+				"fakevalidationaction": 10,
+			},
+		},
+		{
+			name: "no plugins",
+		},
+		{
+			name:           "misspelled plugin",
+			plugins:        []string{"fake-ordering", "fake-ordrering"},
+			expectedErrors: []string{`plugin "fake-ordrering" is configured but does not exist`},
+		},
+		{
+			name:           "plugin that does not register the functions it claims",
+			plugins:        []string{"fake-ordering", "fake-lazy"},
+			expectedErrors: []string{`plugin "fake-lazy" claims to register a PredicateFn but did not`},
+			expectedClosed: []string{"fake-lazy", "fake-ordering"},
+		},
+		{
+			name:    "missing required functions",
+			plugins: []string{"fake-claiming"},
+			expectedErrors: []string{
+				"no configured plugin registers a QueueOrderFn",
+				"no configured plugin registers a JobOrderFn",
+			},
+		},
+		{
+			name:    "queue depth of an unknown action",
+			plugins: []string{"fake-ordering"},
+			queueDepthPerAction: map[string]int{
+				"fakevalidationaction": 10,
+				"alocate":              5,
+			},
+			expectedErrors: []string{`queue depth is configured for unknown action "alocate"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pluginOptions []conf.PluginOption
+			for _, plugin := range tt.plugins {
+				pluginOptions = append(pluginOptions, conf.PluginOption{Name: plugin})
+			}
+			ssn := &Session{
+				plugins: map[string]Plugin{},
+				Config: &conf.SchedulerConfiguration{
+					Tiers:               []conf.Tier{{Plugins: pluginOptions}},
+					QueueDepthPerAction: tt.queueDepthPerAction,
+				},
+			}
+			closedValidationPlugins = nil
+
+			err := ssn.openValidatedPlugins()
+			if len(tt.expectedErrors) == 0 {
+				assert.NoError(t, err)
+				assert.Empty(t, closedValidationPlugins)
+				return
+			}
+			assert.Error(t, err)
+			for _, expectedError := range tt.expectedErrors {
+				assert.Contains(t, err.Error(), expectedError)
+			}
+			if tt.expectedClosed != nil {
+				assert.ElementsMatch(t, tt.expectedClosed, closedValidationPlugins)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// CrossPartitionReclaimEnabled returns true if jobs of other node pools may be reclaimed by jobs of the session's
+// partition. When enabled, the session also holds the nodes, jobs and queues of these node pools. Their jobs are not
+// scheduled and their statuses are not recorded by the session, they are only considered as reclaim victims.
+func (ssn *Session) CrossPartitionReclaimEnabled() bool {
+	return ssn.SchedulerParams.PartitionParams.CrossPartitionReclaimEnabled()
+}
+
+// IsCrossPartitionJob returns true if the job belongs to another node pool, and is in the session only as a
+// potential reclaim victim.
+func (ssn *Session) IsCrossPartitionJob(job *podgroup_info.PodGroupInfo) bool {
+	if job == nil || job.PodGroup == nil {
+		return false
+	}
+	return ssn.SchedulerParams.PartitionParams.IsCrossPartition(job.PodGroup.Labels)
+}
+
+// IsCrossPartitionNode returns true if the node belongs to another node pool.
+func (ssn *Session) IsCrossPartitionNode(node *node_info.NodeInfo) bool {
+	if node == nil || node.Node == nil {
+		return false
+	}
+	return ssn.SchedulerParams.PartitionParams.IsCrossPartition(node.Node.Labels)
+}
+
+// predicateCrossPartitionNode allows tasks on a node of another node pool only when the node releases pods of a
+// cross partition job, so the session uses the nodes of other node pools only for the resources it reclaimed from
+// them, and never takes their idle resources.
+func (ssn *Session) predicateCrossPartitionNode(task *pod_info.PodInfo, node *node_info.NodeInfo) *common_info.FitError {
+	if !ssn.IsCrossPartitionNode(node) {
+		return nil
+	}
+	for _, podInfo := range node.PodInfos {
+		if podInfo.Status == pod_status.Releasing && ssn.IsCrossPartitionJob(ssn.PodGroupInfos[podInfo.Job]) {
+			log.InfraLogger.V(2).Infof("Allowing task <%s/%s> on node <%s> of another node pool, "+
+				"which releases cross partition pod <%s/%s>",
+				task.Namespace, task.Name, node.Name, podInfo.Namespace, podInfo.Name)
+			return nil
+		}
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+		fmt.Sprintf("node belongs to node pool %s, which is only used for cross partition reclaim",
+			ssn.nodePoolOf(node)))
+}
+
+func (ssn *Session) nodePoolOf(node *node_info.NodeInfo) string {
+	return node.Node.Labels[ssn.SchedulerParams.PartitionParams.NodePoolLabelKey]
+}
+
+func (ssn *Session) logCrossPartitionEviction(pod *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, message string) {
+	if !ssn.IsCrossPartitionJob(job) {
+		return
+	}
+	log.InfraLogger.V(1).Warnf("Evicting pod <%s/%s> of job <%s> of node pool <%s> by cross partition reclaim "+
+		"from node pool <%s>: %s", pod.Namespace, pod.Name, job.NamespacedName,
+		job.PodGroup.Labels[ssn.SchedulerParams.PartitionParams.NodePoolLabelKey], ssn.NodePoolName(), message)
+}
+
+func (ssn *Session) logCrossPartitionReclaim() {
+	if !ssn.CrossPartitionReclaimEnabled() {
+		return
+	}
+	crossPartitionJobs, crossPartitionNodes := 0, 0
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			crossPartitionJobs++
+		}
+	}
+	for _, node := range ssn.Nodes {
+		if ssn.IsCrossPartitionNode(node) {
+			crossPartitionNodes++
+		}
+	}
+	log.InfraLogger.V(1).Warnf("Cross partition reclaim is enabled: jobs of node pools %v may be reclaimed by jobs "+
+		"of node pool <%s>. Session %v holds <%d> cross partition jobs and <%d> cross partition nodes",
+		ssn.SchedulerParams.PartitionParams.CrossPartitionReclaimNodePools, ssn.NodePoolName(), ssn.UID,
+		crossPartitionJobs, crossPartitionNodes)
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+const testNodePoolLabelKey = "kai.scheduler/node-pool"
+
+func TestCrossPartitionReclaim(t *testing.T) {
+	buildJob := func(name string, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: state, NodeName: nodeName}},
+		}
+	}
+	jobNodePools := map[string]string{
+		"prod":            "production",
+		"batch-running":   "batch",
+		"batch-releasing": "batch",
+	}
+
+	tests := []struct {
+		name                    string
+		crossPartitionNodePools []string
+		nodeName                string
+		expectedCrossPartition  map[string]bool
+		expectedFit             bool
+	}{
+		{
+			name:                    "node of the partition",
+			crossPartitionNodePools: []string{"batch"},
+			nodeName:                "production-node",
+			expectedCrossPartition:  map[string]bool{"prod": false, "batch-running": true, "batch-releasing": true},
+			expectedFit:             true,
+		},
+		{
+			name:                    "idle resources of a node of another node pool are not used",
+			crossPartitionNodePools: []string{"batch"},
+			nodeName:                "batch-node-running",
+			expectedCrossPartition:  map[string]bool{"prod": false, "batch-running": true, "batch-releasing": true},
+			expectedFit:             false,
+		},
+		{
+			name:                    "node of another node pool releasing a cross partition pod",
+			crossPartitionNodePools: []string{"batch"},
+			nodeName:                "batch-node-releasing",
+			expectedCrossPartition:  map[string]bool{"prod": false, "batch-running": true, "batch-releasing": true},
+			expectedFit:             true,
+		},
+		{
+			name:                   "cross partition reclaim disabled",
+			nodeName:               "batch-node-running",
+			expectedCrossPartition: map[string]bool{"prod": false, "batch-running": false, "batch-releasing": false},
+			expectedFit:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				buildJob("prod", pod_status.Pending, ""),
+				buildJob("batch-running", pod_status.Running, "batch-node-running"),
+				buildJob("batch-releasing", pod_status.Releasing, "batch-node-releasing"),
+			})
+			for name, job := range jobsInfoMap {
+				job.PodGroup = &enginev2alpha2.PodGroup{}
+				job.PodGroup.Labels = map[string]string{testNodePoolLabelKey: jobNodePools[string(name)]}
+			}
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"production-node":      {GPUs: 2, Labels: map[string]string{testNodePoolLabelKey: "production"}},
+				"batch-node-running":   {GPUs: 2, Labels: map[string]string{testNodePoolLabelKey: "batch"}},
+				"batch-node-releasing": {GPUs: 2, Labels: map[string]string{testNodePoolLabelKey: "batch"}},
+			}, tasksToNodeMap, nil)
+			ssn := &Session{
+				PodGroupInfos: jobsInfoMap,
+				Nodes:         nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{PartitionParams: &conf.SchedulingNodePoolParams{
+					NodePoolLabelKey:               testNodePoolLabelKey,
+					NodePoolLabelValue:             "production",
+					CrossPartitionReclaimNodePools: tt.crossPartitionNodePools,
+				}},
+			}
+
+			for name, expected := range tt.expectedCrossPartition {
+				assert.Equal(t, expected, ssn.IsCrossPartitionJob(jobsInfoMap[common_info.PodGroupID(name)]),
+					"cross partition job %s", name)
+			}
+
+			for _, task := range jobsInfoMap["prod"].GetAllPodsMap() {
+				fitError := ssn.predicateCrossPartitionNode(task, nodesInfoMap[tt.nodeName])
+				assert.Equal(t, tt.expectedFit, fitError == nil)
+			}
+		})
+	}
+}
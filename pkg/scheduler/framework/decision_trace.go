@@ -0,0 +1,243 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	// decisionTraceRetentionCycles is the number of scheduling cycles a trace is kept after it was last recorded.
+	decisionTraceRetentionCycles = 3
+	decisionTracePath            = "/get-scheduling-trace"
+)
+
+// PredicateTrace is the result of a single plugin predicate for a node.
+type PredicateTrace struct {
+	Plugin string `json:"plugin"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NodeDecisionTrace holds the predicate results and the per-plugin scores of a node for a traced pod.
+type NodeDecisionTrace struct {
+	Fits       bool               `json:"fits"`
+	FitError   string             `json:"fitError,omitempty"`
+	Predicates []PredicateTrace   `json:"predicates,omitempty"`
+	Scores     map[string]float64 `json:"scores,omitempty"`
+	TotalScore float64            `json:"totalScore"`
+}
+
+// PodDecisionTrace holds the scheduling decisions taken for a pod in a single scheduling cycle: the decisions for each
+// node it was evaluated on, and the decisions of the actions for the pod.
+type PodDecisionTrace struct {
+	PodUID     common_info.PodID             `json:"podUID"`
+	Namespace  string                        `json:"namespace"`
+	Name       string                        `json:"name"`
+	SessionUID types.UID                     `json:"sessionUID"`
+	Nodes      map[string]*NodeDecisionTrace `json:"nodes"`
+	Decisions  []string                      `json:"decisions,omitempty"`
+
+	cycle uint64
+	mutex sync.Mutex
+}
+
+// recordFit records whether the node fits the pod, and logs it.
+func (t *PodDecisionTrace) recordFit(nodeName string, fits bool, fitError string, predicates []PredicateTrace) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	node := t.node(nodeName)
+	node.Fits = fits
+	node.FitError = fitError
+	node.Predicates = predicates
+
+	switch {
+	case fits:
+		t.log("node <%s> fits", nodeName)
+	case fitError != "":
+		t.log("node <%s> does not fit: %s", nodeName, fitError)
+	case len(predicates) > 0:
+		failed := predicates[len(predicates)-1]
+		t.log("node <%s> was rejected by the %s predicate: %s", nodeName, failed.Plugin, failed.Error)
+	}
+}
+
+func (t *PodDecisionTrace) recordScores(nodeName string, scores map[string]float64, totalScore float64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	node := t.node(nodeName)
+	node.Scores = scores
+	node.TotalScore = totalScore
+}
+
+// recordDecision records a decision of an action for the pod, and logs it.
+func (t *PodDecisionTrace) recordDecision(decision string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.Decisions = append(t.Decisions, decision)
+	t.log("%s", decision)
+}
+
+// log logs a decision for the pod regardless of the log level, so traced pods can be followed in the logs.
+func (t *PodDecisionTrace) log(format string, args ...any) {
+	log.InfraLogger.V(0).Infof("Trace of pod <%s/%s>: %s", t.Namespace, t.Name, fmt.Sprintf(format, args...))
+}
+
+func (t *PodDecisionTrace) node(nodeName string) *NodeDecisionTrace {
+	node, found := t.Nodes[nodeName]
+	if !found {
+		node = &NodeDecisionTrace{}
+		t.Nodes[nodeName] = node
+	}
+	return node
+}
+
+// decisionTraceStore keeps the traces of the pods annotated for tracing across sessions, so they can be served
+// after the cycle that recorded them is over.
+type decisionTraceStore struct {
+	mutex      sync.Mutex
+	cycle      uint64
+	sessionUID types.UID
+	traces     map[common_info.PodID]*PodDecisionTrace
+}
+
+var decisionTraces = &decisionTraceStore{traces: map[common_info.PodID]*PodDecisionTrace{}}
+
+// startCycle starts a new scheduling cycle and drops the traces that were not recorded in the recent cycles.
+func (s *decisionTraceStore) startCycle(sessionUID types.UID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cycle++
+	s.sessionUID = sessionUID
+	for podUID, trace := range s.traces {
+		if s.cycle-trace.cycle > decisionTraceRetentionCycles {
+			delete(s.traces, podUID)
+		}
+	}
+}
+
+// traceFor returns the trace of the current cycle for the pod, or nil if the pod is not annotated for tracing.
+// A trace recorded in a previous cycle is replaced, so a trace always describes a single cycle.
+func (s *decisionTraceStore) traceFor(pod *pod_info.PodInfo) *PodDecisionTrace {
+	if pod.Pod == nil || pod.Pod.Annotations[commonconstants.SchedulingTrace] != "true" {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	trace, found := s.traces[pod.UID]
+	if !found || trace.cycle != s.cycle {
+		trace = &PodDecisionTrace{
+			PodUID:     pod.UID,
+			Namespace:  pod.Namespace,
+			Name:       pod.Name,
+			SessionUID: s.sessionUID,
+			Nodes:      map[string]*NodeDecisionTrace{},
+			cycle:      s.cycle,
+		}
+		s.traces[pod.UID] = trace
+	}
+	return trace
+}
+
+// serveTraces serves the trace of the pod given by the "pod" query parameter, or the UIDs of all traced pods.
+func (s *decisionTraceStore) serveTraces(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var response any
+	if podUID := r.URL.Query().Get("pod"); podUID != "" {
+		trace, found := s.traces[common_info.PodID(podUID)]
+		if !found {
+			http.Error(w, fmt.Sprintf("no scheduling trace for pod %s", podUID), http.StatusNotFound)
+			return
+		}
+		trace.mutex.Lock()
+		defer trace.mutex.Unlock()
+		response = trace
+	} else {
+		podUIDs := make([]common_info.PodID, 0, len(s.traces))
+		for podUID := range s.traces {
+			podUIDs = append(podUIDs, podUID)
+		}
+		response = podUIDs
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(response); err != nil {
+		http.Error(w, "Failed to encode scheduling trace", http.StatusInternalServerError)
+	}
+}
+
+// tracedPredicateFn runs the predicates like PredicateFn and records the result of each plugin in the trace.
+func (ssn *Session) tracedPredicateFn(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo, trace *PodDecisionTrace,
+) error {
+	var predicates []PredicateTrace
+	for i, pfn := range ssn.PredicateFns {
+		err := pfn(task, job, node)
+		predicate := PredicateTrace{Plugin: pluginNameAt(ssn.predicateFnPlugins, i), Passed: err == nil}
+		if err != nil {
+			predicate.Error = err.Error()
+		}
+		predicates = append(predicates, predicate)
+		if err != nil {
+			trace.recordFit(node.Name, false, "", predicates)
+			return err
+		}
+	}
+	trace.recordFit(node.Name, true, "", predicates)
+	return nil
+}
+
+// tracedNodeOrderFn scores the node like NodeOrderFn and records the score of each plugin in the trace.
+func (ssn *Session) tracedNodeOrderFn(
+	task *pod_info.PodInfo, node *node_info.NodeInfo, trace *PodDecisionTrace,
+) (float64, error) {
+	priorityScore := float64(0)
+	scores := map[string]float64{}
+	for i, nodeOrderFn := range ssn.NodeOrderFns {
+		score, err := nodeOrderFn(task, node)
+		if err != nil {
+			return 0, err
+		}
+		scores[pluginNameAt(ssn.nodeOrderFnPlugins, i)] += score
+		priorityScore += score
+	}
+	trace.recordScores(node.Name, scores, priorityScore)
+	return priorityScore, nil
+}
+
+func fitErrorMessage(fitError *common_info.FitError) string {
+	if fitError == nil {
+		return "not enough idle or releasing resources"
+	}
+	return fitError.Error()
+}
+
+func pluginNameAt(pluginNames []string, index int) string {
+	if index < len(pluginNames) && pluginNames[index] != "" {
+		return pluginNames[index]
+	}
+	return fmt.Sprintf("#%d", index)
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestDecisionTrace(t *testing.T) {
+	tests := []struct {
+		name              string
+		traced            bool
+		expectedNodes     map[string]*NodeDecisionTrace
+		expectedDecisions []string
+	}{
+		{
+			name:   "annotated pod records predicates and per-plugin scores",
+			traced: true,
+			expectedNodes: map[string]*NodeDecisionTrace{
+				"node0": {
+					Fits:       true,
+					Predicates: []PredicateTrace{{Plugin: "nodeaffinity", Passed: true}},
+					Scores:     map[string]float64{"binpack": 2, "spread": 1},
+					TotalScore: 3,
+				},
+				"node1": {
+					Fits: false,
+					Predicates: []PredicateTrace{
+						{Plugin: "nodeaffinity", Passed: false, Error: "node1 is excluded"},
+					},
+				},
+				"node2": {
+					Fits: false,
+					FitError: "Pod /job0-0 cannot be scheduled on node node2. " +
+						"reasons: node(s) didn't have enough resources: GPUs",
+				},
+			},
+			expectedDecisions: []string{"nodes ordered by score: node0 (3)"},
+		},
+		{
+			name:   "pod without the annotation is not traced",
+			traced: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decisionTraces = &decisionTraceStore{traces: map[common_info.PodID]*PodDecisionTrace{}}
+			decisionTraces.startCycle("session-1")
+
+			ssn, task := newTracingSession(t)
+			if tt.traced {
+				task.Pod.Annotations = map[string]string{commonconstants.SchedulingTrace: "true"}
+			}
+
+			var fittingNodes []*node_info.NodeInfo
+			for _, nodeName := range []string{"node0", "node1", "node2"} {
+				if ssn.FittingNode(task, ssn.Nodes[nodeName], false) {
+					fittingNodes = append(fittingNodes, ssn.Nodes[nodeName])
+				}
+			}
+			ssn.OrderedNodesByTask(fittingNodes, task)
+
+			trace, found := decisionTraces.traces[task.UID]
+			if !tt.traced {
+				assert.False(t, found)
+				return
+			}
+			assert.True(t, found)
+			assert.Equal(t, "session-1", string(trace.SessionUID))
+			assert.Equal(t, tt.expectedNodes, trace.Nodes)
+			assert.Equal(t, tt.expectedDecisions, trace.Decisions)
+		})
+	}
+}
+
+func TestDecisionTrace_Expiry(t *testing.T) {
+	decisionTraces = &decisionTraceStore{traces: map[common_info.PodID]*PodDecisionTrace{}}
+	decisionTraces.startCycle("session-1")
+
+	_, task := newTracingSession(t)
+	task.Pod.Annotations = map[string]string{commonconstants.SchedulingTrace: "true"}
+	firstTrace := decisionTraces.traceFor(task)
+	firstTrace.recordScores("node0", map[string]float64{"binpack": 1}, 1)
+
+	decisionTraces.startCycle("session-2")
+	secondTrace := decisionTraces.traceFor(task)
+	assert.NotSame(t, firstTrace, secondTrace, "a new cycle starts a new trace")
+	assert.Empty(t, secondTrace.Nodes)
+	assert.Equal(t, "session-2", string(secondTrace.SessionUID))
+
+	for range decisionTraceRetentionCycles {
+		decisionTraces.startCycle("session-n")
+		assert.Contains(t, decisionTraces.traces, task.UID)
+	}
+	decisionTraces.startCycle("session-n")
+	assert.NotContains(t, decisionTraces.traces, task.UID)
+}
+
+func TestDecisionTrace_Serve(t *testing.T) {
+	decisionTraces = &decisionTraceStore{traces: map[common_info.PodID]*PodDecisionTrace{}}
+	decisionTraces.startCycle("session-1")
+
+	_, task := newTracingSession(t)
+	task.Pod.Annotations = map[string]string{commonconstants.SchedulingTrace: "true"}
+	decisionTraces.traceFor(task).recordScores("node0", map[string]float64{"binpack": 2}, 2)
+
+	recorder := httptest.NewRecorder()
+	decisionTraces.serveTraces(recorder, httptest.NewRequest(http.MethodGet, decisionTracePath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var podUIDs []common_info.PodID
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &podUIDs))
+	assert.Equal(t, []common_info.PodID{task.UID}, podUIDs)
+
+	recorder = httptest.NewRecorder()
+	decisionTraces.serveTraces(recorder,
+		httptest.NewRequest(http.MethodGet, decisionTracePath+"?pod="+string(task.UID), nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var trace PodDecisionTrace
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &trace))
+	assert.Equal(t, task.Name, trace.Name)
+	assert.Equal(t, 2.0, trace.Nodes["node0"].TotalScore)
+
+	recorder = httptest.NewRecorder()
+	decisionTraces.serveTraces(recorder, httptest.NewRequest(http.MethodGet, decisionTracePath+"?pod=missing", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func newTracingSession(t *testing.T) (*Session, *pod_info.PodInfo) {
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 2},
+			"node1": {GPUs: 2},
+			"node2": {GPUs: 0},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	ssn.registeringPlugin = "nodeaffinity"
+	ssn.AddPredicateFn(func(_ *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+		if node.Name == "node1" {
+			return errors.New("node1 is excluded")
+		}
+		return nil
+	})
+	ssn.registeringPlugin = "binpack"
+	ssn.AddNodeOrderFn(func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+		idleGPUs, _ := node.GetSumOfIdleGPUs()
+		return idleGPUs, nil
+	})
+	ssn.registeringPlugin = "spread"
+	ssn.AddNodeOrderFn(func(_ *pod_info.PodInfo, _ *node_info.NodeInfo) (float64, error) {
+		return 1, nil
+	})
+	ssn.registeringPlugin = ""
+
+	task := jobsInfoMap["job0"].GetAllPodsMap()["job0-0"]
+	assert.NotNil(t, task)
+	return ssn, task
+}
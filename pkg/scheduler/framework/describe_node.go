@@ -0,0 +1,104 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+// DescribeNode returns a human-readable report of the GPU allocation of a node of the session: its whole GPUs, the
+// used, allocated, releasing and idle memory of every shared GPU group with the pods that occupy it, and the pods
+// that use whole GPUs. It is meant for triage, unlike String, which dumps the whole session.
+func (ssn *Session) DescribeNode(name string) (string, error) {
+	node, found := ssn.Nodes[name]
+	if !found {
+		return "", fmt.Errorf("node <%s> was not found in session %v", name, ssn.UID)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Node %s\n", node.Name)
+	fmt.Fprintf(&sb, "GPUs: %d, memory of every GPU: %d MiB\n", node.GetNumberOfGPUsInNode(),
+		node.MemoryOfEveryGpuOnNode)
+	fmt.Fprintf(&sb, "Whole GPUs: idle %v, used %v, releasing %v\n",
+		node.Idle.GPUs(), node.Used.GPUs(), node.Releasing.GPUs())
+	if len(node.UnhealthyGpus) > 0 {
+		unhealthyGpus := maps.Keys(node.UnhealthyGpus)
+		slices.Sort(unhealthyGpus)
+		fmt.Fprintf(&sb, "Unhealthy GPUs: %v\n", unhealthyGpus)
+	}
+
+	podsByGpuGroup, wholeGpuPods := nodeGpuPods(node)
+	gpuGroups := sharedGpuGroups(node)
+	if len(gpuGroups) > 0 {
+		sb.WriteString("Shared GPU groups:\n")
+	}
+	for _, gpuGroup := range gpuGroups {
+		gpuIndex := "unknown"
+		if index, found := node.GetGpuGroupIndex(gpuGroup); found {
+			gpuIndex = fmt.Sprint(index)
+		}
+		usedMemory := node.UsedSharedGPUsMemory[gpuGroup]
+		fmt.Fprintf(&sb, "  %s (GPU %s): used %d MiB, allocated %d MiB, releasing %d MiB, idle %d MiB, tenants %d\n",
+			gpuGroup, gpuIndex, usedMemory, node.AllocatedSharedGPUsMemory[gpuGroup],
+			node.ReleasingSharedGPUsMemory[gpuGroup], max(node.MemoryOfEveryGpuOnNode-usedMemory, 0),
+			node.AllocatedSharedGPUsTenants[gpuGroup])
+		for _, pod := range podsByGpuGroup[gpuGroup] {
+			fmt.Fprintf(&sb, "    %s/%s: %s, %d MiB\n", pod.Namespace, pod.Name, pod.Status,
+				node.GetResourceGpuMemory(pod.ResReq))
+		}
+	}
+
+	if len(wholeGpuPods) > 0 {
+		sb.WriteString("Whole GPU pods:\n")
+	}
+	for _, pod := range wholeGpuPods {
+		fmt.Fprintf(&sb, "  %s/%s: %s, %v GPUs\n", pod.Namespace, pod.Name, pod.Status, pod.ResReq.GPUs())
+	}
+
+	return sb.String(), nil
+}
+
+// sharedGpuGroups returns the GPU groups of the node that have used, allocated or releasing memory, sorted.
+func sharedGpuGroups(node *node_info.NodeInfo) []string {
+	gpuGroups := map[string]bool{}
+	for _, memoryByGroup := range []map[string]int64{
+		node.UsedSharedGPUsMemory, node.AllocatedSharedGPUsMemory, node.ReleasingSharedGPUsMemory,
+	} {
+		for gpuGroup := range memoryByGroup {
+			gpuGroups[gpuGroup] = true
+		}
+	}
+	sortedGroups := maps.Keys(gpuGroups)
+	slices.Sort(sortedGroups)
+	return sortedGroups
+}
+
+// nodeGpuPods returns the GPU sharing pods of the node by GPU group and the pods that use whole GPUs, sorted by
+// namespace and name.
+func nodeGpuPods(node *node_info.NodeInfo) (map[string][]*pod_info.PodInfo, []*pod_info.PodInfo) {
+	pods := maps.Values(node.PodInfos)
+	slices.SortFunc(pods, func(l, r *pod_info.PodInfo) int {
+		return strings.Compare(l.Namespace+"/"+l.Name, r.Namespace+"/"+r.Name)
+	})
+
+	podsByGpuGroup := map[string][]*pod_info.PodInfo{}
+	var wholeGpuPods []*pod_info.PodInfo
+	for _, pod := range pods {
+		if pod.IsSharedGPURequest() {
+			for _, gpuGroup := range pod.GPUGroups {
+				podsByGpuGroup[gpuGroup] = append(podsByGpuGroup[gpuGroup], pod)
+			}
+		} else if pod.ResReq.GPUs() > 0 {
+			wholeGpuPods = append(wholeGpuPods, pod)
+		}
+	}
+	return podsByGpuGroup, wholeGpuPods
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestDescribeNode(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "shared_job0",
+			Namespace:           "ns",
+			RequiredGPUsPerTask: 0.5,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Running, NodeName: "node0", GPUGroups: []string{"group-a"}},
+				{State: pod_status.Releasing, NodeName: "node0", GPUGroups: []string{"group-a"}},
+			},
+		},
+		{
+			Name:                "whole_job0",
+			Namespace:           "ns",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "node0"}},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 4, GPUMemory: 1000},
+	}, tasksToNodeMap, nil)
+	nodesInfoMap["node0"].UnhealthyGpus = map[int]bool{3: true}
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	description, err := ssn.DescribeNode("node0")
+	assert.NoError(t, err)
+	assert.Equal(t, `Node node0
+GPUs: 4, memory of every GPU: 1000 MiB
+Whole GPUs: idle 2, used 1, releasing 0
+Unhealthy GPUs: [3]
+Shared GPU groups:
+  group-a (GPU unknown): used 1000 MiB, allocated 1000 MiB, releasing 500 MiB, idle 0 MiB, tenants 2
+    ns/shared_job0-0: Running, 500 MiB
+    ns/shared_job0-1: Releasing, 500 MiB
+Whole GPU pods:
+  ns/whole_job0-0: Running, 1 GPUs
+`, description)
+
+	_, err = ssn.DescribeNode("node1")
+	assert.EqualError(t, err, "node <node1> was not found in session 1")
+}
@@ -27,7 +27,21 @@ type Event struct {
 	Task *pod_info.PodInfo
 }
 
+// GpuSharingEvent describes a whole GPU switching between exclusive and fractionally shared use on a node.
+type GpuSharingEvent struct {
+	NodeName string
+	GPUGroup string
+	// GPUId is the stable id of the GPU, see Session.GpuGroupId.
+	GPUId string
+	// Task is the first fractional tenant when sharing starts, and the last one when sharing ends.
+	Task *pod_info.PodInfo
+}
+
 type EventHandler struct {
 	AllocateFunc   func(event *Event)
 	DeallocateFunc func(event *Event)
+	// GpuSharingStartFunc is called when the allocation of the first fractional tenant of a whole GPU is committed.
+	GpuSharingStartFunc func(event *GpuSharingEvent)
+	// GpuSharingEndFunc is called when the eviction of the last fractional tenant of a GPU is committed.
+	GpuSharingEndFunc func(event *GpuSharingEvent)
 }
@@ -0,0 +1,14 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+
+// NewFitErrors returns fit errors for a task that retain at most MaxFitErrorsPerTask node errors. The cap is kept when
+// they are added to the fit errors the task already has.
+func (ssn *Session) NewFitErrors() *common_info.FitErrors {
+	fitErrors := common_info.NewFitErrors()
+	fitErrors.SetMaxNodeErrors(ssn.SchedulerParams.MaxFitErrorsPerTask)
+	return fitErrors
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestFittingNode_MaxFitErrorsPerTask(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxFitErrorsPerTask int
+		expectOmittedNodes  bool
+	}{
+		{
+			name: "unlimited",
+		},
+		{
+			name:                "capped",
+			maxFitErrorsPerTask: 1,
+			expectOmittedNodes:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs := []*jobs_fake.TestJobBasic{{
+				Name:                "pending_job",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+			}}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 0},
+				"node1": {GPUs: 0},
+				"node2": {GPUs: 0},
+			}, tasksToNodeMap, nil)
+
+			ssn := &Session{
+				PodGroupInfos:   jobsInfoMap,
+				Nodes:           nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{MaxFitErrorsPerTask: tt.maxFitErrorsPerTask},
+			}
+			job := jobsInfoMap["pending_job"]
+			task := job.GetAllPodsMap()["pending_job-0"]
+			for _, node := range nodesInfoMap {
+				assert.False(t, ssn.FittingNode(task, node, true))
+			}
+
+			fitErrors := job.NodesFitErrors[task.UID]
+			assert.Contains(t, fitErrors.Error(), "3 node(s) didn't have enough resources: GPUs")
+			assert.Equal(t, tt.expectOmittedNodes, strings.Contains(fitErrors.DetailedError(), "<2 more nodes>"))
+		})
+	}
+}
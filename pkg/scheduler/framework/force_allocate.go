@@ -0,0 +1,130 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// ForceAllocate places a pending pod on the given node, and on the given shared GPU groups for GPU sharing pods,
+// regardless of the job, queue, node and GPU ordering, for manual placement by admins. The pod must still fit the
+// idle resources of the node and pass the predicates, otherwise the fit error is returned and nothing is allocated.
+// The allocation is committed right away, is not moved to a fallback node if its bind fails, and is recorded as a
+// ForceAllocated Kubernetes event on the pod and a ForceAllocated scheduling event.
+func (ssn *Session) ForceAllocate(pod *pod_info.PodInfo, nodeName string, gpuGroups []string) error {
+	if pod.Status != pod_status.Pending {
+		return fmt.Errorf("pod <%s/%s> is %v, only pending pods can be force allocated",
+			pod.Namespace, pod.Name, pod.Status)
+	}
+	job, found := ssn.PodGroupInfos[pod.Job]
+	if !found {
+		return fmt.Errorf("could not force allocate pod <%s/%s> without podGroup. podGroupId: <%s>",
+			pod.Namespace, pod.Name, pod.Job)
+	}
+	node, found := ssn.Nodes[nodeName]
+	if !found {
+		return fmt.Errorf("node <%s> doesn't exist in session", nodeName)
+	}
+
+	if err := ssn.forceAllocateFitError(pod, job, node, gpuGroups); err != nil {
+		return err
+	}
+	if err := ssn.PrePredicateFn(pod, job); err != nil {
+		return err
+	}
+	if err := ssn.PredicateFn(pod, job, node); err != nil {
+		return err
+	}
+
+	if pod.IsSharedGPURequest() {
+		pod.GPUGroups = slices.Clone(gpuGroups)
+	}
+	if ssn.forceAllocatedPods == nil {
+		ssn.forceAllocatedPods = map[common_info.PodID]bool{}
+	}
+	ssn.forceAllocatedPods[pod.UID] = true
+
+	stmt := ssn.Statement()
+	if err := stmt.Allocate(pod, node.Name); err != nil {
+		pod.GPUGroups = nil
+		delete(ssn.forceAllocatedPods, pod.UID)
+		return err
+	}
+	if err := stmt.Commit(); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Pod %s/%s was manually placed on node %s, bypassing the scheduling order",
+		pod.Namespace, pod.Name, node.Name)
+	if len(pod.GPUGroups) > 0 {
+		message = fmt.Sprintf("%s, on GPU groups %v", message, pod.GPUGroups)
+	}
+	log.InfraLogger.V(1).Infof("%s", message)
+	ssn.Cache.TaskForceAllocated(pod, message)
+	ssn.emitSchedulingEvent(TaskForceAllocated, pod, node.Name, "", message)
+	return nil
+}
+
+// forceAllocateFitError returns why the pod cannot be allocated on the idle resources of the node and the GPU groups.
+func (ssn *Session) forceAllocateFitError(pod *pod_info.PodInfo, job *podgroup_info.PodGroupInfo,
+	node *node_info.NodeInfo, gpuGroups []string) error {
+	if allocatable, fitError := ssn.isTaskAllocatableOnNode(pod, job, node, true); !allocatable {
+		return idleResourcesFitError(pod, node, fitError)
+	}
+	if !node.IsTaskAllocatable(pod) {
+		return idleResourcesFitError(pod, node, node.FittingError(pod, len(job.GetAllPodsMap()) > 1))
+	}
+
+	if !pod.IsSharedGPURequest() {
+		if len(gpuGroups) > 0 {
+			return fmt.Errorf("GPU groups can only be selected for GPU sharing pods, pod <%s/%s> is not one",
+				pod.Namespace, pod.Name)
+		}
+		return nil
+	}
+
+	if int64(len(gpuGroups)) != pod.ResReq.GetNumOfGpuDevices() {
+		return fmt.Errorf("pod <%s/%s> shares %d GPUs, got %d GPU groups",
+			pod.Namespace, pod.Name, pod.ResReq.GetNumOfGpuDevices(), len(gpuGroups))
+	}
+	for _, gpuGroup := range gpuGroups {
+		if _, found := node.UsedSharedGPUsMemory[gpuGroup]; !found {
+			return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+				fmt.Sprintf("node(s) didn't have the shared GPU group: %s", gpuGroup))
+		}
+		// The same GPU groups that FittingGPUs leaves out for the pod are refused here.
+		if fitError := closedGpuGroupFitError(pod, node, gpuGroup); fitError != nil {
+			return fitError
+		}
+		if !ssn.GpuFilterFn(pod, node, gpuGroup) {
+			return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+				fmt.Sprintf("node(s) didn't have enough resources: GPU group %s is excluded by a GPU filter", gpuGroup))
+		}
+		if node.IsGpuGroupOverBandwidthBudget(pod, gpuGroup) {
+			return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+				fmt.Sprintf("node(s) didn't have enough resources: GPU memory bandwidth budget of GPU group %s", gpuGroup))
+		}
+		if !node.EnoughIdleResourcesOnGpu(pod.ResReq, gpuGroup) {
+			return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+				fmt.Sprintf("node(s) didn't have enough resources: GPU memory of GPU group %s", gpuGroup))
+		}
+	}
+	return nil
+}
+
+func idleResourcesFitError(pod *pod_info.PodInfo, node *node_info.NodeInfo, fitError *common_info.FitError) error {
+	if fitError == nil {
+		return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+			"node(s) didn't have enough idle resources")
+	}
+	return fitError
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_ForceAllocate(t *testing.T) {
+	tests := []struct {
+		name              string
+		job               string
+		nodeName          string
+		gpuGroups         []string
+		predicateErr      error
+		unhealthyGpus     map[int]bool
+		excludeGpuGroups  bool
+		expectFitError    bool
+		expectError       bool
+		expectedGPUGroups []string
+	}{
+		{
+			name:     "whole GPU pod",
+			job:      "pending_whole",
+			nodeName: "node0",
+		},
+		{
+			name:              "GPU sharing pod on a shared GPU group",
+			job:               "pending_fraction",
+			nodeName:          "node0",
+			gpuGroups:         []string{"0"},
+			expectedGPUGroups: []string{"0"},
+		},
+		{
+			name:           "node without idle GPUs",
+			job:            "pending_whole",
+			nodeName:       "node1",
+			expectFitError: true,
+			expectError:    true,
+		},
+		{
+			name:           "unknown GPU group",
+			job:            "pending_fraction",
+			nodeName:       "node0",
+			gpuGroups:      []string{"1"},
+			expectFitError: true,
+			expectError:    true,
+		},
+		{
+			name:           "GPU group on an unhealthy GPU",
+			job:            "pending_fraction",
+			nodeName:       "node0",
+			gpuGroups:      []string{"0"},
+			unhealthyGpus:  map[int]bool{1: true},
+			expectFitError: true,
+			expectError:    true,
+		},
+		{
+			name:             "GPU group excluded by a GPU filter",
+			job:              "pending_fraction",
+			nodeName:         "node0",
+			gpuGroups:        []string{"0"},
+			excludeGpuGroups: true,
+			expectFitError:   true,
+			expectError:      true,
+		},
+		{
+			name:        "GPU groups for a whole GPU pod",
+			job:         "pending_whole",
+			nodeName:    "node0",
+			gpuGroups:   []string{"0"},
+			expectError: true,
+		},
+		{
+			name:         "failing predicate",
+			job:          "pending_whole",
+			nodeName:     "node0",
+			predicateErr: fmt.Errorf("node affinity mismatch"),
+			expectError:  true,
+		},
+		{
+			name:        "running pod",
+			job:         "running_job0",
+			nodeName:    "node0",
+			expectError: true,
+		},
+		{
+			name:        "unknown node",
+			job:         "pending_whole",
+			nodeName:    "node2",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topology := nodes_fake.TestClusterTopology{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "running_job0",
+						RequiredGPUsPerTask: 0.5,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{State: pod_status.Running, NodeName: "node0", GPUGroups: []string{"0"}},
+						},
+					},
+					{
+						Name:                "pending_whole",
+						RequiredGPUsPerTask: 1,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+					},
+					{
+						Name:                "pending_fraction",
+						RequiredGPUsPerTask: 0.5,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 2},
+					"node1": {GPUs: 0},
+				},
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+			nodesInfoMap["node0"].UnhealthyGpus = tt.unhealthyGpus
+
+			var binds []string
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *pod_info.PodInfo, hostname string, _ map[string]string) error {
+					binds = append(binds, hostname)
+					return nil
+				}).AnyTimes()
+			var auditedPods []string
+			mockCache.EXPECT().TaskForceAllocated(gomock.Any(), gomock.Any()).Do(
+				func(task *pod_info.PodInfo, _ string) {
+					auditedPods = append(auditedPods, task.Name)
+				}).AnyTimes()
+			defer func() { bindBackoffs = newBindBackoffStore() }()
+
+			ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+			ssn.AddPredicateFn(func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo, *node_info.NodeInfo) error {
+				return tt.predicateErr
+			})
+			ssn.AddGpuFilterFn(func(*pod_info.PodInfo, *node_info.NodeInfo, string) bool {
+				return !tt.excludeGpuGroups
+			})
+			var events []SchedulingEvent
+			ssn.SubscribeEvents(func(event SchedulingEvent) {
+				events = append(events, event)
+			})
+
+			pod := jobsInfoMap[common_info.PodGroupID(tt.job)].GetAllPodsMap()[common_info.PodID(tt.job+"-0")]
+			err := ssn.ForceAllocate(pod, tt.nodeName, tt.gpuGroups)
+
+			assert.Equal(t, tt.expectError, err != nil, "error: %v", err)
+			var fitError *common_info.FitError
+			assert.Equal(t, tt.expectFitError, errors.As(err, &fitError), "error: %v", err)
+			if tt.expectError {
+				assert.Empty(t, binds)
+				assert.Empty(t, events)
+				assert.Empty(t, auditedPods)
+				if pod.Status == pod_status.Pending {
+					assert.Empty(t, pod.GPUGroups)
+				}
+				return
+			}
+			assert.Equal(t, []string{tt.nodeName}, binds)
+			assert.Equal(t, pod_status.Allocated, pod.Status)
+			assert.Equal(t, tt.expectedGPUGroups, pod.GPUGroups)
+			assert.Len(t, events, 2)
+			assert.Equal(t, TaskAllocated, events[0].Type)
+			assert.Equal(t, TaskForceAllocated, events[1].Type)
+			assert.Equal(t, tt.nodeName, events[1].NodeName)
+			assert.Equal(t, []string{pod.Name}, auditedPods)
+		})
+	}
+}
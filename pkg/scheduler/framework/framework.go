@@ -38,7 +38,29 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 
 	if server == nil {
 		server = newPluginServer(mux)
+		if mux != nil {
+			if err := server.registerPlugin(decisionTracePath, decisionTraces.serveTraces); err != nil {
+				log.InfraLogger.Errorf("Failed to register scheduling trace handler: %v", err)
+			}
+			if err := server.registerPlugin(bindBackoffPath, bindBackoffs.serveBackoffs); err != nil {
+				log.InfraLogger.Errorf("Failed to register bind backoff handler: %v", err)
+			}
+			if err := server.registerPlugin(nodeConsolidationPlanPath, nodeConsolidationPlans.servePlan); err != nil {
+				log.InfraLogger.Errorf("Failed to register node consolidation plan handler: %v", err)
+			}
+			if err := server.registerPlugin(queueOrderExplanationPath, queueOrderExplanations.serveExplanation); err != nil {
+				log.InfraLogger.Errorf("Failed to register queue order explanation handler: %v", err)
+			}
+			if err := server.registerPlugin(gpuGroupTenantsPath, gpuGroupTenants.serveTenants); err != nil {
+				log.InfraLogger.Errorf("Failed to register GPU group tenants handler: %v", err)
+			}
+			if err := server.registerPlugin(heldNodesPath, heldNodes.serveHeldNodes); err != nil {
+				log.InfraLogger.Errorf("Failed to register held nodes handler: %v", err)
+			}
+		}
 	}
+	decisionTraces.startCycle(sessionId)
+	bindBackoffs.prune()
 
 	ssn, err := openSession(cache, sessionId, *schedulerParams, mux)
 	if err != nil {
@@ -46,7 +68,34 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 	}
 	ssn.Config = config
 
-	for _, tier := range config.Tiers {
+	if err := ssn.openValidatedPlugins(); err != nil {
+		return nil, err
+	}
+	ssn.reportReleasedAllocations()
+	ssn.releaseStalePipelines()
+	ssn.evictNodeMismatchedPods(ssn.Now())
+	ssn.setSchedulingDeadlines(ssn.Now())
+	ssn.remediateLostGpuGroups()
+
+	return ssn, nil
+}
+
+// openValidatedPlugins opens the plugins and validates the configured plugin chain. If the chain is invalid, the
+// OnSessionClose of the opened plugins is run, so they release what they acquired on OnSessionOpen.
+func (ssn *Session) openValidatedPlugins() error {
+	ssn.openPlugins()
+	if err := ssn.ValidateConfig(); err != nil {
+		ssn.closePlugins()
+		return err
+	}
+	return nil
+}
+
+// openPlugins builds the plugins of the configured tiers, adjusts the pending tasks with the TaskResourceMutateFns of
+// the plugins that provide one, and then runs the plugins' OnSessionOpen.
+func (ssn *Session) openPlugins() {
+	var plugins []Plugin
+	for _, tier := range ssn.Config.Tiers {
 		for _, pluginOption := range tier.Plugins {
 			pb, found := GetPluginBuilder(pluginOption.Name)
 			if !found {
@@ -56,25 +105,54 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 
 			plugin := pb(pluginOption.Arguments)
 			ssn.plugins[plugin.Name()] = plugin
-
-			onSessionOpenPluginStart := time.Now()
-			plugin.OnSessionOpen(ssn)
-			metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenPluginStart))
+			plugins = append(plugins, plugin)
 		}
 	}
 
-	return ssn, nil
+	for _, plugin := range plugins {
+		if mutator, ok := plugin.(TaskResourceMutator); ok {
+			ssn.registeringPlugin = plugin.Name()
+			ssn.AddTaskResourceMutateFn(mutator.TaskResourceMutateFn(ssn))
+			ssn.registeringPlugin = ""
+		}
+	}
+	ssn.mutateTaskResources()
+
+	for _, plugin := range plugins {
+		onSessionOpenPluginStart := time.Now()
+		ssn.registeringPlugin = plugin.Name()
+		plugin.OnSessionOpen(ssn)
+		ssn.registeringPlugin = ""
+		metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenPluginStart))
+	}
 }
 
-func CloseSession(ssn *Session) {
+// CloseSession fails the jobs whose scheduling deadline passed, records the queue order explanation of the cycle, runs
+// the plugins' OnSessionClose, records the GPU fragmentation metrics and the GPU group tenants of the nodes, the
+// pipelined pods and the status of all jobs in the session.
+// A *JobStatusRecordError is returned if the status of some jobs could not be recorded.
+func CloseSession(ssn *Session) error {
 	closeSessionStart := time.Now()
 	defer metrics.UpdateCloseSessionDuration(closeSessionStart)
 
+	ssn.failTimedOutJobs(ssn.Now())
+	ssn.recordQueueOrderExplanation()
+
+	ssn.closePlugins()
+	ssn.recordGpuFragmentationMetrics()
+	ssn.recordGPUGroupTenants()
+	ssn.recordHeldNodes()
+	ssn.recordGatedPodsMetrics()
+	ssn.recordPendingPodsMetrics()
+	ssn.recordPipelineAges()
+
+	return closeSession(ssn)
+}
+
+func (ssn *Session) closePlugins() {
 	for _, plugin := range ssn.plugins {
 		onSessionCloseStart := time.Now()
 		plugin.OnSessionClose(ssn)
 		metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionClose, metrics.Duration(onSessionCloseStart))
 	}
-
-	closeSession(ssn)
 }
@@ -0,0 +1,34 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// gatedPodsByQueue returns the number of pods held back by scheduling gates, by queue name. Gated pods are not
+// scheduled and do not count towards the readiness of their pod groups until the controllers that own the gates
+// remove them, after which the next snapshot sees them as pending.
+func (ssn *Session) gatedPodsByQueue() map[string]int {
+	gatedPods := map[string]int{}
+	for _, job := range ssn.PodGroupInfos {
+		numGatedTasks := job.GetNumGatedTasks()
+		if numGatedTasks == 0 {
+			continue
+		}
+		queueName := string(job.Queue)
+		if queue, found := ssn.Queues[job.Queue]; found {
+			queueName = queue.Name
+		}
+		gatedPods[queueName] += numGatedTasks
+	}
+	return gatedPods
+}
+
+func (ssn *Session) recordGatedPodsMetrics() {
+	metrics.ResetGatedPods()
+	for queueName, count := range ssn.gatedPodsByQueue() {
+		metrics.UpdateGatedPods(queueName, count)
+	}
+}
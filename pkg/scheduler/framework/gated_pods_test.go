@@ -0,0 +1,56 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestGatedPodsByQueue(t *testing.T) {
+	jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:      "gated_job",
+			QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Gated},
+				{State: pod_status.Gated},
+			},
+		},
+		{
+			Name:      "partially_gated_job",
+			QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Pending},
+				{State: pod_status.Gated},
+			},
+		},
+		{
+			Name:      "unknown_queue_job",
+			QueueName: "queue1",
+			Tasks:     []*tasks_fake.TestTaskBasic{{State: pod_status.Gated}},
+		},
+		{
+			Name:      "pending_job",
+			QueueName: "queue2",
+			Tasks:     []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	})
+	ssn := &Session{
+		PodGroupInfos: jobsInfoMap,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"queue0": {UID: "queue0", Name: "team-a"},
+		},
+	}
+
+	assert.Equal(t, map[string]int{"team-a": 3, "queue1": 1}, ssn.gatedPodsByQueue())
+	assert.False(t, jobsInfoMap["gated_job"].IsReadyForScheduling())
+}
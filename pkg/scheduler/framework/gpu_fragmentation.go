@@ -0,0 +1,133 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// NodeGpuFragmentation describes how much of the free GPU memory of a node is left in pieces too small for the
+// median fractional GPU request of the session.
+type NodeGpuFragmentation struct {
+	// FragmentedMemory is the free memory of the node's shared GPUs, in MiB, that cannot host the median request.
+	FragmentedMemory int64
+	// FragmentationRatio is FragmentedMemory out of all the free GPU memory of the node.
+	FragmentationRatio float64
+	WholeGPUs          float64
+	SharedGPUs         float64
+}
+
+// fractionalRequest is a distinct fractional GPU request of the session, with the number of tasks that request it.
+type fractionalRequest struct {
+	resources *resource_info.ResourceRequirements
+	count     int
+}
+
+// GpuFragmentation returns the GPU fragmentation of every node of the session with GPUs. The median fractional request
+// is taken over the pending and allocated tasks of the session, and converted to GPU memory on each node, since
+// requests for a portion of a GPU need a different amount of memory on nodes with different GPUs.
+func (ssn *Session) GpuFragmentation() map[string]NodeGpuFragmentation {
+	requests := ssn.fractionalRequests()
+	fragmentation := map[string]NodeGpuFragmentation{}
+	for name, node := range ssn.Nodes {
+		if node.Allocatable.GPUs() == 0 {
+			continue
+		}
+		nodeFragmentation := NodeGpuFragmentation{
+			WholeGPUs:  node.Used.GPUs(),
+			SharedGPUs: float64(node.GetNumberOfUsedSharedGPUs()),
+		}
+		if medianMemory := medianRequestMemory(node, requests); medianMemory > 0 {
+			nodeFragmentation.FragmentedMemory = node.GetFragmentedSharedGpuMemory(medianMemory)
+		}
+		if _, idleMemory := node.GetSumOfIdleGPUs(); idleMemory > 0 {
+			nodeFragmentation.FragmentationRatio = float64(nodeFragmentation.FragmentedMemory) / float64(idleMemory)
+		}
+		fragmentation[name] = nodeFragmentation
+	}
+	return fragmentation
+}
+
+// DeferWholeGpuFragmentation orders the nodes whose shared GPUs can host the fractional task before the nodes that
+// would have to share a whole GPU for it, keeping the order of the nodes within each group. When no node can host the
+// task on its shared GPUs, the order of the nodes is kept, since a whole GPU is shared anyway.
+func (ssn *Session) DeferWholeGpuFragmentation(
+	task *pod_info.PodInfo, nodes []*node_info.NodeInfo,
+) []*node_info.NodeInfo {
+	orderedNodes := make([]*node_info.NodeInfo, 0, len(nodes))
+	var fragmentingNodes []*node_info.NodeInfo
+	for _, node := range nodes {
+		if node.HasSharedGpuCapacityFor(task) {
+			orderedNodes = append(orderedNodes, node)
+		} else {
+			fragmentingNodes = append(fragmentingNodes, node)
+		}
+	}
+	return append(orderedNodes, fragmentingNodes...)
+}
+
+func (ssn *Session) recordGpuFragmentationMetrics() {
+	metrics.ResetNodeGpuFragmentation()
+	for name, nodeFragmentation := range ssn.GpuFragmentation() {
+		metrics.UpdateNodeGpuFragmentation(name, float64(nodeFragmentation.FragmentedMemory),
+			nodeFragmentation.FragmentationRatio, nodeFragmentation.WholeGPUs, nodeFragmentation.SharedGPUs)
+	}
+}
+
+func (ssn *Session) fractionalRequests() []*fractionalRequest {
+	type requestKey struct {
+		portion float64
+		memory  int64
+	}
+	requests := map[requestKey]*fractionalRequest{}
+	for _, job := range ssn.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if !task.IsSharedGPURequest() {
+				continue
+			}
+			key := requestKey{portion: task.ResReq.GpuFractionalPortion(), memory: task.ResReq.GpuMemory()}
+			if request, found := requests[key]; found {
+				request.count++
+			} else {
+				requests[key] = &fractionalRequest{resources: task.ResReq, count: 1}
+			}
+		}
+	}
+
+	result := make([]*fractionalRequest, 0, len(requests))
+	for _, request := range requests {
+		result = append(result, request)
+	}
+	return result
+}
+
+// medianRequestMemory returns the GPU memory, in MiB, of the median request on the node, or 0 if there are none.
+func medianRequestMemory(node *node_info.NodeInfo, requests []*fractionalRequest) int64 {
+	type nodeRequest struct {
+		memory int64
+		count  int
+	}
+	nodeRequests := make([]nodeRequest, 0, len(requests))
+	total := 0
+	for _, request := range requests {
+		nodeRequests = append(nodeRequests, nodeRequest{memory: node.GetResourceGpuMemory(request.resources),
+			count: request.count})
+		total += request.count
+	}
+	slices.SortFunc(nodeRequests, func(l, r nodeRequest) int { return int(l.memory - r.memory) })
+
+	seen := 0
+	for _, request := range nodeRequests {
+		seen += request.count
+		if 2*seen >= total {
+			return request.memory
+		}
+	}
+	return 0
+}
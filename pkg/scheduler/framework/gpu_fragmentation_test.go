@@ -0,0 +1,153 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestGpuFragmentation(t *testing.T) {
+	buildJob := func(name string, gpus float64, state pod_status.PodStatus, nodeName string, gpuGroups ...string,
+	) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: gpus,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: state, NodeName: nodeName, GPUGroups: gpuGroups},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		jobs     []*jobs_fake.TestJobBasic
+		expected map[string]NodeGpuFragmentation
+	}{
+		{
+			name: "free memory of shared GPUs below the median request is fragmented",
+			jobs: []*jobs_fake.TestJobBasic{
+				buildJob("half", 0.5, pod_status.Running, "node0", "group-a"),
+				buildJob("three-quarters", 0.75, pod_status.Running, "node0", "group-b"),
+				buildJob("whole", 1, pod_status.Running, "node0"),
+				buildJob("pending", 0.75, pod_status.Pending, ""),
+			},
+			expected: map[string]NodeGpuFragmentation{
+				"node0": {FragmentedMemory: 750, FragmentationRatio: 750.0 / 1750, WholeGPUs: 1, SharedGPUs: 2},
+			},
+		},
+		{
+			name: "free memory of shared GPUs that fits the median request is not fragmented",
+			jobs: []*jobs_fake.TestJobBasic{
+				buildJob("half", 0.5, pod_status.Running, "node0", "group-a"),
+				buildJob("three-quarters", 0.75, pod_status.Running, "node0", "group-b"),
+				buildJob("pending-0", 0.25, pod_status.Pending, ""),
+				buildJob("pending-1", 0.25, pod_status.Pending, ""),
+			},
+			expected: map[string]NodeGpuFragmentation{
+				"node0": {FragmentedMemory: 0, FragmentationRatio: 0, WholeGPUs: 0, SharedGPUs: 2},
+			},
+		},
+		{
+			name: "node without shared GPUs",
+			jobs: []*jobs_fake.TestJobBasic{
+				buildJob("whole", 1, pod_status.Running, "node0"),
+			},
+			expected: map[string]NodeGpuFragmentation{
+				"node0": {WholeGPUs: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(tt.jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0":     {GPUs: 4, GPUMemory: 1000},
+				"cpu-node0": {CPUMillis: 4000},
+			}, tasksToNodeMap, nil)
+			ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+			fragmentation := ssn.GpuFragmentation()
+			assert.Len(t, fragmentation, len(tt.expected))
+			for nodeName, expected := range tt.expected {
+				actual := fragmentation[nodeName]
+				assert.Equal(t, expected.FragmentedMemory, actual.FragmentedMemory)
+				assert.InDelta(t, expected.FragmentationRatio, actual.FragmentationRatio, 1e-9)
+				assert.Equal(t, expected.WholeGPUs, actual.WholeGPUs)
+				assert.Equal(t, expected.SharedGPUs, actual.SharedGPUs)
+			}
+		})
+	}
+}
+
+func TestDeferWholeGpuFragmentation(t *testing.T) {
+	buildJob := func(name string, gpus float64, state pod_status.PodStatus, nodeName string, gpuGroups ...string,
+	) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: gpus,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: state, NodeName: nodeName, GPUGroups: gpuGroups},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		pendingGpus   float64
+		expectedOrder []string
+	}{
+		{
+			name:          "nodes with fitting shared GPUs go first",
+			pendingGpus:   0.25,
+			expectedOrder: []string{"node1", "node0", "node2"},
+		},
+		{
+			name:          "order is kept when no shared GPU fits",
+			pendingGpus:   0.75,
+			expectedOrder: []string{"node0", "node1", "node2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				buildJob("half", 0.5, pod_status.Running, "node1", "group-a"),
+				buildJob("pending", tt.pendingGpus, pod_status.Pending, ""),
+			})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2, GPUMemory: 1000},
+				"node1": {GPUs: 2, GPUMemory: 1000},
+				"node2": {GPUs: 2, GPUMemory: 1000},
+			}, tasksToNodeMap, nil)
+			ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+			var task *pod_info.PodInfo
+			for _, pendingTask := range jobsInfoMap["pending"].GetAllPodsMap() {
+				task = pendingTask
+			}
+			nodes := []*node_info.NodeInfo{nodesInfoMap["node0"], nodesInfoMap["node1"], nodesInfoMap["node2"]}
+
+			var order []string
+			for _, node := range ssn.DeferWholeGpuFragmentation(task, nodes) {
+				order = append(order, node.Name)
+			}
+			assert.Equal(t, tt.expectedOrder, order)
+		})
+	}
+}
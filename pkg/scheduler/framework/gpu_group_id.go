@@ -0,0 +1,29 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+)
+
+// GpuGroupId returns a stable id for the GPU that a shared GPU group runs on. GPU groups are named by a random UUID
+// when a whole GPU is taken for sharing, since the device plugin only picks the GPU when the group's reservation pod
+// starts. Once the reservation pod reports the index of its GPU, the id of the physical GPU is returned, as given by
+// the first GpuDeviceIdFn that knows it, or else by the gpu-device-ids annotation of the node. Otherwise, the GPU
+// group itself is returned.
+func (ssn *Session) GpuGroupId(node *node_info.NodeInfo, gpuGroup string) string {
+	gpuIndex, found := node.GetGpuGroupIndex(gpuGroup)
+	if !found {
+		return gpuGroup
+	}
+	for _, fn := range ssn.GpuDeviceIdFns {
+		if deviceId := fn(node, gpuIndex); deviceId != "" {
+			return deviceId
+		}
+	}
+	if deviceId, found := node.GetGpuDeviceId(gpuIndex); found {
+		return deviceId
+	}
+	return gpuGroup
+}
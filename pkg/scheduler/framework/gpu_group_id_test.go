@@ -0,0 +1,90 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+)
+
+func TestSession_GpuGroupId(t *testing.T) {
+	tests := []struct {
+		name         string
+		gpuGroup     string
+		deviceIdFns  []api.GpuDeviceIdFn
+		gpuDeviceIds []string
+		expected     string
+	}{
+		{
+			name:     "no provider keeps the GPU group",
+			gpuGroup: "group-a",
+			expected: "group-a",
+		},
+		{
+			name:         "node inventory names the GPU",
+			gpuGroup:     "group-a",
+			gpuDeviceIds: []string{"GPU-aaaa", "GPU-bbbb"},
+			expected:     "GPU-bbbb",
+		},
+		{
+			name:     "first provider that knows the GPU names it",
+			gpuGroup: "group-a",
+			deviceIdFns: []api.GpuDeviceIdFn{
+				func(*node_info.NodeInfo, int) string { return "" },
+				func(_ *node_info.NodeInfo, gpuIndex int) string {
+					return []string{"GPU-0", "GPU-1"}[gpuIndex]
+				},
+			},
+			gpuDeviceIds: []string{"GPU-aaaa", "GPU-bbbb"},
+			expected:     "GPU-1",
+		},
+		{
+			name:         "GPU group without a reported index keeps its name",
+			gpuGroup:     "group-b",
+			gpuDeviceIds: []string{"GPU-aaaa", "GPU-bbbb"},
+			expected:     "group-b",
+		},
+		{
+			name:         "GPU missing from the node inventory keeps the GPU group",
+			gpuGroup:     "group-a",
+			gpuDeviceIds: []string{"GPU-aaaa"},
+			expected:     "group-a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2, GPUMemory: 1000},
+			}, nil, nil)
+			node := nodesInfoMap["node0"]
+			node.GpuDeviceIds = tt.gpuDeviceIds
+			reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-group-a",
+				"node0", v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+				map[string]string{
+					commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+					commonconstants.GPUGroup:     "group-a",
+				},
+				map[string]string{commonconstants.ReservedGpuIndex: "1"})
+			assert.NoError(t, node.AddTask(pod_info.NewTaskInfo(reservationPod)))
+
+			ssn := &Session{Nodes: nodesInfoMap}
+			for _, fn := range tt.deviceIdFns {
+				ssn.AddGpuDeviceIdFn(fn)
+			}
+			assert.Equal(t, tt.expected, ssn.GpuGroupId(node, tt.gpuGroup))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+const gpuGroupTenantsPath = "/get-gpu-group-tenants"
+
+// GPUGroupTenant is a pod that shares a GPU group, with the GPU memory it is allotted on the group.
+type GPUGroupTenant struct {
+	Namespace    string `json:"namespace"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	GPUMemoryMiB int64  `json:"gpuMemoryMiB"`
+}
+
+// GPUGroupTenants holds the tenants of the shared GPU groups of every node at the end of a scheduling cycle, by node
+// name and GPU group.
+type GPUGroupTenants struct {
+	SessionUID types.UID                              `json:"sessionUID"`
+	Nodes      map[string]map[string][]GPUGroupTenant `json:"nodes"`
+}
+
+// gpuGroupTenantsStore keeps the nodes of the last scheduling cycle, whose GPU group tenants are built on request.
+type gpuGroupTenantsStore struct {
+	mutex      sync.Mutex
+	sessionUID types.UID
+	nodes      map[string]*node_info.NodeInfo
+}
+
+var gpuGroupTenants = &gpuGroupTenantsStore{}
+
+// GPUGroupTenants returns the GPU sharing pods of the node whose GPU groups include gpuGroup, sorted by namespace and
+// name. The GPU memory allotted to each pod is given by its resource requirements. Nil is returned for unknown nodes.
+func (ssn *Session) GPUGroupTenants(nodeName, gpuGroup string) []*pod_info.PodInfo {
+	node, found := ssn.Nodes[nodeName]
+	if !found {
+		return nil
+	}
+	podsByGpuGroup, _ := nodeGpuPods(node)
+	return podsByGpuGroup[gpuGroup]
+}
+
+// recordGPUGroupTenants keeps the nodes of the session, so the tenants of their shared GPU groups can be served on
+// the GPU group tenants endpoint. The nodes are not modified once the session is closed, as every session takes a
+// new snapshot.
+func (ssn *Session) recordGPUGroupTenants() {
+	gpuGroupTenants.mutex.Lock()
+	defer gpuGroupTenants.mutex.Unlock()
+	gpuGroupTenants.sessionUID = ssn.UID
+	gpuGroupTenants.nodes = ssn.Nodes
+}
+
+// nodeGPUGroupTenants returns the tenants of the shared GPU groups of the node, by GPU group.
+func nodeGPUGroupTenants(node *node_info.NodeInfo) map[string][]GPUGroupTenant {
+	podsByGpuGroup, _ := nodeGpuPods(node)
+	tenants := map[string][]GPUGroupTenant{}
+	for gpuGroup, pods := range podsByGpuGroup {
+		for _, pod := range pods {
+			tenants[gpuGroup] = append(tenants[gpuGroup], GPUGroupTenant{
+				Namespace:    pod.Namespace,
+				Name:         pod.Name,
+				Status:       pod.Status.String(),
+				GPUMemoryMiB: node.GetResourceGpuMemory(pod.ResReq),
+			})
+		}
+	}
+	return tenants
+}
+
+// serveTenants serves the GPU group tenants of the last cycle. With the "node" and "gpuGroup" query parameters, only
+// the tenants of that node or of that GPU group of the node are served.
+func (s *gpuGroupTenantsStore) serveTenants(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.nodes == nil {
+		http.Error(w, "GPU group tenants not ready", http.StatusServiceUnavailable)
+		return
+	}
+	nodeName, gpuGroup := r.URL.Query().Get("node"), r.URL.Query().Get("gpuGroup")
+	if gpuGroup != "" && nodeName == "" {
+		http.Error(w, "The gpuGroup query parameter requires the node query parameter", http.StatusBadRequest)
+		return
+	}
+
+	response := &GPUGroupTenants{
+		SessionUID: s.sessionUID,
+		Nodes:      map[string]map[string][]GPUGroupTenant{},
+	}
+	if nodeName != "" {
+		nodeTenants := map[string][]GPUGroupTenant{}
+		if node, found := s.nodes[nodeName]; found {
+			for group, tenants := range nodeGPUGroupTenants(node) {
+				if gpuGroup == "" || group == gpuGroup {
+					nodeTenants[group] = tenants
+				}
+			}
+		}
+		response.Nodes[nodeName] = nodeTenants
+	} else {
+		for name, node := range s.nodes {
+			if nodeTenants := nodeGPUGroupTenants(node); len(nodeTenants) > 0 {
+				response.Nodes[name] = nodeTenants
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(response); err != nil {
+		http.Error(w, "Failed to encode GPU group tenants", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestGPUGroupTenants(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "shared_job0",
+			Namespace:           "ns",
+			RequiredGPUsPerTask: 0.5,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Running, NodeName: "node0", GPUGroups: []string{"group-a"}},
+				{State: pod_status.Running, NodeName: "node0", GPUGroups: []string{"group-b"}},
+			},
+		},
+		{
+			Name:                "shared_job1",
+			Namespace:           "ns",
+			RequiredGPUsPerTask: 0.25,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Pipelined, NodeName: "node0", GPUGroups: []string{"group-a"}},
+			},
+		},
+		{
+			Name:                "whole_job0",
+			Namespace:           "ns",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "node0"}},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 4, GPUMemory: 1000},
+	}, tasksToNodeMap, nil)
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	podNames := func(pods []*pod_info.PodInfo) []string {
+		var names []string
+		for _, pod := range pods {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"shared_job0-0", "shared_job1-0"}, podNames(ssn.GPUGroupTenants("node0", "group-a")))
+	assert.Equal(t, []string{"shared_job0-1"}, podNames(ssn.GPUGroupTenants("node0", "group-b")))
+	assert.Empty(t, ssn.GPUGroupTenants("node0", "group-c"))
+	assert.Empty(t, ssn.GPUGroupTenants("node1", "group-a"))
+
+	gpuGroupTenants = &gpuGroupTenantsStore{}
+	recorder := httptest.NewRecorder()
+	gpuGroupTenants.serveTenants(recorder, httptest.NewRequest(http.MethodGet, gpuGroupTenantsPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	ssn.recordGPUGroupTenants()
+
+	recorder = httptest.NewRecorder()
+	gpuGroupTenants.serveTenants(recorder,
+		httptest.NewRequest(http.MethodGet, gpuGroupTenantsPath+"?node=node0&gpuGroup=group-a", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var tenants GPUGroupTenants
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &tenants))
+	assert.Equal(t, GPUGroupTenants{
+		SessionUID: "1",
+		Nodes: map[string]map[string][]GPUGroupTenant{"node0": {"group-a": {
+			{Namespace: "ns", Name: "shared_job0-0", Status: "Running", GPUMemoryMiB: 500},
+			{Namespace: "ns", Name: "shared_job1-0", Status: "Pipelined", GPUMemoryMiB: 250},
+		}}},
+	}, tenants)
+
+	recorder = httptest.NewRecorder()
+	gpuGroupTenants.serveTenants(recorder, httptest.NewRequest(http.MethodGet, gpuGroupTenantsPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	tenants = GPUGroupTenants{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &tenants))
+	assert.Len(t, tenants.Nodes["node0"], 2)
+
+	recorder = httptest.NewRecorder()
+	gpuGroupTenants.serveTenants(recorder,
+		httptest.NewRequest(http.MethodGet, gpuGroupTenantsPath+"?gpuGroup=group-a", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
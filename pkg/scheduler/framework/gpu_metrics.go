@@ -0,0 +1,76 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sync"
+	"time"
+)
+
+// GpuUtilizationSample is a single live utilization reading, as reported by DCGM or Prometheus.
+type GpuUtilizationSample struct {
+	// Utilization is the compute utilization as a fraction between 0 and 1.
+	Utilization float64
+	Timestamp   time.Time
+}
+
+// GpuMetricsProvider supplies live GPU utilization to plugins. Implementations are expected to serve from a local
+// cache, since they are queried while scoring every candidate node and GPU.
+type GpuMetricsProvider interface {
+	// GpuUtilization returns the latest sample of a GPU on a node. gpuIdx is the GPU as identified by the scheduler,
+	// i.e. the shared GPU group for fractional allocations.
+	GpuUtilization(nodeName string, gpuIdx string) (GpuUtilizationSample, bool)
+	// NodeGpuUtilization returns the latest average sample across the GPUs of a node.
+	NodeGpuUtilization(nodeName string) (GpuUtilizationSample, bool)
+}
+
+// GpuThermalSample is a single temperature and power reading of a GPU.
+type GpuThermalSample struct {
+	// Temperature is the GPU temperature in degrees Celsius.
+	Temperature float64
+	// PowerUsage is the power draw as a fraction of the GPU's power limit, between 0 and 1.
+	PowerUsage float64
+	Timestamp  time.Time
+}
+
+// GpuThermalMetricsProvider is implemented by GPU metrics providers that also report temperature and power telemetry.
+// Plugins discover it on the session's GpuMetricsProvider.
+type GpuThermalMetricsProvider interface {
+	// GpuThermals returns the latest sample of a GPU on a node, identified like in GpuMetricsProvider.GpuUtilization.
+	GpuThermals(nodeName string, gpuIdx string) (GpuThermalSample, bool)
+	// NodeGpuThermals returns the latest sample of the hottest GPU of a node.
+	NodeGpuThermals(nodeName string) (GpuThermalSample, bool)
+}
+
+var (
+	gpuMetricsProviderMutex sync.Mutex
+	gpuMetricsProvider      GpuMetricsProvider
+)
+
+// RegisterGpuMetricsProvider sets the provider that sessions opened from now on expose to plugins, instead of the GPU
+// metrics in the node annotations. Registering nil removes the provider.
+func RegisterGpuMetricsProvider(provider GpuMetricsProvider) {
+	gpuMetricsProviderMutex.Lock()
+	defer gpuMetricsProviderMutex.Unlock()
+
+	gpuMetricsProvider = provider
+}
+
+func getGpuMetricsProvider() GpuMetricsProvider {
+	gpuMetricsProviderMutex.Lock()
+	defer gpuMetricsProviderMutex.Unlock()
+
+	return gpuMetricsProvider
+}
+
+// GpuMetricsProvider returns the live GPU metrics source of the session. Without a registered provider, the session
+// serves the GPU metrics that the nodes publish in their annotations, or returns nil if no node publishes any.
+func (ssn *Session) GpuMetricsProvider() GpuMetricsProvider {
+	return ssn.gpuMetricsProvider
+}
+
+// SetGpuMetricsProvider overrides the GPU metrics source of this session only.
+func (ssn *Session) SetGpuMetricsProvider(provider GpuMetricsProvider) {
+	ssn.gpuMetricsProvider = provider
+}
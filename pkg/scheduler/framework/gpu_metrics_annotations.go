@@ -0,0 +1,158 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// nodeGpuMetrics are the GPU metrics that a node publishes in its annotations, ordered by GPU index.
+type nodeGpuMetrics struct {
+	utilization []float64
+	temperature []float64
+	powerUsage  []float64
+	timestamp   time.Time
+}
+
+// nodeAnnotationsGpuMetricsProvider is the GPU metrics provider of the sessions when no other provider is registered.
+// It serves the metrics that a node agent, e.g. one reading DCGM, publishes in the gpu-utilization, gpu-temperature
+// and gpu-power-usage annotations of the node, as comma separated values ordered by GPU index, sampled at the time of
+// the gpu-metrics-timestamp annotation. The metrics are parsed once, when the session opens.
+type nodeAnnotationsGpuMetricsProvider struct {
+	nodes   map[string]*node_info.NodeInfo
+	metrics map[string]*nodeGpuMetrics
+}
+
+// newNodeAnnotationsGpuMetricsProvider returns the provider of the GPU metrics in the annotations of the nodes, or nil
+// if no node publishes any.
+func newNodeAnnotationsGpuMetricsProvider(nodes map[string]*node_info.NodeInfo) GpuMetricsProvider {
+	provider := &nodeAnnotationsGpuMetricsProvider{nodes: nodes, metrics: map[string]*nodeGpuMetrics{}}
+	for name, node := range nodes {
+		if metrics := parseNodeGpuMetrics(node); metrics != nil {
+			provider.metrics[name] = metrics
+		}
+	}
+	if len(provider.metrics) == 0 {
+		return nil
+	}
+	return provider
+}
+
+func parseNodeGpuMetrics(node *node_info.NodeInfo) *nodeGpuMetrics {
+	if node.Node == nil {
+		return nil
+	}
+	metrics := &nodeGpuMetrics{
+		utilization: parseGpuMetric(node, commonconstants.GpuUtilization),
+		temperature: parseGpuMetric(node, commonconstants.GpuTemperature),
+		powerUsage:  parseGpuMetric(node, commonconstants.GpuPowerUsage),
+	}
+	if metrics.utilization == nil && metrics.temperature == nil && metrics.powerUsage == nil {
+		return nil
+	}
+
+	value := node.Node.Annotations[commonconstants.GpuMetricsTimestamp]
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring its GPU metrics",
+			node.Name, commonconstants.GpuMetricsTimestamp, value)
+		return nil
+	}
+	metrics.timestamp = timestamp
+	return metrics
+}
+
+// parseGpuMetric parses the comma separated value of every GPU of the node, ordered by GPU index, from the annotation.
+// A missing or malformed annotation means that the metric is unknown.
+func parseGpuMetric(node *node_info.NodeInfo, annotation string) []float64 {
+	value, found := node.Node.Annotations[annotation]
+	if !found || value == "" {
+		return nil
+	}
+
+	var values []float64
+	for _, gpuValueStr := range strings.Split(value, ",") {
+		gpuValue, err := strconv.ParseFloat(strings.TrimSpace(gpuValueStr), 64)
+		if err != nil {
+			log.InfraLogger.V(2).Warnf("Node <%s> has an invalid %s annotation <%s>, ignoring it",
+				node.Name, annotation, value)
+			return nil
+		}
+		values = append(values, gpuValue)
+	}
+	return values
+}
+
+// gpuGroupMetrics returns the metrics of the node and the index of the GPU that the shared GPU group runs on, once its
+// reservation pod reported it.
+func (p *nodeAnnotationsGpuMetricsProvider) gpuGroupMetrics(nodeName string, gpuIdx string) (*nodeGpuMetrics, int, bool) {
+	metrics, found := p.metrics[nodeName]
+	if !found {
+		return nil, 0, false
+	}
+	gpuIndex, found := p.nodes[nodeName].GetGpuGroupIndex(gpuIdx)
+	return metrics, gpuIndex, found
+}
+
+func (p *nodeAnnotationsGpuMetricsProvider) GpuUtilization(nodeName string, gpuIdx string) (GpuUtilizationSample, bool) {
+	metrics, gpuIndex, found := p.gpuGroupMetrics(nodeName, gpuIdx)
+	if !found || gpuIndex >= len(metrics.utilization) {
+		return GpuUtilizationSample{}, false
+	}
+	return GpuUtilizationSample{Utilization: metrics.utilization[gpuIndex], Timestamp: metrics.timestamp}, true
+}
+
+func (p *nodeAnnotationsGpuMetricsProvider) NodeGpuUtilization(nodeName string) (GpuUtilizationSample, bool) {
+	metrics, found := p.metrics[nodeName]
+	if !found || len(metrics.utilization) == 0 {
+		return GpuUtilizationSample{}, false
+	}
+	var sum float64
+	for _, utilization := range metrics.utilization {
+		sum += utilization
+	}
+	return GpuUtilizationSample{
+		Utilization: sum / float64(len(metrics.utilization)),
+		Timestamp:   metrics.timestamp,
+	}, true
+}
+
+func (p *nodeAnnotationsGpuMetricsProvider) GpuThermals(nodeName string, gpuIdx string) (GpuThermalSample, bool) {
+	metrics, gpuIndex, found := p.gpuGroupMetrics(nodeName, gpuIdx)
+	if !found {
+		return GpuThermalSample{}, false
+	}
+	return metrics.thermals(gpuIndex)
+}
+
+func (p *nodeAnnotationsGpuMetricsProvider) NodeGpuThermals(nodeName string) (GpuThermalSample, bool) {
+	metrics, found := p.metrics[nodeName]
+	if !found || len(metrics.temperature) == 0 {
+		return GpuThermalSample{}, false
+	}
+	hottest := 0
+	for gpuIndex, temperature := range metrics.temperature {
+		if temperature > metrics.temperature[hottest] {
+			hottest = gpuIndex
+		}
+	}
+	return metrics.thermals(hottest)
+}
+
+func (m *nodeGpuMetrics) thermals(gpuIndex int) (GpuThermalSample, bool) {
+	if gpuIndex >= len(m.temperature) {
+		return GpuThermalSample{}, false
+	}
+	sample := GpuThermalSample{Temperature: m.temperature[gpuIndex], Timestamp: m.timestamp}
+	if gpuIndex < len(m.powerUsage) {
+		sample.PowerUsage = m.powerUsage[gpuIndex]
+	}
+	return sample, true
+}
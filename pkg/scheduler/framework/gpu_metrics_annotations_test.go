@@ -0,0 +1,119 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+)
+
+func TestNodeAnnotationsGpuMetricsProvider(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                string
+		annotations         map[string]string
+		expectProvider      bool
+		expectedUtilization *GpuUtilizationSample
+		expectedNodeUtil    *GpuUtilizationSample
+		expectedThermals    *GpuThermalSample
+		expectedNodeThermal *GpuThermalSample
+	}{
+		{
+			name: "node without GPU metrics",
+		},
+		{
+			name: "metrics without a timestamp are ignored",
+			annotations: map[string]string{
+				commonconstants.GpuUtilization: "0.2,0.6",
+			},
+		},
+		{
+			name: "malformed metrics are ignored",
+			annotations: map[string]string{
+				commonconstants.GpuUtilization:      "0.2,busy",
+				commonconstants.GpuMetricsTimestamp: timestamp.Format(time.RFC3339),
+			},
+		},
+		{
+			name: "utilization and thermals of the GPU group and the node",
+			annotations: map[string]string{
+				commonconstants.GpuUtilization:      "0.2,0.6",
+				commonconstants.GpuTemperature:      "80,60",
+				commonconstants.GpuPowerUsage:       "0.9,0.5",
+				commonconstants.GpuMetricsTimestamp: timestamp.Format(time.RFC3339),
+			},
+			expectProvider:      true,
+			expectedUtilization: &GpuUtilizationSample{Utilization: 0.6, Timestamp: timestamp},
+			expectedNodeUtil:    &GpuUtilizationSample{Utilization: 0.4, Timestamp: timestamp},
+			expectedThermals:    &GpuThermalSample{Temperature: 60, PowerUsage: 0.5, Timestamp: timestamp},
+			expectedNodeThermal: &GpuThermalSample{Temperature: 80, PowerUsage: 0.9, Timestamp: timestamp},
+		},
+		{
+			name: "utilization only",
+			annotations: map[string]string{
+				commonconstants.GpuUtilization:      "0.2,0.6",
+				commonconstants.GpuMetricsTimestamp: timestamp.Format(time.RFC3339),
+			},
+			expectProvider:      true,
+			expectedUtilization: &GpuUtilizationSample{Utilization: 0.6, Timestamp: timestamp},
+			expectedNodeUtil:    &GpuUtilizationSample{Utilization: 0.4, Timestamp: timestamp},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2, GPUMemory: 1000},
+			}, nil, nil)
+			node := nodesInfoMap["node0"]
+			node.Node.Annotations = tt.annotations
+			reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-group-a",
+				"node0", v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+				map[string]string{
+					commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+					commonconstants.GPUGroup:     "group-a",
+				},
+				map[string]string{commonconstants.ReservedGpuIndex: "1"})
+			assert.NoError(t, node.AddTask(pod_info.NewTaskInfo(reservationPod)))
+
+			provider := newNodeAnnotationsGpuMetricsProvider(nodesInfoMap)
+			if !tt.expectProvider {
+				assert.Nil(t, provider)
+				return
+			}
+			assert.NotNil(t, provider)
+
+			_, found := provider.GpuUtilization("node0", "group-b")
+			assert.False(t, found, "a GPU group without a reported index has no metrics")
+			_, found = provider.NodeGpuUtilization("node1")
+			assert.False(t, found)
+
+			assertSample(t, tt.expectedUtilization)(provider.GpuUtilization("node0", "group-a"))
+			assertSample(t, tt.expectedNodeUtil)(provider.NodeGpuUtilization("node0"))
+			thermalProvider, ok := provider.(GpuThermalMetricsProvider)
+			assert.True(t, ok)
+			assertSample(t, tt.expectedThermals)(thermalProvider.GpuThermals("node0", "group-a"))
+			assertSample(t, tt.expectedNodeThermal)(thermalProvider.NodeGpuThermals("node0"))
+		})
+	}
+}
+
+func assertSample[T any](t *testing.T, expected *T) func(T, bool) {
+	return func(sample T, found bool) {
+		assert.Equal(t, expected != nil, found)
+		if expected != nil {
+			assert.Equal(t, *expected, sample)
+		}
+	}
+}
@@ -27,6 +27,7 @@ const (
 	Allocate          ActionType = "allocate"
 	Consolidation     ActionType = "consolidation"
 	StaleGangEviction ActionType = "stalegangeviction"
+	NodeConsolidation ActionType = "nodeconsolidation"
 )
 
 // Action is the interface of scheduler action.
@@ -0,0 +1,48 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// recordLastGpus records on each job the GPUs that its GPU sharing pods run on, by their index on the node, so a
+// recreated pod can prefer them. The GPUs of pods whose GPU groups have no known index yet, such as groups whose
+// reservation pod was not created yet, are recorded by a later session.
+func (ssn *Session) recordLastGpus() {
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if !pod_status.IsActiveAllocatedStatus(task.Status) || len(task.GPUGroups) == 0 {
+				continue
+			}
+			if lastGpus, found := ssn.taskGpus(task); found {
+				job.SetLastGpus(task.Name, lastGpus)
+			}
+		}
+	}
+}
+
+func (ssn *Session) taskGpus(task *pod_info.PodInfo) (podgroup_info.LastGpus, bool) {
+	node, found := ssn.Nodes[task.NodeName]
+	if !found {
+		return podgroup_info.LastGpus{}, false
+	}
+	gpuIndexes := make([]int, 0, len(task.GPUGroups))
+	for _, gpuGroup := range task.GPUGroups {
+		gpuIndex, found := node.GetGpuGroupIndex(gpuGroup)
+		if !found {
+			return podgroup_info.LastGpus{}, false
+		}
+		gpuIndexes = append(gpuIndexes, gpuIndex)
+	}
+	slices.Sort(gpuIndexes)
+	return podgroup_info.LastGpus{NodeName: node.Name, GpuIndexes: gpuIndexes}, true
+}
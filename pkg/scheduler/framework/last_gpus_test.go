@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestRecordLastGpus(t *testing.T) {
+	tests := []struct {
+		name             string
+		state            pod_status.PodStatus
+		gpuGroups        []string
+		existingLastGpus *podgroup_info.LastGpus
+		expectedLastGpus *podgroup_info.LastGpus
+	}{
+		{
+			name:             "running pod records the indexes of its GPU groups",
+			state:            pod_status.Running,
+			gpuGroups:        []string{"group-b", "group-a"},
+			expectedLastGpus: &podgroup_info.LastGpus{NodeName: "node0", GpuIndexes: []int{0, 1}},
+		},
+		{
+			name:      "GPU group without a known index is not recorded",
+			state:     pod_status.Running,
+			gpuGroups: []string{"group-a", "group-c"},
+		},
+		{
+			name:             "pending pod keeps the GPUs it last ran on",
+			state:            pod_status.Pending,
+			existingLastGpus: &podgroup_info.LastGpus{NodeName: "node0", GpuIndexes: []int{1}},
+			expectedLastGpus: &podgroup_info.LastGpus{NodeName: "node0", GpuIndexes: []int{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &tasks_fake.TestTaskBasic{State: tt.state, GPUGroups: tt.gpuGroups}
+			if tt.state == pod_status.Running {
+				task.NodeName = "node0"
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				{
+					Name:                "job0",
+					RequiredGPUsPerTask: 0.5,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					Tasks:               []*tasks_fake.TestTaskBasic{task},
+				},
+			})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2, GPUMemory: 1000},
+			}, tasksToNodeMap, nil)
+			for gpuGroup, gpuIndex := range map[string]string{"group-a": "0", "group-b": "1"} {
+				reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-"+gpuGroup,
+					"node0", v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+					map[string]string{
+						commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+						commonconstants.GPUGroup:     gpuGroup,
+					},
+					map[string]string{commonconstants.ReservedGpuIndex: gpuIndex})
+				assert.NoError(t, nodesInfoMap["node0"].AddTask(pod_info.NewTaskInfo(reservationPod)))
+			}
+			job := jobsInfoMap["job0"]
+			if tt.existingLastGpus != nil {
+				job.SetLastGpus("job0-0", *tt.existingLastGpus)
+			}
+
+			ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+			ssn.recordLastGpus()
+
+			lastGpus, found := job.GetLastGpus("job0-0")
+			if tt.expectedLastGpus == nil {
+				assert.False(t, found)
+				return
+			}
+			assert.True(t, found)
+			assert.Equal(t, *tt.expectedLastGpus, lastGpus)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const lostGpuGroupEvictionAction = "lostgpugroup"
+
+// remediateLostGpuGroups applies the GPU group loss policy to the running GPU sharing pods whose GPU groups were lost
+// after they were bound, e.g. because the GPU failed. With the Evict policy the jobs of the pods are evicted as a
+// whole, so their gangs are rescheduled together on healthy GPUs. With the Mark policy the pods are annotated with
+// their lost GPU groups and an event is recorded on them, once per change of their lost GPU groups.
+func (ssn *Session) remediateLostGpuGroups() {
+	policy := ssn.SchedulerParams.GpuGroupLossPolicy
+	if policy != conf.GpuGroupLossEvict && policy != conf.GpuGroupLossMark {
+		return
+	}
+
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		lostGpuGroupsByTask := map[common_info.PodID]map[string]string{}
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Running || task.Pod == nil {
+				continue
+			}
+			node, found := ssn.Nodes[task.NodeName]
+			if !found {
+				continue
+			}
+			lostGpuGroups := node.GetLostGpuGroups(task)
+			if policy == conf.GpuGroupLossMark {
+				ssn.markLostGpuGroups(task, lostGpuGroups)
+				continue
+			}
+			if len(lostGpuGroups) > 0 {
+				lostGpuGroupsByTask[task.UID] = lostGpuGroups
+			}
+		}
+		if len(lostGpuGroupsByTask) > 0 {
+			ssn.evictLostGpuGroupsJob(job, lostGpuGroupsByTask)
+		}
+	}
+}
+
+// evictLostGpuGroupsJob evicts all the allocated pods of the job, since the gang cannot keep running without the pods
+// whose GPU groups were lost.
+func (ssn *Session) evictLostGpuGroupsJob(
+	job *podgroup_info.PodGroupInfo, lostGpuGroupsByTask map[common_info.PodID]map[string]string,
+) {
+	var tasksToEvict []*pod_info.PodInfo
+	var lostTaskNames []string
+	for _, task := range job.GetAllPodsMap() {
+		if pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasksToEvict = append(tasksToEvict, task)
+		}
+		if _, found := lostGpuGroupsByTask[task.UID]; found {
+			lostTaskNames = append(lostTaskNames, task.Name)
+		}
+	}
+	slices.Sort(lostTaskNames)
+
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasksToEvict),
+		Action:           lostGpuGroupEvictionAction,
+	}
+	for _, task := range tasksToEvict {
+		reason := fmt.Sprintf("GPU groups of pods of its job were lost: %s", strings.Join(lostTaskNames, ", "))
+		if lostGpuGroups, found := lostGpuGroupsByTask[task.UID]; found {
+			reason = lostGpuGroupsReason(lostGpuGroups)
+		}
+		message := fmt.Sprintf("Pod %s/%s was evicted to be rescheduled because %s", task.Namespace, task.Name, reason)
+		if err := ssn.Evict(task, message, evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> of job <%s> with lost GPU groups: %v",
+				task.Namespace, task.Name, job.NamespacedName, err)
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Evicted task <%s/%s> from node <%s> because %s",
+			task.Namespace, task.Name, task.NodeName, reason)
+	}
+}
+
+// markLostGpuGroups keeps the lost GPU groups annotation of the task in sync with its lost GPU groups, and records an
+// event on the task when it loses GPU groups.
+func (ssn *Session) markLostGpuGroups(task *pod_info.PodInfo, lostGpuGroups map[string]string) {
+	gpuGroups := maps.Keys(lostGpuGroups)
+	slices.Sort(gpuGroups)
+	value := strings.Join(gpuGroups, ",")
+	if task.Pod.Annotations[commonconstants.LostGpuGroups] == value {
+		return
+	}
+
+	if value == "" {
+		ssn.Cache.PatchTaskAnnotations(task, map[string]any{commonconstants.LostGpuGroups: nil})
+		return
+	}
+	ssn.Cache.PatchTaskAnnotations(task, map[string]any{commonconstants.LostGpuGroups: value})
+	message := fmt.Sprintf("Pod %s/%s needs attention because %s", task.Namespace, task.Name,
+		lostGpuGroupsReason(lostGpuGroups))
+	ssn.Cache.TaskGpuGroupsLost(task, message)
+	log.InfraLogger.V(3).Infof("Marked task <%s/%s> on node <%s>: %s",
+		task.Namespace, task.Name, task.NodeName, message)
+}
+
+func lostGpuGroupsReason(lostGpuGroups map[string]string) string {
+	gpuGroups := maps.Keys(lostGpuGroups)
+	slices.Sort(gpuGroups)
+	reasons := make([]string, 0, len(gpuGroups))
+	for _, gpuGroup := range gpuGroups {
+		reasons = append(reasons, fmt.Sprintf("GPU group %s was lost: %s", gpuGroup, lostGpuGroups[gpuGroup]))
+	}
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestRemediateLostGpuGroups(t *testing.T) {
+	tests := []struct {
+		name               string
+		policy             conf.GpuGroupLossPolicy
+		withoutReservation bool
+		unhealthyGpu       bool
+		existingAnnotation string
+		crossPartition     bool
+		nodeGpus           int
+		gangMembers        int
+		expectEviction     bool
+		expectedAnnotation any
+		expectEvent        bool
+	}{
+		{
+			name:   "healthy GPU group",
+			policy: conf.GpuGroupLossEvict,
+		},
+		{
+			name:               "missing reservation pod evicts the pod",
+			policy:             conf.GpuGroupLossEvict,
+			withoutReservation: true,
+			expectEviction:     true,
+		},
+		{
+			name:           "unhealthy GPU evicts the pod",
+			policy:         conf.GpuGroupLossEvict,
+			unhealthyGpu:   true,
+			expectEviction: true,
+		},
+		{
+			name:               "pods of the gang are evicted with the pod",
+			policy:             conf.GpuGroupLossEvict,
+			withoutReservation: true,
+			gangMembers:        1,
+			expectEviction:     true,
+		},
+		{
+			name:     "GPU index beyond the GPUs of the node is not lost",
+			policy:   conf.GpuGroupLossEvict,
+			nodeGpus: 1,
+		},
+		{
+			name:               "job of another node pool is left to its scheduler",
+			policy:             conf.GpuGroupLossEvict,
+			withoutReservation: true,
+			crossPartition:     true,
+		},
+		{
+			name:         "disabled",
+			policy:       conf.GpuGroupLossNone,
+			unhealthyGpu: true,
+		},
+		{
+			name:               "unhealthy GPU marks the pod",
+			policy:             conf.GpuGroupLossMark,
+			unhealthyGpu:       true,
+			expectedAnnotation: "group-a",
+			expectEvent:        true,
+		},
+		{
+			name:               "pod that is already marked",
+			policy:             conf.GpuGroupLossMark,
+			unhealthyGpu:       true,
+			existingAnnotation: "group-a",
+		},
+		{
+			name:               "mark of a recovered pod is removed",
+			policy:             conf.GpuGroupLossMark,
+			existingAnnotation: "group-a",
+			expectedAnnotation: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Running, NodeName: "node0", GPUGroups: []string{"group-a"}},
+			}
+			for range tt.gangMembers {
+				tasks = append(tasks, &tasks_fake.TestTaskBasic{State: pod_status.Running, NodeName: "node1"})
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				{
+					Name:                "job0",
+					RequiredGPUsPerTask: 0.5,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					Tasks:               tasks,
+				},
+			})
+			nodeGpus := 2
+			if tt.nodeGpus > 0 {
+				nodeGpus = tt.nodeGpus
+			}
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: nodeGpus, GPUMemory: 1000},
+				"node1": {GPUs: 2, GPUMemory: 1000},
+			}, tasksToNodeMap, nil)
+			node := nodesInfoMap["node0"]
+			if !tt.withoutReservation {
+				reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-node0-abcde",
+					"node0", v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+					map[string]string{
+						commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+						commonconstants.GPUGroup:     "group-a",
+					},
+					map[string]string{commonconstants.ReservedGpuIndex: "1"})
+				assert.NoError(t, node.AddTask(pod_info.NewTaskInfo(reservationPod)))
+			}
+			if tt.unhealthyGpu {
+				node.UnhealthyGpus = map[int]bool{1: true}
+			}
+			task := jobsInfoMap["job0"].GetAllPodsMap()["job0-0"]
+			if tt.existingAnnotation != "" {
+				task.Pod.Annotations[commonconstants.LostGpuGroups] = tt.existingAnnotation
+			}
+
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			if tt.expectEviction {
+				mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), eviction_info.EvictionMetadata{
+					EvictionGangSize: len(tasks),
+					Action:           lostGpuGroupEvictionAction,
+				}, gomock.Any()).Return(nil).Times(len(tasks))
+			}
+			if tt.expectEvent || tt.existingAnnotation != "" && !tt.unhealthyGpu {
+				mockCache.EXPECT().PatchTaskAnnotations(task,
+					map[string]any{commonconstants.LostGpuGroups: tt.expectedAnnotation})
+			}
+			if tt.expectEvent {
+				mockCache.EXPECT().TaskGpuGroupsLost(task, gomock.Any())
+			}
+
+			ssn := &Session{
+				UID:             "1",
+				Cache:           mockCache,
+				PodGroupInfos:   jobsInfoMap,
+				Nodes:           nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{GpuGroupLossPolicy: tt.policy},
+			}
+			if tt.crossPartition {
+				jobsInfoMap["job0"].PodGroup.Labels = map[string]string{testNodePoolLabelKey: "batch"}
+				ssn.SchedulerParams.PartitionParams = &conf.SchedulingNodePoolParams{
+					NodePoolLabelKey:               testNodePoolLabelKey,
+					NodePoolLabelValue:             "production",
+					CrossPartitionReclaimNodePools: []string{"batch"},
+				}
+			}
+			ssn.remediateLostGpuGroups()
+
+			expectedStatus := pod_status.Running
+			if tt.expectEviction {
+				expectedStatus = pod_status.Releasing
+			}
+			for _, jobTask := range jobsInfoMap["job0"].GetAllPodsMap() {
+				assert.Equal(t, expectedStatus, jobTask.Status, jobTask.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// runningJobsCounter counts the running jobs of every queue and its child queues, so the maximum running jobs of the
+// queues is enforced whichever plugin decides their capacity. A job runs while any of its tasks is active allocated.
+type runningJobsCounter struct {
+	runningJobs      map[common_info.PodGroupID]bool
+	queueRunningJobs map[common_info.QueueID]int
+}
+
+// initRunningJobs counts the running jobs of the queues in the snapshot, and keeps the counts up to date with the
+// allocations and evictions of the session.
+func (ssn *Session) initRunningJobs() {
+	ssn.runningJobsCounter = runningJobsCounter{
+		runningJobs:      map[common_info.PodGroupID]bool{},
+		queueRunningJobs: map[common_info.QueueID]int{},
+	}
+	for _, job := range ssn.PodGroupInfos {
+		ssn.updateRunningJobs(job)
+	}
+
+	onTaskEvent := func(event *Event) {
+		if job, found := ssn.PodGroupInfos[event.Task.Job]; found {
+			ssn.updateRunningJobs(job)
+		}
+	}
+	ssn.eventHandlers = append(ssn.eventHandlers, &EventHandler{
+		AllocateFunc:   onTaskEvent,
+		DeallocateFunc: onTaskEvent,
+	})
+}
+
+// updateRunningJobs updates the running jobs count of the job's queue and its ancestors when the job starts or stops
+// having active allocated tasks.
+func (ssn *Session) updateRunningJobs(job *podgroup_info.PodGroupInfo) {
+	counter := &ssn.runningJobsCounter
+	isRunning := job.GetActiveAllocatedTasksCount() > 0
+	if isRunning == counter.runningJobs[job.UID] {
+		return
+	}
+
+	delta := 1
+	if isRunning {
+		counter.runningJobs[job.UID] = true
+	} else {
+		delete(counter.runningJobs, job.UID)
+		delta = -1
+	}
+	for queue, found := ssn.Queues[job.Queue]; found; queue, found = ssn.Queues[queue.ParentQueue] {
+		counter.queueRunningJobs[queue.UID] += delta
+	}
+}
+
+// isJobOverMaxRunningJobs blocks jobs that are not running yet while their queue, or one of its ancestors, runs its
+// maximum number of jobs. Jobs that already run are not blocked from allocating more tasks.
+func (ssn *Session) isJobOverMaxRunningJobs(job *podgroup_info.PodGroupInfo) *api.SchedulableResult {
+	counter := &ssn.runningJobsCounter
+	if counter.runningJobs == nil || job.GetActiveAllocatedTasksCount() > 0 {
+		return nil
+	}
+
+	for queue, found := ssn.Queues[job.Queue]; found; queue, found = ssn.Queues[queue.ParentQueue] {
+		runningJobs := counter.queueRunningJobs[queue.UID]
+		if queue.MaxRunningJobs == nil || runningJobs < *queue.MaxRunningJobs {
+			continue
+		}
+		return &api.SchedulableResult{
+			IsSchedulable: false,
+			Reason:        enginev2alpha2.MaxRunningJobsReached,
+			Message:       api.GetJobOverMaxRunningJobsMessageForQueue(queue.Name, *queue.MaxRunningJobs, runningJobs),
+			Details:       nil,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_IsJobOverMaxRunningJobs(t *testing.T) {
+	jobs := []*jobs_fake.TestJobBasic{
+		{
+			Name:                "running_job0",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{NodeName: "node0", State: pod_status.Running}},
+		},
+		{
+			Name:                "pending_job0",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue1",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 4},
+	}, tasksToNodeMap, nil)
+	ssn := &Session{
+		PodGroupInfos: jobsInfoMap,
+		Nodes:         nodesInfoMap,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"department0": {UID: "department0", Name: "department0", MaxRunningJobs: ptr.To(2)},
+			"queue0":      {UID: "queue0", Name: "queue0", ParentQueue: "department0"},
+			"queue1":      {UID: "queue1", Name: "queue1", ParentQueue: "department0", MaxRunningJobs: ptr.To(1)},
+		},
+	}
+	ssn.initRunningJobs()
+	assert.Equal(t, 1, ssn.runningJobsCounter.queueRunningJobs["department0"])
+	assert.Nil(t, ssn.isJobOverMaxRunningJobs(jobsInfoMap["pending_job0"]))
+
+	stmt := ssn.Statement()
+	cp := stmt.Checkpoint()
+	task := jobsInfoMap["pending_job0"].GetAllPodsMap()["pending_job0-0"]
+	assert.Nil(t, stmt.Allocate(task, "node0"))
+	assert.Equal(t, 2, ssn.runningJobsCounter.queueRunningJobs["department0"])
+	assert.Equal(t, 1, ssn.runningJobsCounter.queueRunningJobs["queue1"])
+	assert.Nil(t, ssn.isJobOverMaxRunningJobs(jobsInfoMap["pending_job0"]),
+		"a running job is not blocked from allocating more tasks")
+
+	assert.Nil(t, stmt.Rollback(cp))
+	assert.Equal(t, 1, ssn.runningJobsCounter.queueRunningJobs["department0"])
+	assert.Equal(t, 0, ssn.runningJobsCounter.queueRunningJobs["queue1"])
+
+	ssn.Queues["department0"].MaxRunningJobs = ptr.To(1)
+	result := ssn.IsJobOverQueueCapacityFn(jobsInfoMap["pending_job0"], nil)
+	assert.False(t, result.IsSchedulable, "the cap is enforced without a capacity plugin")
+	assert.Equal(t, enginev2alpha2.MaxRunningJobsReached, result.Reason)
+	assert.Contains(t, result.Message, "department0 has reached its limit of 1 running workloads")
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const nodeConsolidationPlanPath = "/get-node-consolidation-plan"
+
+// NodeConsolidationMigration is a pod that node consolidation moves to another node.
+type NodeConsolidationMigration struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	FromNode  string `json:"fromNode"`
+	ToNode    string `json:"toNode"`
+}
+
+// NodeConsolidationPlan holds the pods that node consolidation moved, or would have moved in a dry run, in a single
+// scheduling cycle, and the nodes that were left empty by the moves.
+type NodeConsolidationPlan struct {
+	SessionUID types.UID                    `json:"sessionUID"`
+	DryRun     bool                         `json:"dryRun"`
+	Migrations []NodeConsolidationMigration `json:"migrations"`
+	EmptyNodes []string                     `json:"emptyNodes"`
+}
+
+// nodeConsolidationPlanStore keeps the plan of the last scheduling cycle that ran node consolidation, and whether a
+// dry run plan was requested for the next cycle.
+type nodeConsolidationPlanStore struct {
+	mutex     sync.Mutex
+	plan      *NodeConsolidationPlan
+	requested bool
+}
+
+var nodeConsolidationPlans = &nodeConsolidationPlanStore{}
+
+// RecordNodeConsolidationPlan keeps the plan to be served on the node consolidation plan endpoint, replacing the plan
+// of the previous cycle.
+func (ssn *Session) RecordNodeConsolidationPlan(plan NodeConsolidationPlan) {
+	plan.SessionUID = ssn.UID
+	nodeConsolidationPlans.mutex.Lock()
+	defer nodeConsolidationPlans.mutex.Unlock()
+
+	nodeConsolidationPlans.plan = &plan
+}
+
+// RequestNodeConsolidationPlan requests a dry run plan of node consolidation from the next scheduling cycle, when node
+// consolidation is not allowed.
+func RequestNodeConsolidationPlan() {
+	nodeConsolidationPlans.mutex.Lock()
+	defer nodeConsolidationPlans.mutex.Unlock()
+
+	nodeConsolidationPlans.requested = true
+}
+
+// TakeNodeConsolidationPlanRequest returns whether a dry run plan of node consolidation was requested, and clears the
+// request.
+func TakeNodeConsolidationPlanRequest() bool {
+	nodeConsolidationPlans.mutex.Lock()
+	defer nodeConsolidationPlans.mutex.Unlock()
+
+	requested := nodeConsolidationPlans.requested
+	nodeConsolidationPlans.requested = false
+	return requested
+}
+
+// LastNodeConsolidationPlan returns the plan recorded by the last scheduling cycle that ran node consolidation.
+func LastNodeConsolidationPlan() (NodeConsolidationPlan, bool) {
+	nodeConsolidationPlans.mutex.Lock()
+	defer nodeConsolidationPlans.mutex.Unlock()
+
+	if nodeConsolidationPlans.plan == nil {
+		return NodeConsolidationPlan{}, false
+	}
+	return *nodeConsolidationPlans.plan, true
+}
+
+// servePlan serves the last plan on GET requests. A POST request asks for a dry run plan from the next scheduling
+// cycle, which is served once that cycle ends.
+func (s *nodeConsolidationPlanStore) servePlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPost {
+		RequestNodeConsolidationPlan()
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.plan == nil {
+		http.Error(w, "Node consolidation plan not ready, request one with a POST", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s.plan); err != nil {
+		http.Error(w, "Failed to encode node consolidation plan", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeConsolidationPlan_RecordAndServe(t *testing.T) {
+	nodeConsolidationPlans = &nodeConsolidationPlanStore{}
+	defer func() { nodeConsolidationPlans = &nodeConsolidationPlanStore{} }()
+
+	recorder := httptest.NewRecorder()
+	nodeConsolidationPlans.servePlan(recorder, httptest.NewRequest(http.MethodGet, nodeConsolidationPlanPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	ssn := &Session{UID: "session-1"}
+	ssn.RecordNodeConsolidationPlan(NodeConsolidationPlan{
+		DryRun: true,
+		Migrations: []NodeConsolidationMigration{
+			{Namespace: "ns", Name: "p1", FromNode: "n1", ToNode: "n2"},
+		},
+		EmptyNodes: []string{"n1"},
+	})
+
+	recorder = httptest.NewRecorder()
+	nodeConsolidationPlans.servePlan(recorder, httptest.NewRequest(http.MethodGet, nodeConsolidationPlanPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var plan NodeConsolidationPlan
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &plan))
+	lastPlan, found := LastNodeConsolidationPlan()
+	assert.True(t, found)
+	assert.Equal(t, lastPlan, plan)
+	assert.Equal(t, "session-1", string(plan.SessionUID))
+	assert.True(t, plan.DryRun)
+	assert.Equal(t, []string{"n1"}, plan.EmptyNodes)
+	assert.Equal(t, "n2", plan.Migrations[0].ToNode)
+}
+
+func TestNodeConsolidationPlan_Request(t *testing.T) {
+	nodeConsolidationPlans = &nodeConsolidationPlanStore{}
+	defer func() { nodeConsolidationPlans = &nodeConsolidationPlanStore{} }()
+
+	assert.False(t, TakeNodeConsolidationPlanRequest())
+
+	recorder := httptest.NewRecorder()
+	nodeConsolidationPlans.servePlan(recorder, httptest.NewRequest(http.MethodPost, nodeConsolidationPlanPath, nil))
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+
+	assert.True(t, TakeNodeConsolidationPlanRequest())
+	assert.False(t, TakeNodeConsolidationPlanRequest(), "a request is taken once")
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+const heldNodesPath = "/get-held-nodes"
+
+// HeldNode is a node that does not receive new pods, with the reason it is held for and the number of its active pods.
+type HeldNode struct {
+	Name       string `json:"name"`
+	Reason     string `json:"reason"`
+	ActivePods int    `json:"activePods"`
+}
+
+// HeldNodes holds the nodes that were held in a scheduling cycle, sorted by name.
+type HeldNodes struct {
+	SessionUID types.UID  `json:"sessionUID"`
+	Nodes      []HeldNode `json:"nodes"`
+}
+
+// heldNodesStore keeps the held nodes of the last scheduling cycle.
+type heldNodesStore struct {
+	mutex     sync.Mutex
+	heldNodes *HeldNodes
+}
+
+var heldNodes = &heldNodesStore{}
+
+// NodeHoldReason returns the reason the node is held for, and true if the node is held. A node is held by setting the
+// kai.scheduler/hold annotation to the reason of the hold, and is released by removing the annotation or setting it
+// to "false". A held node does not receive new pods, while the pods that run on it are left alone.
+func NodeHoldReason(node *node_info.NodeInfo) (string, bool) {
+	if node == nil || node.Node == nil {
+		return "", false
+	}
+	reason, found := node.Node.Annotations[commonconstants.NodeHold]
+	if !found || strings.EqualFold(reason, "false") {
+		return "", false
+	}
+	return reason, true
+}
+
+// predicateHeldNode rejects placing tasks on held nodes.
+func predicateHeldNode(task *pod_info.PodInfo, node *node_info.NodeInfo) *common_info.FitError {
+	reason, held := NodeHoldReason(node)
+	if !held {
+		return nil
+	}
+	message := "node is held"
+	if reason != "" && !strings.EqualFold(reason, "true") {
+		message = fmt.Sprintf("node is held: %s", reason)
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name, message)
+}
+
+// recordHeldNodes keeps the held nodes of the session to be served on the held nodes endpoint.
+func (ssn *Session) recordHeldNodes() {
+	held := &HeldNodes{SessionUID: ssn.UID, Nodes: []HeldNode{}}
+	for _, node := range ssn.Nodes {
+		reason, isHeld := NodeHoldReason(node)
+		if !isHeld {
+			continue
+		}
+		activePods := 0
+		for _, pod := range node.PodInfos {
+			if pod_status.IsActiveUsedStatus(pod.Status) {
+				activePods++
+			}
+		}
+		held.Nodes = append(held.Nodes, HeldNode{Name: node.Name, Reason: reason, ActivePods: activePods})
+	}
+	slices.SortFunc(held.Nodes, func(l, r HeldNode) int {
+		return strings.Compare(l.Name, r.Name)
+	})
+
+	heldNodes.mutex.Lock()
+	defer heldNodes.mutex.Unlock()
+	heldNodes.heldNodes = held
+}
+
+// serveHeldNodes serves the held nodes of the last cycle.
+func (s *heldNodesStore) serveHeldNodes(w http.ResponseWriter, _ *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.heldNodes == nil {
+		http.Error(w, "Held nodes not ready", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s.heldNodes); err != nil {
+		http.Error(w, "Failed to encode held nodes", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestNodeHold(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "running_job",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "node0"}},
+		},
+		{
+			Name:                "pending_job",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 4},
+		"node1": {GPUs: 4},
+		"node2": {GPUs: 4},
+	}, tasksToNodeMap, nil)
+	nodesInfoMap["node0"].Node.Annotations = map[string]string{commonconstants.NodeHold: "investigating XID 79"}
+	nodesInfoMap["node1"].Node.Annotations = map[string]string{commonconstants.NodeHold: "false"}
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	job := jobsInfoMap["pending_job"]
+	task := job.GetAllPodsMap()["pending_job-0"]
+	assert.False(t, ssn.FittingNode(task, nodesInfoMap["node0"], true))
+	assert.Contains(t, job.NodesFitErrors[task.UID].DetailedError(), "node is held: investigating XID 79")
+	assert.True(t, ssn.FittingNode(task, nodesInfoMap["node1"], true))
+	assert.True(t, ssn.FittingNode(task, nodesInfoMap["node2"], true))
+
+	heldNodes = &heldNodesStore{}
+	recorder := httptest.NewRecorder()
+	heldNodes.serveHeldNodes(recorder, httptest.NewRequest(http.MethodGet, heldNodesPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	ssn.recordHeldNodes()
+
+	recorder = httptest.NewRecorder()
+	heldNodes.serveHeldNodes(recorder, httptest.NewRequest(http.MethodGet, heldNodesPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var served HeldNodes
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &served))
+	assert.Equal(t, HeldNodes{
+		SessionUID: "1",
+		Nodes:      []HeldNode{{Name: "node0", Reason: "investigating XID 79", ActivePods: 1}},
+	}, served)
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	nodeMismatchEvictionAction = "nodemismatch"
+
+	// nodeConditionTaintPrefix is the prefix of the taints that Kubernetes sets for node conditions and cordoning,
+	// which are handled by Kubernetes itself and do not evict running pods.
+	nodeConditionTaintPrefix = "node.kubernetes.io/"
+)
+
+// nodeMismatchStore tracks since when running pods are on nodes that no longer satisfy their tolerations or required
+// node affinity, across sessions, so a pod is evicted only if the mismatch lasts for the grace period.
+type nodeMismatchStore struct {
+	mutex sync.Mutex
+	since map[common_info.PodID]time.Time
+}
+
+var nodeMismatches = &nodeMismatchStore{since: map[common_info.PodID]time.Time{}}
+
+// observe returns the time the mismatch of the pod was first observed.
+func (s *nodeMismatchStore) observe(podUID common_info.PodID, now time.Time) time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	since, found := s.since[podUID]
+	if !found {
+		since = now
+		s.since[podUID] = since
+	}
+	return since
+}
+
+// retain drops the pods that are no longer mismatched, so a transient mismatch starts a new grace period when it
+// returns.
+func (s *nodeMismatchStore) retain(podUIDs map[common_info.PodID]bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for podUID := range s.since {
+		if !podUIDs[podUID] {
+			delete(s.since, podUID)
+		}
+	}
+}
+
+// evictNodeMismatchedPods evicts the running pods whose node gained a NoSchedule taint they do not tolerate, or lost
+// the labels their node selector or required node affinity select, for longer than the node mismatch eviction grace
+// period, so they are rescheduled on nodes that satisfy them. When evicting the pods would leave a sub-group of their
+// job below its minimum available, the whole job is evicted instead. Disabled when the grace period is not positive.
+func (ssn *Session) evictNodeMismatchedPods(now time.Time) {
+	gracePeriod := ssn.SchedulerParams.NodeMismatchEvictionGracePeriod
+	mismatchedPods := map[common_info.PodID]bool{}
+	defer nodeMismatches.retain(mismatchedPods)
+	if gracePeriod <= 0 {
+		return
+	}
+
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		mismatches := map[common_info.PodID]string{}
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Running || task.Pod == nil {
+				continue
+			}
+			node, found := ssn.Nodes[task.NodeName]
+			if !found || node.Node == nil {
+				continue
+			}
+			mismatch, mismatched := nodeMismatch(task, node.Node)
+			if !mismatched {
+				continue
+			}
+
+			mismatchedPods[task.UID] = true
+			since := nodeMismatches.observe(task.UID, now)
+			if now.Sub(since) < gracePeriod {
+				log.InfraLogger.V(4).Infof("Task <%s/%s> is on node <%s> that %s since <%v>, waiting for the grace "+
+					"period to pass", task.Namespace, task.Name, task.NodeName, mismatch, since.Format(time.RFC3339))
+				continue
+			}
+			mismatches[task.UID] = mismatch
+		}
+		if len(mismatches) > 0 {
+			ssn.evictNodeMismatchedTasks(job, mismatches)
+		}
+	}
+}
+
+// evictNodeMismatchedTasks evicts the mismatched tasks of the job, or all of its allocated tasks if the job would be
+// left without its minimum available.
+func (ssn *Session) evictNodeMismatchedTasks(job *podgroup_info.PodGroupInfo, mismatches map[common_info.PodID]string) {
+	var tasksToEvict []*pod_info.PodInfo
+	wholeJob := breaksMinAvailable(job, mismatches)
+	for _, task := range job.GetAllPodsMap() {
+		_, mismatched := mismatches[task.UID]
+		if mismatched || wholeJob && pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasksToEvict = append(tasksToEvict, task)
+		}
+	}
+
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasksToEvict),
+		Action:           nodeMismatchEvictionAction,
+	}
+	for _, task := range tasksToEvict {
+		reason := "pods of its job are on nodes that no longer satisfy them"
+		if mismatch, found := mismatches[task.UID]; found {
+			reason = fmt.Sprintf("its node %s %s", task.NodeName, mismatch)
+		}
+		message := fmt.Sprintf("Pod %s/%s was evicted to be rescheduled because %s", task.Namespace, task.Name, reason)
+		if err := ssn.Evict(task, message, evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> of job <%s> with mismatched nodes: %v",
+				task.Namespace, task.Name, job.NamespacedName, err)
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Evicted task <%s/%s> from node <%s> because %s",
+			task.Namespace, task.Name, task.NodeName, reason)
+	}
+}
+
+// breaksMinAvailable returns true if evicting the tasks would leave a sub-group of the job with fewer active allocated
+// tasks than its minimum available.
+func breaksMinAvailable(job *podgroup_info.PodGroupInfo, tasks map[common_info.PodID]string) bool {
+	for _, podSet := range job.PodSets {
+		evicted := 0
+		for podID := range podSet.GetPodInfos() {
+			if _, found := tasks[podID]; found {
+				evicted++
+			}
+		}
+		if evicted > 0 && podSet.GetNumActiveAllocatedTasks()-evicted < int(podSet.GetMinAvailable()) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeMismatch returns why the node no longer satisfies the tolerations or the required node affinity of the pod.
+// NoExecute taints are not checked, since Kubernetes evicts the pods that do not tolerate them.
+func nodeMismatch(task *pod_info.PodInfo, node *v1.Node) (string, bool) {
+	taint, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, task.Pod.Spec.Tolerations,
+		func(taint *v1.Taint) bool {
+			return taint.Effect == v1.TaintEffectNoSchedule && !strings.HasPrefix(taint.Key, nodeConditionTaintPrefix)
+		})
+	if untolerated {
+		return fmt.Sprintf("has the taint %s that the pod does not tolerate", taint.ToString()), true
+	}
+
+	matches, err := nodeaffinity.GetRequiredNodeAffinity(task.Pod).Match(node)
+	if err != nil {
+		log.InfraLogger.V(4).Warnf("Failed to match the node affinity of task <%s/%s> with node <%s>: %v",
+			task.Namespace, task.Name, node.Name, err)
+		return "", false
+	}
+	if !matches {
+		return "no longer matches the node selector or the required node affinity of the pod", true
+	}
+	return "", false
+}
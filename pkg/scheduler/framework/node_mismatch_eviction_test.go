@@ -0,0 +1,235 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestEvictNodeMismatchedPods(t *testing.T) {
+	now := time.Now()
+	dedicatedTaint := v1.Taint{Key: "dedicated", Value: "inference", Effect: v1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name          string
+		gracePeriod   time.Duration
+		taints        []v1.Taint
+		tolerations   []v1.Toleration
+		nodeSelector  map[string]string
+		transient     bool
+		expectedState pod_status.PodStatus
+	}{
+		{
+			name:          "node that satisfies the pod",
+			gracePeriod:   time.Minute,
+			nodeSelector:  map[string]string{"pool": "training"},
+			expectedState: pod_status.Running,
+		},
+		{
+			name:          "untolerated taint for longer than the grace period",
+			gracePeriod:   time.Minute,
+			taints:        []v1.Taint{dedicatedTaint},
+			expectedState: pod_status.Releasing,
+		},
+		{
+			name:          "untolerated taint within the grace period",
+			gracePeriod:   time.Hour,
+			taints:        []v1.Taint{dedicatedTaint},
+			expectedState: pod_status.Running,
+		},
+		{
+			name:          "transient untolerated taint",
+			gracePeriod:   time.Minute,
+			taints:        []v1.Taint{dedicatedTaint},
+			transient:     true,
+			expectedState: pod_status.Running,
+		},
+		{
+			name:        "tolerated taint",
+			gracePeriod: time.Minute,
+			taints:      []v1.Taint{dedicatedTaint},
+			tolerations: []v1.Toleration{{
+				Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "inference", Effect: v1.TaintEffectNoSchedule,
+			}},
+			expectedState: pod_status.Running,
+		},
+		{
+			name:        "prefer no schedule taint",
+			gracePeriod: time.Minute,
+			taints: []v1.Taint{
+				{Key: "dedicated", Value: "inference", Effect: v1.TaintEffectPreferNoSchedule},
+			},
+			expectedState: pod_status.Running,
+		},
+		{
+			name:        "cordoned node",
+			gracePeriod: time.Minute,
+			taints: []v1.Taint{
+				{Key: v1.TaintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule},
+			},
+			expectedState: pod_status.Running,
+		},
+		{
+			name:          "node no longer matching the node selector",
+			gracePeriod:   time.Minute,
+			nodeSelector:  map[string]string{"pool": "inference"},
+			expectedState: pod_status.Releasing,
+		},
+		{
+			name:          "disabled",
+			taints:        []v1.Taint{dedicatedTaint},
+			expectedState: pod_status.Running,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeMismatches = &nodeMismatchStore{since: map[common_info.PodID]time.Time{}}
+			topology := nodes_fake.TestClusterTopology{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "job0",
+						RequiredGPUsPerTask: 1,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{State: pod_status.Running, NodeName: "node0"},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 4},
+				},
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+			node := nodesInfoMap["node0"].Node
+			node.Labels = map[string]string{"pool": "training"}
+			node.Spec.Taints = tt.taints
+			task := jobsInfoMap["job0"].GetAllPodsMap()["job0-0"]
+			task.Pod.Spec.Tolerations = tt.tolerations
+			task.Pod.Spec.NodeSelector = tt.nodeSelector
+
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			if tt.expectedState == pod_status.Releasing {
+				mockCache.EXPECT().Evict(task.Pod, gomock.Any(), eviction_info.EvictionMetadata{
+					EvictionGangSize: 1,
+					Action:           nodeMismatchEvictionAction,
+				}, gomock.Any()).Return(nil)
+			}
+
+			ssn := &Session{
+				UID:             "1",
+				Cache:           mockCache,
+				PodGroupInfos:   jobsInfoMap,
+				Nodes:           nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{NodeMismatchEvictionGracePeriod: tt.gracePeriod},
+			}
+			ssn.evictNodeMismatchedPods(now)
+			assert.Equal(t, pod_status.Running, task.Status)
+
+			if tt.transient {
+				node.Spec.Taints = nil
+				ssn.evictNodeMismatchedPods(now.Add(time.Minute))
+				node.Spec.Taints = tt.taints
+			}
+			ssn.evictNodeMismatchedPods(now.Add(2 * time.Minute))
+			assert.Equal(t, tt.expectedState, task.Status)
+		})
+	}
+}
+
+func TestEvictNodeMismatchedPodsOfGangs(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name           string
+		minAvailable   int32
+		expectedStates map[common_info.PodID]pod_status.PodStatus
+	}{
+		{
+			name:         "gang that would break is evicted as a whole",
+			minAvailable: 3,
+			expectedStates: map[common_info.PodID]pod_status.PodStatus{
+				"job0-0": pod_status.Releasing, "job0-1": pod_status.Releasing, "job0-2": pod_status.Releasing,
+			},
+		},
+		{
+			name:         "gang that keeps its minimum available loses the mismatched pod only",
+			minAvailable: 2,
+			expectedStates: map[common_info.PodID]pod_status.PodStatus{
+				"job0-0": pod_status.Releasing, "job0-1": pod_status.Running, "job0-2": pod_status.Running,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeMismatches = &nodeMismatchStore{since: map[common_info.PodID]time.Time{}}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				{
+					Name:                "job0",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					MinAvailable:        &tt.minAvailable,
+					Tasks: []*tasks_fake.TestTaskBasic{
+						{State: pod_status.Running, NodeName: "node0"},
+						{State: pod_status.Running, NodeName: "node1"},
+						{State: pod_status.Running, NodeName: "node1"},
+					},
+				},
+			})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 4},
+				"node1": {GPUs: 4},
+			}, tasksToNodeMap, nil)
+			nodesInfoMap["node0"].Node.Spec.Taints = []v1.Taint{
+				{Key: "dedicated", Value: "inference", Effect: v1.TaintEffectNoSchedule},
+			}
+
+			evictions := 0
+			for _, state := range tt.expectedStates {
+				if state == pod_status.Releasing {
+					evictions++
+				}
+			}
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), eviction_info.EvictionMetadata{
+				EvictionGangSize: evictions,
+				Action:           nodeMismatchEvictionAction,
+			}, gomock.Any()).Return(nil).Times(evictions)
+
+			ssn := &Session{
+				UID:             "1",
+				Cache:           mockCache,
+				PodGroupInfos:   jobsInfoMap,
+				Nodes:           nodesInfoMap,
+				SchedulerParams: conf.SchedulerParams{NodeMismatchEvictionGracePeriod: time.Minute},
+			}
+			ssn.evictNodeMismatchedPods(now)
+			ssn.evictNodeMismatchedPods(now.Add(2 * time.Minute))
+
+			for podID, expectedState := range tt.expectedStates {
+				assert.Equal(t, expectedState, jobsInfoMap["job0"].GetAllPodsMap()[podID].Status, podID)
+			}
+		})
+	}
+}
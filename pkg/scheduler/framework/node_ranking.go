@@ -0,0 +1,193 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// NodeRanking is the order of nodes for a task, together with the node scores that it was built from. The next tasks
+// of the same pod group may reuse it with Rescore, which scores again only the nodes that the placements of the
+// earlier tasks changed.
+type NodeRanking struct {
+	task          *pod_info.PodInfo
+	nodes         []*node_info.NodeInfo
+	nodesByName   map[string]*node_info.NodeInfo
+	scores        map[string]float64
+	unscoredNodes []*node_info.NodeInfo
+	orderedNodes  []*node_info.NodeInfo
+}
+
+// OrderedNodes returns the nodes of the ranking, best first.
+func (r *NodeRanking) OrderedNodes() []*node_info.NodeInfo {
+	return r.orderedNodes
+}
+
+// IsReusableFor returns true if the ranking may be rescored for the task instead of scoring all the nodes again:
+// the task belongs to the same pod group and sub-group as the task of the ranking, with the same resource requests.
+func (r *NodeRanking) IsReusableFor(task *pod_info.PodInfo) bool {
+	if r == nil || r.task.Job != task.Job || r.task.SubGroupName != task.SubGroupName {
+		return false
+	}
+	return r.task.ResReq.LessEqual(task.ResReq) && task.ResReq.LessEqual(r.task.ResReq)
+}
+
+// RankNodesByTask scores the nodes for the task and orders them, best first. When the node scoring budget is enabled,
+// the nodes are scored in a random order, so the nodes scored before the budget is exceeded are a random sample of
+// them, and the nodes that were not scored are put after the scored ones in that random order.
+func (ssn *Session) RankNodesByTask(nodes []*node_info.NodeInfo, task *pod_info.PodInfo) *NodeRanking {
+	var (
+		ranking = &NodeRanking{
+			task:        task,
+			nodes:       nodes,
+			nodesByName: make(map[string]*node_info.NodeInfo, len(nodes)),
+			scores:      make(map[string]float64, len(nodes)),
+		}
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, node := range nodes {
+		ranking.nodesByName[node.Name] = node
+	}
+
+	budget := ssn.newNodeScoringBudget(time.Now())
+	ssn.NodePreOrderFn(task, nodes)
+	trace := decisionTraces.traceFor(task)
+
+	scoringOrder := nodes
+	if budget != nil {
+		scoringOrder = slices.Clone(nodes)
+		shuffleNodes(scoringOrder)
+	}
+	nodesToScore := make(chan *node_info.NodeInfo, len(nodes))
+	for _, node := range scoringOrder {
+		nodesToScore <- node
+	}
+	close(nodesToScore)
+
+	numWorkers := min(ssn.GetNodeScoringWorkers(), len(nodes))
+	for range numWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for node := range nodesToScore {
+				if !budget.allows(time.Now()) {
+					mutex.Lock()
+					ranking.unscoredNodes = append(ranking.unscoredNodes, node)
+					mutex.Unlock()
+					continue
+				}
+
+				score, err := ssn.scoreNode(task, node, trace)
+				budget.recordScored()
+				if err != nil {
+					continue
+				}
+
+				mutex.Lock()
+				ranking.scores[node.Name] = score
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if budget.isExceeded() {
+		metrics.IncNodeScoringBudgetExceeded()
+		log.InfraLogger.V(3).Infof("Node scoring for task <%s/%s> exceeded the budget of %v, scored %d nodes out of %d",
+			task.Namespace, task.Name, ssn.SchedulerParams.NodeScoringBudget, len(nodes)-len(ranking.unscoredNodes),
+			len(nodes))
+	}
+	ranking.orderedNodes = ssn.orderRankedNodes(ranking)
+	traceLogRanking(trace, ranking)
+	return ranking
+}
+
+// shuffleNodes puts the nodes in a random order, replaced in tests.
+var shuffleNodes = defaultShuffleNodes
+
+func defaultShuffleNodes(nodes []*node_info.NodeInfo) {
+	rand.Shuffle(len(nodes), func(i, j int) { nodes[i], nodes[j] = nodes[j], nodes[i] })
+}
+
+// Rescore reuses the ranking of an earlier task of the pod group for the task. Only the named nodes, typically the
+// nodes that the statement changed since the ranking was built, are scored again, and the scores of the other nodes
+// are kept. When a node order fn registered with AddCrossNodeOrderFn scores nodes by the placements on other nodes,
+// all the nodes of the ranking are scored again instead. Nodes that were left unscored by the node scoring budget stay
+// unscored.
+func (ssn *Session) Rescore(ranking *NodeRanking, task *pod_info.PodInfo, nodeNames []string) []*node_info.NodeInfo {
+	ranking.task = task
+	ssn.NodePreOrderFn(task, ranking.nodes)
+	trace := decisionTraces.traceFor(task)
+
+	if ssn.crossNodeOrderFns {
+		nodeNames = make([]string, 0, len(ranking.nodes))
+		for _, node := range ranking.nodes {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+
+	unscored := make(map[string]bool, len(ranking.unscoredNodes))
+	for _, node := range ranking.unscoredNodes {
+		unscored[node.Name] = true
+	}
+	for _, nodeName := range nodeNames {
+		node, found := ranking.nodesByName[nodeName]
+		if !found || unscored[nodeName] {
+			continue
+		}
+		score, err := ssn.scoreNode(task, node, trace)
+		if err != nil {
+			delete(ranking.scores, nodeName)
+			continue
+		}
+		ranking.scores[nodeName] = score
+	}
+
+	ranking.orderedNodes = ssn.orderRankedNodes(ranking)
+	traceLogRanking(trace, ranking)
+	return ranking.orderedNodes
+}
+
+func (ssn *Session) scoreNode(
+	task *pod_info.PodInfo, node *node_info.NodeInfo, trace *PodDecisionTrace,
+) (float64, error) {
+	var score float64
+	var err error
+	if trace != nil {
+		score, err = ssn.tracedNodeOrderFn(task, node, trace)
+	} else {
+		score, err = ssn.NodeOrderFn(task, node)
+	}
+	if err != nil {
+		log.InfraLogger.Errorf("Error in Calculating Priority for the node:%v", err)
+		return 0, err
+	}
+	log.InfraLogger.V(5).Infof("Overall priority node score of node <%v> for task <%v/%v> is: %f",
+		node.Name, task.Namespace, task.Name, score)
+	return score, nil
+}
+
+func (ssn *Session) orderRankedNodes(ranking *NodeRanking) []*node_info.NodeInfo {
+	nodeScores := make(map[float64][]*node_info.NodeInfo)
+	for nodeName, score := range ranking.scores {
+		nodeScores[score] = append(nodeScores[score], ranking.nodesByName[nodeName])
+	}
+
+	var orderedNodes []*node_info.NodeInfo
+	if ssn.SchedulerParams.RandomizeTopNodes {
+		orderedNodes = shuffleTopNodes(nodeScores, ssn.SchedulerParams.TopNodesScoreEpsilon)
+	} else {
+		orderedNodes = sortNodesByScore(nodeScores)
+	}
+	return append(orderedNodes, ranking.unscoredNodes...)
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func TestSession_Rescore(t *testing.T) {
+	nodes := buildScoringNodes(4)
+	nodeScores := map[string]float64{"node-0": 4, "node-1": 3, "node-2": 2, "node-3": 1}
+	var scoredNodes []string
+	ssn := &Session{SchedulerParams: conf.SchedulerParams{NodeScoringWorkers: 1}}
+	ssn.NodeOrderFns = []api.NodeOrderFn{
+		func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+			scoredNodes = append(scoredNodes, node.Name)
+			return nodeScores[node.Name], nil
+		},
+	}
+	task := &pod_info.PodInfo{Name: "task-0", Job: "job", ResReq: resource_info.EmptyResourceRequirements()}
+
+	ranking := ssn.RankNodesByTask(nodes, task)
+	assert.Equal(t, []string{"node-0", "node-1", "node-2", "node-3"}, nodeNames(ranking.OrderedNodes()))
+	assert.Len(t, scoredNodes, 4)
+
+	scoredNodes = nil
+	nodeScores["node-0"] = 0
+	nodeScores["node-3"] = 5
+	nextTask := &pod_info.PodInfo{Name: "task-1", Job: "job", ResReq: resource_info.EmptyResourceRequirements()}
+	assert.True(t, ranking.IsReusableFor(nextTask))
+	orderedNodes := ssn.Rescore(ranking, nextTask, []string{"node-0", "unknown-node"})
+
+	assert.Equal(t, []string{"node-0"}, scoredNodes, "only the changed nodes are scored again")
+	assert.Equal(t, []string{"node-1", "node-2", "node-3", "node-0"}, nodeNames(orderedNodes),
+		"the scores of unchanged nodes are kept")
+}
+
+func TestSession_Rescore_CrossNodeOrderFn(t *testing.T) {
+	nodes := buildScoringNodes(3)
+	nodeScores := map[string]float64{"node-0": 3, "node-1": 2, "node-2": 1}
+	var scoredNodes []string
+	ssn := &Session{SchedulerParams: conf.SchedulerParams{NodeScoringWorkers: 1}}
+	ssn.AddCrossNodeOrderFn(func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+		scoredNodes = append(scoredNodes, node.Name)
+		return nodeScores[node.Name], nil
+	})
+	task := &pod_info.PodInfo{Name: "task-0", Job: "job", ResReq: resource_info.EmptyResourceRequirements()}
+	ranking := ssn.RankNodesByTask(nodes, task)
+
+	scoredNodes = nil
+	nodeScores["node-2"] = 4
+	nextTask := &pod_info.PodInfo{Name: "task-1", Job: "job", ResReq: resource_info.EmptyResourceRequirements()}
+	orderedNodes := ssn.Rescore(ranking, nextTask, []string{"node-0"})
+
+	assert.ElementsMatch(t, []string{"node-0", "node-1", "node-2"}, scoredNodes,
+		"all the nodes are scored again when a node order fn scores by the placements on other nodes")
+	assert.Equal(t, []string{"node-2", "node-0", "node-1"}, nodeNames(orderedNodes))
+}
+
+func TestNodeRanking_IsReusableFor(t *testing.T) {
+	gpuRequest := resource_info.NewResourceRequirementsWithGpus(1)
+	ranking := &NodeRanking{task: &pod_info.PodInfo{Job: "job", SubGroupName: "workers", ResReq: gpuRequest}}
+	tests := []struct {
+		name           string
+		ranking        *NodeRanking
+		task           *pod_info.PodInfo
+		expectReusable bool
+	}{
+		{
+			name:           "same pod group, sub-group and requests",
+			ranking:        ranking,
+			task:           &pod_info.PodInfo{Job: "job", SubGroupName: "workers", ResReq: gpuRequest.Clone()},
+			expectReusable: true,
+		},
+		{
+			name:    "no ranking yet",
+			task:    &pod_info.PodInfo{Job: "job", SubGroupName: "workers", ResReq: gpuRequest.Clone()},
+			ranking: nil,
+		},
+		{
+			name:    "another pod group",
+			ranking: ranking,
+			task:    &pod_info.PodInfo{Job: "other-job", SubGroupName: "workers", ResReq: gpuRequest.Clone()},
+		},
+		{
+			name:    "another sub-group",
+			ranking: ranking,
+			task:    &pod_info.PodInfo{Job: "job", SubGroupName: "leader", ResReq: gpuRequest.Clone()},
+		},
+		{
+			name:    "other requests",
+			ranking: ranking,
+			task: &pod_info.PodInfo{
+				Job: "job", SubGroupName: "workers", ResReq: resource_info.NewResourceRequirementsWithGpus(2),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectReusable, tt.ranking.IsReusableFor(tt.task))
+		})
+	}
+}
+
+func TestStatement_NodesChangedSince(t *testing.T) {
+	stmt := &Statement{operations: []Operation{
+		allocateOperation{nextNode: "node-0"},
+		pipelineOperation{previousNode: "node-1", nextNode: "node-2"},
+		evictOperation{previousNode: &node_info.NodeInfo{Name: "node-3"}},
+		allocateOperation{nextNode: "node-2"},
+		undoOperation{operationIndex: 0},
+	}}
+
+	assert.Equal(t, []string{"node-0", "node-1", "node-2", "node-3"}, stmt.NodesChangedSince(0))
+	assert.Equal(t, []string{"node-3", "node-2", "node-0"}, stmt.NodesChangedSince(2))
+	assert.Empty(t, stmt.NodesChangedSince(stmt.Checkpoint()))
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// nodeScoringBudget bounds the time spent scoring the nodes of a task. Once the budget is exceeded, and at least the
+// sample size of nodes were scored, no more nodes are scored.
+type nodeScoringBudget struct {
+	deadline   time.Time
+	sampleSize int64
+	scored     atomic.Int64
+	exceeded   atomic.Bool
+}
+
+// newNodeScoringBudget returns the node scoring budget of a task whose scoring starts now, or nil if the node scoring
+// budget is disabled.
+func (ssn *Session) newNodeScoringBudget(now time.Time) *nodeScoringBudget {
+	if ssn.SchedulerParams.NodeScoringBudget <= 0 {
+		return nil
+	}
+	return &nodeScoringBudget{
+		deadline:   now.Add(ssn.SchedulerParams.NodeScoringBudget),
+		sampleSize: int64(ssn.SchedulerParams.NodeScoringSampleSize),
+	}
+}
+
+// allows returns whether another node may be scored.
+func (b *nodeScoringBudget) allows(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	if b.exceeded.Load() {
+		return false
+	}
+	if b.scored.Load() < b.sampleSize || now.Before(b.deadline) {
+		return true
+	}
+	b.exceeded.Store(true)
+	return false
+}
+
+func (b *nodeScoringBudget) recordScored() {
+	if b != nil {
+		b.scored.Add(1)
+	}
+}
+
+func (b *nodeScoringBudget) isExceeded() bool {
+	return b != nil && b.exceeded.Load()
+}
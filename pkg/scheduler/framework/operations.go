@@ -33,6 +33,8 @@ const (
 	pipeline = "pipeline"
 	// Allocate op
 	allocate = "allocate"
+	// Shrink op
+	shrink = "shrink"
 	// Undo op
 	undo = "undo"
 )
@@ -50,6 +52,8 @@ type evictOperation struct {
 	previousStatus    pod_status.PodStatus
 	previousNode      *node_info.NodeInfo
 	previousGpuGroups []string
+	// unsharedGpuGroups are the shared GPU groups the task was the last fractional tenant of
+	unsharedGpuGroups []string
 	message           string
 	evictionMetadata  eviction_info.EvictionMetadata
 	reverseOperation  ReverseOperation
@@ -68,9 +72,11 @@ func (op evictOperation) Reverse() error {
 }
 
 type allocateOperation struct {
-	taskInfo         *pod_info.PodInfo
-	nextNode         string
-	reverseOperation ReverseOperation
+	taskInfo *pod_info.PodInfo
+	nextNode string
+	// newlySharedGroups are the whole GPU groups the task is the first fractional tenant of
+	newlySharedGroups []string
+	reverseOperation  ReverseOperation
 }
 
 func (op allocateOperation) Name() string {
@@ -107,6 +113,25 @@ func (op pipelineOperation) Reverse() error {
 	return op.reverseOperation()
 }
 
+type shrinkOperation struct {
+	taskInfo         *pod_info.PodInfo
+	previousTask     *pod_info.PodInfo
+	gpuMemory        int64
+	reverseOperation ReverseOperation
+}
+
+func (op shrinkOperation) Name() string {
+	return shrink
+}
+
+func (op shrinkOperation) TaskInfo() *pod_info.PodInfo {
+	return op.taskInfo
+}
+
+func (op shrinkOperation) Reverse() error {
+	return op.reverseOperation()
+}
+
 type undoOperation struct {
 	operationIndex   int
 	reverseOperation ReverseOperation
@@ -0,0 +1,43 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// pendingPodsByQueue returns the number of pending pods by queue name and pending cause, telling the pods that their
+// queue's quota blocks from the pods that wait for cluster capacity. Pods of pod groups pending for other reasons,
+// and of cross partition pod groups, which the session does not schedule, are not counted.
+func (ssn *Session) pendingPodsByQueue() map[string]map[podgroup_info.PendingCause]int {
+	pendingPods := map[string]map[podgroup_info.PendingCause]int{}
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		cause, found := job.PendingCause()
+		if !found {
+			continue
+		}
+		queueName := string(job.Queue)
+		if queue, found := ssn.Queues[job.Queue]; found {
+			queueName = queue.Name
+		}
+		if pendingPods[queueName] == nil {
+			pendingPods[queueName] = map[podgroup_info.PendingCause]int{}
+		}
+		pendingPods[queueName][cause] += job.GetNumPendingTasks()
+	}
+	return pendingPods
+}
+
+func (ssn *Session) recordPendingPodsMetrics() {
+	metrics.ResetPendingPods()
+	for queueName, causes := range ssn.pendingPodsByQueue() {
+		for cause, count := range causes {
+			metrics.UpdatePendingPods(queueName, string(cause), count)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestPendingPodsByQueue(t *testing.T) {
+	jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:      "over_limit_job",
+			QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Pending},
+				{State: pod_status.Pending},
+			},
+		},
+		{
+			Name:      "unfitting_job",
+			QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{State: pod_status.Pending},
+				{State: pod_status.Running, NodeName: "node0"},
+			},
+		},
+		{
+			Name:      "dependent_job",
+			QueueName: "queue1",
+			Tasks:     []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+		{
+			Name:      "max_running_jobs_job",
+			QueueName: "queue1",
+			Tasks:     []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	})
+	jobsInfoMap["over_limit_job"].SetJobFitError(enginev2alpha2.OverLimit, "over limit", nil)
+	jobsInfoMap["unfitting_job"].SetJobFitError(podgroup_info.PodSchedulingErrors, "no nodes fit", nil)
+	jobsInfoMap["dependent_job"].SetJobFitError(enginev2alpha2.WaitingForDependency, "waiting", nil)
+	jobsInfoMap["max_running_jobs_job"].SetJobFitError(enginev2alpha2.MaxRunningJobsReached, "max jobs", nil)
+	ssn := &Session{
+		PodGroupInfos: jobsInfoMap,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"queue0": {UID: "queue0", Name: "team-a"},
+		},
+	}
+
+	assert.Equal(t, map[string]map[podgroup_info.PendingCause]int{
+		"team-a": {podgroup_info.BlockedByQuota: 2, podgroup_info.InsufficientCapacity: 1},
+		"queue1": {podgroup_info.BlockedByQuota: 1},
+	}, ssn.pendingPodsByQueue())
+}
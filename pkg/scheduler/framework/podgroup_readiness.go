@@ -0,0 +1,43 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+// PodGroupReadiness reports the gang scheduling progress of a pod group in the session, e.g. "3/8 workers placed,
+// waiting for 5 more":
+//   - placed: tasks allocated to a node, binding, bound or running
+//   - pipelined: tasks pipelined to a node, waiting for resources that are being released
+//   - pending: tasks waiting to be scheduled. Gated tasks are not counted until their scheduling gates are removed
+//   - minMember: the number of tasks the gang needs, summed over its sub groups
+//   - minMemberSatisfied: true if every sub group of the gang has at least its minimum of placed, pipelined or
+//     releasing tasks, as for the other gang checks of the scheduler
+//
+// All the values are zero for pod groups that are not in the session.
+func (ssn *Session) PodGroupReadiness(
+	id common_info.PodGroupID,
+) (placed, pipelined, pending, minMember int, minMemberSatisfied bool) {
+	job, found := ssn.PodGroupInfos[id]
+	if !found {
+		return 0, 0, 0, 0, false
+	}
+
+	for _, task := range job.GetAllPodsMap() {
+		switch {
+		case pod_status.AllocatedStatus(task.Status):
+			placed++
+		case task.Status == pod_status.Pipelined:
+			pipelined++
+		case task.Status == pod_status.Pending:
+			pending++
+		}
+	}
+	for _, podSet := range job.PodSets {
+		minMember += int(podSet.GetMinAvailable())
+	}
+	return placed, pipelined, pending, minMember, job.IsGangSatisfied()
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_PodGroupReadiness(t *testing.T) {
+	tests := []struct {
+		name                       string
+		minAvailable               int32
+		taskStatuses               []pod_status.PodStatus
+		jobID                      common_info.PodGroupID
+		expectedPlaced             int
+		expectedPipelined          int
+		expectedPending            int
+		expectedMinMember          int
+		expectedMinMemberSatisfied bool
+	}{
+		{
+			name:         "gang waiting for more workers",
+			minAvailable: 8,
+			taskStatuses: []pod_status.PodStatus{
+				pod_status.Running, pod_status.Bound, pod_status.Allocated,
+				pod_status.Pending, pod_status.Pending, pod_status.Pending, pod_status.Pending, pod_status.Pending,
+			},
+			jobID:             "job",
+			expectedPlaced:    3,
+			expectedPending:   5,
+			expectedMinMember: 8,
+		},
+		{
+			name:         "pipelined and releasing tasks satisfy the gang",
+			minAvailable: 3,
+			taskStatuses: []pod_status.PodStatus{
+				pod_status.Running, pod_status.Pipelined, pod_status.Releasing, pod_status.Gated,
+			},
+			jobID:                      "job",
+			expectedPlaced:             1,
+			expectedPipelined:          1,
+			expectedMinMember:          3,
+			expectedMinMemberSatisfied: true,
+		},
+		{
+			name:         "elastic job above its min member",
+			minAvailable: 2,
+			taskStatuses: []pod_status.PodStatus{
+				pod_status.Running, pod_status.Running, pod_status.Pending, pod_status.Succeeded,
+			},
+			jobID:                      "job",
+			expectedPlaced:             2,
+			expectedPending:            1,
+			expectedMinMember:          2,
+			expectedMinMemberSatisfied: true,
+		},
+		{
+			name:         "pod group that is not in the session",
+			minAvailable: 1,
+			taskStatuses: []pod_status.PodStatus{pod_status.Running},
+			jobID:        "other-job",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tasks []*tasks_fake.TestTaskBasic
+			for _, status := range tt.taskStatuses {
+				task := &tasks_fake.TestTaskBasic{State: status}
+				if pod_status.IsActiveUsedStatus(status) {
+					task.NodeName = "node0"
+				}
+				tasks = append(tasks, task)
+			}
+			jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+				Name:                "job",
+				MinAvailable:        ptr.To(tt.minAvailable),
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks:               tasks,
+			}})
+			ssn := &Session{PodGroupInfos: jobsInfoMap}
+
+			placed, pipelined, pending, minMember, minMemberSatisfied := ssn.PodGroupReadiness(tt.jobID)
+			assert.Equal(t, tt.expectedPlaced, placed)
+			assert.Equal(t, tt.expectedPipelined, pipelined)
+			assert.Equal(t, tt.expectedPending, pending)
+			assert.Equal(t, tt.expectedMinMember, minMember)
+			assert.Equal(t, tt.expectedMinMemberSatisfied, minMemberSatisfied)
+		})
+	}
+}
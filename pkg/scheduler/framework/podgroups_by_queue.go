@@ -0,0 +1,75 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// podGroupsByQueueIndex indexes the podgroups of the session by their queue. The index holds the session's
+// PodGroupInfos, so it reflects their status updates within the session. It is rebuilt when podgroups are added to or
+// removed from the session, when a returned podgroup is no longer in the session or moved to another queue, and after
+// InvalidatePodGroupsByQueue.
+type podGroupsByQueueIndex struct {
+	mutex     sync.Mutex
+	podGroups map[common_info.QueueID][]*podgroup_info.PodGroupInfo
+	// podGroupCount is the number of podgroups of the session when the index was built.
+	podGroupCount int
+}
+
+// GetPodGroupsByQueue returns the podgroups of the queue, ordered by UID, without scanning all the podgroups of the
+// session. The index is built on the first call of the session. The returned slice must not be modified.
+func (ssn *Session) GetPodGroupsByQueue(queueID common_info.QueueID) []*podgroup_info.PodGroupInfo {
+	index := &ssn.podGroupsByQueue
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if !index.isFresh(ssn.PodGroupInfos, queueID) {
+		index.build(ssn.PodGroupInfos)
+	}
+	return index.podGroups[queueID]
+}
+
+// InvalidatePodGroupsByQueue rebuilds the podgroups by queue index on its next use. It must be called after moving a
+// podgroup of the session to another queue, since the index cannot tell on its own when a podgroup moved into a queue.
+func (ssn *Session) InvalidatePodGroupsByQueue() {
+	index := &ssn.podGroupsByQueue
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	index.podGroups = nil
+}
+
+// isFresh returns false if the index was not built, if podgroups were added to or removed from the session since, or
+// if an indexed podgroup of the queue is no longer the session's podgroup of its UID or is in another queue now.
+func (index *podGroupsByQueueIndex) isFresh(
+	podGroupInfos map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, queueID common_info.QueueID,
+) bool {
+	if index.podGroups == nil || index.podGroupCount != len(podGroupInfos) {
+		return false
+	}
+	for _, podGroup := range index.podGroups[queueID] {
+		if podGroupInfos[podGroup.UID] != podGroup || podGroup.Queue != queueID {
+			return false
+		}
+	}
+	return true
+}
+
+func (index *podGroupsByQueueIndex) build(podGroupInfos map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) {
+	index.podGroups = map[common_info.QueueID][]*podgroup_info.PodGroupInfo{}
+	for _, podGroup := range podGroupInfos {
+		index.podGroups[podGroup.Queue] = append(index.podGroups[podGroup.Queue], podGroup)
+	}
+	for _, podGroups := range index.podGroups {
+		sort.Slice(podGroups, func(i, j int) bool {
+			return podGroups[i].UID < podGroups[j].UID
+		})
+	}
+	index.podGroupCount = len(podGroupInfos)
+}
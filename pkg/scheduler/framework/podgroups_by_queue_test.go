@@ -0,0 +1,66 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+func TestSession_GetPodGroupsByQueue(t *testing.T) {
+	ssn := &Session{PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}}
+	for _, podGroup := range []struct {
+		uid   common_info.PodGroupID
+		queue common_info.QueueID
+	}{
+		{"job-b", "queue0"},
+		{"job-a", "queue0"},
+		{"job-c", "queue1"},
+	} {
+		job := podgroup_info.NewPodGroupInfo(podGroup.uid)
+		job.Queue = podGroup.queue
+		ssn.PodGroupInfos[podGroup.uid] = job
+	}
+
+	assert.Equal(t, []common_info.PodGroupID{"job-a", "job-b"}, podGroupUIDs(ssn.GetPodGroupsByQueue("queue0")))
+	assert.Equal(t, []common_info.PodGroupID{"job-c"}, podGroupUIDs(ssn.GetPodGroupsByQueue("queue1")))
+	assert.Empty(t, ssn.GetPodGroupsByQueue("queue2"))
+
+	assert.Same(t, ssn.PodGroupInfos["job-a"], ssn.GetPodGroupsByQueue("queue0")[0])
+
+	job := podgroup_info.NewPodGroupInfo("job-d")
+	job.Queue = "queue2"
+	ssn.PodGroupInfos[job.UID] = job
+	assert.Equal(t, []common_info.PodGroupID{"job-d"}, podGroupUIDs(ssn.GetPodGroupsByQueue("queue2")))
+
+	// A podgroup that moved out of the queue, with the same number of podgroups in the session
+	ssn.PodGroupInfos["job-b"].Queue = "queue1"
+	assert.Equal(t, []common_info.PodGroupID{"job-a"}, podGroupUIDs(ssn.GetPodGroupsByQueue("queue0")))
+	assert.Equal(t, []common_info.PodGroupID{"job-b", "job-c"}, podGroupUIDs(ssn.GetPodGroupsByQueue("queue1")))
+
+	// A podgroup replaced by another one with the same UID
+	replaced := podgroup_info.NewPodGroupInfo("job-c")
+	replaced.Queue = "queue1"
+	ssn.PodGroupInfos["job-c"] = replaced
+	assert.Same(t, replaced, ssn.GetPodGroupsByQueue("queue1")[1])
+
+	// A podgroup that moved into the queue is seen once the index is invalidated
+	ssn.PodGroupInfos["job-d"].Queue = "queue1"
+	ssn.InvalidatePodGroupsByQueue()
+	assert.Equal(t, []common_info.PodGroupID{"job-b", "job-c", "job-d"},
+		podGroupUIDs(ssn.GetPodGroupsByQueue("queue1")))
+	assert.Empty(t, ssn.GetPodGroupsByQueue("queue2"))
+}
+
+func podGroupUIDs(podGroups []*podgroup_info.PodGroupInfo) []common_info.PodGroupID {
+	var uids []common_info.PodGroupID
+	for _, podGroup := range podGroups {
+		uids = append(uids, podGroup.UID)
+	}
+	return uids
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// preemptionFence returns whether the preemption fences of the scheduler configuration decide if the preemptor may
+// preempt the victim, and their decision. A victim above the non-preemptible fence is never preempted, and otherwise
+// a preemptor at or above the can-preempt fence may preempt any victim with a lower priority.
+func (ssn *Session) preemptionFence(preemptor, victim *podgroup_info.PodGroupInfo) (allowed bool, fenced bool) {
+	if ssn.Config == nil || ssn.Config.PreemptionFences == nil {
+		return false, false
+	}
+	fences := ssn.Config.PreemptionFences
+
+	if fences.NonPreemptibleAbove != nil && victim.Priority > *fences.NonPreemptibleAbove {
+		log.InfraLogger.V(6).Infof("Job <%s/%s> with priority %d is above the non-preemptible fence %d",
+			victim.Namespace, victim.Name, victim.Priority, *fences.NonPreemptibleAbove)
+		return false, true
+	}
+	if fences.CanPreemptBelow != nil && preemptor.Priority >= *fences.CanPreemptBelow &&
+		victim.Priority < preemptor.Priority {
+		log.InfraLogger.V(6).Infof("Job <%s/%s> with priority %d is at or above the can-preempt fence %d, "+
+			"and may preempt job <%s/%s> with priority %d", preemptor.Namespace, preemptor.Name, preemptor.Priority,
+			*fences.CanPreemptBelow, victim.Namespace, victim.Name, victim.Priority)
+		return true, true
+	}
+	return false, false
+}
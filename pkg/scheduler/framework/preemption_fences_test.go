@@ -0,0 +1,75 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func TestPreemptVictimFilter_PreemptionFences(t *testing.T) {
+	const (
+		train    = int32(50)
+		build    = int32(100)
+		critical = int32(1000)
+		system   = int32(2000)
+	)
+	fences := &conf.PreemptionFences{
+		NonPreemptibleAbove: ptr.To(int32(500)),
+		CanPreemptBelow:     ptr.To(int32(1500)),
+	}
+
+	tests := []struct {
+		name              string
+		fences            *conf.PreemptionFences
+		preemptorPriority int32
+		victimPriority    int32
+		pluginAllows      bool
+		expectAllowed     bool
+	}{
+		{name: "no fences, plugins allow", preemptorPriority: build, victimPriority: train,
+			pluginAllows: true, expectAllowed: true},
+		{name: "no fences, plugins deny", preemptorPriority: system, victimPriority: critical},
+		{name: "regular preemptor, plugins allow", fences: fences, preemptorPriority: build, victimPriority: train,
+			pluginAllows: true, expectAllowed: true},
+		{name: "regular preemptor, plugins deny", fences: fences, preemptorPriority: build, victimPriority: train},
+		{name: "regular preemptor, non-preemptible victim", fences: fences, preemptorPriority: system - 1,
+			victimPriority: critical, pluginAllows: true},
+		{name: "system preemptor, plugins deny", fences: fences, preemptorPriority: system, victimPriority: train,
+			expectAllowed: true},
+		{name: "system preemptor, non-preemptible victim", fences: fences, preemptorPriority: system,
+			victimPriority: critical, pluginAllows: true},
+		{name: "system preemptor, system victim", fences: fences, preemptorPriority: system,
+			victimPriority: system},
+		{name: "victim at the non-preemptible fence", fences: fences, preemptorPriority: system,
+			victimPriority: 500, expectAllowed: true},
+		{name: "only the can-preempt fence", fences: &conf.PreemptionFences{CanPreemptBelow: ptr.To(system)},
+			preemptorPriority: system, victimPriority: critical, expectAllowed: true},
+		{name: "only the non-preemptible fence", fences: &conf.PreemptionFences{NonPreemptibleAbove: ptr.To(build)},
+			preemptorPriority: system, victimPriority: build, pluginAllows: true, expectAllowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{Config: &conf.SchedulerConfiguration{PreemptionFences: tt.fences}}
+			pluginCalls := 0
+			ssn.AddPreemptVictimFilterFn(func(_, _ *podgroup_info.PodGroupInfo) bool {
+				pluginCalls++
+				return tt.pluginAllows
+			})
+			preemptor := &podgroup_info.PodGroupInfo{Name: "preemptor", Priority: tt.preemptorPriority}
+			victim := &podgroup_info.PodGroupInfo{Name: "victim", Priority: tt.victimPriority}
+
+			assert.Equal(t, tt.expectAllowed, ssn.PreemptVictimFilter(preemptor, victim))
+			_, fenced := ssn.preemptionFence(preemptor, victim)
+			assert.Equal(t, !fenced, pluginCalls == 1, fmt.Sprintf("fenced: %v, plugin calls: %d", fenced, pluginCalls))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// recordPreemptionVictim records the characteristics of a pod evicted to make room for a preemptor: its queue, its
+// priority bucket, how long its pod group ran and the GPU memory it frees on the node. Evictions without a preemptor,
+// such as stale gang evictions, are not recorded.
+func recordPreemptionVictim(pod *pod_info.PodInfo, podGroup *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+	evictionMetadata eviction_info.EvictionMetadata, now time.Time) {
+	if evictionMetadata.Preemptor == nil {
+		return
+	}
+
+	runtime := time.Duration(-1)
+	if podGroup.LastStartTimestamp != nil {
+		runtime = now.Sub(*podGroup.LastStartTimestamp)
+	}
+	var gpuMemory int64
+	if node != nil {
+		_, gpuMemory = node.GetTaskGpuUsage(pod)
+	}
+	metrics.RecordPreemptionVictim(evictionMetadata.Action, string(podGroup.Queue),
+		victimPriorityBucket(podGroup.Priority), runtime, gpuMemory)
+}
+
+// victimPriorityBucket names the range of the well known priorities that the priority falls in.
+func victimPriorityBucket(priority int32) string {
+	switch {
+	case priority >= constants.PriorityInferenceNumber:
+		return "inference"
+	case priority >= constants.PriorityBuildNumber:
+		return "build"
+	case priority >= constants.PriorityInteractivePreemptibleNumber:
+		return "interactive-preemptible"
+	case priority >= constants.PriorityTrainNumber:
+		return "train"
+	default:
+		return "below-train"
+	}
+}
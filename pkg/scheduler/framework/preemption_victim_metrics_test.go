@@ -0,0 +1,33 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+)
+
+func TestVictimPriorityBucket(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority int32
+		expected string
+	}{
+		{name: "inference", priority: constants.PriorityInferenceNumber, expected: "inference"},
+		{name: "above inference", priority: constants.PriorityInferenceNumber + 100, expected: "inference"},
+		{name: "build", priority: constants.PriorityBuildNumber, expected: "build"},
+		{name: "between interactive preemptible and build", priority: constants.PriorityBuildNumber - 1, expected: "interactive-preemptible"},
+		{name: "train", priority: constants.PriorityTrainNumber, expected: "train"},
+		{name: "below train", priority: constants.PriorityTrainNumber - 1, expected: "below-train"},
+		{name: "negative", priority: -10, expected: "below-train"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, victimPriorityBucket(test.priority))
+		})
+	}
+}
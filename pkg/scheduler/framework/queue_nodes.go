@@ -0,0 +1,74 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// NodesForQueue returns the session nodes the jobs of the queue can run on: the nodes matching the node selectors of
+// the queue and all its ancestors. Jobs of queues without node selectors can run on all nodes.
+func (ssn *Session) NodesForQueue(queueID common_info.QueueID) node_info.NodeSet {
+	nodes := make(node_info.NodeSet, 0, len(ssn.Nodes))
+	for _, node := range ssn.Nodes {
+		nodes = append(nodes, node)
+	}
+	return ssn.filterNodesForQueue(queueID, nodes)
+}
+
+// queueNodesSubset restricts the node set to the nodes of the job's queue. It runs before the plugins' subset nodes
+// functions, so they only divide nodes the job's queue may use.
+func (ssn *Session) queueNodesSubset(podGroup *podgroup_info.PodGroupInfo, _ []*pod_info.PodInfo,
+	nodeSet node_info.NodeSet) ([]node_info.NodeSet, error) {
+	nodes := ssn.filterNodesForQueue(podGroup.Queue, nodeSet)
+	if len(nodes) == 0 {
+		return []node_info.NodeSet{}, nil
+	}
+	return []node_info.NodeSet{nodes}, nil
+}
+
+func (ssn *Session) filterNodesForQueue(queueID common_info.QueueID, nodes node_info.NodeSet) node_info.NodeSet {
+	selectors := ssn.queueNodeSelectors(queueID)
+	if len(selectors) == 0 {
+		return nodes
+	}
+
+	filtered := make(node_info.NodeSet, 0, len(nodes))
+	for _, node := range nodes {
+		if nodeMatchesSelectors(node, selectors) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
+func (ssn *Session) queueNodeSelectors(queueID common_info.QueueID) []labels.Selector {
+	var selectors []labels.Selector
+	visited := map[common_info.QueueID]bool{}
+	for queue, found := ssn.Queues[queueID]; found && !visited[queue.UID]; queue, found = ssn.Queues[queue.ParentQueue] {
+		visited[queue.UID] = true
+		if len(queue.NodeSelector) > 0 {
+			selectors = append(selectors, labels.SelectorFromSet(queue.NodeSelector))
+		}
+	}
+	return selectors
+}
+
+func nodeMatchesSelectors(node *node_info.NodeInfo, selectors []labels.Selector) bool {
+	if node.Node == nil {
+		return false
+	}
+	nodeLabels := labels.Set(node.Node.Labels)
+	for _, selector := range selectors {
+		if !selector.Matches(nodeLabels) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+func TestNodesForQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		queueID       common_info.QueueID
+		expectedNodes []string
+	}{
+		{
+			name:          "queue without node selectors sees all nodes",
+			queueID:       "shared",
+			expectedNodes: []string{"a100-1", "a100-2", "h100-1", "cpu-1"},
+		},
+		{
+			name:          "queue sees the nodes of its pool",
+			queueID:       "vision",
+			expectedNodes: []string{"a100-1", "a100-2"},
+		},
+		{
+			name:          "child queue inherits the node selectors of its parent",
+			queueID:       "vision-dev",
+			expectedNodes: []string{"a100-1", "a100-2"},
+		},
+		{
+			name:          "child queue narrows the nodes of its parent",
+			queueID:       "vision-prod",
+			expectedNodes: []string{"a100-1"},
+		},
+		{
+			name:          "queue whose node selectors match no node",
+			queueID:       "vision-h100",
+			expectedNodes: []string{},
+		},
+		{
+			name:          "unknown queue sees all nodes",
+			queueID:       "missing",
+			expectedNodes: []string{"a100-1", "a100-2", "h100-1", "cpu-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := newQueueNodesSession()
+			assert.ElementsMatch(t, tt.expectedNodes, nodeNames(ssn.NodesForQueue(tt.queueID)))
+		})
+	}
+}
+
+func TestSubsetNodesFn_QueueNodes(t *testing.T) {
+	tests := []struct {
+		name             string
+		queueID          common_info.QueueID
+		initNodes        []string
+		expectedNodeSets [][]string
+	}{
+		{
+			name:             "plugins divide all nodes for an unrestricted queue",
+			queueID:          "shared",
+			initNodes:        []string{"a100-1", "h100-1", "cpu-1"},
+			expectedNodeSets: [][]string{{"a100-1"}, {"h100-1"}, {"cpu-1"}},
+		},
+		{
+			name:             "plugins divide only the nodes of the queue",
+			queueID:          "vision",
+			initNodes:        []string{"a100-1", "a100-2", "h100-1", "cpu-1"},
+			expectedNodeSets: [][]string{{"a100-1"}, {"a100-2"}},
+		},
+		{
+			name:             "no node set when no node of the queue is in the node set",
+			queueID:          "vision",
+			initNodes:        []string{"h100-1", "cpu-1"},
+			expectedNodeSets: [][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := newQueueNodesSession()
+			ssn.SubsetNodesFns = []api.SubsetNodesFn{
+				func(_ *podgroup_info.PodGroupInfo, _ []*pod_info.PodInfo,
+					nodeSet node_info.NodeSet) ([]node_info.NodeSet, error) {
+					var nodeSets []node_info.NodeSet
+					for _, node := range nodeSet {
+						nodeSets = append(nodeSets, node_info.NodeSet{node})
+					}
+					return nodeSets, nil
+				},
+			}
+			var initNodeSet node_info.NodeSet
+			for _, name := range tt.initNodes {
+				initNodeSet = append(initNodeSet, ssn.Nodes[name])
+			}
+
+			nodeSets, err := ssn.SubsetNodesFn(&podgroup_info.PodGroupInfo{Queue: tt.queueID}, nil, initNodeSet)
+
+			assert.NoError(t, err)
+			var nodeSetNames [][]string
+			for _, nodeSet := range nodeSets {
+				nodeSetNames = append(nodeSetNames, nodeNames(nodeSet))
+			}
+			if len(tt.expectedNodeSets) == 0 {
+				assert.Empty(t, nodeSetNames)
+			} else {
+				assert.ElementsMatch(t, tt.expectedNodeSets, nodeSetNames)
+			}
+		})
+	}
+}
+
+func newQueueNodesSession() *Session {
+	nodes := map[string]*node_info.NodeInfo{}
+	for name, nodeLabels := range map[string]map[string]string{
+		"a100-1": {"pool": "vision", "tier": "prod"},
+		"a100-2": {"pool": "vision"},
+		"h100-1": {"pool": "llm", "tier": "prod"},
+		"cpu-1":  {},
+	} {
+		nodes[name] = &node_info.NodeInfo{
+			Name: name,
+			Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels}},
+		}
+	}
+
+	return &Session{
+		Nodes: nodes,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"shared":     {UID: "shared"},
+			"vision":     {UID: "vision", NodeSelector: map[string]string{"pool": "vision"}},
+			"vision-dev": {UID: "vision-dev", ParentQueue: "vision"},
+			"vision-prod": {
+				UID: "vision-prod", ParentQueue: "vision", NodeSelector: map[string]string{"tier": "prod"},
+			},
+			"vision-h100": {
+				UID: "vision-h100", ParentQueue: "vision", NodeSelector: map[string]string{"pool": "llm"},
+			},
+		},
+	}
+}
+
+func nodeNames(nodes node_info.NodeSet) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
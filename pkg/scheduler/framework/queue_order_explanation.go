@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+const (
+	queueOrderExplanationPath = "/get-queue-order-explanation"
+
+	queuePriorityClassCompareFn = "queuePriorityClass"
+	queueCreationTimeCompareFn  = "creationTimestamp"
+)
+
+// QueueCompareFnResult is the result of a single CompareQueueFn: negative if it ordered the left queue first,
+// positive if it ordered the right queue first, and zero if it did not order the queues.
+type QueueCompareFnResult struct {
+	Fn     string `json:"fn"`
+	Result int    `json:"result"`
+}
+
+// QueueComparison explains the last comparison of two queues in a scheduling cycle. The CompareQueueFns are consulted
+// in order until one of them orders the queues, so the fns after DecidedBy are not listed.
+type QueueComparison struct {
+	Left       common_info.QueueID    `json:"left"`
+	Right      common_info.QueueID    `json:"right"`
+	LeftJob    string                 `json:"leftJob,omitempty"`
+	RightJob   string                 `json:"rightJob,omitempty"`
+	LeftFirst  bool                   `json:"leftFirst"`
+	DecidedBy  string                 `json:"decidedBy"`
+	Results    []QueueCompareFnResult `json:"results"`
+	LeftQueue  QueueOrderValues       `json:"leftQueue"`
+	RightQueue QueueOrderValues       `json:"rightQueue"`
+}
+
+// QueueOrderValues holds the values of a queue that the CompareQueueFns order by, as computed when the queues were
+// compared.
+type QueueOrderValues struct {
+	Name          string              `json:"name"`
+	ParentQueue   common_info.QueueID `json:"parentQueue,omitempty"`
+	PriorityClass int                 `json:"priorityClass"`
+	Deserved      v1.ResourceList     `json:"deserved,omitempty"`
+	Allocated     v1.ResourceList     `json:"allocated,omitempty"`
+	FairShare     v1.ResourceList     `json:"fairShare,omitempty"`
+}
+
+// QueueOrderExplanation explains the queue order of a single scheduling cycle.
+type QueueOrderExplanation struct {
+	SessionUID  types.UID         `json:"sessionUID"`
+	Comparisons []QueueComparison `json:"comparisons"`
+}
+
+// queueOrderExplanationStore keeps the explanation of the last scheduling cycle.
+type queueOrderExplanationStore struct {
+	mutex       sync.Mutex
+	explanation *QueueOrderExplanation
+}
+
+var queueOrderExplanations = &queueOrderExplanationStore{}
+
+func queuePairKey(lQ, rQ *queue_info.QueueInfo) [2]common_info.QueueID {
+	if rQ.UID < lQ.UID {
+		return [2]common_info.QueueID{rQ.UID, lQ.UID}
+	}
+	return [2]common_info.QueueID{lQ.UID, rQ.UID}
+}
+
+// recordQueueComparison keeps the rationale of a QueueOrderFn call, with the values of the queues at the time of the
+// comparison. The last comparison of two queues in the session replaces the previous ones, as the queues are compared
+// again whenever their order is needed and their values change as jobs are allocated.
+func (ssn *Session) recordQueueComparison(lQ, rQ *queue_info.QueueInfo, lJob, rJob *podgroup_info.PodGroupInfo,
+	results []QueueCompareFnResult, decidedBy string, leftFirst bool) {
+	if ssn.queueComparisons == nil {
+		ssn.queueComparisons = map[[2]common_info.QueueID]*QueueComparison{}
+	}
+
+	comparison := &QueueComparison{
+		Left:       lQ.UID,
+		Right:      rQ.UID,
+		LeftFirst:  leftFirst,
+		DecidedBy:  decidedBy,
+		Results:    results,
+		LeftQueue:  ssn.queueOrderValues(lQ),
+		RightQueue: ssn.queueOrderValues(rQ),
+	}
+	if lJob != nil {
+		comparison.LeftJob = lJob.Name
+	}
+	if rJob != nil {
+		comparison.RightJob = rJob.Name
+	}
+	ssn.queueComparisons[queuePairKey(lQ, rQ)] = comparison
+}
+
+// recordQueueOrderExplanation keeps the queue comparisons of the session to be served on the queue order explanation
+// endpoint.
+func (ssn *Session) recordQueueOrderExplanation() {
+	explanation := &QueueOrderExplanation{
+		SessionUID:  ssn.UID,
+		Comparisons: make([]QueueComparison, 0, len(ssn.queueComparisons)),
+	}
+	for _, comparison := range ssn.queueComparisons {
+		explanation.Comparisons = append(explanation.Comparisons, *comparison)
+	}
+	slices.SortFunc(explanation.Comparisons, func(l, r QueueComparison) int {
+		return cmp.Or(cmp.Compare(l.Left, r.Left), cmp.Compare(l.Right, r.Right))
+	})
+
+	queueOrderExplanations.mutex.Lock()
+	defer queueOrderExplanations.mutex.Unlock()
+	queueOrderExplanations.explanation = explanation
+}
+
+func (ssn *Session) queueOrderValues(queue *queue_info.QueueInfo) QueueOrderValues {
+	return QueueOrderValues{
+		Name:          queue.Name,
+		ParentQueue:   queue.ParentQueue,
+		PriorityClass: queue.PriorityClass,
+		Deserved:      resourceListOf(ssn.QueueDeservedResources(queue)),
+		Allocated:     resourceListOf(ssn.QueueAllocatedResources(queue)),
+		FairShare:     resourceListOf(ssn.QueueFairShare(queue)),
+	}
+}
+
+func resourceListOf(resources *resource_info.ResourceRequirements) v1.ResourceList {
+	if resources == nil {
+		return nil
+	}
+	return resources.ToResourceList()
+}
+
+// serveExplanation serves the explanation of the last cycle. With the "queue" query parameter, only the comparisons
+// of that queue are served.
+func (s *queueOrderExplanationStore) serveExplanation(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.explanation == nil {
+		http.Error(w, "Queue order explanation not ready", http.StatusServiceUnavailable)
+		return
+	}
+	response := s.explanation
+	if queueID := common_info.QueueID(r.URL.Query().Get("queue")); queueID != "" {
+		response = &QueueOrderExplanation{SessionUID: s.explanation.SessionUID}
+		for _, comparison := range s.explanation.Comparisons {
+			if comparison.Left == queueID || comparison.Right == queueID {
+				response.Comparisons = append(response.Comparisons, comparison)
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(response); err != nil {
+		http.Error(w, "Failed to encode queue order explanation", http.StatusInternalServerError)
+	}
+}
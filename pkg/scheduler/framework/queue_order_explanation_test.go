@@ -0,0 +1,140 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func TestSession_QueueOrderExplanation(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"a": {UID: "a", Name: "a"},
+		"b": {UID: "b", Name: "b"},
+		"c": {UID: "c", Name: "c", PriorityClass: 10},
+	}
+	preferA := func(lQ, rQ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo, _, _ []*podgroup_info.PodGroupInfo) int {
+		switch {
+		case lQ.UID == "a":
+			return -1
+		case rQ.UID == "a":
+			return 1
+		}
+		return 0
+	}
+	noOrder := func(_, _ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo, _, _ []*podgroup_info.PodGroupInfo) int {
+		return 0
+	}
+
+	deservedCPU := 4000.0
+	ssn := &Session{Queues: queues}
+	ssn.registeringPlugin = "fairsharedecay"
+	ssn.AddQueueOrderFn(noOrder)
+	ssn.registeringPlugin = "proportion"
+	ssn.AddQueueOrderFn(preferA)
+	ssn.AddGetQueueDeservedResourcesFn(func(*queue_info.QueueInfo) *resource_info.ResourceRequirements {
+		return resource_info.NewResourceRequirements(2, deservedCPU, 8e9)
+	})
+	ssn.registeringPlugin = ""
+
+	aValues := QueueOrderValues{Name: "a", Deserved: v1.ResourceList{
+		v1.ResourceCPU:                resource.MustParse("4"),
+		v1.ResourceMemory:             resource.MustParse("8G"),
+		resource_info.GPUResourceName: resource.MustParse("2"),
+	}}
+	tests := []struct {
+		name               string
+		left, right        common_info.QueueID
+		leftJob            *podgroup_info.PodGroupInfo
+		expectedComparison QueueComparison
+	}{
+		{
+			name:    "decided by a plugin",
+			left:    "b",
+			right:   "a",
+			leftJob: &podgroup_info.PodGroupInfo{Name: "job-b"},
+			expectedComparison: QueueComparison{
+				Left: "b", Right: "a", LeftJob: "job-b", DecidedBy: "proportion",
+				Results: []QueueCompareFnResult{
+					{Fn: queuePriorityClassCompareFn}, {Fn: "fairsharedecay"}, {Fn: "proportion", Result: 1},
+				},
+			},
+		},
+		{
+			name:    "decided by the queue priority class",
+			left:    "a",
+			right:   "c",
+			leftJob: &podgroup_info.PodGroupInfo{Name: "job-a"},
+			expectedComparison: QueueComparison{
+				Left: "a", Right: "c", LeftJob: "job-a", DecidedBy: queuePriorityClassCompareFn,
+				Results:   []QueueCompareFnResult{{Fn: queuePriorityClassCompareFn, Result: 1}},
+				LeftQueue: aValues,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leftFirst := ssn.QueueOrderFn(queues[tt.left], queues[tt.right], tt.leftJob, nil, nil, nil)
+			assert.Equal(t, tt.expectedComparison.LeftFirst, leftFirst)
+		})
+	}
+
+	// The last comparison of a pair is kept, with the values of the queues when they were compared.
+	deservedCPU = 6000
+	assert.False(t, ssn.QueueOrderFn(queues["b"], queues["a"], &podgroup_info.PodGroupInfo{Name: "job-b-2"}, nil, nil, nil))
+	lastComparison := tests[0].expectedComparison
+	lastComparison.LeftJob = "job-b-2"
+	lastValues := QueueOrderValues{Name: "a", Deserved: v1.ResourceList{
+		v1.ResourceCPU:                resource.MustParse("6"),
+		v1.ResourceMemory:             resource.MustParse("8G"),
+		resource_info.GPUResourceName: resource.MustParse("2"),
+	}}
+	lastComparison.LeftQueue = QueueOrderValues{Name: "b", Deserved: lastValues.Deserved}
+	lastComparison.RightQueue = lastValues
+	tests[1].expectedComparison.RightQueue = QueueOrderValues{Name: "c", PriorityClass: 10, Deserved: aValues.Deserved}
+
+	queueOrderExplanations = &queueOrderExplanationStore{}
+	recorder := httptest.NewRecorder()
+	queueOrderExplanations.serveExplanation(recorder,
+		httptest.NewRequest(http.MethodGet, queueOrderExplanationPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	ssn.recordQueueOrderExplanation()
+
+	recorder = httptest.NewRecorder()
+	queueOrderExplanations.serveExplanation(recorder,
+		httptest.NewRequest(http.MethodGet, queueOrderExplanationPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var explanation QueueOrderExplanation
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &explanation))
+	assertQueueComparisons(t, []QueueComparison{tests[1].expectedComparison, lastComparison}, explanation.Comparisons)
+
+	recorder = httptest.NewRecorder()
+	queueOrderExplanations.serveExplanation(recorder,
+		httptest.NewRequest(http.MethodGet, queueOrderExplanationPath+"?queue=c", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	explanation = QueueOrderExplanation{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &explanation))
+	assertQueueComparisons(t, []QueueComparison{tests[1].expectedComparison}, explanation.Comparisons)
+}
+
+// assertQueueComparisons compares the comparisons by their JSON form, as resource quantities are compared by value.
+func assertQueueComparisons(t *testing.T, expected, actual []QueueComparison) {
+	expectedJSON, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	actualJSON, err := json.Marshal(actual)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"math"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// QueueRemainingQuota returns the queue's deserved resources minus its allocated resources, clamped at zero, as
+// computed by the session's queue resource functions. A resource without a deserved limit is set to
+// commonconstants.UnlimitedResourceQuantity. The remaining GPU memory, in MiB, is based on the smallest GPU in the
+// session so that it is never overestimated on heterogeneous clusters.
+func (ssn *Session) QueueRemainingQuota(queueID common_info.QueueID) (common_info.Resource, error) {
+	queue, found := ssn.Queues[queueID]
+	if !found {
+		return common_info.Resource{}, fmt.Errorf("failed to find queue <%s> in session", queueID)
+	}
+
+	deserved := ssn.QueueDeservedResources(queue)
+	if deserved == nil {
+		return common_info.Resource{}, fmt.Errorf("no plugin provides the deserved resources of queue <%s>", queueID)
+	}
+	allocated := ssn.QueueAllocatedResources(queue)
+	if allocated == nil {
+		return common_info.Resource{}, fmt.Errorf("no plugin provides the allocated resources of queue <%s>", queueID)
+	}
+
+	remaining := common_info.Resource{
+		MilliCPU: remainingQuantity(deserved.Cpu(), allocated.Cpu()),
+		Memory:   remainingQuantity(deserved.Memory(), allocated.Memory()),
+		GPU:      remainingQuantity(deserved.GPUs(), allocated.GPUs()),
+	}
+	remaining.GPUMemory = remaining.GPU
+	if remaining.GPU != commonconstants.UnlimitedResourceQuantity {
+		remaining.GPUMemory = remaining.GPU * float64(ssn.smallestGpuMemory())
+	}
+	return remaining, nil
+}
+
+func remainingQuantity(deserved, allocated float64) float64 {
+	if deserved == commonconstants.UnlimitedResourceQuantity {
+		return commonconstants.UnlimitedResourceQuantity
+	}
+	return math.Max(deserved-allocated, 0)
+}
+
+func (ssn *Session) smallestGpuMemory() int64 {
+	var smallest int64
+	for _, node := range ssn.Nodes {
+		if node.MemoryOfEveryGpuOnNode <= 0 {
+			continue
+		}
+		if smallest == 0 || node.MemoryOfEveryGpuOnNode < smallest {
+			smallest = node.MemoryOfEveryGpuOnNode
+		}
+	}
+	return smallest
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func TestQueueRemainingQuota(t *testing.T) {
+	tests := []struct {
+		name          string
+		queueID       common_info.QueueID
+		deserved      *resource_info.ResourceRequirements
+		allocated     *resource_info.ResourceRequirements
+		expected      common_info.Resource
+		expectedError bool
+	}{
+		{
+			name:      "remaining quota is deserved minus allocated",
+			queueID:   "q1",
+			deserved:  resource_info.NewResourceRequirements(4, 8000, 16e9),
+			allocated: resource_info.NewResourceRequirements(1, 2000, 4e9),
+			expected:  common_info.Resource{MilliCPU: 6000, Memory: 12e9, GPU: 3, GPUMemory: 3 * 40000},
+		},
+		{
+			name:      "over quota is clamped at zero",
+			queueID:   "q1",
+			deserved:  resource_info.NewResourceRequirements(2, 1000, 1e9),
+			allocated: resource_info.NewResourceRequirements(3, 2000, 4e9),
+			expected:  common_info.Resource{},
+		},
+		{
+			name:      "unlimited resources stay unlimited",
+			queueID:   "q1",
+			deserved:  resource_info.NewResourceRequirements(2, commonconstants.UnlimitedResourceQuantity, commonconstants.UnlimitedResourceQuantity),
+			allocated: resource_info.NewResourceRequirements(2, 2000, 4e9),
+			expected: common_info.Resource{
+				MilliCPU: commonconstants.UnlimitedResourceQuantity,
+				Memory:   commonconstants.UnlimitedResourceQuantity,
+			},
+		},
+		{
+			name:          "unknown queue",
+			queueID:       "missing",
+			deserved:      resource_info.EmptyResourceRequirements(),
+			allocated:     resource_info.EmptyResourceRequirements(),
+			expectedError: true,
+		},
+		{
+			name:          "no deserved resources provider",
+			queueID:       "q1",
+			allocated:     resource_info.EmptyResourceRequirements(),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{
+				Queues: map[common_info.QueueID]*queue_info.QueueInfo{"q1": {UID: "q1"}},
+				Nodes: map[string]*node_info.NodeInfo{
+					"big":   {Name: "big", MemoryOfEveryGpuOnNode: 80000},
+					"small": {Name: "small", MemoryOfEveryGpuOnNode: 40000},
+					"cpu":   {Name: "cpu"},
+				},
+			}
+			if tt.deserved != nil {
+				ssn.GetQueueDeservedResourcesFns = []api.QueueResource{
+					func(*queue_info.QueueInfo) *resource_info.ResourceRequirements { return tt.deserved },
+				}
+			}
+			if tt.allocated != nil {
+				ssn.GetQueueAllocatedResourcesFns = []api.QueueResource{
+					func(*queue_info.QueueInfo) *resource_info.ResourceRequirements { return tt.allocated },
+				}
+			}
+
+			remaining, err := ssn.QueueRemainingQuota(tt.queueID)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, remaining)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const rebindAction = "rebind"
+
+// RebindPod relocates a running pod to another node, for workloads that checkpoint and restore across nodes.
+// The pod is evicted from its current node and pipelined to the new node in a single statement, so the session
+// accounting of both nodes is updated before the eviction reaches the API server, and nothing is changed if any step
+// fails. The new node is recorded on the pod in the kai.scheduler/rebind-node annotation, which the nominatednode
+// plugin prefers for the restored pod. The pre-predicates, predicates and resource fit of the pod on the new node are
+// checked first.
+// Pods on shared GPUs are not supported, as their GPU group on the new node must be chosen by the allocation logic.
+func (ssn *Session) RebindPod(pod *pod_info.PodInfo, newNodeName string) error {
+	job, found := ssn.PodGroupInfos[pod.Job]
+	if !found {
+		return fmt.Errorf("could not rebind pod <%v/%v> without podGroup. podGroupId: <%v>",
+			pod.Namespace, pod.Name, pod.Job)
+	}
+	if pod.Status != pod_status.Running || pod.NodeName == "" {
+		return fmt.Errorf("could not rebind pod <%v/%v> in status %v, only running pods can be rebound",
+			pod.Namespace, pod.Name, pod.Status)
+	}
+	if pod.IsSharedGPUAllocation() {
+		return fmt.Errorf("could not rebind pod <%v/%v>, rebinding pods on shared GPUs is not supported",
+			pod.Namespace, pod.Name)
+	}
+	oldNodeName := pod.NodeName
+	if oldNodeName == newNodeName {
+		return fmt.Errorf("pod <%v/%v> is already on node <%v>", pod.Namespace, pod.Name, newNodeName)
+	}
+	if _, found := ssn.Nodes[oldNodeName]; !found {
+		return fmt.Errorf("node of pod <%v/%v> doesn't exist in session: <%v>", pod.Namespace, pod.Name, oldNodeName)
+	}
+	newNode, found := ssn.Nodes[newNodeName]
+	if !found {
+		return fmt.Errorf("node doesn't exist in session: <%v>", newNodeName)
+	}
+
+	if err := ssn.PrePredicateFn(pod, job); err != nil {
+		return fmt.Errorf("could not rebind pod <%v/%v> to node <%v>: %v",
+			pod.Namespace, pod.Name, newNodeName, err)
+	}
+	if !ssn.FittingNode(pod, newNode, false) {
+		return fmt.Errorf("pod <%v/%v> does not fit on node <%v>", pod.Namespace, pod.Name, newNodeName)
+	}
+
+	message := fmt.Sprintf("Pod %s/%s is being moved from node %s to node %s",
+		pod.Namespace, pod.Name, oldNodeName, newNodeName)
+	stmt := ssn.Statement()
+	if err := stmt.Evict(pod, message,
+		eviction_info.EvictionMetadata{EvictionGangSize: 1, Action: rebindAction}); err != nil {
+		stmt.Discard()
+		return err
+	}
+	if err := stmt.Pipeline(pod, newNodeName, false); err != nil {
+		stmt.Discard()
+		return err
+	}
+
+	ssn.Cache.PatchTaskAnnotations(pod, map[string]any{commonconstants.RebindNode: newNodeName})
+	if err := stmt.Commit(); err != nil {
+		ssn.Cache.PatchTaskAnnotations(pod, map[string]any{commonconstants.RebindNode: nil})
+		return err
+	}
+
+	log.InfraLogger.V(6).Infof("Rebound task <%v/%v> from node <%v> to node <%v>",
+		pod.Namespace, pod.Name, oldNodeName, newNodeName)
+	return nil
+}
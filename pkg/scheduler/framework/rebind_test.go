@@ -0,0 +1,144 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_RebindPod(t *testing.T) {
+	tests := []struct {
+		name          string
+		gpusPerTask   float64
+		newNode       string
+		newNodeGPUs   int
+		evictErr      error
+		expectEvict   bool
+		expectedError bool
+	}{
+		{
+			name:        "running pod is moved to a fitting node",
+			gpusPerTask: 1,
+			newNode:     "node1",
+			newNodeGPUs: 2,
+			expectEvict: true,
+		},
+		{
+			name:          "pod that does not fit the new node is not moved",
+			gpusPerTask:   1,
+			newNode:       "node1",
+			newNodeGPUs:   0,
+			expectedError: true,
+		},
+		{
+			name:          "pod cannot be rebound to its own node",
+			gpusPerTask:   1,
+			newNode:       "node0",
+			newNodeGPUs:   2,
+			expectedError: true,
+		},
+		{
+			name:          "unknown node",
+			gpusPerTask:   1,
+			newNode:       "missing",
+			newNodeGPUs:   2,
+			expectedError: true,
+		},
+		{
+			name:          "shared GPU pod is not supported",
+			gpusPerTask:   0.5,
+			newNode:       "node1",
+			newNodeGPUs:   2,
+			expectedError: true,
+		},
+		{
+			name:          "cache eviction failure is returned and the pod is not pipelined",
+			gpusPerTask:   1,
+			newNode:       "node1",
+			newNodeGPUs:   2,
+			evictErr:      errors.New("apiserver unavailable"),
+			expectEvict:   true,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topology := nodes_fake.TestClusterTopology{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "job0",
+						RequiredGPUsPerTask: tt.gpusPerTask,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:     pod_status.Running,
+								NodeName:  "node0",
+								GPUGroups: []string{"0"},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 2},
+					"node1": {GPUs: tt.newNodeGPUs},
+				},
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			if tt.expectEvict {
+				mockCache.EXPECT().PatchTaskAnnotations(gomock.Any(),
+					map[string]any{commonconstants.RebindNode: tt.newNode})
+				mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.evictErr)
+			}
+			if tt.expectEvict && tt.evictErr == nil {
+				mockCache.EXPECT().TaskPipelined(gomock.Any(), gomock.Any())
+			}
+			if tt.evictErr != nil {
+				mockCache.EXPECT().PatchTaskAnnotations(gomock.Any(),
+					map[string]any{commonconstants.RebindNode: nil})
+			}
+
+			ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+			task := jobsInfoMap["job0"].GetAllPodsMap()["job0-0"]
+			oldIdle := nodesInfoMap["node0"].Idle.Clone()
+
+			err := ssn.RebindPod(task, tt.newNode)
+			if tt.evictErr != nil {
+				assert.ErrorIs(t, err, tt.evictErr)
+				return
+			}
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, "node0", task.NodeName)
+				assert.Equal(t, pod_status.Running, task.Status)
+				assert.Equal(t, oldIdle, nodesInfoMap["node0"].Idle)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.newNode, task.NodeName)
+			assert.Equal(t, pod_status.Pipelined, task.Status)
+			assert.Equal(t, pod_status.Releasing, nodesInfoMap["node0"].PodInfos["job0-0"].Status)
+			assert.Equal(t, tt.gpusPerTask, nodesInfoMap["node0"].Releasing.GPUs())
+			assert.Equal(t, pod_status.Pipelined, nodesInfoMap["node1"].PodInfos["job0-0"].Status)
+		})
+	}
+}
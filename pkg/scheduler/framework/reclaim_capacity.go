@@ -0,0 +1,106 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"math"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// ResourcesFreeableByReclaim returns the resources held by jobs of other queues that a job of forQueue could reclaim:
+// preemptible jobs that pass the session's reclaim victim filters, counted up to the part of each victim queue's
+// allocated resources that is above its deserved quota. Tasks of whole or fractional GPUs count the GPU memory of the
+// GPUs of their node. It returns no resources when forQueue is not in the session or when the session's can reclaim
+// resources functions do not allow forQueue to reclaim at all. Nothing is simulated, so the result is an upper bound
+// for capacity planning rather than a guarantee that a specific job fits.
+func (ssn *Session) ResourcesFreeableByReclaim(forQueue common_info.QueueID) common_info.Resource {
+	if _, found := ssn.Queues[forQueue]; !found {
+		log.InfraLogger.V(2).Warnf("Failed to find queue <%s> in session, no resources are freeable by reclaim",
+			forQueue)
+		return common_info.Resource{}
+	}
+
+	reclaimer := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(fmt.Sprintf("%s-reclaim-capacity", forQueue)))
+	reclaimer.Queue = forQueue
+	if !ssn.CanReclaimResources(reclaimer) {
+		return common_info.Resource{}
+	}
+
+	heldByQueue := map[common_info.QueueID]*common_info.Resource{}
+	for _, job := range ssn.PodGroupInfos {
+		if job.Queue == forQueue || !job.IsPreemptibleJob() || job.GetActiveAllocatedTasksCount() == 0 {
+			continue
+		}
+		if !ssn.ReclaimVictimFilter(reclaimer, job) {
+			continue
+		}
+		held, found := heldByQueue[job.Queue]
+		if !found {
+			held = &common_info.Resource{}
+			heldByQueue[job.Queue] = held
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if pod_status.AllocatedStatus(task.Status) {
+				held.Add(ssn.taskFreeableResources(task))
+			}
+		}
+	}
+
+	freeable := common_info.Resource{}
+	for queueID, held := range heldByQueue {
+		if queue, found := ssn.Queues[queueID]; found {
+			ssn.capAtOverQuota(held, queue)
+		}
+		freeable.Add(*held)
+	}
+	return freeable
+}
+
+func (ssn *Session) taskFreeableResources(task *pod_info.PodInfo) common_info.Resource {
+	resources := common_info.Resource{
+		MilliCPU:  task.ResReq.Cpu(),
+		Memory:    task.ResReq.Memory(),
+		GPU:       task.ResReq.GetSumGPUs(),
+		GPUMemory: float64(task.ResReq.GpuMemory()),
+	}
+	if resources.GPUMemory == 0 {
+		if node, found := ssn.Nodes[task.NodeName]; found {
+			resources.GPUMemory = resources.GPU * float64(node.MemoryOfEveryGpuOnNode)
+		}
+	}
+	return resources
+}
+
+// capAtOverQuota limits the resources to the queue's allocated resources above its deserved quota, since reclaim does
+// not take a queue below its deserved quota. GPU memory is limited in proportion to the GPUs.
+func (ssn *Session) capAtOverQuota(fr *common_info.Resource, queue *queue_info.QueueInfo) {
+	deserved := ssn.QueueDeservedResources(queue)
+	allocated := ssn.QueueAllocatedResources(queue)
+	if deserved == nil || allocated == nil {
+		return
+	}
+
+	fr.MilliCPU = math.Min(fr.MilliCPU, overQuotaQuantity(deserved.Cpu(), allocated.Cpu()))
+	fr.Memory = math.Min(fr.Memory, overQuotaQuantity(deserved.Memory(), allocated.Memory()))
+	cappedGPU := math.Min(fr.GPU, overQuotaQuantity(deserved.GPUs(), allocated.GPUs()))
+	if fr.GPU > 0 {
+		fr.GPUMemory *= cappedGPU / fr.GPU
+	}
+	fr.GPU = cappedGPU
+}
+
+func overQuotaQuantity(deserved, allocated float64) float64 {
+	if deserved == commonconstants.UnlimitedResourceQuantity {
+		return 0
+	}
+	return math.Max(allocated-deserved, 0)
+}
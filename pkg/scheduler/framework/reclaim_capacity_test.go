@@ -0,0 +1,128 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+)
+
+func TestResourcesFreeableByReclaim(t *testing.T) {
+	newJob := func(uid common_info.PodGroupID, queue common_info.QueueID, priority int32, gpus ...string,
+	) *podgroup_info.PodGroupInfo {
+		var tasks []*pod_info.PodInfo
+		for i, gpu := range gpus {
+			pod := common_info.BuildPod("ns", string(uid)+"-"+string(rune('0'+i)), "n1", v1.PodRunning,
+				common_info.BuildResourceListWithGPU("1000m", "1G", gpu), []metav1.OwnerReference{}, nil, nil)
+			tasks = append(tasks, pod_info.NewTaskInfo(pod))
+		}
+		job := podgroup_info.NewPodGroupInfo(uid, tasks...)
+		job.Queue = queue
+		job.Priority = priority
+		return job
+	}
+
+	tests := []struct {
+		name         string
+		forQueue     common_info.QueueID
+		canReclaim   bool
+		victimFilter api.VictimFilterFn
+		deserved     map[common_info.QueueID]*resource_info.ResourceRequirements
+		allocated    map[common_info.QueueID]*resource_info.ResourceRequirements
+		expected     common_info.Resource
+	}{
+		{
+			name:       "preemptible jobs of other queues are freeable",
+			forQueue:   "q1",
+			canReclaim: true,
+			expected:   common_info.Resource{MilliCPU: 3000, Memory: 3e9, GPU: 2.5, GPUMemory: 2.5 * 40000},
+		},
+		{
+			name:       "victim queues keep their deserved quota",
+			forQueue:   "q1",
+			canReclaim: true,
+			deserved: map[common_info.QueueID]*resource_info.ResourceRequirements{
+				"q2": resource_info.NewResourceRequirements(1.5, 2000, 1e9),
+			},
+			allocated: map[common_info.QueueID]*resource_info.ResourceRequirements{
+				"q2": resource_info.NewResourceRequirements(2.5, 3000, 3e9),
+			},
+			expected: common_info.Resource{MilliCPU: 1000, Memory: 2e9, GPU: 1, GPUMemory: 40000},
+		},
+		{
+			name:       "jobs rejected by the victim filters are not freeable",
+			forQueue:   "q1",
+			canReclaim: true,
+			victimFilter: func(_ *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+				return victim.UID != "q2-fraction"
+			},
+			expected: common_info.Resource{MilliCPU: 2000, Memory: 2e9, GPU: 2, GPUMemory: 2 * 40000},
+		},
+		{
+			name:     "queues that cannot reclaim free nothing",
+			forQueue: "q1",
+			expected: common_info.Resource{},
+		},
+		{
+			name:       "unknown queue",
+			forQueue:   "missing",
+			canReclaim: true,
+			expected:   common_info.Resource{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{
+				Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+					"q1": {UID: "q1"},
+					"q2": {UID: "q2"},
+				},
+				Nodes: map[string]*node_info.NodeInfo{
+					"n1": {Name: "n1", MemoryOfEveryGpuOnNode: 40000},
+				},
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+					"q1-own":            newJob("q1-own", "q1", constants.PriorityTrainNumber, "4"),
+					"q2-train":          newJob("q2-train", "q2", constants.PriorityTrainNumber, "1", "1"),
+					"q2-fraction":       newJob("q2-fraction", "q2", constants.PriorityTrainNumber, "0.5"),
+					"q2-nonpreemptible": newJob("q2-nonpreemptible", "q2", constants.PriorityBuildNumber, "2"),
+				},
+			}
+			ssn.AddCanReclaimResourcesFn(func(*podgroup_info.PodGroupInfo) bool { return tt.canReclaim })
+			if tt.victimFilter != nil {
+				ssn.AddReclaimVictimFilterFn(tt.victimFilter)
+			}
+			if tt.deserved != nil {
+				ssn.GetQueueDeservedResourcesFns = []api.QueueResource{
+					func(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
+						return tt.deserved[queue.UID]
+					},
+				}
+				ssn.GetQueueAllocatedResourcesFns = []api.QueueResource{
+					func(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
+						return tt.allocated[queue.UID]
+					},
+				}
+			}
+
+			freeable := ssn.ResourcesFreeableByReclaim(tt.forQueue)
+			assert.InDelta(t, tt.expected.MilliCPU, freeable.MilliCPU, 1e-6)
+			assert.InDelta(t, tt.expected.Memory, freeable.Memory, 1e-6)
+			assert.InDelta(t, tt.expected.GPU, freeable.GPU, 1e-6)
+			assert.InDelta(t, tt.expected.GPUMemory, freeable.GPUMemory, 1e-6)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// ReclaimSolver searches for a reclaim scenario for the reclaimer job. The returned statement holds the simulated
+// evictions and placements and is owned by the caller, which must either commit or discard it.
+type ReclaimSolver func(ssn *Session, reclaimer *podgroup_info.PodGroupInfo) (bool, *Statement)
+
+var reclaimSolver ReclaimSolver
+
+// RegisterReclaimSolver sets the solver used by Session.SimulateReclaim. It is registered by the reclaim action,
+// which owns the scenario search logic.
+func RegisterReclaimSolver(solver ReclaimSolver) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	reclaimSolver = solver
+}
+
+func getReclaimSolver() ReclaimSolver {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	return reclaimSolver
+}
+
+// ReclaimPlan describes the outcome of a simulated reclaim for a single preemptor.
+type ReclaimPlan struct {
+	Preemptor *pod_info.PodInfo
+	// Fits is true when the preemptor can be placed once the victims are evicted.
+	Fits bool
+	// NodeName and GPUGroups are the preemptor's placement when Fits is true.
+	NodeName  string
+	GPUGroups []string
+	// Victims are the tasks that would be evicted, as they exist in the session.
+	Victims []*pod_info.PodInfo
+	// Shrunk are the running GPU sharing tasks whose GPU memory would be shrunk instead of evicting them.
+	Shrunk []ShrunkTask
+	// FreedResourcesPerNode is the estimated amount of resources released on each node by evicting the victims and
+	// shrinking the shrunk tasks.
+	FreedResourcesPerNode map[string]*resource_info.Resource
+}
+
+// ShrunkTask is a task of a reclaim plan that keeps running with less GPU memory.
+type ShrunkTask struct {
+	Task *pod_info.PodInfo
+	// GpuMemory is the GPU memory in MiB per device that the task would be left with.
+	GpuMemory int64
+}
+
+// SimulateReclaim runs the reclaim scenario search for the preemptor, applying the reclaim victim filters and
+// scenario validators, and returns the resulting plan, including the GPU sharing tasks that the reclaim would shrink
+// rather than evict. Nothing is evicted: the simulated statement is discarded
+// before returning, leaving the session unchanged.
+// Reclaim is gang scoped, so the search is done for the preemptor's whole pod group.
+func (ssn *Session) SimulateReclaim(preemptor *pod_info.PodInfo) (ReclaimPlan, error) {
+	plan := ReclaimPlan{
+		Preemptor:             preemptor,
+		FreedResourcesPerNode: map[string]*resource_info.Resource{},
+	}
+
+	solver := getReclaimSolver()
+	if solver == nil {
+		return plan, fmt.Errorf("no reclaim solver is registered")
+	}
+	job, found := ssn.PodGroupInfos[preemptor.Job]
+	if !found {
+		return plan, fmt.Errorf("failed to find job <%s> of task <%s/%s> in session",
+			preemptor.Job, preemptor.Namespace, preemptor.Name)
+	}
+	if preemptor.Status != pod_status.Pending {
+		return plan, fmt.Errorf("task <%s/%s> is not pending, status: %s",
+			preemptor.Namespace, preemptor.Name, preemptor.Status)
+	}
+
+	solved, statement := solver(ssn, job)
+	if statement == nil {
+		return plan, nil
+	}
+	defer statement.Discard()
+
+	if !solved {
+		return plan, nil
+	}
+
+	for i, op := range statement.operations {
+		if !statement.operationValid(i) {
+			continue
+		}
+		switch typedOp := op.(type) {
+		case evictOperation:
+			plan.Victims = append(plan.Victims, typedOp.taskInfo)
+			freed, found := plan.FreedResourcesPerNode[typedOp.previousNode.Name]
+			if !found {
+				freed = resource_info.EmptyResource()
+				plan.FreedResourcesPerNode[typedOp.previousNode.Name] = freed
+			}
+			freed.AddResourceRequirements(typedOp.taskInfo.AcceptedResource)
+		case shrinkOperation:
+			plan.addShrunkTask(ssn, typedOp)
+		case pipelineOperation:
+			if typedOp.taskInfo.UID == preemptor.UID {
+				plan.setPreemptorPlacement(typedOp.nextNode, typedOp.taskInfo.GPUGroups)
+			}
+		case allocateOperation:
+			if typedOp.taskInfo.UID == preemptor.UID {
+				plan.setPreemptorPlacement(typedOp.nextNode, typedOp.taskInfo.GPUGroups)
+			}
+		}
+	}
+
+	log.InfraLogger.V(4).Infof("Simulated reclaim for task <%s/%s>: fits <%v> on node <%s>, %d victims, %d shrunk",
+		preemptor.Namespace, preemptor.Name, plan.Fits, plan.NodeName, len(plan.Victims), len(plan.Shrunk))
+
+	return plan, nil
+}
+
+// addShrunkTask records the shrinking of a task. A task shrunk more than once by the statement is listed once, with
+// the GPU memory of its last shrinking, and the memory freed by each shrinking is added to its node.
+func (p *ReclaimPlan) addShrunkTask(ssn *Session, op shrinkOperation) {
+	node, found := ssn.Nodes[op.taskInfo.NodeName]
+	if !found {
+		return
+	}
+	freedMemory := node.GetResourceGpuMemory(op.previousTask.ResReq) - op.gpuMemory
+	if freedMemory > 0 && node.MemoryOfEveryGpuOnNode > 0 {
+		freed, found := p.FreedResourcesPerNode[node.Name]
+		if !found {
+			freed = resource_info.EmptyResource()
+			p.FreedResourcesPerNode[node.Name] = freed
+		}
+		freed.AddResourceRequirements(&resource_info.ResourceRequirements{
+			BaseResource: *resource_info.EmptyBaseResource(),
+			GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithGpus(
+				float64(freedMemory)/float64(node.MemoryOfEveryGpuOnNode), 0),
+		})
+	}
+
+	for i := range p.Shrunk {
+		if p.Shrunk[i].Task.UID == op.taskInfo.UID {
+			p.Shrunk[i].GpuMemory = op.gpuMemory
+			return
+		}
+	}
+	p.Shrunk = append(p.Shrunk, ShrunkTask{Task: op.taskInfo, GpuMemory: op.gpuMemory})
+}
+
+func (p *ReclaimPlan) setPreemptorPlacement(nodeName string, gpuGroups []string) {
+	p.Fits = true
+	p.NodeName = nodeName
+	p.GPUGroups = append([]string{}, gpuGroups...)
+}
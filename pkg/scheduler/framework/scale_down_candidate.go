@@ -0,0 +1,30 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+// IsScaleDownCandidate returns true if node consolidation marked the node as a scale-down candidate with the
+// kai.scheduler/scale-down-candidate annotation, so the cluster autoscaler can remove it.
+func IsScaleDownCandidate(node *node_info.NodeInfo) bool {
+	if node == nil || node.Node == nil {
+		return false
+	}
+	_, found := node.Node.Annotations[commonconstants.NodeScaleDownCandidate]
+	return found
+}
+
+// predicateScaleDownCandidateNode rejects placing tasks on scale-down candidates, so the pods that node consolidation
+// moved away are not replaced by new ones before the cluster autoscaler removes the node.
+func predicateScaleDownCandidateNode(task *pod_info.PodInfo, node *node_info.NodeInfo) *common_info.FitError {
+	if !IsScaleDownCandidate(node) {
+		return nil
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name, "node is a scale-down candidate")
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestFittingNode_ScaleDownCandidate(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "pending_job",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 4},
+		"node1": {GPUs: 4},
+	}, tasksToNodeMap, nil)
+	nodesInfoMap["node0"].Node.Annotations = map[string]string{
+		commonconstants.NodeScaleDownCandidate: "2025-01-01T00:00:00Z",
+	}
+	ssn := &Session{UID: "1", PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	job := jobsInfoMap["pending_job"]
+	task := job.GetAllPodsMap()["pending_job-0"]
+	assert.True(t, IsScaleDownCandidate(nodesInfoMap["node0"]))
+	assert.False(t, ssn.FittingNode(task, nodesInfoMap["node0"], true))
+	assert.Contains(t, job.NodesFitErrors[task.UID].DetailedError(), "node is a scale-down candidate")
+	assert.False(t, IsScaleDownCandidate(nodesInfoMap["node1"]))
+	assert.True(t, ssn.FittingNode(task, nodesInfoMap["node1"], true))
+}
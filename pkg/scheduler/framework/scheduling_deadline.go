@@ -0,0 +1,83 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"time"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const schedulingTimeoutEvictionAction = "schedulingtimeout"
+
+// setSchedulingDeadlines sets the scheduling deadline of the jobs with a scheduling timeout that never started, to
+// their timeout counted from the time the scheduler first saw them pending. The jobs seen pending for the first time
+// are marked as pending since now, which is recorded on their pod group when the session closes, so the deadline
+// counts from the first time any scheduler saw them rather than from their creation, which may predate their queue
+// or the scheduler itself.
+func (ssn *Session) setSchedulingDeadlines(now time.Time) {
+	for _, job := range ssn.PodGroupInfos {
+		if job.SchedulingTimeout == nil || job.LastStartTimestamp != nil {
+			continue
+		}
+		if job.PendingSince == nil {
+			// The annotation keeps seconds only, so the deadline does not move when it is read back
+			pendingSince := now.Truncate(time.Second)
+			job.PendingSince = &pendingSince
+		}
+		deadline := job.PendingSince.Add(*job.SchedulingTimeout)
+		job.SchedulingDeadline = &deadline
+	}
+}
+
+// failTimedOutJobs marks the jobs whose scheduling deadline passed as timed out, so their status is recorded with a
+// SchedulingTimedOut condition. Pods of partially placed gangs are evicted, so a timed-out job never holds resources
+// it cannot use.
+func (ssn *Session) failTimedOutJobs(now time.Time) {
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) || !job.IsSchedulingDeadlineExceeded(now) {
+			continue
+		}
+
+		log.InfraLogger.V(3).Infof("Job <%s> was not scheduled before its deadline <%v>, giving up on it",
+			job.NamespacedName, job.SchedulingDeadline.Format(time.RFC3339))
+		job.SchedulingTimedOut = true
+		job.JobFitErrors = enginev2alpha2.UnschedulableExplanations{{
+			Reason: enginev2alpha2.SchedulingDeadlineExceeded,
+			Message: fmt.Sprintf("Job was not scheduled before its scheduling deadline %s",
+				job.SchedulingDeadline.Format(time.RFC3339)),
+		}}
+		job.NodesFitErrors = map[common_info.PodID]*common_info.FitErrors{}
+		ssn.releaseTimedOutJob(job)
+	}
+}
+
+func (ssn *Session) releaseTimedOutJob(job *podgroup_info.PodGroupInfo) {
+	var tasksToEvict []*pod_info.PodInfo
+	for _, task := range job.GetAllPodsMap() {
+		if pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasksToEvict = append(tasksToEvict, task)
+		}
+	}
+
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasksToEvict),
+		Action:           schedulingTimeoutEvictionAction,
+	}
+	for _, task := range tasksToEvict {
+		message := fmt.Sprintf("Pod %s/%s was evicted because its job was not scheduled before its scheduling deadline",
+			task.Namespace, task.Name)
+		if err := ssn.Evict(task, message, evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> of timed out job <%s>: %v",
+				task.Namespace, task.Name, job.NamespacedName, err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestFailTimedOutJobs(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name               string
+		taskStates         []pod_status.PodStatus
+		deadline           *time.Time
+		started            bool
+		expectedTimedOut   bool
+		expectedTaskStates []pod_status.PodStatus
+	}{
+		{
+			name:               "job without a scheduling timeout",
+			taskStates:         []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+		},
+		{
+			name:               "job before its deadline",
+			taskStates:         []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+			deadline:           ptr.To(now.Add(time.Minute)),
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+		},
+		{
+			name:               "pending job after its deadline",
+			taskStates:         []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+			deadline:           ptr.To(now.Add(-time.Minute)),
+			expectedTimedOut:   true,
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+		},
+		{
+			name:               "partially placed job after its deadline is released",
+			taskStates:         []pod_status.PodStatus{pod_status.Running, pod_status.Pending},
+			deadline:           ptr.To(now.Add(-time.Minute)),
+			expectedTimedOut:   true,
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Releasing, pod_status.Pending},
+		},
+		{
+			name:               "running job after its deadline",
+			taskStates:         []pod_status.PodStatus{pod_status.Running, pod_status.Running},
+			deadline:           ptr.To(now.Add(-time.Minute)),
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Running, pod_status.Running},
+		},
+		{
+			name:               "job that started once is not timed out",
+			taskStates:         []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+			deadline:           ptr.To(now.Add(-time.Minute)),
+			started:            true,
+			expectedTaskStates: []pod_status.PodStatus{pod_status.Pending, pod_status.Pending},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tasks []*tasks_fake.TestTaskBasic
+			for _, state := range tt.taskStates {
+				task := &tasks_fake.TestTaskBasic{State: state}
+				if state == pod_status.Running {
+					task.NodeName = "node0"
+				}
+				tasks = append(tasks, task)
+			}
+			topology := nodes_fake.TestClusterTopology{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "job0",
+						RequiredGPUsPerTask: 1,
+						QueueName:           "queue0",
+						Priority:            constants.PriorityTrainNumber,
+						Tasks:               tasks,
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {GPUs: 4},
+				},
+			}
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+			job := jobsInfoMap["job0"]
+			job.SchedulingDeadline = tt.deadline
+			job.LastStartTimestamp = nil
+			if tt.started {
+				job.LastStartTimestamp = ptr.To(now.Add(-time.Hour))
+			}
+
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			for _, state := range tt.expectedTaskStates {
+				if state == pod_status.Releasing {
+					mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				}
+			}
+
+			ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+			ssn.failTimedOutJobs(now)
+
+			assert.Equal(t, tt.expectedTimedOut, job.SchedulingTimedOut)
+			if tt.expectedTimedOut {
+				assert.Len(t, job.JobFitErrors, 1)
+				assert.Equal(t, enginev2alpha2.SchedulingDeadlineExceeded, job.JobFitErrors[0].Reason)
+			}
+			for i, expectedState := range tt.expectedTaskStates {
+				task := job.GetAllPodsMap()[common_info.PodID(fmt.Sprintf("job0-%d", i))]
+				assert.Equal(t, expectedState, task.Status)
+			}
+		})
+	}
+}
+
+func TestSetSchedulingDeadlines(t *testing.T) {
+	firstSeen := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	jobs := []*jobs_fake.TestJobBasic{
+		{Name: "pending_job", QueueName: "queue0", Tasks: []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}}},
+		{Name: "started_job", QueueName: "queue0", Tasks: []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}}},
+		{Name: "no_timeout_job", QueueName: "queue0", Tasks: []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}}},
+	}
+	newSession := func() *Session {
+		jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps(jobs)
+		for _, job := range jobsInfoMap {
+			job.LastStartTimestamp = nil
+			if job.Name != "no_timeout_job" {
+				job.SchedulingTimeout = ptr.To(30 * time.Minute)
+			}
+		}
+		jobsInfoMap["started_job"].LastStartTimestamp = ptr.To(firstSeen.Add(-time.Hour))
+		return &Session{PodGroupInfos: jobsInfoMap}
+	}
+
+	ssn := newSession()
+	ssn.setSchedulingDeadlines(firstSeen.Add(time.Millisecond))
+	assert.Equal(t, ptr.To(firstSeen), ssn.PodGroupInfos["pending_job"].PendingSince)
+	assert.Equal(t, ptr.To(firstSeen.Add(30*time.Minute)), ssn.PodGroupInfos["pending_job"].SchedulingDeadline)
+	assert.Nil(t, ssn.PodGroupInfos["started_job"].SchedulingDeadline)
+	assert.Nil(t, ssn.PodGroupInfos["no_timeout_job"].PendingSince)
+	assert.Nil(t, ssn.PodGroupInfos["no_timeout_job"].SchedulingDeadline)
+
+	ssn = newSession()
+	ssn.PodGroupInfos["pending_job"].PendingSince = ptr.To(firstSeen)
+	ssn.setSchedulingDeadlines(firstSeen.Add(time.Hour))
+	assert.Equal(t, ptr.To(firstSeen.Add(30*time.Minute)), ssn.PodGroupInfos["pending_job"].SchedulingDeadline,
+		"the deadline counts from the recorded time the job was first seen pending")
+	assert.True(t, ssn.PodGroupInfos["pending_job"].IsSchedulingDeadlineExceeded(firstSeen.Add(time.Hour)))
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+type SchedulingEventType string
+
+const (
+	TaskAllocated SchedulingEventType = "Allocated"
+	TaskPipelined SchedulingEventType = "Pipelined"
+	TaskEvicted   SchedulingEventType = "Evicted"
+	TaskPreempted SchedulingEventType = "Preempted"
+	TaskFitFailed SchedulingEventType = "FitFailed"
+	// TaskForceAllocated is emitted after the Allocated event of a pod placed by Session.ForceAllocate, as an audit
+	// record of the manual override.
+	TaskForceAllocated SchedulingEventType = "ForceAllocated"
+)
+
+// SchedulingEvent is a scheduling decision taken by the session for a pod. Allocated, Pipelined, Evicted and
+// Preempted events are emitted when the decision is committed, FitFailed events whenever the pod is found not to fit
+// a node, including while simulating scenarios that are not committed.
+type SchedulingEvent struct {
+	Type      SchedulingEventType
+	Namespace string
+	Name      string
+	Job       common_info.PodGroupID
+	NodeName  string
+	// Action is the action that evicted the pod, set for Evicted and Preempted events.
+	Action  string
+	Message string
+}
+
+type SchedulingEventSubscriber func(event SchedulingEvent)
+
+type schedulingEventSubscribers struct {
+	mutex       sync.Mutex
+	subscribers []SchedulingEventSubscriber
+}
+
+// SubscribeEvents registers a subscriber to the scheduling events of the session, so tests can assert on the
+// decisions of a cycle without polling the API server. Subscribers are called synchronously, in the order of the
+// decisions, and must not block. Events are only built when the session has subscribers.
+func (ssn *Session) SubscribeEvents(subscriber SchedulingEventSubscriber) {
+	ssn.eventSubscribers.mutex.Lock()
+	defer ssn.eventSubscribers.mutex.Unlock()
+	ssn.eventSubscribers.subscribers = append(ssn.eventSubscribers.subscribers, subscriber)
+}
+
+func (ssn *Session) emitSchedulingEvent(
+	eventType SchedulingEventType, task *pod_info.PodInfo, nodeName, action, message string,
+) {
+	subscribers := ssn.eventSubscribers.get()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	event := SchedulingEvent{
+		Type:      eventType,
+		Namespace: task.Namespace,
+		Name:      task.Name,
+		Job:       task.Job,
+		NodeName:  nodeName,
+		Action:    action,
+		Message:   message,
+	}
+	for _, subscriber := range subscribers {
+		subscriber(event)
+	}
+}
+
+func (ssn *Session) emitEvictionEvent(task *pod_info.PodInfo, nodeName, action, message string) {
+	eventType := TaskEvicted
+	if action == string(Preempt) {
+		eventType = TaskPreempted
+	}
+	ssn.emitSchedulingEvent(eventType, task, nodeName, action, message)
+}
+
+// emitFitFailedEvent builds the message only if the session has subscribers, since fits are checked for every node.
+func (ssn *Session) emitFitFailedEvent(task *pod_info.PodInfo, nodeName string, message func() string) {
+	if len(ssn.eventSubscribers.get()) == 0 {
+		return
+	}
+	ssn.emitSchedulingEvent(TaskFitFailed, task, nodeName, "", message())
+}
+
+func (s *schedulingEventSubscribers) get() []SchedulingEventSubscriber {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.subscribers
+}
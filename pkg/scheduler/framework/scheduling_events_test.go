@@ -0,0 +1,113 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_SubscribeEvents(t *testing.T) {
+	buildJob := func(name string, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: state, NodeName: nodeName}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		evictionAction string
+		subscribe      bool
+		expectedEvents []SchedulingEvent
+	}{
+		{
+			name:           "committed decisions and fit failures are emitted in order",
+			evictionAction: string(Preempt),
+			subscribe:      true,
+			expectedEvents: []SchedulingEvent{
+				{Type: TaskFitFailed, Name: "pending-0", Job: "pending", NodeName: "node1",
+					Message: "not enough idle or releasing resources"},
+				{Type: TaskPreempted, Name: "running-0", Job: "running", NodeName: "node1",
+					Action: string(Preempt), Message: "preempted"},
+				{Type: TaskPipelined, Name: "pending-0", Job: "pending", NodeName: "node1",
+					Message: "Pod /pending-0 was pipelined to node node1"},
+				{Type: TaskAllocated, Name: "other-0", Job: "other", NodeName: "node0"},
+			},
+		},
+		{
+			name:           "evictions of other actions",
+			evictionAction: string(Reclaim),
+			subscribe:      true,
+			expectedEvents: []SchedulingEvent{
+				{Type: TaskFitFailed, Name: "pending-0", Job: "pending", NodeName: "node1",
+					Message: "not enough idle or releasing resources"},
+				{Type: TaskEvicted, Name: "running-0", Job: "running", NodeName: "node1",
+					Action: string(Reclaim), Message: "preempted"},
+				{Type: TaskPipelined, Name: "pending-0", Job: "pending", NodeName: "node1",
+					Message: "Pod /pending-0 was pipelined to node node1"},
+				{Type: TaskAllocated, Name: "other-0", Job: "other", NodeName: "node0"},
+			},
+		},
+		{
+			name:           "no subscribers",
+			evictionAction: string(Preempt),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				buildJob("running", pod_status.Running, "node1"),
+				buildJob("pending", pod_status.Pending, ""),
+				buildJob("other", pod_status.Pending, ""),
+			})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 1},
+				"node1": {GPUs: 1},
+			}, tasksToNodeMap, nil)
+
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+			mockCache.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockCache.EXPECT().TaskPipelined(gomock.Any(), gomock.Any())
+			mockCache.EXPECT().Bind(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+			var events []SchedulingEvent
+			if tt.subscribe {
+				ssn.SubscribeEvents(func(event SchedulingEvent) {
+					events = append(events, event)
+				})
+			}
+
+			running := jobsInfoMap["running"].GetAllPodsMap()["running-0"]
+			pending := jobsInfoMap["pending"].GetAllPodsMap()["pending-0"]
+			other := jobsInfoMap["other"].GetAllPodsMap()["other-0"]
+
+			assert.False(t, ssn.FittingNode(pending, nodesInfoMap["node1"], false))
+			stmt := ssn.Statement()
+			assert.NoError(t, stmt.Evict(running, "preempted",
+				eviction_info.EvictionMetadata{EvictionGangSize: 1, Action: tt.evictionAction}))
+			assert.NoError(t, stmt.Pipeline(pending, "node1", false))
+			assert.NoError(t, stmt.Allocate(other, "node0"))
+			assert.NoError(t, stmt.Commit())
+
+			assert.Equal(t, tt.expectedEvents, events)
+		})
+	}
+}
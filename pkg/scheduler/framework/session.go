@@ -21,15 +21,23 @@ package framework
 
 import (
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"net/http"
+	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	ksf "k8s.io/kube-scheduler/framework"
 	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/configmap_info"
@@ -50,6 +58,29 @@ import (
 
 var server *PluginServer
 
+// jobStatusRecordBackoff bounds the retries of recording a single job's status on session close.
+var jobStatusRecordBackoff = wait.Backoff{
+	Steps:    3,
+	Duration: 10 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// JobStatusRecordError is returned from closing a session when the status of some jobs could not be recorded,
+// so the caller can decide whether to re-enqueue them.
+type JobStatusRecordError struct {
+	FailedJobs map[common_info.PodGroupID]error
+}
+
+func (e *JobStatusRecordError) Error() string {
+	var failures []string
+	for jobID, err := range e.FailedJobs {
+		failures = append(failures, fmt.Sprintf("<%s>: %v", jobID, err))
+	}
+	sort.Strings(failures)
+	return fmt.Sprintf("failed to record status for %d jobs: %s", len(e.FailedJobs), strings.Join(failures, ", "))
+}
+
 type Session struct {
 	UID   types.UID
 	Cache cache.Cache
@@ -60,8 +91,11 @@ type Session struct {
 	ResourceUsage queue_info.ClusterUsage
 	ConfigMaps    map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo
 	Topologies    []*kueuev1alpha1.Topology
+	// PodDisruptionBudgets are the PodDisruptionBudgets of the cluster, as of the snapshot.
+	PodDisruptionBudgets []*policyv1.PodDisruptionBudget
 
 	GpuOrderFns                           []api.GpuOrderFn
+	GpuFilterFns                          []api.GpuFilterFn
 	NodePreOrderFns                       []api.NodePreOrderFn
 	NodeOrderFns                          []api.NodeOrderFn
 	JobOrderFns                           []common_info.CompareFn
@@ -73,6 +107,8 @@ type Session struct {
 	PreemptVictimFilterFns                []api.VictimFilterFn
 	ReclaimScenarioValidatorFns           []api.ScenarioValidatorFn
 	PreemptScenarioValidatorFns           []api.ScenarioValidatorFn
+	CommitValidatorFns                    []api.CommitValidatorFn
+	AllocationUsageFns                    []api.AllocationUsageFn
 	OnJobSolutionStartFns                 []api.OnJobSolutionStartFn
 	GetQueueAllocatedResourcesFns         []api.QueueResource
 	GetQueueDeservedResourcesFns          []api.QueueResource
@@ -84,6 +120,8 @@ type Session struct {
 	PrePredicateFns                       []api.PrePredicateFn
 	PredicateFns                          []api.PredicateFn
 	BindRequestMutateFns                  []api.BindRequestMutateFn
+	TaskResourceMutateFns                 []api.TaskResourceMutateFn
+	GpuDeviceIdFns                        []api.GpuDeviceIdFn
 
 	Config          *conf.SchedulerConfiguration
 	plugins         map[string]Plugin
@@ -91,7 +129,43 @@ type Session struct {
 	SchedulerParams conf.SchedulerParams
 	mux             *http.ServeMux
 
+	gpuMetricsProvider    GpuMetricsProvider
 	k8sResourceStateCache sync.Map
+
+	// registeringPlugin is the plugin whose OnSessionOpen is running, used to name the fns it registers in
+	// scheduling traces and queue order explanations.
+	registeringPlugin   string
+	nodeOrderFnPlugins  []string
+	predicateFnPlugins  []string
+	queueOrderFnPlugins []string
+	// crossNodeOrderFns is set when a node order fn scores a node by the placements on other nodes, so Rescore scores
+	// all the nodes again.
+	crossNodeOrderFns bool
+	// forceAllocatedPods are the pods placed by ForceAllocate, which are not moved to fallback nodes.
+	forceAllocatedPods map[common_info.PodID]bool
+	// queueComparisons are the last comparisons of each pair of queues in the session, by their sorted UIDs.
+	queueComparisons map[[2]common_info.QueueID]*QueueComparison
+	// nextCheckpoints caches the next checkpoint of each job for the checkpoint aware victim order, nil for none.
+	nextCheckpoints map[common_info.PodGroupID]*time.Time
+	// registeredFns counts the fns each plugin registered, by kind, to validate the configured plugin chain.
+	registeredFns map[string]map[FnName]int
+
+	eventSubscribers schedulingEventSubscribers
+	// dispatchedQueues are the queues whose jobs the running action schedules. Nil for all the queues.
+	dispatchedQueues   map[common_info.QueueID]bool
+	podGroupsByQueue   podGroupsByQueueIndex
+	runningJobsCounter runningJobsCounter
+	// clock is the time source of the session's time based decisions, time.Now unless replaced by tests.
+	clock func() time.Time
+}
+
+// Now returns the current time by the session's clock. Time based decisions of the session and its actions, such as
+// scheduling deadlines, use it so they can be tested.
+func (ssn *Session) Now() time.Time {
+	if ssn.clock == nil {
+		return time.Now()
+	}
+	return ssn.clock()
 }
 
 func (ssn *Session) Statement() *Statement {
@@ -115,9 +189,17 @@ func (ssn *Session) GetNodes() []ksf.NodeInfo {
 
 func (ssn *Session) BindPod(pod *pod_info.PodInfo) error {
 	bindRequestAnnotations := ssn.MutateBindRequestAnnotations(pod, pod.NodeName)
-	if err := ssn.Cache.Bind(pod, pod.NodeName, bindRequestAnnotations); err != nil {
-		return err
+	return ssn.completeBind(pod, ssn.Cache.Bind(pod, pod.NodeName, bindRequestAnnotations))
+}
+
+// completeBind records the result of the bind of the pod, and moves the pod to the Binding status once bound.
+func (ssn *Session) completeBind(pod *pod_info.PodInfo, bindErr error) error {
+	if bindErr != nil {
+		reason := bindBackoffs.recordFailure(pod, bindErr)
+		metrics.RecordPodBindFailure(reason)
+		return bindErr
 	}
+	bindBackoffs.reset(pod.UID)
 
 	if err := ssn.updatePodOnSession(pod, pod_status.Binding); err != nil {
 		log.InfraLogger.Errorf("Failed to update pod <%s/%s> status from %s to %s in session: %v",
@@ -135,6 +217,8 @@ func (ssn *Session) Evict(pod *pod_info.PodInfo, message string, evictionMetadat
 		return fmt.Errorf("could not evict pod <%v/%v> without podGroup. podGroupId: <%v>",
 			pod.Namespace, pod.Name, pod.Job)
 	}
+	ssn.logCrossPartitionEviction(pod, podGroup, message)
+	evictionMetadata = ssn.preEvict(pod, evictionMetadata)
 	if err := ssn.Cache.Evict(pod.Pod, podGroup, evictionMetadata, message); err != nil {
 		return err
 	}
@@ -144,6 +228,8 @@ func (ssn *Session) Evict(pod *pod_info.PodInfo, message string, evictionMetadat
 	if err := ssn.updatePodOnNode(pod); err != nil {
 		return err
 	}
+	ssn.emitEvictionEvent(pod, pod.NodeName, evictionMetadata.Action, message)
+	recordPreemptionVictim(pod, podGroup, ssn.Nodes[pod.NodeName], evictionMetadata, ssn.Now())
 	for _, eh := range ssn.eventHandlers {
 		if eh.DeallocateFunc != nil {
 			eh.DeallocateFunc(&Event{
@@ -151,11 +237,103 @@ func (ssn *Session) Evict(pod *pod_info.PodInfo, message string, evictionMetadat
 			})
 		}
 	}
+	if node, found := ssn.Nodes[pod.NodeName]; found {
+		ssn.onGpuSharingEnd(node.Name, unsharedGpuGroups(node, pod, pod.GPUGroups), pod)
+		usage := ssn.newAllocationUsageRecorder()
+		usage.record(api.AllocationStopped, pod, node)
+		usage.report()
+	}
 	return nil
 }
 
+// preEvict asks pods carrying the graceful-checkpoint annotation to checkpoint before they are evicted, by setting
+// the checkpoint timeout of their eviction.
+func (ssn *Session) preEvict(
+	pod *pod_info.PodInfo, evictionMetadata eviction_info.EvictionMetadata,
+) eviction_info.EvictionMetadata {
+	if pod.Pod == nil || pod.Pod.Annotations[commonconstants.GracefulCheckpoint] != "true" {
+		return evictionMetadata
+	}
+	evictionMetadata.CheckpointTimeout = ssn.SchedulerParams.CheckpointEvictionTimeout
+	return evictionMetadata
+}
+
 func (ssn *Session) AddEventHandler(eh *EventHandler) {
 	ssn.eventHandlers = append(ssn.eventHandlers, eh)
+	ssn.recordRegisteredFn(EventHandlerFnName)
+}
+
+// newlySharedGpuGroups returns the GPU groups of a shared task that have no fractional tenant yet on the node, i.e.
+// whole GPUs the task is the first fractional tenant of.
+func newlySharedGpuGroups(node *node_info.NodeInfo, task *pod_info.PodInfo) []string {
+	if !task.IsSharedGPURequest() {
+		return nil
+	}
+	var gpuGroups []string
+	for _, gpuGroup := range task.GPUGroups {
+		if node.UsedSharedGPUsMemory[gpuGroup] == 0 {
+			gpuGroups = append(gpuGroups, gpuGroup)
+		}
+	}
+	return gpuGroups
+}
+
+// unsharedGpuGroups returns the GPU groups of an evicted shared task that have no remaining tenant on the node other
+// than releasing ones.
+func unsharedGpuGroups(node *node_info.NodeInfo, task *pod_info.PodInfo, gpuGroups []string) []string {
+	if !task.IsSharedGPUAllocation() {
+		return nil
+	}
+	var unshared []string
+	for _, gpuGroup := range gpuGroups {
+		if node.UsedSharedGPUsMemory[gpuGroup] <= node.ReleasingSharedGPUsMemory[gpuGroup] {
+			unshared = append(unshared, gpuGroup)
+		}
+	}
+	return unshared
+}
+
+// onGpuSharingStart notifies the event handlers that task is the first fractional tenant of the GPU groups on the
+// node.
+func (ssn *Session) onGpuSharingStart(nodeName string, gpuGroups []string, task *pod_info.PodInfo) {
+	for _, gpuGroup := range gpuGroups {
+		gpuId := ssn.gpuGroupIdOnNode(nodeName, gpuGroup)
+		for _, eh := range ssn.eventHandlers {
+			if eh.GpuSharingStartFunc != nil {
+				eh.GpuSharingStartFunc(&GpuSharingEvent{
+					NodeName: nodeName,
+					GPUGroup: gpuGroup,
+					GPUId:    gpuId,
+					Task:     task,
+				})
+			}
+		}
+	}
+}
+
+func (ssn *Session) gpuGroupIdOnNode(nodeName string, gpuGroup string) string {
+	node, found := ssn.Nodes[nodeName]
+	if !found {
+		return gpuGroup
+	}
+	return ssn.GpuGroupId(node, gpuGroup)
+}
+
+// onGpuSharingEnd notifies the event handlers that task was the last fractional tenant of the GPU groups on the node.
+func (ssn *Session) onGpuSharingEnd(nodeName string, gpuGroups []string, task *pod_info.PodInfo) {
+	for _, gpuGroup := range gpuGroups {
+		gpuId := ssn.gpuGroupIdOnNode(nodeName, gpuGroup)
+		for _, eh := range ssn.eventHandlers {
+			if eh.GpuSharingEndFunc != nil {
+				eh.GpuSharingEndFunc(&GpuSharingEvent{
+					NodeName: nodeName,
+					GPUGroup: gpuGroup,
+					GPUId:    gpuId,
+					Task:     task,
+				})
+			}
+		}
+	}
 }
 
 // FittingGPUs returns a list of GPUs that fit the pod, sorted by fit score (descending)
@@ -174,26 +352,40 @@ func (ssn *Session) FittingGPUs(node *node_info.NodeInfo, pod *pod_info.PodInfo)
 
 func filterGpusByEnoughResources(node *node_info.NodeInfo, pod *pod_info.PodInfo) []string {
 	filteredGPUs := []string{}
-	log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>: Filtering GPUs for pod <%s/%s>, requested gpu-memory: <%d MB>",
-		node.Name, pod.Namespace, pod.Name, pod.ResReq.GpuMemory())
+	log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>: Filtering GPUs for pod <%s/%s>, requested gpu-memory: <%d MB>, "+
+		"rounded to the gpu memory quantum: <%d MB>",
+		node.Name, pod.Namespace, pod.Name, pod.ResReq.GpuMemory(), node.GetResourceGpuMemory(pod.ResReq))
 
 	for gpuIdx := range node.UsedSharedGPUsMemory {
-		fits := node.IsTaskFitOnGpuGroup(pod.ResReq, gpuIdx)
-		log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>, GPU <%s>: UsedMemory=<%d MB>, AllocatedMemory=<%d MB>, ReleasingMemory=<%d MB>, TotalGpuMemory=<%d MB>, Fits=<%v>",
+		// The pod needs the max of its init and main requirements, and ResReq may hold only the main GPU fraction.
+		fits := node.IsTaskFitOnGpuGroup(pod.ResReq, gpuIdx) &&
+			(pod.InitResReq == nil || pod.InitResReq.GpuMemory() == 0 || node.IsTaskFitOnGpuGroup(pod.InitResReq, gpuIdx))
+		closedError := closedGpuGroupFitError(pod, node, gpuIdx)
+		log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>, GPU <%s>: UsedMemory=<%d MB>, AllocatedMemory=<%d MB>, ReleasingMemory=<%d MB>, TotalGpuMemory=<%d MB>, Fits=<%v>, AtTenantLimit=<%v>, Healthy=<%v>",
 			node.Name, gpuIdx,
 			node.UsedSharedGPUsMemory[gpuIdx],
 			node.AllocatedSharedGPUsMemory[gpuIdx],
 			node.ReleasingSharedGPUsMemory[gpuIdx],
 			node.MemoryOfEveryGpuOnNode,
-			fits)
+			fits, node.IsGpuGroupAtTenantLimit(gpuIdx), node.IsGpuGroupHealthy(gpuIdx))
+		fits = fits && closedError == nil
 		if fits {
 			filteredGPUs = append(filteredGPUs, gpuIdx)
 		}
 	}
-	if node.Idle.GPUs() > 0 || node.Releasing.GPUs() > 0 {
-		log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>: IdleGPUs=<%v>, ReleasingGPUs=<%v>, adding <%d> whole GPU indicators",
-			node.Name, node.Idle.GPUs(), node.Releasing.GPUs(), int(node.Idle.GPUs())+int(node.Releasing.GPUs()))
-		for range int(node.Idle.GPUs()) + int(node.Releasing.GPUs()) {
+	// The reserved GPU memory of the node applies to GPUs that are not shared yet as well.
+	fitsWholeGpu := node.IsTaskFitOnWholeGpu(pod.ResReq) &&
+		(pod.InitResReq == nil || pod.InitResReq.GpuMemory() == 0 || node.IsTaskFitOnWholeGpu(pod.InitResReq))
+	if !fitsWholeGpu {
+		log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>: Requested gpu-memory exceeds the usable memory of a whole GPU <%d MB>",
+			node.Name, node.UsableGpuMemory(pod_info.WholeGpuIndicator))
+	}
+	// Unhealthy GPUs that no shared GPU group runs on are not offered as whole GPUs.
+	wholeGpus := max(int(node.Idle.GPUs())+int(node.Releasing.GPUs())-node.GetNumOfUnhealthyWholeGpus(), 0)
+	if fitsWholeGpu && wholeGpus > 0 {
+		log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>: IdleGPUs=<%v>, ReleasingGPUs=<%v>, UnhealthyGPUs=<%d>, adding <%d> whole GPU indicators",
+			node.Name, node.Idle.GPUs(), node.Releasing.GPUs(), len(node.UnhealthyGpus), wholeGpus)
+		for range wholeGpus {
 			filteredGPUs = append(filteredGPUs, pod_info.WholeGpuIndicator)
 		}
 	}
@@ -201,9 +393,28 @@ func filterGpusByEnoughResources(node *node_info.NodeInfo, pod *pod_info.PodInfo
 	return filteredGPUs
 }
 
+// closedGpuGroupFitError returns why the shared GPU group takes no more fractional pods, even if their memory fits:
+// the group is at the tenant limit of the node pool, or it runs on an unhealthy GPU.
+func closedGpuGroupFitError(pod *pod_info.PodInfo, node *node_info.NodeInfo, gpuGroup string) *common_info.FitError {
+	if node.IsGpuGroupAtTenantLimit(gpuGroup) {
+		return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+			fmt.Sprintf("node(s) didn't have enough resources: GPU group %s is at the tenant limit", gpuGroup))
+	}
+	if !node.IsGpuGroupHealthy(gpuGroup) {
+		return common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+			fmt.Sprintf("node(s) didn't have enough resources: GPU group %s runs on an unhealthy GPU", gpuGroup))
+	}
+	return nil
+}
+
 func (ssn *Session) sortGPUs(filteredGPUs []string, pod *pod_info.PodInfo, node *node_info.NodeInfo) []string {
 	gpuScores := map[float64][]string{}
 	for _, gpuIdx := range filteredGPUs {
+		if !ssn.GpuFilterFn(pod, node, gpuIdx) {
+			log.InfraLogger.V(4).Infof("[GPU_FILTER] Node <%s>, GPU <%s>: Excluded for pod <%s/%s> by a GPU filter",
+				node.Name, gpuIdx, pod.Namespace, pod.Name)
+			continue
+		}
 		score, err := ssn.GpuOrderFn(pod, node, gpuIdx)
 		if err != nil {
 			log.InfraLogger.Errorf("Error in calculating score for node/gpu %s/%d:%v", node.Name, gpuIdx, err)
@@ -220,15 +431,22 @@ func (ssn *Session) sortGPUs(filteredGPUs []string, pod *pod_info.PodInfo, node
 func (ssn *Session) FittingNode(task *pod_info.PodInfo, node *node_info.NodeInfo, writeFittingDelta bool) bool {
 	var fitErrors *common_info.FitErrors
 	if writeFittingDelta {
-		fitErrors = common_info.NewFitErrors()
+		fitErrors = ssn.NewFitErrors()
 	}
 
 	job := ssn.PodGroupInfos[task.Job]
 
+	trace := decisionTraces.traceFor(task)
+
 	log.InfraLogger.V(6).Infof("Checking if task <%v/%v> is allocatable on node <%v>: <%v> vs. <%v>",
 		task.Namespace, task.Name, node.Name, task.ResReq, node.Idle)
-	allocatable, fitError := ssn.isTaskAllocatableOnNode(task, job, node, writeFittingDelta)
+	allocatable, fitError := ssn.isTaskAllocatableOnNode(task, job, node,
+		writeFittingDelta || trace != nil)
 	if !allocatable {
+		if trace != nil {
+			trace.recordFit(node.Name, false, fitErrorMessage(fitError), nil)
+		}
+		ssn.emitFitFailedEvent(task, node.Name, func() string { return fitErrorMessage(fitError) })
 		if fitError != nil && writeFittingDelta {
 			fitErrors.SetNodeError(node.Name, fitError)
 			job.SetTaskFitError(task, fitErrors)
@@ -238,9 +456,16 @@ func (ssn *Session) FittingNode(task *pod_info.PodInfo, node *node_info.NodeInfo
 
 	log.InfraLogger.V(6).Infof("Running predicates for task <%v/%v> on node <%v>",
 		task.Namespace, task.Name, node.Name)
-	if err := ssn.PredicateFn(task, job, node); err != nil {
+	var err error
+	if trace != nil {
+		err = ssn.tracedPredicateFn(task, job, node, trace)
+	} else {
+		err = ssn.PredicateFn(task, job, node)
+	}
+	if err != nil {
 		log.InfraLogger.V(6).Infof("Predicates failed for task <%s/%s> on node <%s>: %v",
 			task.Namespace, task.Name, node.Name, err)
+		ssn.emitFitFailedEvent(task, node.Name, err.Error)
 		if writeFittingDelta {
 			fitErrors.SetNodeError(node.Name, err)
 			job.SetTaskFitError(task, fitErrors)
@@ -250,36 +475,10 @@ func (ssn *Session) FittingNode(task *pod_info.PodInfo, node *node_info.NodeInfo
 	return true
 }
 
+// OrderedNodesByTask orders the nodes by their score for the task, best first. When the node scoring budget of the
+// task is exceeded, the nodes that were not scored yet are put after the scored ones, in a random order.
 func (ssn *Session) OrderedNodesByTask(nodes []*node_info.NodeInfo, task *pod_info.PodInfo) []*node_info.NodeInfo {
-	var (
-		nodeScores = make(map[float64][]*node_info.NodeInfo)
-		mutex      sync.Mutex
-		wg         sync.WaitGroup
-	)
-
-	ssn.NodePreOrderFn(task, nodes)
-
-	for _, node := range nodes {
-		wg.Add(1)
-		go func(node *node_info.NodeInfo) {
-			defer wg.Done()
-			score, err := ssn.NodeOrderFn(task, node)
-			if err != nil {
-				log.InfraLogger.Errorf("Error in Calculating Priority for the node:%v", err)
-				return
-			}
-
-			mutex.Lock()
-			nodeScores[score] = append(nodeScores[score], node)
-			mutex.Unlock()
-
-			log.InfraLogger.V(5).Infof("Overall priority node score of node <%v> for task <%v/%v> is: %f",
-				node.Name, task.Namespace, task.Name, score)
-		}(node)
-	}
-
-	wg.Wait()
-	return sortNodesByScore(nodeScores)
+	return ssn.RankNodesByTask(nodes, task).OrderedNodes()
 }
 
 func (ssn *Session) isTaskAllocatableOnNode(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo,
@@ -287,6 +486,18 @@ func (ssn *Session) isTaskAllocatableOnNode(task *pod_info.PodInfo, job *podgrou
 	allocatable := true
 	var fitError *common_info.FitError = nil
 
+	if err := predicateHeldNode(task, node); err != nil {
+		log.InfraLogger.V(6).Infof("Task: <%s/%s> does not fit held node <%s>: %v",
+			task.Namespace, task.Name, node.Name, err)
+		return false, err
+	}
+
+	if err := predicateScaleDownCandidateNode(task, node); err != nil {
+		log.InfraLogger.V(6).Infof("Task: <%s/%s> does not fit scale-down candidate node <%s>",
+			task.Namespace, task.Name, node.Name)
+		return false, err
+	}
+
 	if !node.IsTaskAllocatableOnReleasingOrIdle(task) {
 		allocatable = false
 		log.InfraLogger.V(6).Infof("Not enough resources for task: <%s/%s>, init requested: <%v>. "+
@@ -297,6 +508,19 @@ func (ssn *Session) isTaskAllocatableOnNode(task *pod_info.PodInfo, job *podgrou
 				fitError = node.FittingError(task, len(job.GetAllPodsMap()) > 1)
 			}
 		}
+		return allocatable, fitError
+	}
+
+	if err := node.PredicateByExclusiveNode(task, ssn.GetSchedulerName()); err != nil {
+		log.InfraLogger.V(6).Infof("Task: <%s/%s> does not fit node <%s> exclusivity: %v",
+			task.Namespace, task.Name, node.Name, err)
+		return false, err
+	}
+
+	if err := ssn.predicateCrossPartitionNode(task, node); err != nil {
+		log.InfraLogger.V(6).Infof("Task: <%s/%s> does not fit node <%s> of another node pool: %v",
+			task.Namespace, task.Name, node.Name, err)
+		return false, err
 	}
 	return allocatable, fitError
 }
@@ -346,6 +570,41 @@ func (ssn *Session) updatePodOnSession(pod *pod_info.PodInfo, status pod_status.
 	return err
 }
 
+// resizeTask changes the resources of an allocated task in place, keeping the node, job and plugin accounting in
+// sync with the new resource request.
+func (ssn *Session) resizeTask(task *pod_info.PodInfo, node *node_info.NodeInfo, resize func()) error {
+	job, found := ssn.PodGroupInfos[task.Job]
+	if !found {
+		return fmt.Errorf("failed to find job %s", task.Job)
+	}
+
+	for _, eh := range ssn.eventHandlers {
+		if eh.DeallocateFunc != nil {
+			eh.DeallocateFunc(&Event{
+				Task: task,
+			})
+		}
+	}
+
+	job.Allocated.SubResourceRequirements(task.ResReq)
+	resize()
+	job.Allocated.AddResourceRequirements(task.ResReq)
+	err := node.UpdateTask(task)
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to update task <%v/%v> in Session <%v>: %v",
+			task.Namespace, task.Name, ssn.UID, err)
+	}
+
+	for _, eh := range ssn.eventHandlers {
+		if eh.AllocateFunc != nil {
+			eh.AllocateFunc(&Event{
+				Task: task,
+			})
+		}
+	}
+	return err
+}
+
 func (ssn *Session) clear() {
 	ssn.PodGroupInfos = nil
 	ssn.Nodes = nil
@@ -369,7 +628,9 @@ func openSession(cache cache.Cache, sessionId types.UID, schedulerParams conf.Sc
 		plugins:               map[string]Plugin{},
 		SchedulerParams:       schedulerParams,
 		mux:                   mux,
+		gpuMetricsProvider:    getGpuMetricsProvider(),
 		k8sResourceStateCache: sync.Map{},
+		clock:                 time.Now,
 	}
 
 	log.InfraLogger.V(2).Infof("Taking cluster snapshot ...")
@@ -377,28 +638,45 @@ func openSession(cache cache.Cache, sessionId types.UID, schedulerParams conf.Sc
 	if err != nil {
 		return nil, err
 	}
+	if err := snapshotBreaker.check(snapshot, schedulerParams.MaxSnapshotStaleness, time.Now()); err != nil {
+		return nil, err
+	}
 
 	ssn.PodGroupInfos = snapshot.PodGroupInfos
 	ssn.Nodes = snapshot.Nodes
+	if ssn.gpuMetricsProvider == nil {
+		ssn.gpuMetricsProvider = newNodeAnnotationsGpuMetricsProvider(ssn.Nodes)
+	}
 	ssn.Queues = snapshot.Queues
 	ssn.ResourceUsage = snapshot.QueueResourceUsage
 	ssn.ConfigMaps = snapshot.ConfigMaps
 	ssn.Topologies = snapshot.Topologies
+	ssn.PodDisruptionBudgets = snapshot.PodDisruptionBudgets
+	ssn.initRunningJobs()
 
 	log.InfraLogger.V(2).Infof("Session %v with <%d> Jobs, <%d> Queues and <%d> Nodes",
 		ssn.UID, len(ssn.PodGroupInfos), len(ssn.Queues), len(ssn.Nodes))
+	ssn.logCrossPartitionReclaim()
 
 	return ssn, nil
 }
 
-func closeSession(ssn *Session) {
+func closeSession(ssn *Session) error {
 	log.InfraLogger.V(6).Infof("Close Session %v with <%d> Jobs and <%d> Queues",
 		ssn.UID, len(ssn.PodGroupInfos), len(ssn.Queues))
 
+	ssn.recordLastGpus()
+
 	// Push all jobs for status update into the channel
+	failedJobs := map[common_info.PodGroupID]error{}
 	for _, job := range ssn.PodGroupInfos {
-		if err := ssn.Cache.RecordJobStatusEvent(job); err != nil {
+		// The statuses of cross partition jobs are recorded by the scheduler of their own partition
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		if err := recordJobStatusEventWithRetry(ssn.Cache, job); err != nil {
 			log.InfraLogger.Errorf("Failed to record job status event for job <%s>: %v", job.Name, err)
+			failedJobs[job.UID] = err
 		}
 	}
 
@@ -407,6 +685,24 @@ func closeSession(ssn *Session) {
 	ssn.Cache.WaitForWorkers(stopCh)
 
 	log.InfraLogger.V(6).Infof("Done updating job statuses for session: %v", ssn.UID)
+
+	if len(failedJobs) > 0 {
+		return &JobStatusRecordError{FailedJobs: failedJobs}
+	}
+	return nil
+}
+
+func recordJobStatusEventWithRetry(cache cache.Cache, job *podgroup_info.PodGroupInfo) error {
+	attempt := 0
+	return retry.OnError(jobStatusRecordBackoff, func(error) bool { return true }, func() error {
+		attempt++
+		err := cache.RecordJobStatusEvent(job)
+		if err != nil {
+			log.InfraLogger.V(4).Infof("Attempt %d to record job status event for job <%s> failed: %v",
+				attempt, job.Name, err)
+		}
+		return err
+	})
 }
 
 func (ssn *Session) GetMaxNumberConsolidationPreemptees() int {
@@ -417,6 +713,15 @@ func (ssn *Session) OverrideMaxNumberConsolidationPreemptees(maxPreemptees int)
 	ssn.SchedulerParams.MaxNumberConsolidationPreemptees = maxPreemptees
 }
 
+// GetNodeScoringWorkers returns the number of goroutines used to score nodes in OrderedNodesByTask.
+// Defaults to GOMAXPROCS when not configured.
+func (ssn *Session) GetNodeScoringWorkers() int {
+	if ssn.SchedulerParams.NodeScoringWorkers > 0 {
+		return ssn.SchedulerParams.NodeScoringWorkers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
 func (ssn *Session) UseSchedulingSignatures() bool {
 	return ssn.SchedulerParams.UseSchedulingSignatures
 }
@@ -468,6 +773,30 @@ func (ssn *Session) OverrideAllowConsolidatingReclaim(allowConsolidatingReclaim
 	ssn.SchedulerParams.AllowConsolidatingReclaim = allowConsolidatingReclaim
 }
 
+func (ssn *Session) AllowNodeConsolidation() bool {
+	return ssn.SchedulerParams.AllowNodeConsolidation
+}
+
+func (ssn *Session) GetNodeConsolidationThreshold() float64 {
+	return ssn.SchedulerParams.NodeConsolidationThreshold
+}
+
+// OverrideNodeConsolidation overrides the values returned by AllowNodeConsolidation and
+// GetNodeConsolidationThreshold. Use for testing purposes.
+func (ssn *Session) OverrideNodeConsolidation(allow bool, threshold float64) {
+	ssn.SchedulerParams.AllowNodeConsolidation = allow
+	ssn.SchedulerParams.NodeConsolidationThreshold = threshold
+}
+
+func (ssn *Session) GangCompletionPreemption() bool {
+	return ssn.SchedulerParams.GangCompletionPreemption
+}
+
+// OverrideGangCompletionPreemption overrides the value returned by GangCompletionPreemption. Use for testing purposes.
+func (ssn *Session) OverrideGangCompletionPreemption(allow bool) {
+	ssn.SchedulerParams.GangCompletionPreemption = allow
+}
+
 func (ssn *Session) GetSchedulerName() string {
 	return ssn.SchedulerParams.SchedulerName
 }
@@ -480,6 +809,9 @@ func (ssn *Session) InternalK8sPlugins() *k8splugins.K8sPlugins {
 	return ssn.Cache.InternalK8sPlugins()
 }
 
+// randFloat64 draws the random numbers of shuffleTopNodes, replaced in tests.
+var randFloat64 = rand.Float64
+
 func sortNodesByScore(nodeScores map[float64][]*node_info.NodeInfo) []*node_info.NodeInfo {
 	var nodesInorder []*node_info.NodeInfo
 	var keys []float64
@@ -494,6 +826,58 @@ func sortNodesByScore(nodeScores map[float64][]*node_info.NodeInfo) []*node_info
 	return nodesInorder
 }
 
+// shuffleTopNodes orders the nodes like sortNodesByScore, except that the nodes whose score is within epsilon of the
+// top score are put first in a random order, where each next node is drawn with a probability proportional to its
+// score above the lowest of them plus epsilon. This spreads pods between nodes that are almost equally good.
+func shuffleTopNodes(nodeScores map[float64][]*node_info.NodeInfo, epsilon float64) []*node_info.NodeInfo {
+	orderedNodes := sortNodesByScore(nodeScores)
+	if len(orderedNodes) < 2 || epsilon <= 0 {
+		return orderedNodes
+	}
+
+	scores := make(map[string]float64, len(orderedNodes))
+	topScore := math.Inf(-1)
+	for score, nodes := range nodeScores {
+		topScore = max(topScore, score)
+		for _, node := range nodes {
+			scores[node.Name] = score
+		}
+	}
+
+	numTopNodes := 0
+	lowestTopScore := topScore
+	for _, node := range orderedNodes {
+		if scores[node.Name] < topScore-epsilon {
+			break
+		}
+		lowestTopScore = scores[node.Name]
+		numTopNodes++
+	}
+
+	topNodes := orderedNodes[:numTopNodes]
+	totalWeight := 0.0
+	weights := make([]float64, numTopNodes)
+	for i, node := range topNodes {
+		weights[i] = scores[node.Name] - lowestTopScore + epsilon
+		totalWeight += weights[i]
+	}
+	for i := range topNodes {
+		draw := randFloat64() * totalWeight
+		selected := len(topNodes) - 1
+		for j := i; j < len(topNodes); j++ {
+			if draw < weights[j] {
+				selected = j
+				break
+			}
+			draw -= weights[j]
+		}
+		topNodes[i], topNodes[selected] = topNodes[selected], topNodes[i]
+		weights[i], weights[selected] = weights[selected], weights[i]
+		totalWeight -= weights[i]
+	}
+	return orderedNodes
+}
+
 func sortNodesByName(nodes []*node_info.NodeInfo) []*node_info.NodeInfo {
 	sort.Slice(nodes, func(i, j int) bool {
 		return nodes[i].Name < nodes[j].Name
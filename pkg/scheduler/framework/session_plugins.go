@@ -22,7 +22,12 @@ package framework
 import (
 	"maps"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -38,74 +43,128 @@ type CompareQueueFn func(lQ, rQ *queue_info.QueueInfo, lJob, rJob *podgroup_info
 
 func (ssn *Session) AddGPUOrderFn(gof api.GpuOrderFn) {
 	ssn.GpuOrderFns = append(ssn.GpuOrderFns, gof)
+	ssn.recordRegisteredFn(GPUOrderFnName)
+}
+
+func (ssn *Session) AddGpuFilterFn(gff api.GpuFilterFn) {
+	ssn.GpuFilterFns = append(ssn.GpuFilterFns, gff)
+	ssn.recordRegisteredFn(GpuFilterFnName)
 }
 
 func (ssn *Session) AddNodePreOrderFn(npof api.NodePreOrderFn) {
 	ssn.NodePreOrderFns = append(ssn.NodePreOrderFns, npof)
+	ssn.recordRegisteredFn(NodePreOrderFnName)
 }
 
 func (ssn *Session) AddNodeOrderFn(nof api.NodeOrderFn) {
 	ssn.NodeOrderFns = append(ssn.NodeOrderFns, nof)
+	ssn.recordRegisteredFn(NodeOrderFnName)
+	ssn.nodeOrderFnPlugins = append(ssn.nodeOrderFnPlugins, ssn.registeringPlugin)
+}
+
+// AddCrossNodeOrderFn adds a node order fn whose score for a node depends on the tasks placed on other nodes, e.g. on
+// the nodes of the same topology domain. Rescore scores all the nodes again while such a fn is registered, since the
+// placements of earlier tasks may change the scores of nodes they were not placed on.
+func (ssn *Session) AddCrossNodeOrderFn(nof api.NodeOrderFn) {
+	ssn.AddNodeOrderFn(nof)
+	ssn.crossNodeOrderFns = true
 }
 
 func (ssn *Session) AddPrePredicateFn(pf api.PrePredicateFn) {
 	ssn.PrePredicateFns = append(ssn.PrePredicateFns, pf)
+	ssn.recordRegisteredFn(PrePredicateFnName)
 }
 
 func (ssn *Session) AddSubsetNodesFn(snf api.SubsetNodesFn) {
 	ssn.SubsetNodesFns = append(ssn.SubsetNodesFns, snf)
+	ssn.recordRegisteredFn(SubsetNodesFnName)
 }
 
 func (ssn *Session) AddPredicateFn(pf api.PredicateFn) {
 	ssn.PredicateFns = append(ssn.PredicateFns, pf)
+	ssn.recordRegisteredFn(PredicateFnName)
+	ssn.predicateFnPlugins = append(ssn.predicateFnPlugins, ssn.registeringPlugin)
 }
 
 func (ssn *Session) AddJobOrderFn(jof common_info.CompareFn) {
 	ssn.JobOrderFns = append(ssn.JobOrderFns, jof)
+	ssn.recordRegisteredFn(JobOrderFnName)
 }
 
 func (ssn *Session) AddTaskOrderFn(tof common_info.CompareFn) {
 	ssn.TaskOrderFns = append(ssn.TaskOrderFns, tof)
+	ssn.recordRegisteredFn(TaskOrderFnName)
 }
 
 func (ssn *Session) AddSubGroupsOrderFn(sgof common_info.CompareFn) {
 	ssn.SubGroupsOrderFns = append(ssn.SubGroupsOrderFns, sgof)
+	ssn.recordRegisteredFn(SubGroupsOrderFnName)
 }
 
 func (ssn *Session) AddQueueOrderFn(qof CompareQueueFn) {
 	ssn.QueueOrderFns = append(ssn.QueueOrderFns, qof)
+	ssn.recordRegisteredFn(QueueOrderFnName)
+	ssn.queueOrderFnPlugins = append(ssn.queueOrderFnPlugins, ssn.registeringPlugin)
 }
 
 func (ssn *Session) AddOnJobSolutionStartFn(jssf api.OnJobSolutionStartFn) {
 	ssn.OnJobSolutionStartFns = append(ssn.OnJobSolutionStartFns, jssf)
+	ssn.recordRegisteredFn(OnJobSolutionStartFnName)
 }
 
 func (ssn *Session) AddGetQueueAllocatedResourcesFn(of api.QueueResource) {
 	ssn.GetQueueAllocatedResourcesFns = append(ssn.GetQueueAllocatedResourcesFns, of)
+	ssn.recordRegisteredFn(GetQueueAllocatedResourcesFnName)
 }
 
 func (ssn *Session) AddPreemptVictimFilterFn(pf api.VictimFilterFn) {
 	ssn.PreemptVictimFilterFns = append(ssn.PreemptVictimFilterFns, pf)
+	ssn.recordRegisteredFn(PreemptVictimFilterFnName)
 }
 
 func (ssn *Session) AddCanReclaimResourcesFn(crf api.CanReclaimResourcesFn) {
 	ssn.CanReclaimResourcesFns = append(ssn.CanReclaimResourcesFns, crf)
+	ssn.recordRegisteredFn(CanReclaimResourcesFnName)
 }
 
 func (ssn *Session) AddReclaimScenarioValidatorFn(rf api.ScenarioValidatorFn) {
 	ssn.ReclaimScenarioValidatorFns = append(ssn.ReclaimScenarioValidatorFns, rf)
+	ssn.recordRegisteredFn(ReclaimScenarioValidatorFnName)
 }
 
 func (ssn *Session) AddPreemptScenarioValidatorFn(rf api.ScenarioValidatorFn) {
 	ssn.PreemptScenarioValidatorFns = append(ssn.PreemptScenarioValidatorFns, rf)
+	ssn.recordRegisteredFn(PreemptScenarioValidatorFnName)
+}
+
+func (ssn *Session) AddCommitValidatorFn(cvf api.CommitValidatorFn) {
+	ssn.CommitValidatorFns = append(ssn.CommitValidatorFns, cvf)
+	ssn.recordRegisteredFn(CommitValidatorFnName)
+}
+
+func (ssn *Session) AddAllocationUsageFn(auf api.AllocationUsageFn) {
+	ssn.AllocationUsageFns = append(ssn.AllocationUsageFns, auf)
+	ssn.recordRegisteredFn(AllocationUsageFnName)
 }
 
 func (ssn *Session) AddReclaimVictimFilterFn(rf api.VictimFilterFn) {
 	ssn.ReclaimVictimFilterFns = append(ssn.ReclaimVictimFilterFns, rf)
+	ssn.recordRegisteredFn(ReclaimVictimFilterFnName)
 }
 
 func (ssn *Session) AddBindRequestMutateFn(fn api.BindRequestMutateFn) {
 	ssn.BindRequestMutateFns = append(ssn.BindRequestMutateFns, fn)
+	ssn.recordRegisteredFn(BindRequestMutateFnName)
+}
+
+func (ssn *Session) AddTaskResourceMutateFn(fn api.TaskResourceMutateFn) {
+	ssn.TaskResourceMutateFns = append(ssn.TaskResourceMutateFns, fn)
+	ssn.recordRegisteredFn(TaskResourceMutateFnName)
+}
+
+func (ssn *Session) AddGpuDeviceIdFn(fn api.GpuDeviceIdFn) {
+	ssn.GpuDeviceIdFns = append(ssn.GpuDeviceIdFns, fn)
+	ssn.recordRegisteredFn(GpuDeviceIdFnName)
 }
 
 func (ssn *Session) CanReclaimResources(reclaimer *podgroup_info.PodGroupInfo) bool {
@@ -136,7 +195,21 @@ func (ssn *Session) ReclaimScenarioValidatorFn(scenario api.ScenarioInfo) bool {
 	return true
 }
 
+// CommitValidator returns false and the reason of the first validator that vetoes the commit.
+func (ssn *Session) CommitValidator(commit api.CommitInfo) (bool, string) {
+	for _, cvf := range ssn.CommitValidatorFns {
+		if valid, reason := cvf(commit); !valid {
+			return false, reason
+		}
+	}
+
+	return true, ""
+}
+
 func (ssn *Session) PreemptVictimFilter(preemptor *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if allowed, fenced := ssn.preemptionFence(preemptor, victim); fenced {
+		return allowed
+	}
 	for _, pf := range ssn.PreemptVictimFilterFns {
 		if !pf(preemptor, victim) {
 			return false
@@ -159,6 +232,7 @@ func (ssn *Session) PreemptScenarioValidator(
 }
 
 func (ssn *Session) AddHttpHandler(path string, handler func(http.ResponseWriter, *http.Request)) {
+	ssn.recordRegisteredFn(HttpHandlerFnName)
 	if server == nil {
 		return
 	}
@@ -184,22 +258,27 @@ func (ssn *Session) QueueDeservedResources(queue *queue_info.QueueInfo) *resourc
 
 func (ssn *Session) AddGetQueueDeservedResourcesFn(of api.QueueResource) {
 	ssn.GetQueueDeservedResourcesFns = append(ssn.GetQueueDeservedResourcesFns, of)
+	ssn.recordRegisteredFn(GetQueueDeservedResourcesFnName)
 }
 
 func (ssn *Session) AddGetQueueFairShareFn(of api.QueueResource) {
 	ssn.GetQueueFairShareFns = append(ssn.GetQueueFairShareFns, of)
+	ssn.recordRegisteredFn(GetQueueFairShareFnName)
 }
 
 func (ssn *Session) AddIsNonPreemptibleJobOverQueueQuotaFns(of api.IsJobOverCapacityFn) {
 	ssn.IsNonPreemptibleJobOverQueueQuotaFns = append(ssn.IsNonPreemptibleJobOverQueueQuotaFns, of)
+	ssn.recordRegisteredFn(IsNonPreemptibleJobOverQueueQuotaFnName)
 }
 
 func (ssn *Session) AddIsJobOverCapacityFn(of api.IsJobOverCapacityFn) {
 	ssn.IsJobOverCapacityFns = append(ssn.IsJobOverCapacityFns, of)
+	ssn.recordRegisteredFn(IsJobOverCapacityFnName)
 }
 
 func (ssn *Session) AddIsTaskAllocationOnNodeOverCapacityFn(of api.IsTaskAllocationOverCapacityFn) {
 	ssn.IsTaskAllocationOnNodeOverCapacityFns = append(ssn.IsTaskAllocationOnNodeOverCapacityFns, of)
+	ssn.recordRegisteredFn(IsTaskAllocationOnNodeOverCapacityFnName)
 }
 
 func (ssn *Session) QueueFairShare(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
@@ -264,18 +343,44 @@ func (ssn *Session) SubGroupOrderFn(l, r interface{}) bool {
 	return lSubGroup.GetName() < rSubGroup.GetName()
 }
 
+// QueueOrderFn returns true if the left queue is ordered before the right one. The rationale of the comparison is
+// recorded for the queue order explanation endpoint.
 func (ssn *Session) QueueOrderFn(lQ, rQ *queue_info.QueueInfo, lJob, rJob *podgroup_info.PodGroupInfo, lVictims, rVictims []*podgroup_info.PodGroupInfo) bool {
-	for _, qof := range ssn.QueueOrderFns {
-		if j := qof(lQ, rQ, lJob, rJob, lVictims, rVictims); j != 0 {
+	j := compareQueuePriorityClass(lQ, rQ, lJob, rJob, lVictims, rVictims)
+	results := []QueueCompareFnResult{{Fn: queuePriorityClassCompareFn, Result: j}}
+	if j != 0 {
+		ssn.recordQueueComparison(lQ, rQ, lJob, rJob, results, queuePriorityClassCompareFn, j < 0)
+		return j < 0
+	}
+
+	for i, qof := range ssn.QueueOrderFns {
+		j = qof(lQ, rQ, lJob, rJob, lVictims, rVictims)
+		results = append(results, QueueCompareFnResult{Fn: pluginNameAt(ssn.queueOrderFnPlugins, i), Result: j})
+		if j != 0 {
+			ssn.recordQueueComparison(lQ, rQ, lJob, rJob, results, pluginNameAt(ssn.queueOrderFnPlugins, i), j < 0)
 			return j < 0
 		}
 	}
 
 	// If no queue order funcs, order queue by CreationTimestamp first, then by UID.
+	leftFirst := lQ.CreationTimestamp.Before(&rQ.CreationTimestamp)
 	if lQ.CreationTimestamp.Equal(&rQ.CreationTimestamp) {
-		return lQ.UID < rQ.UID
+		leftFirst = lQ.UID < rQ.UID
 	}
-	return lQ.CreationTimestamp.Before(&rQ.CreationTimestamp)
+	ssn.recordQueueComparison(lQ, rQ, lJob, rJob, results, queueCreationTimeCompareFn, leftFirst)
+	return leftFirst
+}
+
+// compareQueuePriorityClass is a built-in CompareQueueFn that is consulted before the plugins' queue order functions,
+// so a queue with a higher priority class always outranks queues with a lower one.
+func compareQueuePriorityClass(lQ, rQ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo, _, _ []*podgroup_info.PodGroupInfo) int {
+	if lQ.PriorityClass > rQ.PriorityClass {
+		return -1
+	}
+	if lQ.PriorityClass < rQ.PriorityClass {
+		return 1
+	}
+	return 0
 }
 
 func (ssn *Session) IsNonPreemptibleJobOverQueueQuotaFn(job *podgroup_info.PodGroupInfo,
@@ -295,6 +400,9 @@ func (ssn *Session) IsNonPreemptibleJobOverQueueQuotaFn(job *podgroup_info.PodGr
 
 func (ssn *Session) IsJobOverQueueCapacityFn(job *podgroup_info.PodGroupInfo,
 	tasksToAllocate []*pod_info.PodInfo) *api.SchedulableResult {
+	if result := ssn.isJobOverMaxRunningJobs(job); result != nil {
+		return result
+	}
 	for _, fn := range ssn.IsJobOverCapacityFns {
 		return fn(job, tasksToAllocate)
 	}
@@ -323,7 +431,10 @@ func (ssn *Session) IsTaskAllocationOnNodeOverCapacityFn(task *pod_info.PodInfo,
 }
 
 func (ssn *Session) SubsetNodesFn(podGroup *podgroup_info.PodGroupInfo, tasks []*pod_info.PodInfo, initNodeSet node_info.NodeSet) ([]node_info.NodeSet, error) {
-	nodeSets := []node_info.NodeSet{initNodeSet}
+	nodeSets, err := ssn.queueNodesSubset(podGroup, tasks, initNodeSet)
+	if err != nil {
+		return nil, err
+	}
 	for _, subsetNodesFn := range ssn.SubsetNodesFns {
 		log.InfraLogger.V(7).Infof(
 			"Running plugin func <%v> on podGroup <%s/%s>", subsetNodesFn, podGroup.Namespace, podGroup.Namespace)
@@ -356,6 +467,10 @@ func logNodeSetsPluginResult(subsetNodesFn api.SubsetNodesFn, podGroup *podgroup
 }
 
 func (ssn *Session) PrePredicateFn(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo) error {
+	if err := bindBackoffPrePredicate(task); err != nil {
+		log.InfraLogger.V(6).Infof("Task %s/%s is in bind backoff: %v", task.Namespace, task.Name, err)
+		return err
+	}
 	for _, prePredicate := range ssn.PrePredicateFns {
 		err := prePredicate(task, job)
 		if err != nil {
@@ -392,6 +507,16 @@ func (ssn *Session) GpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo,
 	return score, nil
 }
 
+// GpuFilterFn returns false if any of the registered GPU filters excludes the gpu for the task.
+func (ssn *Session) GpuFilterFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) bool {
+	for _, gff := range ssn.GpuFilterFns {
+		if !gff(task, node, gpuIdx) {
+			return false
+		}
+	}
+	return true
+}
+
 func (ssn *Session) NodePreOrderFn(task *pod_info.PodInfo, fittingNodes []*node_info.NodeInfo) {
 	for _, nodePreOrderFn := range ssn.NodePreOrderFns {
 		if err := nodePreOrderFn(task, fittingNodes); err != nil {
@@ -413,14 +538,45 @@ func (ssn *Session) NodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo
 	return priorityScore, nil
 }
 
+// gpuGroupsAnnotationValue returns the GPU groups sorted and without duplicates, so the value of a pod does not
+// change when it is bound again to the same GPU groups.
+func gpuGroupsAnnotationValue(gpuGroups []string) string {
+	sortedGroups := slices.Clone(gpuGroups)
+	slices.Sort(sortedGroups)
+	return strings.Join(slices.Compact(sortedGroups), ",")
+}
+
 func (ssn *Session) IsRestrictNodeSchedulingEnabled() bool {
 	return ssn.SchedulerParams.RestrictSchedulingNodes
 }
 
 func (ssn *Session) MutateBindRequestAnnotations(pod *pod_info.PodInfo, nodeName string) map[string]string {
 	annotations := map[string]string{}
+	if gpuGroups := gpuGroupsAnnotationValue(pod.GPUGroups); gpuGroups != "" {
+		annotations[commonconstants.GpuGroupsAnnotation] = gpuGroups
+	}
+	if len(pod.GpuMemorySplit) > 0 {
+		annotations[commonconstants.GpuMemorySplit] = resources.FormatGpuMemorySplit(pod.GpuMemorySplit)
+	}
+	if quantizedGpuMemory, found := ssn.quantizedGpuMemory(pod, nodeName); found {
+		annotations[commonconstants.QuantizedGpuMemory] = strconv.FormatInt(quantizedGpuMemory, 10)
+	}
+	if resources, found := rightsizedResources(pod); found {
+		annotations[commonconstants.RightsizedResources] = resources
+	}
 	for _, fn := range ssn.BindRequestMutateFns {
 		maps.Copy(annotations, fn(pod, nodeName))
 	}
 	return annotations
 }
+
+// quantizedGpuMemory returns the GPU memory, in MiB, that the pod takes from each of its GPUs on the node, if its GPU
+// memory request was rounded up to the GPU memory quantum of the node.
+func (ssn *Session) quantizedGpuMemory(pod *pod_info.PodInfo, nodeName string) (int64, bool) {
+	node, found := ssn.Nodes[nodeName]
+	if !found || pod.ResReq == nil || pod.ResReq.GpuMemory() == 0 {
+		return 0, false
+	}
+	gpuMemory := node.GetResourceGpuMemory(pod.ResReq)
+	return gpuMemory, gpuMemory != pod.ResReq.GpuMemory()
+}
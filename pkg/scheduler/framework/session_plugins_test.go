@@ -11,15 +11,23 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 )
 
 func TestMutateBindRequestAnnotations(t *testing.T) {
 	tests := []struct {
 		name                string
+		gpuGroups           []string
+		gpuMemorySplit      map[string]int64
+		gpuMemory           int64
+		gpuMemoryQuantum    *conf.GpuMemoryQuantum
 		mutateFns           []api.BindRequestMutateFn
 		expectedAnnotations map[string]string
 	}{
@@ -73,17 +81,77 @@ func TestMutateBindRequestAnnotations(t *testing.T) {
 			},
 			expectedAnnotations: map[string]string{"key1": "value1"},
 		},
+		{
+			name:      "gpu groups are sorted and deduplicated",
+			gpuGroups: []string{"group-b", "group-a", "group-b"},
+			mutateFns: []api.BindRequestMutateFn{},
+			expectedAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a,group-b",
+			},
+		},
+		{
+			name:      "gpu groups with mutate functions",
+			gpuGroups: []string{"group-a"},
+			mutateFns: []api.BindRequestMutateFn{
+				func(pod *pod_info.PodInfo, nodeName string) map[string]string {
+					return map[string]string{"key1": "value1"}
+				},
+			},
+			expectedAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a",
+				"key1":                              "value1",
+			},
+		},
+		{
+			name:           "gpu memory split",
+			gpuGroups:      []string{"group-a", "group-b"},
+			gpuMemorySplit: map[string]int64{"group-b": 400, "group-a": 600},
+			mutateFns:      []api.BindRequestMutateFn{},
+			expectedAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a,group-b",
+				commonconstants.GpuMemorySplit:      "group-a:600,group-b:400",
+			},
+		},
+		{
+			name:             "gpu memory rounded up to the quantum",
+			gpuGroups:        []string{"group-a"},
+			gpuMemory:        250,
+			gpuMemoryQuantum: &conf.GpuMemoryQuantum{MemoryMiB: 100},
+			mutateFns:        []api.BindRequestMutateFn{},
+			expectedAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a",
+				commonconstants.QuantizedGpuMemory:  "300",
+			},
+		},
+		{
+			name:             "gpu memory on the quantum",
+			gpuGroups:        []string{"group-a"},
+			gpuMemory:        300,
+			gpuMemoryQuantum: &conf.GpuMemoryQuantum{MemoryMiB: 100},
+			mutateFns:        []api.BindRequestMutateFn{},
+			expectedAnnotations: map[string]string{
+				commonconstants.GpuGroupsAnnotation: "group-a",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			nodeName := "test-node"
 			ssn := &Session{
 				BindRequestMutateFns: tt.mutateFns,
+				Nodes: map[string]*node_info.NodeInfo{
+					nodeName: {Name: nodeName, MemoryOfEveryGpuOnNode: 1000, GpuMemoryQuantum: tt.gpuMemoryQuantum},
+				},
 			}
 			pod := &pod_info.PodInfo{
-				Name: "test-pod",
+				Name:           "test-pod",
+				GPUGroups:      tt.gpuGroups,
+				GpuMemorySplit: tt.gpuMemorySplit,
+				ResReq: &resource_info.ResourceRequirements{
+					GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithGpus(0, tt.gpuMemory),
+				},
 			}
-			nodeName := "test-node"
 			annotations := ssn.MutateBindRequestAnnotations(pod, nodeName)
 			assert.Equal(t, tt.expectedAnnotations, annotations)
 		})
@@ -161,3 +229,54 @@ func TestPartitionMultiImplementation(t *testing.T) {
 	assert.Equal(t, partitions[3][0].Name, "cluster1rack1-1")
 	assert.Equal(t, partitions[3][1].Name, "cluster1rack1-2")
 }
+
+func TestQueueOrderFnPriorityClass(t *testing.T) {
+	preferRight := func(_, _ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo, _, _ []*podgroup_info.PodGroupInfo) int {
+		return 1
+	}
+
+	tests := []struct {
+		name               string
+		lPriorityClass     int
+		rPriorityClass     int
+		queueOrderFns      []CompareQueueFn
+		expectedLeftBefore bool
+	}{
+		{
+			name:               "higher priority class wins over plugin ordering",
+			lPriorityClass:     10,
+			rPriorityClass:     0,
+			queueOrderFns:      []CompareQueueFn{preferRight},
+			expectedLeftBefore: true,
+		},
+		{
+			name:               "lower priority class is ordered last",
+			lPriorityClass:     0,
+			rPriorityClass:     10,
+			expectedLeftBefore: false,
+		},
+		{
+			name:               "equal priority class falls through to plugin ordering",
+			lPriorityClass:     5,
+			rPriorityClass:     5,
+			queueOrderFns:      []CompareQueueFn{preferRight},
+			expectedLeftBefore: false,
+		},
+		{
+			name:               "equal priority class without plugins falls back to UID",
+			lPriorityClass:     5,
+			rPriorityClass:     5,
+			expectedLeftBefore: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{QueueOrderFns: tt.queueOrderFns}
+			lQueue := &queue_info.QueueInfo{UID: "a", PriorityClass: tt.lPriorityClass}
+			rQueue := &queue_info.QueueInfo{UID: "b", PriorityClass: tt.rPriorityClass}
+
+			assert.Equal(t, tt.expectedLeftBefore, ssn.QueueOrderFn(lQueue, rQueue, nil, nil, nil, nil))
+		})
+	}
+}
@@ -0,0 +1,553 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func TestCloseSession_RecordJobStatusRetries(t *testing.T) {
+	tests := []struct {
+		name               string
+		failuresPerJob     map[common_info.PodGroupID]int
+		expectedFailedJobs []common_info.PodGroupID
+	}{
+		{
+			name:               "all jobs recorded on first attempt",
+			failuresPerJob:     map[common_info.PodGroupID]int{"job-1": 0, "job-2": 0},
+			expectedFailedJobs: nil,
+		},
+		{
+			name:               "transient failure is retried",
+			failuresPerJob:     map[common_info.PodGroupID]int{"job-1": 2, "job-2": 0},
+			expectedFailedJobs: nil,
+		},
+		{
+			name:               "persistent failure is returned",
+			failuresPerJob:     map[common_info.PodGroupID]int{"job-1": 0, "job-2": 100},
+			expectedFailedJobs: []common_info.PodGroupID{"job-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			mockCache := cache.NewMockCache(controller)
+
+			podGroupInfos := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+			remainingFailures := map[common_info.PodGroupID]int{}
+			for jobID, failures := range tt.failuresPerJob {
+				podGroupInfos[jobID] = podgroup_info.NewPodGroupInfo(jobID)
+				remainingFailures[jobID] = failures
+			}
+
+			mockCache.EXPECT().RecordJobStatusEvent(gomock.Any()).AnyTimes().DoAndReturn(
+				func(job *podgroup_info.PodGroupInfo) error {
+					if remainingFailures[job.UID] > 0 {
+						remainingFailures[job.UID]--
+						return errors.New("apiserver unavailable")
+					}
+					return nil
+				})
+			mockCache.EXPECT().WaitForWorkers(gomock.Any()).Times(1)
+
+			ssn := &Session{UID: "1", Cache: mockCache, PodGroupInfos: podGroupInfos}
+			err := closeSession(ssn)
+
+			if len(tt.expectedFailedJobs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var recordErr *JobStatusRecordError
+			assert.True(t, errors.As(err, &recordErr))
+			assert.Len(t, recordErr.FailedJobs, len(tt.expectedFailedJobs))
+			for _, jobID := range tt.expectedFailedJobs {
+				assert.Contains(t, recordErr.FailedJobs, jobID)
+			}
+		})
+	}
+}
+
+func TestOrderedNodesByTask_DeterministicForAnyWorkerCount(t *testing.T) {
+	nodes := buildScoringNodes(100)
+	var expectedOrder []string
+	for _, workers := range []int{1, 2, 7, 100, 1000} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			ssn := newNodeScoringSession(workers)
+			orderedNodes := ssn.OrderedNodesByTask(nodes, &pod_info.PodInfo{Name: "task"})
+
+			var order []string
+			for _, node := range orderedNodes {
+				order = append(order, node.Name)
+			}
+			assert.Len(t, order, len(nodes))
+			if expectedOrder == nil {
+				expectedOrder = order
+				return
+			}
+			assert.Equal(t, expectedOrder, order)
+		})
+	}
+}
+
+func TestGetNodeScoringWorkers(t *testing.T) {
+	ssn := newNodeScoringSession(0)
+	assert.Positive(t, ssn.GetNodeScoringWorkers())
+
+	ssn = newNodeScoringSession(3)
+	assert.Equal(t, 3, ssn.GetNodeScoringWorkers())
+}
+
+func TestShuffleTopNodes(t *testing.T) {
+	tests := []struct {
+		name          string
+		epsilon       float64
+		draws         []float64
+		expectedOrder []string
+	}{
+		{
+			name:          "no epsilon keeps the score order",
+			epsilon:       0,
+			expectedOrder: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:          "low draws keep the score order",
+			epsilon:       1,
+			draws:         []float64{0, 0, 0},
+			expectedOrder: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:          "high draw selects the lowest top node first",
+			epsilon:       1,
+			draws:         []float64{0.9, 0, 0},
+			expectedOrder: []string{"c", "b", "a", "d"},
+		},
+		{
+			name:          "draws are weighted by score",
+			epsilon:       1,
+			draws:         []float64{0.5, 0.5, 0},
+			expectedOrder: []string{"b", "a", "c", "d"},
+		},
+		{
+			name:          "nodes outside epsilon are not shuffled",
+			epsilon:       0.4,
+			draws:         []float64{0.99},
+			expectedOrder: []string{"a", "b", "c", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draws := tt.draws
+			randFloat64 = func() float64 {
+				draw := draws[0]
+				draws = draws[1:]
+				return draw
+			}
+			defer func() { randFloat64 = rand.Float64 }()
+			nodeScores := map[float64][]*node_info.NodeInfo{
+				10:  {{Name: "a"}},
+				9.5: {{Name: "b"}},
+				9:   {{Name: "c"}},
+				5:   {{Name: "d"}},
+			}
+
+			var order []string
+			for _, node := range shuffleTopNodes(nodeScores, tt.epsilon) {
+				order = append(order, node.Name)
+			}
+
+			assert.Equal(t, tt.expectedOrder, order)
+		})
+	}
+}
+
+func TestOrderedNodesByTask_RandomizeTopNodes(t *testing.T) {
+	nodes := buildScoringNodes(30)
+	ssn := newNodeScoringSession(4)
+	ssn.SchedulerParams.RandomizeTopNodes = true
+	ssn.SchedulerParams.TopNodesScoreEpsilon = 0.5
+
+	firstNodes := map[string]bool{}
+	for range 50 {
+		orderedNodes := ssn.OrderedNodesByTask(nodes, &pod_info.PodInfo{Name: "task"})
+		assert.Len(t, orderedNodes, len(nodes))
+		assert.Equal(t, 1, len(orderedNodes[0].Name)%3, "first node must have the top score")
+		firstNodes[orderedNodes[0].Name] = true
+	}
+	assert.Greater(t, len(firstNodes), 1, "pods should spread between the top nodes")
+}
+
+func TestOrderedNodesByTask_NodeScoringBudget(t *testing.T) {
+	tests := []struct {
+		name           string
+		budget         time.Duration
+		sampleSize     int
+		expectedScored int
+	}{
+		{
+			name:           "disabled",
+			expectedScored: 10,
+		},
+		{
+			name:           "budget exceeded after the sample",
+			budget:         time.Millisecond,
+			sampleSize:     3,
+			expectedScored: 3,
+		},
+		{
+			name:           "sample larger than the nodes",
+			budget:         time.Millisecond,
+			sampleSize:     20,
+			expectedScored: 10,
+		},
+		{
+			name:           "budget not exceeded",
+			budget:         time.Hour,
+			sampleSize:     3,
+			expectedScored: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := buildScoringNodes(10)
+			ssn := &Session{SchedulerParams: conf.SchedulerParams{
+				NodeScoringWorkers:    1,
+				NodeScoringBudget:     tt.budget,
+				NodeScoringSampleSize: tt.sampleSize,
+			}}
+			var scoredNodes []string
+			ssn.NodeOrderFns = []api.NodeOrderFn{
+				func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+					time.Sleep(2 * time.Millisecond)
+					scoredNodes = append(scoredNodes, node.Name)
+					return float64(len(scoredNodes)), nil
+				},
+			}
+
+			orderedNodes := ssn.OrderedNodesByTask(nodes, &pod_info.PodInfo{Name: "task"})
+
+			assert.Len(t, orderedNodes, len(nodes))
+			assert.Len(t, scoredNodes, tt.expectedScored)
+			for i, node := range orderedNodes[:tt.expectedScored] {
+				assert.Equal(t, scoredNodes[tt.expectedScored-1-i], node.Name, "scored nodes must be first")
+			}
+		})
+	}
+}
+
+func TestOrderedNodesByTask_NodeScoringBudgetOrder(t *testing.T) {
+	shuffleNodes = slices.Reverse[[]*node_info.NodeInfo]
+	defer func() { shuffleNodes = defaultShuffleNodes }()
+
+	nodes := buildScoringNodes(5)
+	ssn := &Session{SchedulerParams: conf.SchedulerParams{
+		NodeScoringWorkers:    1,
+		NodeScoringBudget:     time.Millisecond,
+		NodeScoringSampleSize: 2,
+	}}
+	ssn.NodeOrderFns = []api.NodeOrderFn{
+		func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+			time.Sleep(2 * time.Millisecond)
+			return 0, nil
+		},
+	}
+
+	var order []string
+	for _, node := range ssn.OrderedNodesByTask(nodes, &pod_info.PodInfo{Name: "task"}) {
+		order = append(order, node.Name)
+	}
+	assert.Equal(t, []string{nodes[3].Name, nodes[4].Name, nodes[2].Name, nodes[1].Name, nodes[0].Name}, order,
+		"the sampled nodes must be scored first, and the other nodes kept in the sampling order")
+}
+
+func BenchmarkOrderedNodesByTask(b *testing.B) {
+	nodes := buildScoringNodes(5000)
+	task := &pod_info.PodInfo{Name: "task"}
+	for _, workers := range []int{0, 1, 5000} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			ssn := newNodeScoringSession(workers)
+			for b.Loop() {
+				ssn.OrderedNodesByTask(nodes, task)
+			}
+		})
+	}
+}
+
+func newNodeScoringSession(workers int) *Session {
+	ssn := &Session{SchedulerParams: conf.SchedulerParams{NodeScoringWorkers: workers}}
+	// Few distinct scores, so most nodes tie and are ordered by name
+	ssn.NodeOrderFns = []api.NodeOrderFn{
+		func(_ *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+			return float64(len(node.Name) % 3), nil
+		},
+	}
+	return ssn
+}
+
+func buildScoringNodes(count int) []*node_info.NodeInfo {
+	nodes := make([]*node_info.NodeInfo, 0, count)
+	for i := range count {
+		nodes = append(nodes, &node_info.NodeInfo{Name: fmt.Sprintf("node-%d", i)})
+	}
+	return nodes
+}
+
+func TestSortGPUs_GpuFilterFn(t *testing.T) {
+	ssn := &Session{}
+	ssn.AddGPUOrderFn(func(_ *pod_info.PodInfo, _ *node_info.NodeInfo, gpuIdx string) (float64, error) {
+		if gpuIdx == "group-a" {
+			return 10, nil
+		}
+		return 0, nil
+	})
+	gpus := []string{"group-a", "group-b", pod_info.WholeGpuIndicator}
+	task := pod_info.NewTaskInfo(common_info.BuildPod("ns", "p1", "", v1.PodPending,
+		common_info.BuildResourceList("1000m", "1G"), []metav1.OwnerReference{}, nil, nil))
+	node := &node_info.NodeInfo{Name: "n1"}
+
+	assert.Equal(t, []string{"group-a", "group-b", pod_info.WholeGpuIndicator}, ssn.sortGPUs(gpus, task, node))
+
+	ssn.AddGpuFilterFn(func(_ *pod_info.PodInfo, _ *node_info.NodeInfo, gpuIdx string) bool {
+		return gpuIdx != "group-a"
+	})
+	assert.Equal(t, []string{"group-b", pod_info.WholeGpuIndicator}, ssn.sortGPUs(gpus, task, node))
+}
+
+func TestFilterGpusByEnoughResources_InitGpuMemory(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedGPUs []string
+	}{
+		{
+			name:         "GPU fraction fits",
+			annotations:  map[string]string{common_info.GPUFraction: "0.3"},
+			expectedGPUs: []string{"group-a"},
+		},
+		{
+			name: "init needs more GPU memory than the free memory of the shared GPU",
+			annotations: map[string]string{
+				common_info.GPUFraction:       "0.3",
+				commonconstants.InitGpuMemory: "5000",
+			},
+			expectedGPUs: []string{},
+		},
+		{
+			name: "init needs more GPU memory than main and it fits",
+			annotations: map[string]string{
+				pod_info.GpuMemoryAnnotationName: "2000",
+				commonconstants.InitGpuMemory:    "4000",
+			},
+			expectedGPUs: []string{"group-a"},
+		},
+		{
+			name: "GPU memory request sized to the init GPU memory",
+			annotations: map[string]string{
+				pod_info.GpuMemoryAnnotationName: "2000",
+				commonconstants.InitGpuMemory:    "5000",
+			},
+			expectedGPUs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &node_info.NodeInfo{
+				Name:                   "n1",
+				MemoryOfEveryGpuOnNode: 10000,
+				GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+					UsedSharedGPUsMemory:      map[string]int64{"group-a": 6000},
+					AllocatedSharedGPUsMemory: map[string]int64{"group-a": 6000},
+					ReleasingSharedGPUsMemory: map[string]int64{},
+				},
+				Idle:      resource_info.EmptyResource(),
+				Releasing: resource_info.EmptyResource(),
+			}
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending, common_info.BuildResourceList("1000m", "1G"),
+				[]metav1.OwnerReference{}, nil, tt.annotations)
+
+			assert.Equal(t, tt.expectedGPUs, filterGpusByEnoughResources(node, pod_info.NewTaskInfo(pod)))
+		})
+	}
+}
+
+func TestFilterGpusByEnoughResources_ReservedGpuMemory(t *testing.T) {
+	tests := []struct {
+		name              string
+		reservedGpuMemory int64
+		expectedGPUs      []string
+	}{
+		{
+			name:         "no reserved memory",
+			expectedGPUs: []string{"group-a", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "reserved memory leaves room for the pod",
+			reservedGpuMemory: 500,
+			expectedGPUs:      []string{"group-a", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "reserved memory excludes the shared gpu",
+			reservedGpuMemory: 1000,
+			expectedGPUs:      []string{pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "reserved memory excludes shared and whole gpus",
+			reservedGpuMemory: 2000,
+			expectedGPUs:      []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &node_info.NodeInfo{
+				Name:                   "n1",
+				MemoryOfEveryGpuOnNode: 10000,
+				ReservedGpuMemory:      tt.reservedGpuMemory,
+				GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+					UsedSharedGPUsMemory:      map[string]int64{"group-a": 1000},
+					AllocatedSharedGPUsMemory: map[string]int64{"group-a": 1000},
+					ReleasingSharedGPUsMemory: map[string]int64{},
+				},
+				Idle:      resource_info.ResourceFromResourceList(common_info.BuildResourceListWithGPU("0", "0", "1")),
+				Releasing: resource_info.EmptyResource(),
+			}
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending, common_info.BuildResourceList("1000m", "1G"),
+				[]metav1.OwnerReference{}, nil, map[string]string{pod_info.GpuMemoryAnnotationName: "8500"})
+
+			assert.Equal(t, tt.expectedGPUs, filterGpusByEnoughResources(node, pod_info.NewTaskInfo(pod)))
+		})
+	}
+}
+
+func TestFilterGpusByEnoughResources_UnhealthyGpus(t *testing.T) {
+	tests := []struct {
+		name          string
+		unhealthyGpus string
+		expectedGPUs  []string
+	}{
+		{
+			name:         "all gpus healthy",
+			expectedGPUs: []string{"group-a", "group-b", pod_info.WholeGpuIndicator, pod_info.WholeGpuIndicator},
+		},
+		{
+			name:          "unhealthy gpu of a shared group",
+			unhealthyGpus: "1",
+			expectedGPUs:  []string{"group-a", pod_info.WholeGpuIndicator, pod_info.WholeGpuIndicator},
+		},
+		{
+			name:          "unhealthy idle gpu",
+			unhealthyGpus: "3",
+			expectedGPUs:  []string{"group-a", "group-b", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:          "all gpus unhealthy",
+			unhealthyGpus: "0,1,2,3",
+			expectedGPUs:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "10G", "4"))
+			node.Annotations = map[string]string{commonconstants.UnhealthyGpus: tt.unhealthyGpus}
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(gomock.NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).AnyTimes()
+			nodeInfo := node_info.NewNodeInfo(node, nodePodAffinityInfo)
+			nodeInfo.MemoryOfEveryGpuOnNode = 10000
+			for gpuGroup, gpuIndex := range map[string]string{"group-a": "0", "group-b": "1"} {
+				reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-"+gpuGroup, "n1",
+					v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+					map[string]string{
+						commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+						commonconstants.GPUGroup:     gpuGroup,
+					},
+					map[string]string{commonconstants.ReservedGpuIndex: gpuIndex})
+				assert.NoError(t, nodeInfo.AddTask(pod_info.NewTaskInfo(reservationPod)))
+				tenant := pod_info.NewTaskInfo(common_info.BuildPod("ns", "tenant-"+gpuGroup, "n1", v1.PodRunning,
+					common_info.BuildResourceList("1000m", "1G"), []metav1.OwnerReference{}, nil,
+					map[string]string{
+						pod_info.GpuMemoryAnnotationName:     "2000",
+						commonconstants.ReceivedResourceType: string(pod_info.ReceivedTypeFraction),
+					}))
+				tenant.GPUGroups = []string{gpuGroup}
+				assert.NoError(t, nodeInfo.AddTask(tenant))
+			}
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending, common_info.BuildResourceList("1000m", "1G"),
+				[]metav1.OwnerReference{}, nil, map[string]string{common_info.GPUFraction: "0.3"})
+
+			gpus := filterGpusByEnoughResources(nodeInfo, pod_info.NewTaskInfo(pod))
+			slices.Sort(gpus)
+			expectedGPUs := slices.Clone(tt.expectedGPUs)
+			slices.Sort(expectedGPUs)
+			assert.Equal(t, expectedGPUs, gpus)
+		})
+	}
+}
+
+func TestFilterGpusByEnoughResources_MaxGpuSharingTenants(t *testing.T) {
+	tests := []struct {
+		name                 string
+		maxGpuSharingTenants int
+		expectedGPUs         []string
+	}{
+		{
+			name:         "tenants are not limited",
+			expectedGPUs: []string{"group-a", "group-b"},
+		},
+		{
+			name:                 "gpu at the tenant limit is excluded even if the memory fits",
+			maxGpuSharingTenants: 2,
+			expectedGPUs:         []string{"group-b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &node_info.NodeInfo{
+				Name:                   "n1",
+				MemoryOfEveryGpuOnNode: 10000,
+				MaxGpuSharingTenants:   tt.maxGpuSharingTenants,
+				GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+					UsedSharedGPUsMemory:       map[string]int64{"group-a": 2000, "group-b": 6000},
+					AllocatedSharedGPUsMemory:  map[string]int64{"group-a": 2000, "group-b": 6000},
+					ReleasingSharedGPUsMemory:  map[string]int64{},
+					AllocatedSharedGPUsTenants: map[string]int{"group-a": 2, "group-b": 1},
+					ReleasingSharedGPUsTenants: map[string]int{},
+				},
+				Idle:      resource_info.EmptyResource(),
+				Releasing: resource_info.EmptyResource(),
+			}
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending, common_info.BuildResourceList("1000m", "1G"),
+				[]metav1.OwnerReference{}, nil, map[string]string{common_info.GPUFraction: "0.3"})
+
+			gpus := filterGpusByEnoughResources(node, pod_info.NewTaskInfo(pod))
+			slices.Sort(gpus)
+			assert.Equal(t, tt.expectedGPUs, gpus)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
+)
+
+// TopologyDomainForNode returns the key of the topology domain that the node belongs to at the level, given by the
+// node label of the level, and whether the node is part of a loaded topology with such a level. The key joins the
+// node's label values of the level and of all the levels above it with dots, so equally named domains under different
+// parent domains have different keys.
+func (ssn *Session) TopologyDomainForNode(node *node_info.NodeInfo, level string) (string, bool) {
+	for _, topology := range ssn.Topologies {
+		levelIndex := topologyLevelIndex(topology, level)
+		if levelIndex < 0 || !isNodeInTopology(node, topology) {
+			continue
+		}
+		return topology_info.CalcDomainId(levelIndex, topology.Spec.Levels, node.Node.Labels), true
+	}
+	return "", false
+}
+
+// TopologyDomainsForTask returns the keys of the topology domains of the node that the task is placed on, one per
+// level from the top level down. The topology of the task's job constraint is used, or else the first loaded topology
+// that the node is part of. It returns nil for tasks that are not placed on a node of any topology.
+func (ssn *Session) TopologyDomainsForTask(task *pod_info.PodInfo) []string {
+	node, found := ssn.Nodes[task.NodeName]
+	if !found {
+		return nil
+	}
+
+	topologyName := ""
+	if job, found := ssn.PodGroupInfos[task.Job]; found && job.TopologyConstraint != nil {
+		topologyName = job.TopologyConstraint.Topology
+	}
+	for _, topology := range ssn.Topologies {
+		if topologyName != "" && topology.Name != topologyName {
+			continue
+		}
+		if !isNodeInTopology(node, topology) {
+			continue
+		}
+		domains := make([]string, 0, len(topology.Spec.Levels))
+		for levelIndex := range topology.Spec.Levels {
+			domains = append(domains, topology_info.CalcDomainId(levelIndex, topology.Spec.Levels, node.Node.Labels))
+		}
+		return domains
+	}
+	return nil
+}
+
+func topologyLevelIndex(topology *kueuev1alpha1.Topology, level string) int {
+	for levelIndex, topologyLevel := range topology.Spec.Levels {
+		if topologyLevel.NodeLabel == level {
+			return levelIndex
+		}
+	}
+	return -1
+}
+
+// isNodeInTopology returns whether the node has a label for every level of the topology.
+func isNodeInTopology(node *node_info.NodeInfo, topology *kueuev1alpha1.Topology) bool {
+	if node.Node == nil {
+		return false
+	}
+	for _, level := range topology.Spec.Levels {
+		if _, found := node.Node.Labels[level.NodeLabel]; !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
+)
+
+func newTopology(name string, levels ...string) *kueuev1alpha1.Topology {
+	topology := &kueuev1alpha1.Topology{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, level := range levels {
+		topology.Spec.Levels = append(topology.Spec.Levels, kueuev1alpha1.TopologyLevel{NodeLabel: level})
+	}
+	return topology
+}
+
+func newTopologySession() *Session {
+	nodes := map[string]*node_info.NodeInfo{}
+	for name, labels := range map[string]map[string]string{
+		"node-1": {"zone": "zone-a", "rack": "rack-1", "gpu-block": "block-x"},
+		"node-2": {"zone": "zone-b", "rack": "rack-1"},
+		"node-3": {"zone": "zone-a"},
+	} {
+		nodes[name] = node_info.NewNodeInfo(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}, nil)
+	}
+	return &Session{
+		Nodes: nodes,
+		Topologies: []*kueuev1alpha1.Topology{
+			newTopology("datacenter", "zone", "rack"),
+			newTopology("nvlink", "gpu-block"),
+		},
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			"unconstrained": {UID: "unconstrained"},
+			"nvlink-job": {
+				UID:                "nvlink-job",
+				TopologyConstraint: &topology_info.TopologyConstraintInfo{Topology: "nvlink"},
+			},
+			"missing-topology-job": {
+				UID:                "missing-topology-job",
+				TopologyConstraint: &topology_info.TopologyConstraintInfo{Topology: "missing"},
+			},
+		},
+	}
+}
+
+func TestTopologyDomainForNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		node           string
+		level          string
+		expectedDomain string
+		expectedFound  bool
+	}{
+		{
+			name:           "top level",
+			node:           "node-1",
+			level:          "zone",
+			expectedDomain: "zone-a",
+			expectedFound:  true,
+		},
+		{
+			name:           "lower level is keyed by its parent domains",
+			node:           "node-2",
+			level:          "rack",
+			expectedDomain: "zone-b.rack-1",
+			expectedFound:  true,
+		},
+		{
+			name:           "level of another topology",
+			node:           "node-1",
+			level:          "gpu-block",
+			expectedDomain: "block-x",
+			expectedFound:  true,
+		},
+		{
+			name:  "node missing a level of the topology",
+			node:  "node-3",
+			level: "zone",
+		},
+		{
+			name:  "unknown level",
+			node:  "node-1",
+			level: "row",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := newTopologySession()
+			domain, found := ssn.TopologyDomainForNode(ssn.Nodes[tt.node], tt.level)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedDomain, domain)
+		})
+	}
+}
+
+func TestTopologyDomainsForTask(t *testing.T) {
+	tests := []struct {
+		name            string
+		job             common_info.PodGroupID
+		node            string
+		expectedDomains []string
+	}{
+		{
+			name:            "first topology of the node",
+			job:             "unconstrained",
+			node:            "node-1",
+			expectedDomains: []string{"zone-a", "zone-a.rack-1"},
+		},
+		{
+			name:            "topology of the job constraint",
+			job:             "nvlink-job",
+			node:            "node-1",
+			expectedDomains: []string{"block-x"},
+		},
+		{
+			name: "node outside the topology of the job constraint",
+			job:  "nvlink-job",
+			node: "node-2",
+		},
+		{
+			name: "missing topology of the job constraint",
+			job:  "missing-topology-job",
+			node: "node-1",
+		},
+		{
+			name: "node outside all topologies",
+			job:  "unconstrained",
+			node: "node-3",
+		},
+		{
+			name: "task not placed on a node",
+			job:  "unconstrained",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := newTopologySession()
+			task := &pod_info.PodInfo{Name: "task", Job: tt.job, NodeName: tt.node}
+			assert.Equal(t, tt.expectedDomains, ssn.TopologyDomainsForTask(task))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// ErrStaleSnapshot is returned when opening a session on a cache snapshot older than the configured threshold.
+var ErrStaleSnapshot = errors.New("cache snapshot is stale")
+
+// staleSnapshotBreaker pauses scheduling while the cache snapshots are stale, and resumes it as soon as a fresh
+// snapshot is taken.
+type staleSnapshotBreaker struct {
+	open bool
+}
+
+var snapshotBreaker = &staleSnapshotBreaker{}
+
+// check returns ErrStaleSnapshot if the cache informers have not resynced for longer than maxStaleness.
+// A non-positive maxStaleness, or a snapshot without a known update time, disables the check.
+func (b *staleSnapshotBreaker) check(snapshot *api.ClusterInfo, maxStaleness time.Duration, now time.Time) error {
+	if maxStaleness <= 0 || snapshot.LastCacheUpdate.IsZero() {
+		b.close()
+		return nil
+	}
+
+	staleness := now.Sub(snapshot.LastCacheUpdate)
+	metrics.UpdateSnapshotStaleness(staleness)
+	if staleness <= maxStaleness {
+		b.close()
+		return nil
+	}
+
+	if !b.open {
+		log.InfraLogger.Errorf("The cache informers have not resynced for %v, over the %v threshold. "+
+			"Pausing scheduling until the cache is fresh", staleness, maxStaleness)
+		b.open = true
+	}
+	metrics.IncStaleSnapshotSkippedCycles()
+	return fmt.Errorf("%w: no informer resync for %v, over the %v threshold", ErrStaleSnapshot, staleness,
+		maxStaleness)
+}
+
+func (b *staleSnapshotBreaker) close() {
+	if b.open {
+		log.InfraLogger.V(1).Infof("The cache is fresh again, resuming scheduling")
+		b.open = false
+	}
+}
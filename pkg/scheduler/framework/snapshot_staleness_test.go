@@ -0,0 +1,73 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+)
+
+func TestStaleSnapshotBreaker_Check(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		maxStaleness    time.Duration
+		lastCacheUpdate time.Time
+		wasOpen         bool
+		expectStale     bool
+	}{
+		{
+			name:            "check disabled",
+			lastCacheUpdate: now.Add(-time.Hour),
+		},
+		{
+			name:         "unknown cache update time",
+			maxStaleness: time.Minute,
+		},
+		{
+			name:            "fresh snapshot",
+			maxStaleness:    time.Minute,
+			lastCacheUpdate: now.Add(-30 * time.Second),
+		},
+		{
+			name:            "stale snapshot",
+			maxStaleness:    time.Minute,
+			lastCacheUpdate: now.Add(-2 * time.Minute),
+			expectStale:     true,
+		},
+		{
+			name:            "still stale snapshot",
+			maxStaleness:    time.Minute,
+			lastCacheUpdate: now.Add(-2 * time.Minute),
+			wasOpen:         true,
+			expectStale:     true,
+		},
+		{
+			name:            "recovered snapshot",
+			maxStaleness:    time.Minute,
+			lastCacheUpdate: now.Add(-time.Second),
+			wasOpen:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breaker := &staleSnapshotBreaker{open: tt.wasOpen}
+			snapshot := &api.ClusterInfo{LastCacheUpdate: tt.lastCacheUpdate}
+
+			err := breaker.check(snapshot, tt.maxStaleness, now)
+			if tt.expectStale {
+				assert.ErrorIs(t, err, ErrStaleSnapshot)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectStale, breaker.open)
+		})
+	}
+}
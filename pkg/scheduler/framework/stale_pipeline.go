@@ -0,0 +1,59 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// releaseStalePipelines releases the pipelines of the pending pods of the snapshot that did not progress to
+// allocation within the stale pipeline cycles, so the pods are re-evaluated instead of holding their reservation on
+// the node for as long as their victims do not release.
+func (ssn *Session) releaseStalePipelines() {
+	for _, job := range ssn.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Pending || !ssn.IsStalePipeline(task, task.PipelinedNodeName) {
+				continue
+			}
+			metrics.IncStalePipelineReleases()
+			log.InfraLogger.V(3).Infof("Released the pipeline of task <%s/%s> to node <%s>, which did not "+
+				"progress to allocation in %d cycles", task.Namespace, task.Name, task.PipelinedNodeName,
+				task.PipelineAge)
+		}
+	}
+}
+
+// IsStalePipeline returns true if the task was pipelined to the node for the stale pipeline cycles without being
+// allocated, in which case it is not pipelined to the node again in this session.
+func (ssn *Session) IsStalePipeline(task *pod_info.PodInfo, nodeName string) bool {
+	staleCycles := ssn.SchedulerParams.StalePipelineCycles
+	return staleCycles > 0 && task.PipelineAge >= staleCycles && task.PipelinedNodeName == nodeName
+}
+
+// recordPipelineAges tracks the pods pipelined in the session for the next sessions, on the scheduler cache. A pod
+// pipelined to another node than in the last cycle starts its age over. Released stale pipelines are not tracked, so
+// the pods are re-evaluated from scratch by the next sessions and may be pipelined to the same node again.
+func (ssn *Session) recordPipelineAges() {
+	if ssn.Cache == nil {
+		return
+	}
+	ages := map[common_info.PodID]pod_info.PipelineAge{}
+	for _, job := range ssn.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Pipelined {
+				continue
+			}
+			cycles := 1
+			if task.PipelinedNodeName == task.NodeName {
+				cycles = task.PipelineAge + 1
+			}
+			ages[task.UID] = pod_info.PipelineAge{NodeName: task.NodeName, Cycles: cycles}
+		}
+	}
+	ssn.Cache.PipelineAges().Set(ages)
+}
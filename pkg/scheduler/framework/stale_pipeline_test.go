@@ -0,0 +1,81 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestStalePipelines(t *testing.T) {
+	jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "stale_job",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+		{
+			Name:                "fresh_job",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		},
+	})
+	staleTask := jobsInfoMap["stale_job"].GetAllPodsMap()["stale_job-0"]
+	freshTask := jobsInfoMap["fresh_job"].GetAllPodsMap()["fresh_job-0"]
+	staleTask.PipelineAge, staleTask.PipelinedNodeName = 3, "node0"
+	freshTask.PipelineAge, freshTask.PipelinedNodeName = 1, "node0"
+
+	pipelineAges := pod_info.NewPipelineAges()
+	mockCache := cache.NewMockCache(gomock.NewController(t))
+	mockCache.EXPECT().PipelineAges().AnyTimes().Return(pipelineAges)
+	ssn := &Session{
+		Cache:           mockCache,
+		PodGroupInfos:   jobsInfoMap,
+		SchedulerParams: conf.SchedulerParams{StalePipelineCycles: 3},
+	}
+	ssn.releaseStalePipelines()
+
+	assert.True(t, ssn.IsStalePipeline(staleTask, "node0"))
+	assert.False(t, ssn.IsStalePipeline(staleTask, "node1"))
+	assert.False(t, ssn.IsStalePipeline(freshTask, "node0"))
+
+	ssn.SchedulerParams.StalePipelineCycles = 0
+	assert.False(t, ssn.IsStalePipeline(staleTask, "node0"))
+	ssn.SchedulerParams.StalePipelineCycles = 3
+
+	freshTask.NodeName = "node0"
+	assert.NoError(t, jobsInfoMap["fresh_job"].UpdateTaskStatus(freshTask, pod_status.Pipelined))
+	ssn.recordPipelineAges()
+
+	_, found := pipelineAges.Get(staleTask.UID)
+	assert.False(t, found, "a released pipeline is not tracked, so the pod is re-evaluated from scratch")
+	age, found := pipelineAges.Get(freshTask.UID)
+	assert.True(t, found)
+	assert.Equal(t, pod_info.PipelineAge{NodeName: "node0", Cycles: 2}, age)
+
+	// The next session snapshots the stale task without a pipeline age, and pipelines it to the same node again
+	staleTask.PipelineAge, staleTask.PipelinedNodeName = 0, ""
+	assert.False(t, ssn.IsStalePipeline(staleTask, "node0"))
+	staleTask.NodeName = "node0"
+	assert.NoError(t, jobsInfoMap["stale_job"].UpdateTaskStatus(staleTask, pod_status.Pipelined))
+	ssn.recordPipelineAges()
+
+	age, found = pipelineAges.Get(staleTask.UID)
+	assert.True(t, found)
+	assert.Equal(t, pod_info.PipelineAge{NodeName: "node0", Cycles: 1}, age)
+}
@@ -20,11 +20,15 @@ limitations under the License.
 package framework
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/types"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -33,22 +37,76 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
 
+// ErrCommitVetoed is returned from committing a statement whose operations were rejected by a commit validator.
+// The operations are discarded, leaving the session as it was before the statement.
+var ErrCommitVetoed = errors.New("statement commit vetoed")
+
 type Statement struct {
 	operations []Operation
 	ssn        *Session
 	sessionUID types.UID
+	// checkpoints holds the checkpoints that can still be rolled back to, oldest first
+	checkpoints []Checkpoint
 }
 
 type Checkpoint int
 
+// Checkpoint marks the current state of the statement to roll back to. Checkpoints nest: rolling back to a checkpoint
+// keeps it valid and invalidates the checkpoints taken after it, so an action can try a placement, backtrack and try
+// another one without discarding the whole statement.
 func (s *Statement) Checkpoint() Checkpoint {
-	return Checkpoint(len(s.operations))
+	cp := Checkpoint(len(s.operations))
+	if len(s.checkpoints) == 0 || s.checkpoints[len(s.checkpoints)-1] != cp {
+		s.checkpoints = append(s.checkpoints, cp)
+	}
+	return cp
+}
+
+// NodesChangedSince returns the names of the nodes that the operations of the statement since the checkpoint placed
+// tasks on, evicted tasks from or shrank tasks on, in the order of the operations.
+func (s *Statement) NodesChangedSince(cp Checkpoint) []string {
+	var nodeNames []string
+	seen := map[string]bool{}
+	for i := max(int(cp), 0); i < len(s.operations); i++ {
+		for _, nodeName := range s.operationNodes(s.operations[i]) {
+			if nodeName != "" && !seen[nodeName] {
+				seen[nodeName] = true
+				nodeNames = append(nodeNames, nodeName)
+			}
+		}
+	}
+	return nodeNames
+}
+
+func (s *Statement) operationNodes(op Operation) []string {
+	switch op := op.(type) {
+	case allocateOperation:
+		return []string{op.nextNode}
+	case pipelineOperation:
+		return []string{op.previousNode, op.nextNode}
+	case evictOperation:
+		if op.previousNode == nil {
+			return nil
+		}
+		return []string{op.previousNode.Name}
+	case shrinkOperation:
+		return []string{op.taskInfo.NodeName}
+	case undoOperation:
+		if op.operationIndex < 0 || op.operationIndex >= len(s.operations) {
+			return nil
+		}
+		return s.operationNodes(s.operations[op.operationIndex])
+	}
+	return nil
 }
 
 func (s *Statement) Rollback(cp Checkpoint) error {
 	if cp < 0 || int(cp) > len(s.operations) {
 		return fmt.Errorf("invalid checkpoint %d, statement has %d operations", cp, len(s.operations))
 	}
+	if cp != 0 && !slices.Contains(s.checkpoints, cp) {
+		return fmt.Errorf("invalid checkpoint %d, it was taken after the checkpoint the statement was rolled back to", cp)
+	}
 
 	for i := len(s.operations) - 1; i >= int(cp); i-- {
 		if err := s.undoOperation(i); err != nil {
@@ -57,6 +115,9 @@ func (s *Statement) Rollback(cp Checkpoint) error {
 	}
 
 	s.operations = s.operations[:cp]
+	for len(s.checkpoints) > 0 && s.checkpoints[len(s.checkpoints)-1] > cp {
+		s.checkpoints = s.checkpoints[:len(s.checkpoints)-1]
+	}
 	return nil
 }
 
@@ -104,6 +165,7 @@ func (s *Statement) Evict(reclaimeeTask *pod_info.PodInfo, message string,
 			previousStatus:    previousStatus,
 			previousNode:      node,
 			previousGpuGroups: previousGpuGroup,
+			unsharedGpuGroups: unsharedGpuGroups(node, reclaimeeTask, previousGpuGroup),
 			message:           message,
 			evictionMetadata:  evictionMetadata,
 			reverseOperation: func() error {
@@ -129,7 +191,9 @@ func (s *Statement) commitEvict(reclaimee *pod_info.PodInfo, evictOp evictOperat
 	previousStatus := reclaimee.Status
 	previousGpuGroup := reclaimee.GPUGroups
 	previousIsVirtualStatus := reclaimee.IsVirtualStatus
-	if err := s.ssn.Cache.Evict(reclaimee.Pod, reclaimeePodGroup, evictOp.evictionMetadata, evictOp.message); err != nil {
+	s.ssn.logCrossPartitionEviction(reclaimee, reclaimeePodGroup, evictOp.message)
+	evictionMetadata := s.ssn.preEvict(reclaimee, evictOp.evictionMetadata)
+	if err := s.ssn.Cache.Evict(reclaimee.Pod, reclaimeePodGroup, evictionMetadata, evictOp.message); err != nil {
 		log.InfraLogger.Errorf("Failed to evict task <%v/%v>: %v.", reclaimee.Namespace, reclaimee.Name, err)
 		if e := s.unevict(reclaimee, previousStatus, evictOp.previousNode, previousGpuGroup,
 			previousIsVirtualStatus); e != nil {
@@ -139,6 +203,10 @@ func (s *Statement) commitEvict(reclaimee *pod_info.PodInfo, evictOp evictOperat
 		return err
 	}
 	reclaimee.IsVirtualStatus = false
+	s.ssn.emitEvictionEvent(reclaimee, evictOp.previousNode.Name, evictionMetadata.Action, evictOp.message)
+	// Virtually evicted tasks may be allocated again during simulations, so the job's cached tasks to allocate are
+	// stale once the eviction is real.
+	reclaimeePodGroup.InvalidateTasksCache()
 
 	return nil
 }
@@ -282,7 +350,37 @@ func (s *Statement) Pipeline(task *pod_info.PodInfo, hostname string, updateTask
 
 func (s *Statement) Allocate(task *pod_info.PodInfo, hostname string) error {
 	node := s.ssn.Nodes[hostname]
+	var newlySharedGroups []string
+	if node != nil {
+		newlySharedGroups = newlySharedGpuGroups(node, task)
+	}
+	if err := s.allocateInSession(task, hostname); err != nil {
+		return err
+	}
 
+	// Update status in session
+	previousIsVirtualStatus := task.IsVirtualStatus
+	s.operations = append(s.operations,
+		allocateOperation{
+			taskInfo:          task.Clone(),
+			nextNode:          node.Name,
+			newlySharedGroups: newlySharedGroups,
+			reverseOperation: func() error {
+				return s.unallocate(task, node.Name, previousIsVirtualStatus)
+			},
+		},
+	)
+	task.IsVirtualStatus = true
+
+	log.InfraLogger.V(6).Infof(
+		"Statement allocated task: <%v/%v> to node: <%v>",
+		task.Namespace, task.Name, hostname)
+
+	return nil
+}
+
+// allocateInSession allocates the task to the node in the session's job and node, and notifies the event handlers.
+func (s *Statement) allocateInSession(task *pod_info.PodInfo, hostname string) error {
 	// Only update status in session
 	job, found := s.ssn.PodGroupInfos[task.Job]
 	if found {
@@ -322,56 +420,75 @@ func (s *Statement) Allocate(task *pod_info.PodInfo, hostname string) error {
 			})
 		}
 	}
-
-	// Update status in session
-	previousIsVirtualStatus := task.IsVirtualStatus
-	s.operations = append(s.operations,
-		allocateOperation{
-			taskInfo: task.Clone(),
-			nextNode: node.Name,
-			reverseOperation: func() error {
-				return s.unallocate(task, node.Name, previousIsVirtualStatus)
-			},
-		},
-	)
-	task.IsVirtualStatus = true
-
-	log.InfraLogger.V(6).Infof(
-		"Statement allocated task: <%v/%v> to node: <%v>",
-		task.Namespace, task.Name, hostname)
-
 	return nil
 }
 
-func (s *Statement) commitAllocate(task *pod_info.PodInfo) error {
-	hostname := task.NodeName
-	node, found := s.ssn.Nodes[hostname]
-	if !found {
-		log.InfraLogger.Errorf("Failed to find node: %v", hostname)
-		return fmt.Errorf("node doesn't exist on cluster")
+// commitAllocations binds the tasks of the allocate operations in one BindPodBatch, and then binds the tasks whose
+// nodes changed since the snapshot to fallback nodes. The tasks that fail to bind are left unallocated, and the error
+// of the first of them in operation order is returned. The batch is committed as a whole, so if any task fails to bind,
+// the tasks that were bound with it are evicted and unallocated too, and a gang is not left partially bound.
+func (s *Statement) commitAllocations(ops []allocateOperation, usage *allocationUsageRecorder) error {
+	tasks := make([]*pod_info.PodInfo, 0, len(ops))
+	for _, op := range ops {
+		task := op.TaskInfo()
+		log.InfraLogger.V(4).Infof("Allocating task: %v/%v", task.Namespace, task.Name)
+		node, found := s.ssn.Nodes[task.NodeName]
+		if !found {
+			log.InfraLogger.Errorf("Failed to find node: %v", task.NodeName)
+			for _, batchOp := range ops {
+				s.cleanupFailedAllocation(batchOp.TaskInfo())
+			}
+			return fmt.Errorf("failed to allocate task <%v/%v>: node <%v> doesn't exist on cluster",
+				task.Namespace, task.Name, task.NodeName)
+		}
+
+		if task.IsFractionAllocation() {
+			for _, gpuGroup := range task.GPUGroups {
+				if _, found := node.UsedSharedGPUsMemory[gpuGroup]; !found {
+					node.UsedSharedGPUsMemory[gpuGroup] = 0
+				}
+			}
+		}
+		tasks = append(tasks, task)
 	}
 
-	var err error
-	defer func() {
-		if err != nil {
-			s.cleanupFailedAllocation(task, node)
+	bindErrors := s.ssn.BindPodBatch(tasks)
+	var conflicts []nodeConflict
+	for i, task := range tasks {
+		err := bindErrors[i]
+		if err == nil {
+			continue
+		}
+		node := s.ssn.Nodes[task.NodeName]
+		if isNodeConflictBindError(err) {
+			conflicts = append(conflicts, nodeConflict{index: i, task: task, node: node, err: err})
+			continue
 		}
-	}()
+		log.InfraLogger.Errorf("Failed to bind task <%v/%v>. Error: %v", task.Namespace, task.Name, err)
+		s.cleanupFailedAllocation(task)
+	}
+	for _, conflict := range s.bindOnFallbackNodes(conflicts) {
+		bindErrors[conflict.index] = conflict.err
+	}
 
-	if task.IsFractionAllocation() {
-		for _, gpuGroup := range task.GPUGroups {
-			if _, found := node.UsedSharedGPUsMemory[gpuGroup]; !found {
-				node.UsedSharedGPUsMemory[gpuGroup] = 0
+	for i, task := range tasks {
+		if bindErrors[i] == nil {
+			continue
+		}
+		for j, boundTask := range tasks {
+			if bindErrors[j] == nil {
+				s.rollbackBind(boundTask)
 			}
 		}
+		return bindErrors[i]
 	}
 
-	if err = s.ssn.BindPod(task); err != nil {
-		log.InfraLogger.Errorf("Failed to bind task <%v/%v>. Error: %v",
-			task.Namespace, task.Name, err)
+	for i, task := range tasks {
+		usage.record(api.AllocationStarted, task, s.ssn.Nodes[task.NodeName])
+		s.ssn.emitSchedulingEvent(TaskAllocated, task, task.NodeName, "", "")
+		s.ssn.onGpuSharingStart(task.NodeName, ops[i].newlySharedGroups, task)
 	}
-
-	return err
+	return nil
 }
 
 // unallocate the pod for task
@@ -414,6 +531,7 @@ func (s *Statement) unallocate(task *pod_info.PodInfo, previousNodeName string,
 
 func (s *Statement) commitPipeline(task *pod_info.PodInfo, message string) {
 	s.ssn.Cache.TaskPipelined(task, message)
+	s.ssn.emitSchedulingEvent(TaskPipelined, task, task.NodeName, "", message)
 }
 
 func (s *Statement) unpipeline(
@@ -501,6 +619,7 @@ func (s *Statement) ConvertAllAllocatedToPipelined(jobID common_info.PodGroupID)
 
 func (s *Statement) clearOperations() {
 	s.operations = []Operation{}
+	s.checkpoints = nil
 }
 
 func (s *Statement) Discard() {
@@ -523,14 +642,48 @@ func (s *Statement) Commit() error {
 		return nil
 	}
 
+	if valid, reason := s.ssn.CommitValidator(s.commitInfo()); !valid {
+		log.InfraLogger.V(2).Infof("Commit vetoed, discarding operations: %s", reason)
+		s.Discard()
+		return fmt.Errorf("%w: %s", ErrCommitVetoed, reason)
+	}
+
 	var err error
+	usage := s.ssn.newAllocationUsageRecorder()
+	defer usage.report()
 
+	// Consecutive allocations are bound together, so the binds of a gang run concurrently while the order of the
+	// allocations relative to the other operations is kept.
+	var allocations []allocateOperation
+	commitAllocations := func() error {
+		if len(allocations) == 0 {
+			return nil
+		}
+		allocateErr := s.commitAllocations(allocations, usage)
+		allocations = nil
+		if allocateErr != nil {
+			log.InfraLogger.Errorf("Failed to allocate task. error: %s", allocateErr.Error())
+			s.clearOperations()
+		}
+		return allocateErr
+	}
+
+	// A task whose eviction failed is still on its node, so it is not pipelined elsewhere.
+	failedEvictions := map[common_info.PodID]bool{}
 	log.InfraLogger.V(4).Infof("Committing operations ...")
 	for i, op := range s.operations {
 		if !s.operationValid(i) {
 			continue
 		}
 
+		if op.Name() == allocate {
+			allocations = append(allocations, op.(allocateOperation))
+			continue
+		}
+		if allocateErr := commitAllocations(); allocateErr != nil {
+			return allocateErr
+		}
+
 		taskInfo := op.TaskInfo()
 		switch op.Name() {
 		case evict:
@@ -539,26 +692,95 @@ func (s *Statement) Commit() error {
 			if err = s.commitEvict(taskInfo, evictOp); err != nil {
 				log.InfraLogger.Errorf("Failed to evict task <%v/%v>, error: <%v>",
 					taskInfo.Namespace, taskInfo.Name, err)
+				failedEvictions[taskInfo.UID] = true
+			} else {
+				usage.record(api.AllocationStopped, taskInfo, evictOp.previousNode)
+				s.ssn.onGpuSharingEnd(evictOp.previousNode.Name, evictOp.unsharedGpuGroups, taskInfo)
 			}
 		case pipeline:
+			if failedEvictions[taskInfo.UID] {
+				log.InfraLogger.V(4).Infof("Not pipelining task: %v/%v, its eviction failed",
+					taskInfo.Namespace, taskInfo.Name)
+				continue
+			}
 			log.InfraLogger.V(4).Infof("Pipelining task: %v/%v", taskInfo.Namespace, taskInfo.Name)
 			s.commitPipeline(taskInfo, op.(pipelineOperation).message)
-		case allocate:
-			log.InfraLogger.V(4).Infof("Allocating task: %v/%v", taskInfo.Namespace, taskInfo.Name)
-			err = s.commitAllocate(taskInfo)
-			if err != nil {
-				log.InfraLogger.Errorf("Failed to allocate task. error: %s", err.Error())
-				s.clearOperations()
-				return err
-			}
+		case shrink:
+			log.InfraLogger.V(4).Infof("Shrinking task: %v/%v", taskInfo.Namespace, taskInfo.Name)
+			shrinkOp := op.(shrinkOperation)
+			s.commitShrink(taskInfo, shrinkOp)
+			node := s.ssn.Nodes[taskInfo.NodeName]
+			usage.record(api.AllocationStopped, shrinkOp.previousTask, node)
+			usage.record(api.AllocationStarted, taskInfo, node)
 		}
 	}
+	if allocateErr := commitAllocations(); allocateErr != nil {
+		return allocateErr
+	}
 
 	s.clearOperations()
 
 	return err
 }
 
+// Shrink lowers the GPU memory of a running GPU sharing task to gpuMemory MiB per device, freeing the rest of its
+// GPU memory for other tasks. On commit, the pod is told its new allotment through an annotation.
+func (s *Statement) Shrink(task *pod_info.PodInfo, gpuMemory int64) error {
+	node, found := s.ssn.Nodes[task.NodeName]
+	if !found {
+		return fmt.Errorf("node doesn't exist in sesssion: <%s>", task.NodeName)
+	}
+
+	previousTask := task.Clone()
+	if err := s.ssn.resizeTask(task, node, func() { task.SetGpuMemoryRequest(gpuMemory) }); err != nil {
+		log.InfraLogger.Errorf("Failed to shrink task <%v/%v> to %d MiB of GPU memory in Session <%v>: %v",
+			task.Namespace, task.Name, gpuMemory, s.sessionUID, err)
+		return err
+	}
+
+	s.operations = append(s.operations, shrinkOperation{
+		taskInfo:     task,
+		previousTask: previousTask,
+		gpuMemory:    gpuMemory,
+		reverseOperation: func() error {
+			return s.ssn.resizeTask(task, node, func() {
+				task.ResReq = previousTask.ResReq.Clone()
+				task.ResourceRequestType = previousTask.ResourceRequestType
+			})
+		},
+	})
+
+	log.InfraLogger.V(6).Infof("Statement shrunk task: <%v/%v> on node: <%v> to %d MiB of GPU memory",
+		task.Namespace, task.Name, node.Name, gpuMemory)
+	return nil
+}
+
+func (s *Statement) commitShrink(task *pod_info.PodInfo, shrinkOp shrinkOperation) {
+	s.ssn.Cache.PatchTaskAnnotations(task, map[string]any{
+		commonconstants.GpuMemoryAllotment: strconv.FormatInt(shrinkOp.gpuMemory, 10),
+	})
+}
+
+func (s *Statement) commitInfo() api.CommitInfo {
+	commit := api.CommitInfo{}
+	for i, op := range s.operations {
+		if !s.operationValid(i) {
+			continue
+		}
+		switch op.Name() {
+		case evict:
+			commit.Evicted = append(commit.Evicted, op.TaskInfo())
+		case pipeline:
+			commit.Pipelined = append(commit.Pipelined, op.TaskInfo())
+		case allocate:
+			commit.Allocated = append(commit.Allocated, op.TaskInfo())
+		case shrink:
+			commit.Shrunk = append(commit.Shrunk, op.TaskInfo())
+		}
+	}
+	return commit
+}
+
 // undoEarliestValidOperation will undo the earliest valid operation of the given type
 func (s *Statement) undoEarliestValidOperation(taskToUndo *pod_info.PodInfo, opName string) error {
 	for index, op := range s.operations {
@@ -607,6 +829,10 @@ func (s *Statement) undoOperation(index int) error {
 		redoOperation = func() error {
 			return s.Allocate(taskToUndo, op.(allocateOperation).nextNode)
 		}
+	case shrinkOperation:
+		redoOperation = func() error {
+			return s.Shrink(taskToUndo, op.(shrinkOperation).gpuMemory)
+		}
 	case undoOperation:
 		redoOperation = func() error {
 			return s.undoOperation(op.(undoOperation).operationIndex)
@@ -627,11 +853,26 @@ func (s *Statement) undoOperation(index int) error {
 	return err
 }
 
-func (s *Statement) cleanupFailedAllocation(task *pod_info.PodInfo, node *node_info.NodeInfo) {
+func (s *Statement) cleanupFailedAllocation(task *pod_info.PodInfo) {
 	log.InfraLogger.V(4).Infof("Cleaning up for failed allocation for task: <%v/%v> on node: %v",
-		task.Namespace, task.Name, node.Name)
+		task.Namespace, task.Name, task.NodeName)
 
-	_ = s.unallocate(task, node.Name, false)
+	_ = s.unallocate(task, task.NodeName, false)
+}
+
+// rollbackBind evicts a task that was bound in a batch in which another task failed to bind, so the gang of the batch
+// is not left partially bound, and unallocates it in the session.
+func (s *Statement) rollbackBind(task *pod_info.PodInfo) {
+	log.InfraLogger.V(2).Warnf("Rolling back the bind of task <%v/%v> to node <%v>, another task of its batch failed to bind",
+		task.Namespace, task.Name, task.NodeName)
+	if job, found := s.ssn.PodGroupInfos[task.Job]; found {
+		if err := s.ssn.Cache.Evict(task.Pod, job, eviction_info.EvictionMetadata{},
+			"Another pod of the pod group failed to bind"); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%v/%v> to roll back its bind: %v",
+				task.Namespace, task.Name, err)
+		}
+	}
+	s.cleanupFailedAllocation(task)
 }
 
 func (s *Statement) operationValid(i int) bool {
@@ -252,3 +252,57 @@ func assertEqualSessionData(t *testing.T,
 		assert.Equal(t, originalNodes[name], node)
 	}
 }
+
+func TestStatement_NestedCheckpoints(t *testing.T) {
+	clusterTopology := nodes_fake.TestClusterTopology{
+		Name: "test",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "pending_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 2},
+			"node1": {GPUs: 2},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(clusterTopology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(clusterTopology.Nodes, tasksToNodeMap, nil)
+	ssn := &Session{}
+	ssn.PodGroupInfos = jobsInfoMap
+	ssn.Nodes = nodesInfoMap
+	stmt := &Statement{operations: []Operation{}, ssn: ssn, sessionUID: "1234"}
+	tasks := ssn.PodGroupInfos["pending_job0"].GetAllPodsMap()
+
+	originalJobs, originalNodes := extractSessionAssertedData(ssn)
+	root := stmt.Checkpoint()
+	assert.Nil(t, stmt.Allocate(tasks["pending_job0-0"], "node0"))
+	afterFirstTask := stmt.Checkpoint()
+	afterFirstJobs, afterFirstNodes := extractSessionAssertedData(ssn)
+
+	// Try the second task on node0, then backtrack and place it on node1
+	assert.Nil(t, stmt.Allocate(tasks["pending_job0-1"], "node0"))
+	nested := stmt.Checkpoint()
+	assert.Nil(t, stmt.Rollback(afterFirstTask))
+	updatedJobs, updatedNodes := extractSessionAssertedData(ssn)
+	assertEqualSessionData(t, updatedJobs, afterFirstJobs, updatedNodes, afterFirstNodes)
+	assert.Error(t, stmt.Rollback(nested), "checkpoints after the rolled back checkpoint are invalid")
+
+	assert.Nil(t, stmt.Allocate(tasks["pending_job0-1"], "node1"))
+	assert.Equal(t, "node1", tasks["pending_job0-1"].NodeName)
+	assert.Error(t, stmt.Rollback(nested), "an invalidated checkpoint stays invalid after more operations")
+	assert.Nil(t, stmt.Rollback(afterFirstTask), "a checkpoint stays valid after rolling back to it")
+	assert.Nil(t, stmt.Rollback(root))
+	updatedJobs, updatedNodes = extractSessionAssertedData(ssn)
+	assertEqualSessionData(t, updatedJobs, originalJobs, updatedNodes, originalNodes)
+
+	assert.Error(t, stmt.Rollback(-1))
+	assert.Error(t, stmt.Rollback(afterFirstTask))
+}
@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -1250,3 +1251,123 @@ func TestStatement_Allocate_Undo_Undo(t *testing.T) {
 		})
 	}
 }
+
+func TestStatement_Commit_Vetoed(t *testing.T) {
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:    pod_status.Running,
+						NodeName: "node0",
+					},
+				},
+			},
+			{
+				Name:                "pending_job0",
+				RequiredGPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State: pod_status.Pending,
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 1,
+			},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+	var validatedCommit api.CommitInfo
+	ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	ssn.AddCommitValidatorFn(func(commit api.CommitInfo) (bool, string) {
+		validatedCommit = commit
+		return false, "maintenance freeze"
+	})
+	s := ssn.Statement()
+
+	runningTask := jobsInfoMap["running_job0"].GetAllPodsMap()["running_job0-0"]
+	pendingTask := jobsInfoMap["pending_job0"].GetAllPodsMap()["pending_job0-0"]
+	originalNode := extractNodeAssertedInfo(nodesInfoMap["node0"])
+
+	assert.NoError(t, s.Evict(runningTask, "eviction message", eviction_info.EvictionMetadata{
+		Action:           "action",
+		EvictionGangSize: 1,
+	}))
+	assert.NoError(t, s.Pipeline(pendingTask, "node0", false))
+
+	err := s.Commit()
+	assert.ErrorIs(t, err, ErrCommitVetoed)
+	assert.ErrorContains(t, err, "maintenance freeze")
+	assert.Equal(t, []*pod_info.PodInfo{runningTask}, validatedCommit.Evicted)
+	assert.Equal(t, []*pod_info.PodInfo{pendingTask}, validatedCommit.Pipelined)
+	assert.Empty(t, validatedCommit.Allocated)
+
+	assert.Empty(t, s.operations)
+	assert.Equal(t, pod_status.Running, runningTask.Status)
+	assert.Equal(t, "node0", runningTask.NodeName)
+	assert.Equal(t, pod_status.Pending, pendingTask.Status)
+	assert.Equal(t, "", pendingTask.NodeName)
+	originalNode.assertEqual(t, extractNodeAssertedInfo(nodesInfoMap["node0"]))
+}
+
+func TestStatement_Shrink_Unshrink(t *testing.T) {
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 0.5,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State:     pod_status.Running,
+						NodeName:  "node0",
+						GPUGroups: []string{"0"},
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs:      1,
+				GPUMemory: 1000,
+			},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+	ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+	s := ssn.Statement()
+
+	node := nodesInfoMap["node0"]
+	task := jobsInfoMap["running_job0"].GetAllPodsMap()["running_job0-0"]
+	originalTask := task.Clone()
+	originalJob := jobsInfoMap["running_job0"].Clone()
+	originalNodeInfo := extractNodeAssertedInfo(node)
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, s.Shrink(task, 200))
+		assert.Equal(t, int64(200), node.GetResourceGpuMemory(task.ResReq))
+		assert.Equal(t, int64(200), node.UsedSharedGPUsMemory["0"])
+		assert.True(t, task.IsMemoryRequest())
+
+		assert.NoError(t, s.undoOperation(len(s.operations)-1))
+	}
+
+	assert.Equal(t, *originalTask.ResReq, *task.ResReq)
+	assert.Equal(t, originalTask.ResourceRequestType, task.ResourceRequestType)
+	assert.Equal(t, *originalJob.Allocated, *jobsInfoMap["running_job0"].Allocated)
+	originalNodeInfo.assertEqual(t, extractNodeAssertedInfo(node))
+	assert.Equal(t, int64(500), node.UsedSharedGPUsMemory["0"])
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"encoding/json"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// TaskResourceMutator is implemented by plugins that schedule pending tasks against other resource requirements than
+// their request. The TaskResourceMutateFn is registered and applied before any plugin runs OnSessionOpen, so that the
+// plugins computing queue usage and demand when the session opens see the adjusted requirements.
+type TaskResourceMutator interface {
+	TaskResourceMutateFn(ssn *Session) api.TaskResourceMutateFn
+}
+
+// mutateTaskResources lets the TaskResourceMutateFns adjust the resource requirements of the pending tasks of the
+// rightsizing queues, before the plugins open and the session computes any fit. The request of an adjusted task is
+// kept in its OriginalResReq. Adjustments of the GPU requirement are ignored, since only the other resources are
+// recorded on the bind request and read back from the bound pod.
+func (ssn *Session) mutateTaskResources() {
+	if len(ssn.TaskResourceMutateFns) == 0 {
+		return
+	}
+	rightsizingQueues := ssn.rightsizingQueues()
+	if len(rightsizingQueues) == 0 {
+		return
+	}
+
+	for _, job := range ssn.PodGroupInfos {
+		if !rightsizingQueues[job.Queue] {
+			continue
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Pending {
+				continue
+			}
+			for _, fn := range ssn.TaskResourceMutateFns {
+				resReq := fn(task, job)
+				if resReq == nil {
+					continue
+				}
+				if !isSameGpuRequirement(&resReq.GpuResourceRequirement, &task.ResReq.GpuResourceRequirement) {
+					log.InfraLogger.V(2).Warnf("Ignoring the adjusted resource requirements <%v> of task <%s/%s>, "+
+						"the GPU requirement of a task cannot be adjusted", resReq, task.Namespace, task.Name)
+					continue
+				}
+				log.InfraLogger.V(2).Infof("Adjusting the resource requirements of task <%s/%s> of queue <%s> "+
+					"from <%v> to <%v>", task.Namespace, task.Name, job.Queue, task.ResReq, resReq)
+				if task.OriginalResReq == nil {
+					task.OriginalResReq = task.ResReq
+				}
+				task.ResReq = resReq
+			}
+		}
+	}
+}
+
+func isSameGpuRequirement(gpuRequirement, other *resource_info.GpuResourceRequirement) bool {
+	return gpuRequirement.LessEqual(other) && other.LessEqual(gpuRequirement) &&
+		gpuRequirement.GpuMemory() == other.GpuMemory()
+}
+
+// rightsizingQueues returns the queues listed in RightsizingQueues, by their own name or the name of an ancestor.
+func (ssn *Session) rightsizingQueues() map[common_info.QueueID]bool {
+	if ssn.Config == nil || len(ssn.Config.RightsizingQueues) == 0 {
+		return nil
+	}
+
+	rightsizingQueues := map[common_info.QueueID]bool{}
+	for queueID := range ssn.Queues {
+		for queue := ssn.Queues[queueID]; queue != nil; queue = ssn.Queues[queue.ParentQueue] {
+			if slices.Contains(ssn.Config.RightsizingQueues, queue.Name) {
+				rightsizingQueues[queueID] = true
+				break
+			}
+		}
+	}
+	return rightsizingQueues
+}
+
+// rightsizedResources returns the resource requirements that the pod was scheduled against, as a JSON resource list,
+// if a TaskResourceMutateFn adjusted them.
+func rightsizedResources(pod *pod_info.PodInfo) (string, bool) {
+	if pod.OriginalResReq == nil || pod.ResReq == nil {
+		return "", false
+	}
+
+	resources := pod.ResReq.ToResourceList()
+	for name, quantity := range resources {
+		if quantity.IsZero() {
+			delete(resources, name)
+		}
+	}
+	value, err := json.Marshal(v1.ResourceList(resources))
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to record the rightsized resources of pod <%s/%s>: %v",
+			pod.Namespace, pod.Name, err)
+		return "", false
+	}
+	return string(value), true
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestSession_mutateTaskResources(t *testing.T) {
+	rightsized := resource_info.NewResourceRequirements(0, 500, 1000)
+	rightsizeFn := func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements {
+		return rightsized.Clone()
+	}
+	gpuFn := func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements {
+		return resource_info.NewResourceRequirements(1, 500, 1000)
+	}
+	keepFn := func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements {
+		return nil
+	}
+
+	tests := []struct {
+		name              string
+		rightsizingQueues []string
+		fns               []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements
+		taskStatus        pod_status.PodStatus
+		expectRightsized  bool
+	}{
+		{
+			name:              "pending task of a rightsizing queue",
+			rightsizingQueues: []string{"queue0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				rightsizeFn,
+			},
+			taskStatus:       pod_status.Pending,
+			expectRightsized: true,
+		},
+		{
+			name:              "pending task of a child queue of a rightsizing queue",
+			rightsizingQueues: []string{"department0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				keepFn, rightsizeFn,
+			},
+			taskStatus:       pod_status.Pending,
+			expectRightsized: true,
+		},
+		{
+			name:              "pending task of another queue",
+			rightsizingQueues: []string{"queue1"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				rightsizeFn,
+			},
+			taskStatus: pod_status.Pending,
+		},
+		{
+			name:              "running task of a rightsizing queue",
+			rightsizingQueues: []string{"queue0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				rightsizeFn,
+			},
+			taskStatus: pod_status.Running,
+		},
+		{
+			name:              "fn adjusting the GPU requirement is ignored",
+			rightsizingQueues: []string{"queue0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				gpuFn,
+			},
+			taskStatus: pod_status.Pending,
+		},
+		{
+			name:              "fn adjusting the GPU requirement is ignored after another fn",
+			rightsizingQueues: []string{"queue0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				rightsizeFn, gpuFn,
+			},
+			taskStatus:       pod_status.Pending,
+			expectRightsized: true,
+		},
+		{
+			name:              "no fn adjusts the task",
+			rightsizingQueues: []string{"queue0"},
+			fns: []func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements{
+				keepFn,
+			},
+			taskStatus: pod_status.Pending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &tasks_fake.TestTaskBasic{State: tt.taskStatus}
+			if tt.taskStatus == pod_status.Running {
+				task.NodeName = "node0"
+			}
+			jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+				Name:                "job",
+				RequiredCPUsPerTask: 2000,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks:               []*tasks_fake.TestTaskBasic{task},
+			}})
+			ssn := &Session{
+				Config:        &conf.SchedulerConfiguration{RightsizingQueues: tt.rightsizingQueues},
+				PodGroupInfos: jobsInfoMap,
+				Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+					"department0": {UID: "department0", Name: "department0"},
+					"queue0":      {UID: "queue0", Name: "queue0", ParentQueue: "department0"},
+					"queue1":      {UID: "queue1", Name: "queue1", ParentQueue: "department0"},
+				},
+			}
+			for _, fn := range tt.fns {
+				ssn.AddTaskResourceMutateFn(fn)
+			}
+			pods := slices.Collect(maps.Values(jobsInfoMap["job"].GetAllPodsMap()))
+			assert.Len(t, pods, 1)
+			pod := pods[0]
+			request := pod.ResReq
+
+			ssn.mutateTaskResources()
+
+			annotations := ssn.MutateBindRequestAnnotations(pod, "node0")
+			if tt.expectRightsized {
+				assert.Equal(t, rightsized, pod.ResReq)
+				assert.Equal(t, request, pod.OriginalResReq)
+				assert.Equal(t, `{"cpu":"500m","memory":"1k"}`, annotations[commonconstants.RightsizedResources])
+			} else {
+				assert.Equal(t, request, pod.ResReq)
+				assert.Nil(t, pod.OriginalResReq)
+				assert.NotContains(t, annotations, commonconstants.RightsizedResources)
+			}
+		})
+	}
+}
+
+type fakeMutatorPlugin struct {
+	fakeValidationPlugin
+	rightsized *resource_info.ResourceRequirements
+}
+
+func (p *fakeMutatorPlugin) TaskResourceMutateFn(*Session) api.TaskResourceMutateFn {
+	return func(*pod_info.PodInfo, *podgroup_info.PodGroupInfo) *resource_info.ResourceRequirements {
+		return p.rightsized.Clone()
+	}
+}
+
+func TestSession_openPluginsMutatesBeforeOpen(t *testing.T) {
+	rightsized := resource_info.NewResourceRequirements(0, 500, 1000)
+	var requestsOnOpen []*resource_info.ResourceRequirements
+	RegisterPluginBuilder("fake-observer", func(map[string]string) Plugin {
+		return &fakeValidationPlugin{name: "fake-observer", open: func(ssn *Session) {
+			for _, pod := range ssn.PodGroupInfos["job"].GetAllPodsMap() {
+				requestsOnOpen = append(requestsOnOpen, pod.ResReq)
+			}
+		}}
+	})
+	RegisterPluginBuilder("fake-mutator", func(map[string]string) Plugin {
+		return &fakeMutatorPlugin{
+			fakeValidationPlugin: fakeValidationPlugin{name: "fake-mutator", open: func(*Session) {}},
+			rightsized:           rightsized,
+		}
+	})
+
+	jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+		Name:                "job",
+		RequiredCPUsPerTask: 2000,
+		QueueName:           "queue0",
+		Priority:            constants.PriorityTrainNumber,
+		Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+	}})
+	ssn := &Session{
+		Config: &conf.SchedulerConfiguration{
+			RightsizingQueues: []string{"queue0"},
+			Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+				{Name: "fake-observer"}, {Name: "fake-mutator"},
+			}}},
+		},
+		PodGroupInfos: jobsInfoMap,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"queue0": {UID: "queue0", Name: "queue0"},
+		},
+		plugins: map[string]Plugin{},
+	}
+
+	ssn.openPlugins()
+
+	assert.Equal(t, []*resource_info.ResourceRequirements{rightsized}, requestsOnOpen,
+		"plugins opened before the mutator see the adjusted requirements")
+}
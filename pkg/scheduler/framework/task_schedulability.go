@@ -0,0 +1,82 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"golang.org/x/exp/maps"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	taskSchedulabilityPodGroupNotFound = "pod group of the pod was not found"
+	taskSchedulabilityGated            = "pod has scheduling gates"
+)
+
+// IsTaskSchedulable returns true if the task fits a node of the session as it is now, without placing it. It runs the
+// pre-predicates of the task, subsets the nodes for the task alone and checks the nodes of the subsets for resources
+// and predicates, by name, stopping at the first node that fits. When no node fits, the returned fit error aggregates
+// the reasons of all the nodes. The job fit errors that plugins set while subsetting the nodes are not kept on the job.
+// Pods held back by scheduling gates are not schedulable.
+func (ssn *Session) IsTaskSchedulable(task *pod_info.PodInfo) (bool, *common_info.FitError) {
+	job, found := ssn.PodGroupInfos[task.Job]
+	if !found {
+		return false, common_info.NewFitError(task.Name, task.Namespace, "", taskSchedulabilityPodGroupNotFound)
+	}
+	if task.Status == pod_status.Gated {
+		return false, common_info.NewFitError(task.Name, task.Namespace, "", taskSchedulabilityGated)
+	}
+
+	if err := ssn.PrePredicateFn(task, job); err != nil {
+		return false, common_info.NewFitErrorByReasons(task.Name, task.Namespace, "", err)
+	}
+
+	jobFitErrors := job.JobFitErrors
+	nodeSets, err := ssn.SubsetNodesFn(job, []*pod_info.PodInfo{task}, maps.Values(ssn.Nodes))
+	subsetFitErrors := job.JobFitErrors[len(jobFitErrors):]
+	job.JobFitErrors = jobFitErrors
+	if err != nil {
+		return false, common_info.NewFitErrorByReasons(task.Name, task.Namespace, "", err)
+	}
+
+	candidates := map[string]*node_info.NodeInfo{}
+	for _, nodeSet := range nodeSets {
+		for _, node := range nodeSet {
+			candidates[node.Name] = node
+		}
+	}
+	if len(candidates) == 0 {
+		reasons := []string{common_info.ResourcesWereNotFoundMsg}
+		if len(subsetFitErrors) > 0 {
+			reasons = nil
+			for _, fitError := range subsetFitErrors {
+				reasons = append(reasons, fitError.Message)
+			}
+		}
+		return false, common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, "", reasons)
+	}
+
+	fitErrors := ssn.NewFitErrors()
+	for _, node := range sortNodesByName(maps.Values(candidates)) {
+		allocatable, fitError := ssn.isTaskAllocatableOnNode(task, job, node, true)
+		if !allocatable {
+			if fitError != nil {
+				fitErrors.SetNodeError(node.Name, fitError)
+			}
+			continue
+		}
+		if err := ssn.PredicateFn(task, job, node); err != nil {
+			fitErrors.SetNodeError(node.Name, err)
+			continue
+		}
+		log.InfraLogger.V(6).Infof("Task <%s/%s> is schedulable on node <%s>", task.Namespace, task.Name, node.Name)
+		return true, nil
+	}
+	return false, common_info.NewFitErrorWithDetailedMessage(task.Name, task.Namespace, "",
+		[]string{fitErrors.Error()}, fitErrors.DetailedError())
+}
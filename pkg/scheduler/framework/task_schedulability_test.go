@@ -0,0 +1,136 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestIsTaskSchedulable(t *testing.T) {
+	tests := []struct {
+		name            string
+		requiredGPUs    float64
+		predicateFns    []api.PredicateFn
+		subsetNodesFns  []api.SubsetNodesFn
+		expected        bool
+		expectedReasons string
+	}{
+		{
+			name:         "fits a node",
+			requiredGPUs: 2,
+			expected:     true,
+		},
+		{
+			name:            "does not fit any node",
+			requiredGPUs:    4,
+			expectedReasons: "node(s) didn't have enough resources: GPUs",
+		},
+		{
+			name:         "rejected by the predicates on the node with resources",
+			requiredGPUs: 2,
+			predicateFns: []api.PredicateFn{
+				func(_ *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+					if node.Name == "node0" {
+						return fmt.Errorf("node0 is rejected")
+					}
+					return nil
+				},
+			},
+			expectedReasons: "node0 is rejected",
+		},
+		{
+			name:         "no node left by the node subsets",
+			requiredGPUs: 1,
+			subsetNodesFns: []api.SubsetNodesFn{
+				func(podGroup *podgroup_info.PodGroupInfo, _ []*pod_info.PodInfo, _ node_info.NodeSet,
+				) ([]node_info.NodeSet, error) {
+					podGroup.SetJobFitError(podgroup_info.PodSchedulingErrors, "no domain fits", nil)
+					return []node_info.NodeSet{}, nil
+				},
+			},
+			expectedReasons: "no domain fits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+				{
+					Name:                "running_job0",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "node1"}},
+				},
+				{
+					Name:                "pending_job0",
+					RequiredGPUsPerTask: tt.requiredGPUs,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+				},
+			})
+			nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+				"node1": {GPUs: 2},
+			}, tasksToNodeMap, nil)
+			ssn := &Session{
+				PodGroupInfos:  jobsInfoMap,
+				Nodes:          nodesInfoMap,
+				PredicateFns:   tt.predicateFns,
+				SubsetNodesFns: tt.subsetNodesFns,
+			}
+			job := jobsInfoMap["pending_job0"]
+			task := job.GetAllPodsMap()["pending_job0-0"]
+			idleGPUs := nodesInfoMap["node0"].Idle.GPUs()
+
+			schedulable, fitError := ssn.IsTaskSchedulable(task)
+			assert.Equal(t, tt.expected, schedulable)
+			if tt.expected {
+				assert.Nil(t, fitError)
+			} else {
+				assert.Contains(t, fitError.Error(), tt.expectedReasons)
+			}
+
+			assert.Equal(t, pod_status.Pending, task.Status)
+			assert.Empty(t, task.NodeName)
+			assert.Equal(t, idleGPUs, nodesInfoMap["node0"].Idle.GPUs())
+			assert.Empty(t, job.JobFitErrors)
+			assert.Empty(t, job.NodesFitErrors)
+		})
+	}
+}
+
+func TestIsTaskSchedulableGated(t *testing.T) {
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name:                "gated_job0",
+			RequiredGPUsPerTask: 1,
+			QueueName:           "queue0",
+			Priority:            constants.PriorityTrainNumber,
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Gated}},
+		},
+	})
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"node0": {GPUs: 2},
+	}, tasksToNodeMap, nil)
+	ssn := &Session{PodGroupInfos: jobsInfoMap, Nodes: nodesInfoMap}
+
+	schedulable, fitError := ssn.IsTaskSchedulable(jobsInfoMap["gated_job0"].GetAllPodsMap()["gated_job0-0"])
+	assert.False(t, schedulable)
+	assert.Contains(t, fitError.Error(), taskSchedulabilityGated)
+}
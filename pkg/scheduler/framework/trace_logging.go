@@ -0,0 +1,50 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// traceLoggedRankedNodes is the number of best ranked nodes that are logged for a traced pod.
+const traceLoggedRankedNodes = 10
+
+// TraceLogf records a scheduling decision in the trace of the pod and logs it regardless of the log level, if the pod
+// is annotated with kai.scheduler/scheduling-trace.
+func TraceLogf(task *pod_info.PodInfo, format string, args ...any) {
+	if trace := decisionTraces.traceFor(task); trace != nil {
+		trace.recordDecision(fmt.Sprintf(format, args...))
+	}
+}
+
+// TraceLogJobf records a scheduling decision for every pending pod of the job that is traced.
+func TraceLogJobf(job *podgroup_info.PodGroupInfo, format string, args ...any) {
+	for _, task := range job.PodStatusIndex[pod_status.Pending] {
+		TraceLogf(task, format, args...)
+	}
+}
+
+// traceLogRanking records the best ranked nodes for the task with their scores in its trace, if the task is traced.
+func traceLogRanking(trace *PodDecisionTrace, ranking *NodeRanking) {
+	if trace == nil {
+		return
+	}
+	var nodes []string
+	for _, node := range ranking.orderedNodes[:min(len(ranking.orderedNodes), traceLoggedRankedNodes)] {
+		if score, found := ranking.scores[node.Name]; found {
+			nodes = append(nodes, fmt.Sprintf("%s (%v)", node.Name, score))
+		} else {
+			nodes = append(nodes, fmt.Sprintf("%s (unscored)", node.Name))
+		}
+	}
+	if omitted := len(ranking.orderedNodes) - len(nodes); omitted > 0 {
+		nodes = append(nodes, fmt.Sprintf("and %d more", omitted))
+	}
+	trace.recordDecision(fmt.Sprintf("nodes ordered by score: %s", strings.Join(nodes, ", ")))
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func TestTraceLogf(t *testing.T) {
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		expectedDecisions []string
+	}{
+		{
+			name:              "traced pod",
+			annotations:       map[string]string{commonconstants.SchedulingTrace: "true"},
+			expectedDecisions: []string{"placed on node <node0> as Allocated", "not placed, no node fits"},
+		},
+		{
+			name: "pod without the annotation",
+		},
+		{
+			name:        "annotation that is not true",
+			annotations: map[string]string{commonconstants.SchedulingTrace: "false"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decisionTraces = &decisionTraceStore{traces: map[common_info.PodID]*PodDecisionTrace{}}
+			decisionTraces.startCycle("session-1")
+
+			ssn, task := newTracingSession(t)
+			task.Pod.Annotations = tt.annotations
+			TraceLogf(task, "placed on node <%s> as %s", "node0", "Allocated")
+			TraceLogJobf(ssn.PodGroupInfos[task.Job], "not placed, no node fits")
+
+			trace, found := decisionTraces.traces[task.UID]
+			if tt.expectedDecisions == nil {
+				assert.False(t, found)
+				return
+			}
+			assert.True(t, found)
+			assert.Equal(t, tt.expectedDecisions, trace.Decisions)
+		})
+	}
+}
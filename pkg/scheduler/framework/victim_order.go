@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"time"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// VictimOrderFn returns true if l is taken as a victim before r, among jobs of the same queue. The jobs are ordered
+// in the reverse of the job order functions, and the jobs that these do not tell apart by their next checkpoint, when
+// the victim order is checkpoint aware, and then by the configured victim order.
+func (ssn *Session) VictimOrderFn(l, r interface{}) bool {
+	for _, jof := range ssn.JobOrderFns {
+		if j := jof(l, r); j != 0 {
+			return j > 0
+		}
+	}
+
+	if ssn.SchedulerParams.CheckpointAwareVictimOrder {
+		if lFirst, decided := victimCheckpointOrder(
+			ssn.nextCheckpoint(l.(*podgroup_info.PodGroupInfo)),
+			ssn.nextCheckpoint(r.(*podgroup_info.PodGroupInfo))); decided {
+			return lFirst
+		}
+	}
+	if lFirst, decided := victimTimeOrder(ssn.SchedulerParams.VictimOrder,
+		l.(*podgroup_info.PodGroupInfo), r.(*podgroup_info.PodGroupInfo)); decided {
+		return lFirst
+	}
+	return !ssn.JobOrderFn(l, r)
+}
+
+// victimTimeOrder orders the jobs by their submission or start time, as the victim order defines. The order is
+// decided only if the times of the jobs differ. Jobs that have not started yet are the most recently started.
+func victimTimeOrder(order conf.VictimOrder, l, r *podgroup_info.PodGroupInfo) (bool, bool) {
+	var lTime, rTime time.Time
+	newestFirst := false
+	switch order {
+	case conf.VictimOrderNewestSubmittedFirst, conf.VictimOrderOldestSubmittedFirst:
+		lTime, rTime = l.CreationTimestamp.Time, r.CreationTimestamp.Time
+		newestFirst = order == conf.VictimOrderNewestSubmittedFirst
+	case conf.VictimOrderNewestStartedFirst, conf.VictimOrderOldestStartedFirst:
+		now := time.Now()
+		lTime, rTime = startTime(l, now), startTime(r, now)
+		newestFirst = order == conf.VictimOrderNewestStartedFirst
+	default:
+		return false, false
+	}
+
+	if lTime.Equal(rTime) {
+		return false, false
+	}
+	if newestFirst {
+		return lTime.After(rTime), true
+	}
+	return lTime.Before(rTime), true
+}
+
+func startTime(job *podgroup_info.PodGroupInfo, notStarted time.Time) time.Time {
+	if job.LastStartTimestamp == nil || job.LastStartTimestamp.IsZero() {
+		return notStarted
+	}
+	return *job.LastStartTimestamp
+}
+
+// victimCheckpointOrder takes the job whose next checkpoint is further away first, since it checkpointed more recently
+// and loses less work, and keeps the jobs that are about to checkpoint. Jobs without a next checkpoint are taken after
+// all the jobs with one. The order is decided unless both jobs have the same next checkpoint or neither has one.
+func victimCheckpointOrder(lCheckpoint, rCheckpoint *time.Time) (bool, bool) {
+	switch {
+	case lCheckpoint == nil && rCheckpoint == nil:
+		return false, false
+	case lCheckpoint == nil:
+		return false, true
+	case rCheckpoint == nil:
+		return true, true
+	case lCheckpoint.Equal(*rCheckpoint):
+		return false, false
+	}
+	return lCheckpoint.After(*rCheckpoint), true
+}
+
+// nextCheckpoint returns the next checkpoint of the job, computed once per session, or nil if it has none.
+func (ssn *Session) nextCheckpoint(job *podgroup_info.PodGroupInfo) *time.Time {
+	if checkpoint, found := ssn.nextCheckpoints[job.UID]; found {
+		return checkpoint
+	}
+	if ssn.nextCheckpoints == nil {
+		ssn.nextCheckpoints = map[common_info.PodGroupID]*time.Time{}
+	}
+	checkpoint := jobNextCheckpoint(job)
+	ssn.nextCheckpoints[job.UID] = checkpoint
+	return checkpoint
+}
+
+// jobNextCheckpoint returns the earliest next checkpoint of the active tasks of the job, from their next checkpoint
+// ETA annotation, or nil if none of them has one.
+func jobNextCheckpoint(job *podgroup_info.PodGroupInfo) *time.Time {
+	var earliest *time.Time
+	for _, task := range job.GetAllPodsMap() {
+		if task.Pod == nil || !pod_status.IsActiveUsedStatus(task.Status) {
+			continue
+		}
+		value, annotated := task.Pod.Annotations[commonconstants.NextCheckpointEta]
+		if !annotated {
+			continue
+		}
+		checkpoint, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.InfraLogger.V(4).Warnf("Invalid %s annotation value of pod <%s/%s>: %s",
+				commonconstants.NextCheckpointEta, task.Namespace, task.Name, value)
+			continue
+		}
+		if earliest == nil || checkpoint.Before(*earliest) {
+			earliest = &checkpoint
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func TestVictimOrderFn(t *testing.T) {
+	now := time.Now()
+	earlyStart := now.Add(-2 * time.Hour)
+	lateStart := now.Add(-time.Hour)
+
+	newJob := func(uid string, priority int32, submitted time.Time, started *time.Time) *podgroup_info.PodGroupInfo {
+		return &podgroup_info.PodGroupInfo{
+			UID:                "job-" + common_info.PodGroupID(uid),
+			Priority:           priority,
+			CreationTimestamp:  metav1.Time{Time: submitted},
+			LastStartTimestamp: started,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		order             conf.VictimOrder
+		l, r              *podgroup_info.PodGroupInfo
+		expectedLeftFirst bool
+	}{
+		{
+			name:              "default takes the most recently submitted first",
+			order:             conf.VictimOrderDefault,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &earlyStart),
+			r:                 newJob("b", 50, now.Add(-2*time.Hour), &lateStart),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "newest submitted first",
+			order:             conf.VictimOrderNewestSubmittedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &earlyStart),
+			r:                 newJob("b", 50, now.Add(-2*time.Hour), &lateStart),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "oldest submitted first",
+			order:             conf.VictimOrderOldestSubmittedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &earlyStart),
+			r:                 newJob("b", 50, now.Add(-2*time.Hour), &lateStart),
+			expectedLeftFirst: false,
+		},
+		{
+			name:              "newest started first",
+			order:             conf.VictimOrderNewestStartedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &earlyStart),
+			r:                 newJob("b", 50, now.Add(-2*time.Hour), &lateStart),
+			expectedLeftFirst: false,
+		},
+		{
+			name:              "oldest started first",
+			order:             conf.VictimOrderOldestStartedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &earlyStart),
+			r:                 newJob("b", 50, now.Add(-2*time.Hour), &lateStart),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "job that has not started is the most recently started",
+			order:             conf.VictimOrderNewestStartedFirst,
+			l:                 newJob("a", 50, now.Add(-2*time.Hour), nil),
+			r:                 newJob("b", 50, now.Add(-time.Hour), &lateStart),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "lower priority is taken first regardless of the times",
+			order:             conf.VictimOrderOldestSubmittedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &lateStart),
+			r:                 newJob("b", 100, now.Add(-2*time.Hour), &earlyStart),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "same times fall back to the reverse job order",
+			order:             conf.VictimOrderOldestSubmittedFirst,
+			l:                 newJob("a", 50, now.Add(-time.Hour), &lateStart),
+			r:                 newJob("b", 50, now.Add(-time.Hour), &lateStart),
+			expectedLeftFirst: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{SchedulerParams: conf.SchedulerParams{VictimOrder: tt.order}}
+			ssn.AddJobOrderFn(func(l, r interface{}) int {
+				lPriority := l.(*podgroup_info.PodGroupInfo).Priority
+				rPriority := r.(*podgroup_info.PodGroupInfo).Priority
+				if lPriority == rPriority {
+					return 0
+				}
+				if lPriority > rPriority {
+					return -1
+				}
+				return 1
+			})
+			assert.Equal(t, tt.expectedLeftFirst, ssn.VictimOrderFn(tt.l, tt.r))
+			assert.Equal(t, !tt.expectedLeftFirst, ssn.VictimOrderFn(tt.r, tt.l))
+		})
+	}
+}
+
+func TestVictimOrderFn_CheckpointAware(t *testing.T) {
+	now := time.Now()
+
+	newJob := func(uid string, submitted time.Time, nextCheckpoints ...string) *podgroup_info.PodGroupInfo {
+		var tasks []*pod_info.PodInfo
+		for i, nextCheckpoint := range nextCheckpoints {
+			annotations := map[string]string{}
+			if nextCheckpoint != "" {
+				annotations[commonconstants.NextCheckpointEta] = nextCheckpoint
+			}
+			pod := common_info.BuildPod("ns", fmt.Sprintf("%s-%d", uid, i), "node0", v1.PodRunning,
+				common_info.BuildResourceList("1", "1G"), nil, nil, annotations)
+			tasks = append(tasks, pod_info.NewTaskInfo(pod))
+		}
+		job := podgroup_info.NewPodGroupInfo("job-"+common_info.PodGroupID(uid), tasks...)
+		job.CreationTimestamp = metav1.Time{Time: submitted}
+		return job
+	}
+	soon := now.Add(5 * time.Minute).Format(time.RFC3339)
+	later := now.Add(time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name              string
+		checkpointAware   bool
+		l, r              *podgroup_info.PodGroupInfo
+		expectedLeftFirst bool
+	}{
+		{
+			name:              "job further from its next checkpoint is taken first",
+			checkpointAware:   true,
+			l:                 newJob("a", now.Add(-2*time.Hour), later),
+			r:                 newJob("b", now.Add(-time.Hour), soon),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "earliest next checkpoint of the job's pods counts",
+			checkpointAware:   true,
+			l:                 newJob("a", now.Add(-2*time.Hour), later, soon),
+			r:                 newJob("b", now.Add(-time.Hour), later),
+			expectedLeftFirst: false,
+		},
+		{
+			name:              "job without a next checkpoint is taken after jobs with one",
+			checkpointAware:   true,
+			l:                 newJob("a", now.Add(-2*time.Hour), later),
+			r:                 newJob("b", now.Add(-time.Hour), ""),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "job with an invalid next checkpoint is taken after jobs with one",
+			checkpointAware:   true,
+			l:                 newJob("a", now.Add(-2*time.Hour), soon),
+			r:                 newJob("b", now.Add(-time.Hour), "in an hour"),
+			expectedLeftFirst: true,
+		},
+		{
+			name:              "jobs without next checkpoints fall back to the victim order",
+			checkpointAware:   true,
+			l:                 newJob("a", now.Add(-2*time.Hour), ""),
+			r:                 newJob("b", now.Add(-time.Hour), "in an hour"),
+			expectedLeftFirst: false,
+		},
+		{
+			name:              "next checkpoints are ignored unless checkpoint aware",
+			l:                 newJob("a", now.Add(-2*time.Hour), later),
+			r:                 newJob("b", now.Add(-time.Hour), soon),
+			expectedLeftFirst: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{SchedulerParams: conf.SchedulerParams{
+				VictimOrder:                conf.VictimOrderNewestSubmittedFirst,
+				CheckpointAwareVictimOrder: tt.checkpointAware,
+			}}
+			assert.Equal(t, tt.expectedLeftFirst, ssn.VictimOrderFn(tt.l, tt.r))
+			assert.Equal(t, !tt.expectedLeftFirst, ssn.VictimOrderFn(tt.r, tt.l))
+		})
+	}
+
+	ssn := &Session{SchedulerParams: conf.SchedulerParams{
+		VictimOrder:                conf.VictimOrderNewestSubmittedFirst,
+		CheckpointAwareVictimOrder: true,
+	}}
+	jobs := []*podgroup_info.PodGroupInfo{
+		newJob("a", now.Add(-4*time.Hour), ""),
+		newJob("b", now.Add(-3*time.Hour), soon),
+		newJob("c", now.Add(-2*time.Hour), ""),
+		newJob("d", now.Add(-time.Hour), later),
+	}
+	slices.SortFunc(jobs, func(l, r *podgroup_info.PodGroupInfo) int {
+		if ssn.VictimOrderFn(l, r) {
+			return -1
+		}
+		if ssn.VictimOrderFn(r, l) {
+			return 1
+		}
+		return 0
+	})
+	var order []common_info.PodGroupID
+	for _, job := range jobs {
+		order = append(order, job.UID)
+	}
+	assert.Equal(t, []common_info.PodGroupID{"job-d", "job-b", "job-c", "job-a"}, order)
+}
@@ -4,10 +4,18 @@
 package gpu_sharing
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dustin/go-humanize"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
@@ -15,6 +23,8 @@ import (
 type nodeGpuForSharing struct {
 	Groups      []string
 	IsReleasing bool
+	// GpuMemorySplit is the memory taken from each of the groups by a splittable pod that no single GPU fits
+	GpuMemorySplit map[string]int64
 }
 
 func AllocateFractionalGPUTaskToNode(ssn *framework.Session, stmt *framework.Statement, pod *pod_info.PodInfo,
@@ -26,17 +36,29 @@ func AllocateFractionalGPUTaskToNode(ssn *framework.Session, stmt *framework.Sta
 	log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: FittingGPUs=<%v>",
 		pod.Namespace, pod.Name, node.Name, fittingGPUs)
 
-	gpuForSharing := getNodePreferableGpuForSharing(fittingGPUs, node, pod, isPipelineOnly)
+	gpuForSharing, fitError := getNodePreferableGpuForSharing(ssn, fittingGPUs, node, pod, isPipelineOnly)
+	if fitError != nil {
+		log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: %v",
+			pod.Namespace, pod.Name, node.Name, fitError)
+		if job, found := ssn.PodGroupInfos[pod.Job]; found {
+			fitErrors := ssn.NewFitErrors()
+			fitErrors.SetNodeError(node.Name, fitError)
+			job.SetTaskFitError(pod, fitErrors)
+		}
+		return false
+	}
 	if gpuForSharing == nil {
 		log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: No preferable GPU found for sharing",
 			pod.Namespace, pod.Name, node.Name)
 		return false
 	}
 
-	log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: Selected GPU groups=<%v>, IsReleasing=<%v>",
-		pod.Namespace, pod.Name, node.Name, gpuForSharing.Groups, gpuForSharing.IsReleasing)
+	log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: Selected GPU groups=<%v>, IsReleasing=<%v>, GpuMemorySplit=<%v>",
+		pod.Namespace, pod.Name, node.Name, gpuForSharing.Groups, gpuForSharing.IsReleasing,
+		gpuForSharing.GpuMemorySplit)
 
 	pod.GPUGroups = gpuForSharing.Groups
+	pod.GpuMemorySplit = gpuForSharing.GpuMemorySplit
 
 	isPipelineOnly = isPipelineOnly || gpuForSharing.IsReleasing
 	log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: Final isPipelineOnly=<%v> (original=<%v>, gpuIsReleasing=<%v>)",
@@ -47,6 +69,7 @@ func AllocateFractionalGPUTaskToNode(ssn *framework.Session, stmt *framework.Sta
 		log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: Allocation failed, clearing GPU groups",
 			pod.Namespace, pod.Name, node.Name)
 		pod.GPUGroups = nil
+		pod.GpuMemorySplit = nil
 	} else {
 		log.InfraLogger.V(4).Infof("[GPU_ALLOCATE] Pod <%s/%s> on Node <%s>: Allocation successful",
 			pod.Namespace, pod.Name, node.Name)
@@ -54,19 +77,33 @@ func AllocateFractionalGPUTaskToNode(ssn *framework.Session, stmt *framework.Sta
 	return success
 }
 
-func getNodePreferableGpuForSharing(fittingGPUsOnNode []string, node *node_info.NodeInfo, pod *pod_info.PodInfo,
-	isPipelineOnly bool) *nodeGpuForSharing {
+// getNodePreferableGpuForSharing selects the GPU groups for a fractional pod on the node. The pod is only pipelined
+// when the node's idle CPU or memory cannot host it until releasing pods terminate. A fit error is returned instead
+// when the node's idle and releasing CPU or memory cannot host the pod, since any GPU group on it would do no good,
+// or when the GPU groups with enough memory for the pod are at the tenant limit of the node, would exceed its GPU memory
+// bandwidth budget or are unhealthy, or are kept from new tenants since the node is under pressure.
+func getNodePreferableGpuForSharing(ssn *framework.Session, fittingGPUsOnNode []string, node *node_info.NodeInfo,
+	pod *pod_info.PodInfo, isPipelineOnly bool) (*nodeGpuForSharing, *common_info.FitError) {
 	log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Selecting from fitting GPUs=<%v>, required devices=<%d>",
 		pod.Namespace, pod.Name, fittingGPUsOnNode, pod.ResReq.GetNumOfGpuDevices())
 
+	if fitError := cpuMemoryFitError(node, pod, node.NonAllocatedResources()); fitError != nil {
+		return nil, fitError
+	}
+	isPipelineOnly = isPipelineOnly || cpuMemoryFitError(node, pod, node.Idle) != nil
+
 	nodeGpusSharing := &nodeGpuForSharing{
 		Groups:      []string{},
-		IsReleasing: false,
+		IsReleasing: isPipelineOnly,
 	}
 
+	pressurePolicy := gpuSharingNodePressurePolicy(ssn, node)
 	deviceCounts := pod.ResReq.GetNumOfGpuDevices()
-	for _, gpuIdx := range fittingGPUsOnNode {
+	for _, gpuIdx := range preferLastGpuGroups(ssn, fittingGPUsOnNode, node, pod) {
 		if gpuIdx == pod_info.WholeGpuIndicator {
+			if pressurePolicy == conf.GpuSharingNodePressureAllGpus || node.IsTaskOverWholeGpuBandwidthBudget(pod) {
+				continue
+			}
 			log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Processing whole GPU indicator",
 				pod.Namespace, pod.Name)
 			if wholeGpuForSharing := findGpuForSharingOnNode(pod, node, isPipelineOnly); wholeGpuForSharing != nil {
@@ -77,7 +114,10 @@ func getNodePreferableGpuForSharing(fittingGPUsOnNode []string, node *node_info.
 				nodeGpusSharing.Groups = append(nodeGpusSharing.Groups, wholeGpuForSharing.Groups...)
 			}
 		} else {
-			hasEnoughIdle := node.EnoughIdleResourcesOnGpu(pod.ResReq, gpuIdx)
+			if pressurePolicy != conf.GpuSharingNodePressureNone || node.IsGpuGroupOverBandwidthBudget(pod, gpuIdx) {
+				continue
+			}
+			hasEnoughIdle := node.EnoughIdleResourcesOnGpu(pod.ResReq, gpuIdx) && node.HasIdleBandwidthOnGpu(pod, gpuIdx)
 			isTaskAllocatable := node.IsTaskAllocatable(pod)
 			gpuIsReleasing := !hasEnoughIdle || !isTaskAllocatable
 
@@ -91,13 +131,120 @@ func getNodePreferableGpuForSharing(fittingGPUsOnNode []string, node *node_info.
 		if len(nodeGpusSharing.Groups) == int(deviceCounts) {
 			log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Required device count reached, selected groups=<%v>, isReleasing=<%v>",
 				pod.Namespace, pod.Name, nodeGpusSharing.Groups, nodeGpusSharing.IsReleasing)
-			return nodeGpusSharing
+			return nodeGpusSharing, nil
 		}
 	}
 
 	log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Could not satisfy device requirements, collected groups=<%v> (needed <%d>)",
 		pod.Namespace, pod.Name, nodeGpusSharing.Groups, deviceCounts)
-	return nil
+
+	if pressurePolicy != conf.GpuSharingNodePressureNone {
+		return nil, common_info.NewFitError(pod.Name, pod.Namespace, node.Name,
+			"node is under memory or disk pressure, its GPUs are not shared with new pods")
+	}
+	if splitGpuForSharing := findGpuMemorySplitOnNode(ssn, pod, node, isPipelineOnly); splitGpuForSharing != nil {
+		log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Splitting the gpu memory across groups=<%v>, split=<%v>",
+			pod.Namespace, pod.Name, splitGpuForSharing.Groups, splitGpuForSharing.GpuMemorySplit)
+		return splitGpuForSharing, nil
+	}
+	if fitError := node.BandwidthBudgetFitError(pod); fitError != nil {
+		return nil, fitError
+	}
+	if fitError := node.TenantLimitFitError(pod); fitError != nil {
+		return nil, fitError
+	}
+	return nil, node.UnhealthyGpusFitError(pod)
+}
+
+// gpuSharingNodePressurePolicy returns the node pressure policy that applies to new fractional tenants of the node,
+// which is GpuSharingNodePressureNone unless the node is under memory or disk pressure.
+func gpuSharingNodePressurePolicy(ssn *framework.Session, node *node_info.NodeInfo) conf.GpuSharingNodePressurePolicy {
+	policy, err := conf.ParseGpuSharingNodePressurePolicy(string(ssn.SchedulerParams.GpuSharingNodePressurePolicy))
+	if err != nil || policy == conf.GpuSharingNodePressureNone || !node.IsUnderPressure() {
+		return conf.GpuSharingNodePressureNone
+	}
+	return policy
+}
+
+// findGpuMemorySplitOnNode spreads the memory of a splittable pod over the idle memory of several shared GPUs of the
+// node. GPUs that a GPU filter of the session excludes for the pod are not used. Pods that are not splittable, or that
+// are only pipelined, never span GPUs.
+func findGpuMemorySplitOnNode(
+	ssn *framework.Session, pod *pod_info.PodInfo, node *node_info.NodeInfo, isPipelineOnly bool,
+) *nodeGpuForSharing {
+	if isPipelineOnly {
+		return nil
+	}
+	split := node.GetGpuMemorySplit(pod, func(gpuGroup string) bool {
+		return ssn.GpuFilterFn(pod, node, gpuGroup)
+	})
+	if split == nil {
+		return nil
+	}
+	return &nodeGpuForSharing{
+		Groups:         slices.Sorted(maps.Keys(split)),
+		IsReleasing:    false,
+		GpuMemorySplit: split,
+	}
+}
+
+// preferLastGpuGroups moves the fitting GPU groups that run on the GPUs the pod last ran on to the front, so a
+// recreated pod lands on the same GPUs while they still fit it. The last GPUs are kept by the pod's job, by their
+// index on the node, since the GPU groups of the pod are gone once it is deleted. The order of the other GPUs is kept.
+func preferLastGpuGroups(
+	ssn *framework.Session, fittingGPUsOnNode []string, node *node_info.NodeInfo, pod *pod_info.PodInfo,
+) []string {
+	job, found := ssn.PodGroupInfos[pod.Job]
+	if !found {
+		return fittingGPUsOnNode
+	}
+	lastGpus, found := job.GetLastGpus(pod.Name)
+	if !found || lastGpus.NodeName != node.Name {
+		return fittingGPUsOnNode
+	}
+
+	preferredGPUs := make([]string, 0, len(fittingGPUsOnNode))
+	var otherGPUs []string
+	for _, gpuIdx := range fittingGPUsOnNode {
+		gpuIndex, found := node.GetGpuGroupIndex(gpuIdx)
+		if gpuIdx != pod_info.WholeGpuIndicator && found && slices.Contains(lastGpus.GpuIndexes, gpuIndex) {
+			preferredGPUs = append(preferredGPUs, gpuIdx)
+		} else {
+			otherGPUs = append(otherGPUs, gpuIdx)
+		}
+	}
+	if len(preferredGPUs) > 0 {
+		log.InfraLogger.V(4).Infof("[GPU_SELECT] Pod <%s/%s>: Preferring its last GPU groups=<%v>",
+			pod.Namespace, pod.Name, preferredGPUs)
+	}
+	return append(preferredGPUs, otherGPUs...)
+}
+
+// cpuMemoryFitError returns a fit error if the CPU or memory requested by the pod exceeds the available CPU or memory
+// of the node.
+func cpuMemoryFitError(
+	node *node_info.NodeInfo, pod *pod_info.PodInfo, available *resource_info.Resource,
+) *common_info.FitError {
+
+	var reasons, detailedReasons []string
+	if pod.ResReq.Cpu() > available.Cpu() {
+		reasons = append(reasons, "node(s) didn't have enough resources for the shared GPU pod: CPU cores")
+		detailedReasons = append(detailedReasons, fmt.Sprintf(
+			"node(s) have a GPU that fits the pod, but didn't have enough CPU cores: requested %s, available %s",
+			humanize.FtoaWithDigits(pod.ResReq.Cpu()/resource_info.MilliCPUToCores, 3),
+			humanize.FtoaWithDigits(available.Cpu()/resource_info.MilliCPUToCores, 3)))
+	}
+	if pod.ResReq.Memory() > available.Memory() {
+		reasons = append(reasons, "node(s) didn't have enough resources for the shared GPU pod: memory")
+		detailedReasons = append(detailedReasons, fmt.Sprintf(
+			"node(s) have a GPU that fits the pod, but didn't have enough memory: requested %s GB, available %s GB",
+			humanize.FtoaWithDigits(pod.ResReq.Memory()/resource_info.MemoryToGB, 3),
+			humanize.FtoaWithDigits(available.Memory()/resource_info.MemoryToGB, 3)))
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return common_info.NewFitErrorWithDetailedMessage(pod.Name, pod.Namespace, node.Name, reasons, detailedReasons...)
 }
 
 func findGpuForSharingOnNode(task *pod_info.PodInfo, node *node_info.NodeInfo, isPipelineOnly bool) *nodeGpuForSharing {
@@ -113,6 +260,11 @@ func findGpuForSharingOnNode(task *pod_info.PodInfo, node *node_info.NodeInfo, i
 func allocateSharedGPUTask(ssn *framework.Session, stmt *framework.Statement, node *node_info.NodeInfo,
 	task *pod_info.PodInfo, isPipelineOnly bool) bool {
 	if isPipelineOnly {
+		if ssn.IsStalePipeline(task, node.Name) {
+			log.InfraLogger.V(6).Infof("Not pipelining Task <%v/%v> to node <%v>, its pipeline to the node is stale",
+				task.Namespace, task.Name, node.Name)
+			return false
+		}
 		log.InfraLogger.V(6).Infof(
 			"Pipelining Task <%v/%v> to node <%v> gpuGroup: <%v>, requires: <%v, %v mb> GPUs",
 			task.Namespace, task.Name, node.Name,
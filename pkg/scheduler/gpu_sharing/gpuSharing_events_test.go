@@ -0,0 +1,106 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu_sharing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/gpu_sharing"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestGpuSharingEvents(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	ssn := test_utils.BuildSession(test_utils.TestTopologyBasic{
+		Name: "two fractional tenants on a single GPU",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "job0",
+				RequiredGPUsPerTask: 0.5,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+			},
+			{
+				Name:                "job1",
+				RequiredGPUsPerTask: 0.5,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 1},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 1},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{NumberOfCacheBinds: 2, NumberOfCacheEvictions: 2},
+		},
+	}, controller)
+
+	var started, ended []*framework.GpuSharingEvent
+	ssn.AddEventHandler(&framework.EventHandler{
+		GpuSharingStartFunc: func(event *framework.GpuSharingEvent) { started = append(started, event) },
+		GpuSharingEndFunc:   func(event *framework.GpuSharingEvent) { ended = append(ended, event) },
+	})
+
+	node := ssn.Nodes["node0"]
+	tasks := []*pod_info.PodInfo{firstTask(ssn, "job0"), firstTask(ssn, "job1")}
+
+	stmt := ssn.Statement()
+	assert.True(t, gpu_sharing.AllocateFractionalGPUTaskToNode(ssn, stmt, tasks[0], node, false))
+	stmt.Discard()
+	assert.Empty(t, started, "a discarded allocation does not start sharing")
+
+	stmt = ssn.Statement()
+	assert.True(t, gpu_sharing.AllocateFractionalGPUTaskToNode(ssn, stmt, tasks[0], node, false))
+	assert.True(t, gpu_sharing.AllocateFractionalGPUTaskToNode(ssn, stmt, tasks[1], node, false))
+	assert.Empty(t, started, "events are emitted on commit")
+	assert.NoError(t, stmt.Commit())
+	assert.Len(t, started, 1, "second tenant shares an already shared GPU")
+	assert.Equal(t, "node0", started[0].NodeName)
+	assert.Equal(t, tasks[0].GPUGroups[0], started[0].GPUGroup)
+	assert.Equal(t, tasks[0].UID, started[0].Task.UID)
+	assert.Equal(t, tasks[0].GPUGroups, tasks[1].GPUGroups)
+
+	stmt = ssn.Statement()
+	assert.NoError(t, stmt.Evict(tasks[0], "", eviction_info.EvictionMetadata{}))
+	assert.NoError(t, stmt.Evict(tasks[1], "", eviction_info.EvictionMetadata{}))
+	stmt.Discard()
+	assert.Empty(t, ended, "discarded evictions do not end sharing")
+
+	stmt = ssn.Statement()
+	assert.NoError(t, stmt.Evict(tasks[0], "", eviction_info.EvictionMetadata{}))
+	assert.NoError(t, stmt.Evict(tasks[1], "", eviction_info.EvictionMetadata{}))
+	assert.Empty(t, ended, "events are emitted on commit")
+	assert.NoError(t, stmt.Commit())
+	assert.Len(t, ended, 1, "a tenant was still using the GPU when the first one was evicted")
+	assert.Equal(t, "node0", ended[0].NodeName)
+	assert.Equal(t, tasks[1].GPUGroups[0], ended[0].GPUGroup)
+	assert.Equal(t, tasks[1].UID, ended[0].Task.UID)
+}
+
+func firstTask(ssn *framework.Session, jobName common_info.PodGroupID) *pod_info.PodInfo {
+	for _, task := range ssn.PodGroupInfos[jobName].GetAllPodsMap() {
+		return task
+	}
+	return nil
+}
@@ -4,16 +4,26 @@
 package gpu_sharing
 
 import (
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
+	"go.uber.org/mock/gomock"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
 func Test_getNodePreferableGpuForSharing(t *testing.T) {
@@ -28,6 +38,7 @@ func Test_getNodePreferableGpuForSharing(t *testing.T) {
 		groupLength          int
 		expectedGroupsInList []string
 		isReleasing          bool
+		fitError             bool
 	}
 	tests := []struct {
 		name string
@@ -122,6 +133,100 @@ func Test_getNodePreferableGpuForSharing(t *testing.T) {
 				isReleasing:          false,
 			},
 		},
+		{
+			name: "one fraction gpu - gpu free but not enough cpu on node",
+			args: args{
+				fittingGPUsOnNode: []string{pod_info.WholeGpuIndicator},
+				node: node_info.NewNodeInfo(&v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "n1",
+						Annotations: map[string]string{},
+					},
+					Spec: v1.NodeSpec{},
+					Status: v1.NodeStatus{
+						Allocatable: map[v1.ResourceName]resource.Quantity{
+							v1.ResourceCPU:    resource.MustParse("4"),
+							v1.ResourceMemory: resource.MustParse("10G"),
+							"nvidia.com/gpu":  resource.MustParse("1"),
+						},
+					},
+				}, nil),
+				nodeSharingInfo: nil,
+				pod: pod_info.NewTaskInfo(&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "p1",
+						Annotations: map[string]string{
+							commonconstants.PodGroupAnnotationForPod: "pg1",
+							commonconstants.GpuFraction:              "0.5",
+						},
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "c1",
+								Resources: v1.ResourceRequirements{
+									Requests: v1.ResourceList{
+										v1.ResourceCPU: resource.MustParse("8"),
+									},
+								},
+							},
+						},
+					},
+				}),
+				isPipelineOnly: false,
+			},
+			want: want{
+				groupLength: 0,
+				fitError:    true,
+			},
+		},
+		{
+			name: "one fraction gpu - cpu on node free only once releasing pods terminate",
+			args: args{
+				fittingGPUsOnNode: []string{pod_info.WholeGpuIndicator},
+				node: withReleasingCPU(node_info.NewNodeInfo(&v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "n1",
+						Annotations: map[string]string{},
+					},
+					Spec: v1.NodeSpec{},
+					Status: v1.NodeStatus{
+						Allocatable: map[v1.ResourceName]resource.Quantity{
+							v1.ResourceCPU:    resource.MustParse("4"),
+							v1.ResourceMemory: resource.MustParse("10G"),
+							"nvidia.com/gpu":  resource.MustParse("1"),
+						},
+					},
+				}, nil), 3000),
+				nodeSharingInfo: nil,
+				pod: pod_info.NewTaskInfo(&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "p1",
+						Annotations: map[string]string{
+							commonconstants.PodGroupAnnotationForPod: "pg1",
+							commonconstants.GpuFraction:              "0.5",
+						},
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "c1",
+								Resources: v1.ResourceRequirements{
+									Requests: v1.ResourceList{
+										v1.ResourceCPU: resource.MustParse("2"),
+									},
+								},
+							},
+						},
+					},
+				}),
+				isPipelineOnly: false,
+			},
+			want: want{
+				groupLength: 1,
+				isReleasing: true,
+			},
+		},
 		{
 			name: "one fraction gpu - half gpu free on node",
 			args: args{
@@ -236,8 +341,12 @@ func Test_getNodePreferableGpuForSharing(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gpusForSharing := getNodePreferableGpuForSharing(
+			gpusForSharing, fitError := getNodePreferableGpuForSharing(&framework.Session{},
 				tt.args.fittingGPUsOnNode, tt.args.node, tt.args.pod, tt.args.isPipelineOnly)
+			if (fitError != nil) != tt.want.fitError {
+				t.Errorf("getNodePreferableGpuForSharing() fit error = %v, want fit error %v",
+					fitError, tt.want.fitError)
+			}
 
 			if gpusForSharing == nil {
 				if tt.want.groupLength > 0 {
@@ -266,3 +375,395 @@ func Test_getNodePreferableGpuForSharing(t *testing.T) {
 		})
 	}
 }
+
+func Test_getNodePreferableGpuForSharing_GpuMemorySplit(t *testing.T) {
+	tests := []struct {
+		name           string
+		podAnnotations map[string]string
+		isPipelineOnly bool
+		releasingCPU   bool
+		excludedGpu    string
+		expectedGroups []string
+		expectedSplit  map[string]int64
+	}{
+		{
+			name: "splittable pod spans two gpus",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "900",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectedGroups: []string{"group-a", "group-b"},
+			expectedSplit:  map[string]int64{"group-a": 600, "group-b": 300},
+		},
+		{
+			name:           "not splittable pod is rejected",
+			podAnnotations: map[string]string{commonconstants.GpuMemory: "900"},
+		},
+		{
+			name: "splittable pod that fits a single gpu is not split",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "500",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			expectedGroups: []string{"group-a"},
+		},
+		{
+			name: "pipelined splittable pod is not split",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "900",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			isPipelineOnly: true,
+		},
+		{
+			name: "splittable pod with cpu free only once releasing pods terminate is not split",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "900",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			releasingCPU: true,
+		},
+		{
+			name: "splittable pod is not split onto a gpu excluded by a gpu filter",
+			podAnnotations: map[string]string{
+				commonconstants.GpuMemory:           "900",
+				commonconstants.SplittableGpuMemory: "true",
+			},
+			excludedGpu: "group-b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := node_info.NewNodeInfo(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+				Status: v1.NodeStatus{
+					Allocatable: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceCPU:    resource.MustParse("4"),
+						v1.ResourceMemory: resource.MustParse("10G"),
+						"nvidia.com/gpu":  resource.MustParse("2"),
+					},
+				},
+			}, nil)
+			node.MemoryOfEveryGpuOnNode = 1000
+			for gpuGroup, allocatedMemory := range map[string]int64{"group-a": 400, "group-b": 600} {
+				node.UsedSharedGPUsMemory[gpuGroup] = allocatedMemory
+				node.AllocatedSharedGPUsMemory[gpuGroup] = allocatedMemory
+			}
+			node.Idle.SubGPUs(2)
+			container := v1.Container{Name: "c1"}
+			if tt.releasingCPU {
+				withReleasingCPU(node, node.Idle.Cpu())
+				container.Resources.Requests = v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}
+			}
+
+			pod := pod_info.NewTaskInfo(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Annotations: tt.podAnnotations},
+				Spec:       v1.PodSpec{Containers: []v1.Container{container}},
+			})
+			var fittingGPUs []string
+			for _, gpuGroup := range []string{"group-a", "group-b"} {
+				if node.IsTaskFitOnGpuGroup(pod.ResReq, gpuGroup) {
+					fittingGPUs = append(fittingGPUs, gpuGroup)
+				}
+			}
+
+			ssn := &framework.Session{}
+			if tt.excludedGpu != "" {
+				ssn.AddGpuFilterFn(func(_ *pod_info.PodInfo, _ *node_info.NodeInfo, gpuIdx string) bool {
+					return gpuIdx != tt.excludedGpu
+				})
+			}
+			gpusForSharing, fitError := getNodePreferableGpuForSharing(
+				ssn, fittingGPUs, node, pod, tt.isPipelineOnly)
+			if fitError != nil {
+				t.Fatalf("getNodePreferableGpuForSharing() unexpected fit error %v", fitError)
+			}
+			if tt.expectedGroups == nil {
+				if gpusForSharing != nil {
+					t.Errorf("getNodePreferableGpuForSharing() = %v, expected no gpus", gpusForSharing.Groups)
+				}
+				return
+			}
+			if gpusForSharing == nil {
+				t.Fatalf("getNodePreferableGpuForSharing() couldn't find any gpus, expected %v", tt.expectedGroups)
+			}
+			if !slices.Equal(gpusForSharing.Groups, tt.expectedGroups) {
+				t.Errorf("getNodePreferableGpuForSharing() groups %v, expected %v",
+					gpusForSharing.Groups, tt.expectedGroups)
+			}
+			if !reflect.DeepEqual(gpusForSharing.GpuMemorySplit, tt.expectedSplit) {
+				t.Errorf("getNodePreferableGpuForSharing() gpu memory split %v, expected %v",
+					gpusForSharing.GpuMemorySplit, tt.expectedSplit)
+			}
+		})
+	}
+}
+
+func Test_getNodePreferableGpuForSharing_NodePressure(t *testing.T) {
+	tests := []struct {
+		name             string
+		policy           conf.GpuSharingNodePressurePolicy
+		underPressure    bool
+		expectSharedGpu  bool
+		expectedFitError bool
+	}{
+		{
+			name:            "no pressure",
+			policy:          conf.GpuSharingNodePressureSharedGpus,
+			expectSharedGpu: true,
+		},
+		{
+			name:            "pressure ignored",
+			policy:          conf.GpuSharingNodePressureNone,
+			underPressure:   true,
+			expectSharedGpu: true,
+		},
+		{
+			name:          "pressure keeps pod off shared gpus",
+			policy:        conf.GpuSharingNodePressureSharedGpus,
+			underPressure: true,
+		},
+		{
+			name:             "pressure keeps pod off all gpus",
+			policy:           conf.GpuSharingNodePressureAllGpus,
+			underPressure:    true,
+			expectedFitError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditionStatus := v1.ConditionFalse
+			if tt.underPressure {
+				conditionStatus = v1.ConditionTrue
+			}
+			node := node_info.NewNodeInfo(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+				Status: v1.NodeStatus{
+					Allocatable: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceCPU:    resource.MustParse("4"),
+						v1.ResourceMemory: resource.MustParse("10G"),
+						"nvidia.com/gpu":  resource.MustParse("2"),
+					},
+					Conditions: []v1.NodeCondition{
+						{Type: v1.NodeMemoryPressure, Status: conditionStatus},
+					},
+				},
+			}, nil)
+			node.MemoryOfEveryGpuOnNode = 1000
+			node.UsedSharedGPUsMemory["group-a"] = 400
+			node.AllocatedSharedGPUsMemory["group-a"] = 400
+			node.Idle.SubGPUs(1)
+
+			pod := pod_info.NewTaskInfo(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "p1",
+					Annotations: map[string]string{commonconstants.GpuMemory: "300"},
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			})
+			ssn := &framework.Session{SchedulerParams: conf.SchedulerParams{GpuSharingNodePressurePolicy: tt.policy}}
+
+			gpusForSharing, fitError := getNodePreferableGpuForSharing(
+				ssn, []string{"group-a", pod_info.WholeGpuIndicator}, node, pod, false)
+			if (fitError != nil) != tt.expectedFitError {
+				t.Fatalf("getNodePreferableGpuForSharing() fit error = %v, want fit error %v",
+					fitError, tt.expectedFitError)
+			}
+			if tt.expectedFitError {
+				return
+			}
+			if gpusForSharing == nil || len(gpusForSharing.Groups) != 1 {
+				t.Fatalf("getNodePreferableGpuForSharing() = %v, expected a single gpu", gpusForSharing)
+			}
+			if isSharedGpu := gpusForSharing.Groups[0] == "group-a"; isSharedGpu != tt.expectSharedGpu {
+				t.Errorf("getNodePreferableGpuForSharing() groups %v, expected shared gpu %v",
+					gpusForSharing.Groups, tt.expectSharedGpu)
+			}
+		})
+	}
+}
+
+func Test_getNodePreferableGpuForSharing_BandwidthBudget(t *testing.T) {
+	tests := []struct {
+		name             string
+		budget           string
+		weight           string
+		expectSharedGpu  bool
+		expectedFitError bool
+	}{
+		{
+			name:            "unlimited budget",
+			weight:          "8",
+			expectSharedGpu: true,
+		},
+		{
+			name:            "within budget",
+			budget:          "10",
+			weight:          "6",
+			expectSharedGpu: true,
+		},
+		{
+			name:   "over budget of shared gpu",
+			budget: "10",
+			weight: "7",
+		},
+		{
+			name:             "over budget of whole gpu",
+			budget:           "10",
+			weight:           "11",
+			expectedFitError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeAnnotations := map[string]string{}
+			if tt.budget != "" {
+				nodeAnnotations[commonconstants.GpuMemoryBandwidthBudget] = tt.budget
+			}
+			node := node_info.NewNodeInfo(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1", Annotations: nodeAnnotations},
+				Status: v1.NodeStatus{
+					Allocatable: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceCPU:    resource.MustParse("4"),
+						v1.ResourceMemory: resource.MustParse("10G"),
+						"nvidia.com/gpu":  resource.MustParse("2"),
+					},
+				},
+			}, nil)
+			node.MemoryOfEveryGpuOnNode = 1000
+			node.UsedSharedGPUsMemory["group-a"] = 400
+			node.AllocatedSharedGPUsMemory["group-a"] = 400
+			node.AllocatedSharedGPUsBandwidth["group-a"] = 4
+			node.Idle.SubGPUs(1)
+
+			pod := pod_info.NewTaskInfo(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+					Annotations: map[string]string{
+						commonconstants.GpuMemory:                "300",
+						commonconstants.GpuMemoryBandwidthWeight: tt.weight,
+					},
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			})
+
+			gpusForSharing, fitError := getNodePreferableGpuForSharing(
+				&framework.Session{}, []string{"group-a", pod_info.WholeGpuIndicator}, node, pod, false)
+			if (fitError != nil) != tt.expectedFitError {
+				t.Fatalf("getNodePreferableGpuForSharing() fit error = %v, want fit error %v",
+					fitError, tt.expectedFitError)
+			}
+			if tt.expectedFitError {
+				if !strings.Contains(fitError.Error(), "GPU memory bandwidth budget") {
+					t.Errorf("getNodePreferableGpuForSharing() fit error = %v, expected a bandwidth budget error", fitError)
+				}
+				return
+			}
+			if gpusForSharing == nil || len(gpusForSharing.Groups) != 1 {
+				t.Fatalf("getNodePreferableGpuForSharing() = %v, expected a single gpu", gpusForSharing)
+			}
+			if isSharedGpu := gpusForSharing.Groups[0] == "group-a"; isSharedGpu != tt.expectSharedGpu {
+				t.Errorf("getNodePreferableGpuForSharing() groups %v, expected shared gpu %v",
+					gpusForSharing.Groups, tt.expectSharedGpu)
+			}
+		})
+	}
+}
+
+func Test_preferLastGpuGroups(t *testing.T) {
+	tests := []struct {
+		name              string
+		fittingGPUsOnNode []string
+		lastGpus          *podgroup_info.LastGpus
+		expectedGPUs      []string
+	}{
+		{
+			name:              "no last gpus",
+			fittingGPUsOnNode: []string{"group-a", "group-b", pod_info.WholeGpuIndicator},
+			expectedGPUs:      []string{"group-a", "group-b", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "last gpu fits",
+			fittingGPUsOnNode: []string{"group-a", "group-b", pod_info.WholeGpuIndicator},
+			lastGpus:          &podgroup_info.LastGpus{NodeName: "n1", GpuIndexes: []int{1}},
+			expectedGPUs:      []string{"group-b", "group-a", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "last gpu does not fit anymore",
+			fittingGPUsOnNode: []string{"group-a", pod_info.WholeGpuIndicator},
+			lastGpus:          &podgroup_info.LastGpus{NodeName: "n1", GpuIndexes: []int{1}},
+			expectedGPUs:      []string{"group-a", pod_info.WholeGpuIndicator},
+		},
+		{
+			name:              "several last gpus keep their fitting order",
+			fittingGPUsOnNode: []string{"group-a", "group-b", "group-c", "group-d"},
+			lastGpus:          &podgroup_info.LastGpus{NodeName: "n1", GpuIndexes: []int{1, 3}},
+			expectedGPUs:      []string{"group-b", "group-d", "group-a", "group-c"},
+		},
+		{
+			name:              "last gpus on another node",
+			fittingGPUsOnNode: []string{"group-a", "group-b"},
+			lastGpus:          &podgroup_info.LastGpus{NodeName: "n2", GpuIndexes: []int{1}},
+			expectedGPUs:      []string{"group-a", "group-b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(gomock.NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).AnyTimes()
+			node := node_info.NewNodeInfo(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+				Status: v1.NodeStatus{
+					Allocatable: map[v1.ResourceName]resource.Quantity{
+						"nvidia.com/gpu": resource.MustParse("4"),
+					},
+				},
+			}, nodePodAffinityInfo)
+			for gpuIndex, gpuGroup := range []string{"group-a", "group-b", "group-c", "group-d"} {
+				reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-"+gpuGroup,
+					"n1", v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+					map[string]string{
+						commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+						commonconstants.GPUGroup:     gpuGroup,
+					},
+					map[string]string{commonconstants.ReservedGpuIndex: strconv.Itoa(gpuIndex)})
+				if err := node.AddTask(pod_info.NewTaskInfo(reservationPod)); err != nil {
+					t.Fatalf("failed to add the reservation pod of %s: %v", gpuGroup, err)
+				}
+			}
+			pod := pod_info.NewTaskInfo(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+					Annotations: map[string]string{
+						commonconstants.PodGroupAnnotationForPod: "pg1",
+						commonconstants.GpuFraction:              "0.5",
+					},
+				},
+			})
+			job := podgroup_info.NewPodGroupInfo(pod.Job)
+			if tt.lastGpus != nil {
+				job.SetLastGpus(pod.Name, *tt.lastGpus)
+			}
+			ssn := &framework.Session{PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+				pod.Job: job,
+			}}
+
+			gpus := preferLastGpuGroups(ssn, tt.fittingGPUsOnNode, node, pod)
+			if !slices.Equal(gpus, tt.expectedGPUs) {
+				t.Errorf("preferLastGpuGroups() = %v, expected %v", gpus, tt.expectedGPUs)
+			}
+		})
+	}
+}
+
+// withReleasingCPU moves milliCPU of the node's idle CPU to its releasing resources.
+func withReleasingCPU(node *node_info.NodeInfo, milliCPU float64) *node_info.NodeInfo {
+	releasing := resource_info.NewResource(milliCPU, 0, 0)
+	node.Idle.Sub(releasing)
+	node.Releasing.Add(releasing)
+	return node
+}
@@ -48,6 +48,9 @@ var (
 	scenariosSimulatedByAction  *prometheus.CounterVec
 	scenariosFilteredByAction   *prometheus.CounterVec
 	preemptionAttempts          prometheus.Counter
+	preemptionVictims           *prometheus.CounterVec
+	preemptionVictimRuntime     *prometheus.HistogramVec
+	preemptionVictimGpuMemory   *prometheus.CounterVec
 	queueFairShareCPU           *prometheus.GaugeVec
 	queueFairShareMemory        *prometheus.GaugeVec
 	queueFairShareGPU           *prometheus.GaugeVec
@@ -55,6 +58,18 @@ var (
 	queueMemoryUsage            *prometheus.GaugeVec
 	queueGPUUsage               *prometheus.GaugeVec
 	usageQueryLatency           *prometheus.HistogramVec
+	podsSkippedBySchedulerName  prometheus.Counter
+	podBindFailures             *prometheus.CounterVec
+	snapshotStaleness           prometheus.Gauge
+	staleSnapshotSkippedCycles  prometheus.Counter
+	nodeScoringBudgetExceeded   prometheus.Counter
+	stalePipelineReleases       prometheus.Counter
+	nodeGpuFragmentedMemory     *prometheus.GaugeVec
+	nodeGpuFragmentationRatio   *prometheus.GaugeVec
+	nodeGpus                    *prometheus.GaugeVec
+	gatedPods                   *prometheus.GaugeVec
+	pendingPods                 *prometheus.GaugeVec
+	queueLoans                  *prometheus.GaugeVec
 )
 
 func init() {
@@ -154,6 +169,75 @@ func InitMetrics(namespace string) {
 		},
 	)
 
+	preemptionVictims = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "preemption_victims",
+			Help:      "Total pods evicted to make room for a preemptor, by action, victim queue and victim priority bucket",
+		}, []string{"action", "queue", "priority"})
+
+	preemptionVictimRuntime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "preemption_victim_runtime_seconds",
+			Help:      "Time that the pod groups of pods evicted to make room for a preemptor ran before the eviction, in seconds",
+			Buckets:   prometheus.ExponentialBuckets(60, 4, 8),
+		}, []string{"action", "priority"})
+
+	preemptionVictimGpuMemory = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "preemption_victim_gpu_memory_freed_mib",
+			Help:      "Total GPU memory, in MiB, freed by pods evicted to make room for a preemptor, by action and victim queue",
+		}, []string{"action", "queue"})
+
+	podsSkippedBySchedulerName = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pods_skipped_by_scheduler_name",
+			Help:      "Total pods left out of the scheduling snapshot because their schedulerName belongs to another scheduler",
+		},
+	)
+
+	podBindFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pod_bind_failures",
+			Help:      "Total failed pod binds, by the reason returned by the API server",
+		}, []string{"reason"})
+
+	snapshotStaleness = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "snapshot_staleness_seconds",
+			Help:      "Time since the scheduler cache last observed an update from the API server, as of the last snapshot",
+		},
+	)
+
+	staleSnapshotSkippedCycles = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stale_snapshot_skipped_cycles",
+			Help:      "Total scheduling cycles skipped because the cache snapshot was staler than the configured threshold",
+		},
+	)
+
+	nodeScoringBudgetExceeded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "node_scoring_budget_exceeded",
+			Help:      "Total tasks whose node scoring was cut short because it exceeded the node scoring budget",
+		},
+	)
+
+	stalePipelineReleases = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stale_pipeline_releases",
+			Help:      "Total pipelines of pods released because they did not progress to allocation within the configured number of cycles",
+		},
+	)
+
 	queueFairShareCPU = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -192,6 +276,46 @@ func InitMetrics(namespace string) {
 			Help:      "GPU usage of queue, as a gauge. Units depend on UsageDB configuration",
 		}, []string{"queue_name"})
 
+	nodeGpuFragmentedMemory = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "node_gpu_fragmented_memory_mib",
+			Help:      "Free memory of the shared GPUs of a node that cannot host the median fractional GPU request, in MiB",
+		}, []string{"node"})
+	nodeGpuFragmentationRatio = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "node_gpu_fragmentation_ratio",
+			Help:      "Fraction of the free GPU memory of a node that cannot host the median fractional GPU request",
+		}, []string{"node"})
+	nodeGpus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "node_gpus",
+			Help:      "Number of GPUs of a node used by whole GPU pods and by shared GPU pods, by the allocation label",
+		}, []string{"node", "allocation"})
+
+	gatedPods = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gated_pods",
+			Help:      "Number of pending pods of a queue that are held back by scheduling gates",
+		}, []string{"queue_name"})
+
+	pendingPods = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "pending_pods",
+			Help:      "Number of pending pods of a queue, by whether they are blocked by the queue's quota or by insufficient cluster capacity",
+		}, []string{"queue_name", "cause"})
+
+	queueLoans = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_loans",
+			Help:      "Deserved quota of a lender queue used by a sibling borrower queue, by resource. CPU is in cores, memory in GB and GPU in devices",
+		}, []string{"lender_queue", "borrower_queue", "resource"})
+
 	usageQueryLatency = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -286,6 +410,47 @@ func ResetQueueUsage() {
 	queueGPUUsage.Reset()
 }
 
+// UpdateNodeGpuFragmentation updates the GPU fragmentation and the whole and shared GPU counts of a node
+func UpdateNodeGpuFragmentation(nodeName string, fragmentedMemory, fragmentationRatio, wholeGpus, sharedGpus float64) {
+	nodeGpuFragmentedMemory.WithLabelValues(nodeName).Set(fragmentedMemory)
+	nodeGpuFragmentationRatio.WithLabelValues(nodeName).Set(fragmentationRatio)
+	nodeGpus.WithLabelValues(nodeName, "whole").Set(wholeGpus)
+	nodeGpus.WithLabelValues(nodeName, "shared").Set(sharedGpus)
+}
+
+func ResetNodeGpuFragmentation() {
+	nodeGpuFragmentedMemory.Reset()
+	nodeGpuFragmentationRatio.Reset()
+	nodeGpus.Reset()
+}
+
+// UpdateGatedPods updates the number of pods of a queue that are held back by scheduling gates
+func UpdateGatedPods(queueName string, count int) {
+	gatedPods.WithLabelValues(queueName).Set(float64(count))
+}
+
+func ResetGatedPods() {
+	gatedPods.Reset()
+}
+
+// UpdatePendingPods updates the number of pending pods of a queue with the given pending cause
+func UpdatePendingPods(queueName, cause string, count int) {
+	pendingPods.WithLabelValues(queueName, cause).Set(float64(count))
+}
+
+func ResetPendingPods() {
+	pendingPods.Reset()
+}
+
+// UpdateQueueLoan updates the quota of a resource that a lender queue lends to a sibling borrower queue
+func UpdateQueueLoan(lenderQueue, borrowerQueue, resource string, amount float64) {
+	queueLoans.WithLabelValues(lenderQueue, borrowerQueue, resource).Set(amount)
+}
+
+func ResetQueueLoans() {
+	queueLoans.Reset()
+}
+
 func UpdateUsageQueryLatency(latency time.Duration) {
 	usageQueryLatency.WithLabelValues().Observe(float64(latency.Milliseconds()))
 }
@@ -295,6 +460,47 @@ func RegisterPreemptionAttempts() {
 	preemptionAttempts.Inc()
 }
 
+// RecordPreemptionVictim records a pod evicted to make room for a preemptor. A negative runtime is not observed.
+func RecordPreemptionVictim(action, queue, priorityBucket string, runtime time.Duration, gpuMemoryMiB int64) {
+	preemptionVictims.WithLabelValues(action, queue, priorityBucket).Inc()
+	if runtime >= 0 {
+		preemptionVictimRuntime.WithLabelValues(action, priorityBucket).Observe(runtime.Seconds())
+	}
+	if gpuMemoryMiB > 0 {
+		preemptionVictimGpuMemory.WithLabelValues(action, queue).Add(float64(gpuMemoryMiB))
+	}
+}
+
+// AddPodsSkippedBySchedulerName records pods left out of a snapshot due to a scheduler name mismatch
+func AddPodsSkippedBySchedulerName(count int) {
+	podsSkippedBySchedulerName.Add(float64(count))
+}
+
+// RecordPodBindFailure records a failed pod bind by its reason
+func RecordPodBindFailure(reason string) {
+	podBindFailures.WithLabelValues(reason).Inc()
+}
+
+// UpdateSnapshotStaleness records how long ago the cache last observed an update, as of the last snapshot
+func UpdateSnapshotStaleness(staleness time.Duration) {
+	snapshotStaleness.Set(staleness.Seconds())
+}
+
+// IncStaleSnapshotSkippedCycles records a scheduling cycle skipped due to a stale snapshot
+func IncStaleSnapshotSkippedCycles() {
+	staleSnapshotSkippedCycles.Inc()
+}
+
+// IncNodeScoringBudgetExceeded records a task whose node scoring exceeded the node scoring budget
+func IncNodeScoringBudgetExceeded() {
+	nodeScoringBudgetExceeded.Inc()
+}
+
+// IncStalePipelineReleases records a pipeline of a pod released because it did not progress to allocation in time
+func IncStalePipelineReleases() {
+	stalePipelineReleases.Inc()
+}
+
 // Duration get the time since specified start
 func Duration(start time.Time) time.Duration {
 	return time.Since(start)
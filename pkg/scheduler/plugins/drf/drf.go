@@ -0,0 +1,371 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package drf
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	ppolicy "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/preemption_policy"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/scheduler_util"
+)
+
+const (
+	pluginName = "drf"
+	mebibytes  = 1000 * 1000
+)
+
+type queueShare struct {
+	queue                   *queue_info.QueueInfo
+	allocated               resources
+	allocatedNonPreemptible resources
+	request                 resources
+	fairShare               resources
+}
+
+// drfPlugin divides the cluster between queues by hierarchical dominant resource fairness. A queue's dominant share
+// is its largest share of the cluster in any of CPU, memory, GPUs and GPU memory, so jobs that are heavy in different
+// resources are compared by the resource they stress most. The fair share of each queue is computed by progressive
+// filling, level by level down the queue hierarchy. The plugin is an alternative to the proportion plugin, and the two
+// should not be enabled together: it provides the queue order, fair share, capacity and reclaim functions on its own.
+type drfPlugin struct {
+	totalResources  resources
+	gpuMemoryPerGpu float64
+	nodes           map[string]*node_info.NodeInfo
+	queues          map[common_info.QueueID]*queueShare
+	subGroupOrderFn common_info.LessFn
+	taskOrderFn     common_info.LessFn
+
+	preemptionPolicy       *ppolicy.PreemptionPolicy
+	solutionStartAllocated map[common_info.QueueID]resources
+	isCrossPartitionJob    func(job *podgroup_info.PodGroupInfo) bool
+}
+
+func New(_ map[string]string) framework.Plugin {
+	return &drfPlugin{}
+}
+
+func (dp *drfPlugin) Name() string {
+	return pluginName
+}
+
+func (dp *drfPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{
+		framework.QueueOrderFnName,
+		framework.GetQueueFairShareFnName,
+		framework.GetQueueDeservedResourcesFnName,
+		framework.GetQueueAllocatedResourcesFnName,
+		framework.CanReclaimResourcesFnName,
+		framework.ReclaimScenarioValidatorFnName,
+		framework.ReclaimVictimFilterFnName,
+		framework.PreemptVictimFilterFnName,
+		framework.OnJobSolutionStartFnName,
+		framework.EventHandlerFnName,
+	}
+}
+
+func (dp *drfPlugin) OnSessionOpen(ssn *framework.Session) {
+	dp.subGroupOrderFn = ssn.SubGroupOrderFn
+	dp.taskOrderFn = ssn.TaskOrderFn
+	dp.nodes = ssn.Nodes
+	dp.preemptionPolicy = ppolicy.New(ssn.Queues)
+	dp.isCrossPartitionJob = ssn.IsCrossPartitionJob
+	dp.setTotalResources(ssn)
+	dp.createQueueShares(ssn)
+	dp.setFairShare()
+	log.InfraLogger.V(3).Infof("DRF total resources are <%s>, number of queues: <%d>",
+		dp.totalResources, len(dp.queues))
+
+	ssn.AddQueueOrderFn(dp.queueOrder)
+	ssn.AddIsJobOverCapacityFn(dp.isJobOverCapacity)
+	ssn.AddGetQueueFairShareFn(dp.getQueueFairShare)
+	ssn.AddGetQueueDeservedResourcesFn(dp.getQueueDeservedResources)
+	ssn.AddGetQueueAllocatedResourcesFn(dp.getQueueAllocatedResources)
+	ssn.AddCanReclaimResourcesFn(dp.canReclaimResources)
+	ssn.AddReclaimScenarioValidatorFn(dp.reclaimScenarioValidator)
+	ssn.AddReclaimVictimFilterFn(dp.preemptionPolicy.VictimFilter)
+	ssn.AddReclaimVictimFilterFn(dp.reclaimVictimFilter)
+	ssn.AddPreemptVictimFilterFn(dp.preemptionPolicy.VictimFilter)
+	ssn.AddOnJobSolutionStartFn(dp.onJobSolutionStart)
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc:   dp.allocateHandlerFn(ssn),
+		DeallocateFunc: dp.deallocateHandlerFn(ssn),
+	})
+}
+
+func (dp *drfPlugin) OnSessionClose(_ *framework.Session) {
+	dp.queues = nil
+	dp.nodes = nil
+	dp.preemptionPolicy = nil
+	dp.solutionStartAllocated = nil
+	dp.isCrossPartitionJob = nil
+}
+
+// setTotalResources sums the resources of the ready nodes of the session's partition. Nodes of other node pools, which
+// are in the session only for cross partition reclaim, are not divided between the queues of the partition.
+func (dp *drfPlugin) setTotalResources(ssn *framework.Session) {
+	dp.totalResources = resources{}
+	for _, node := range ssn.Nodes {
+		if ssn.IsCrossPartitionNode(node) {
+			continue
+		}
+		if !scheduler_util.ValidateIsNodeReady(node.Node) {
+			log.InfraLogger.V(2).Infof("Node <%v> is not ready, not counting its resources for DRF", node.Name)
+			continue
+		}
+		dp.totalResources = dp.totalResources.add(nodeResources(node))
+	}
+
+	dp.gpuMemoryPerGpu = 0
+	if dp.totalResources[gpuResource] > 0 {
+		dp.gpuMemoryPerGpu = dp.totalResources[gpuMemoryResource] / dp.totalResources[gpuResource]
+	}
+}
+
+// createQueueShares accounts the jobs of the session's partition to their queues. Jobs of other node pools are
+// accounted by the scheduler of their own partition.
+func (dp *drfPlugin) createQueueShares(ssn *framework.Session) {
+	dp.queues = map[common_info.QueueID]*queueShare{}
+	for _, queue := range ssn.Queues {
+		dp.queues[queue.UID] = &queueShare{queue: queue}
+	}
+
+	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
+		for status, tasks := range job.PodStatusIndex {
+			for _, task := range tasks {
+				if pod_status.AllocatedStatus(status) {
+					taskResources := dp.allocatedTaskResources(task)
+					dp.updateAllocated(job, taskResources)
+					dp.forEachQueueUp(job.Queue, func(share *queueShare) {
+						share.request = share.request.add(taskResources)
+					})
+				} else if status == pod_status.Pending {
+					taskResources := requirementResources(task.ResReq, dp.gpuMemoryPerGpu)
+					dp.forEachQueueUp(job.Queue, func(share *queueShare) {
+						share.request = share.request.add(taskResources)
+					})
+				}
+			}
+		}
+	}
+}
+
+func (dp *drfPlugin) setFairShare() {
+	var topQueues []*queueShare
+	for _, share := range dp.queues {
+		if _, found := dp.queues[share.queue.ParentQueue]; !found {
+			topQueues = append(topQueues, share)
+		}
+	}
+	dp.setFairShareForQueues(dp.totalResources, topQueues)
+}
+
+func (dp *drfPlugin) setFairShareForQueues(capacity resources, queues []*queueShare) {
+	divideFairShare(capacity, dp.totalResources, queues)
+	for _, share := range queues {
+		var childQueues []*queueShare
+		for _, childQueueID := range share.queue.ChildQueues {
+			if childShare, found := dp.queues[childQueueID]; found {
+				childQueues = append(childQueues, childShare)
+			}
+		}
+		if len(childQueues) > 0 {
+			dp.setFairShareForQueues(share.fairShare, childQueues)
+		}
+		log.InfraLogger.V(5).Infof("DRF fair share of queue <%s> is <%s>, dominant share: <%v>",
+			share.queue.Name, share.fairShare, share.fairShare.dominantShare(dp.totalResources))
+	}
+}
+
+// forEachQueueUp calls fn for the queue and each of its ancestors.
+func (dp *drfPlugin) forEachQueueUp(queueID common_info.QueueID, fn func(*queueShare)) {
+	for share, found := dp.queues[queueID]; found; share, found = dp.queues[share.queue.ParentQueue] {
+		fn(share)
+	}
+}
+
+func (dp *drfPlugin) updateAllocated(job *podgroup_info.PodGroupInfo, taskResources resources) {
+	isPreemptible := job.IsPreemptibleJob()
+	dp.forEachQueueUp(job.Queue, func(share *queueShare) {
+		share.allocated = share.allocated.add(taskResources)
+		if !isPreemptible {
+			share.allocatedNonPreemptible = share.allocatedNonPreemptible.add(taskResources)
+		}
+	})
+}
+
+func (dp *drfPlugin) allocateHandlerFn(ssn *framework.Session) func(event *framework.Event) {
+	return func(event *framework.Event) {
+		job := ssn.PodGroupInfos[event.Task.Job]
+		if ssn.IsCrossPartitionJob(job) {
+			return
+		}
+		dp.updateAllocated(job, dp.allocatedTaskResources(event.Task))
+	}
+}
+
+func (dp *drfPlugin) deallocateHandlerFn(ssn *framework.Session) func(event *framework.Event) {
+	return func(event *framework.Event) {
+		job := ssn.PodGroupInfos[event.Task.Job]
+		if ssn.IsCrossPartitionJob(job) {
+			return
+		}
+		dp.updateAllocated(job, resources{}.sub(dp.allocatedTaskResources(event.Task)))
+	}
+}
+
+// queueOrder prioritizes the queue with the smaller dominant share, after allocating the queue's next job or
+// evicting its victims.
+func (dp *drfPlugin) queueOrder(lQ, rQ *queue_info.QueueInfo, lJob, rJob *podgroup_info.PodGroupInfo,
+	lVictims, rVictims []*podgroup_info.PodGroupInfo) int {
+	lShare, found := dp.queues[lQ.UID]
+	if !found {
+		log.InfraLogger.Errorf("Failed to find queue: <%v>", lQ.Name)
+		return 1
+	}
+	rShare, found := dp.queues[rQ.UID]
+	if !found {
+		log.InfraLogger.Errorf("Failed to find queue: <%v>", rQ.Name)
+		return -1
+	}
+
+	lDominantShare := dp.dominantShareWith(lShare, lJob, lVictims)
+	rDominantShare := dp.dominantShareWith(rShare, rJob, rVictims)
+	if lDominantShare < rDominantShare {
+		return -1
+	}
+	if lDominantShare > rDominantShare {
+		return 1
+	}
+	return 0
+}
+
+func (dp *drfPlugin) dominantShareWith(share *queueShare, job *podgroup_info.PodGroupInfo,
+	victims []*podgroup_info.PodGroupInfo) float64 {
+	allocated := share.allocated
+	if job != nil {
+		allocated = allocated.add(dp.tasksResources(
+			podgroup_info.GetTasksToAllocate(job, dp.subGroupOrderFn, dp.taskOrderFn, false)))
+	}
+	for _, victim := range victims {
+		allocated = allocated.sub(dp.jobAllocatedResources(victim))
+	}
+	return allocated.dominantShare(dp.totalResources)
+}
+
+func (dp *drfPlugin) tasksResources(tasks []*pod_info.PodInfo) resources {
+	var total resources
+	for _, task := range tasks {
+		total = total.add(requirementResources(task.ResReq, dp.gpuMemoryPerGpu))
+	}
+	return total
+}
+
+// allocatedTaskResources returns the resources of a task allocated to a node, counting the GPU memory of the node's
+// GPUs rather than the cluster average.
+func (dp *drfPlugin) allocatedTaskResources(task *pod_info.PodInfo) resources {
+	gpuMemoryPerGpu := dp.gpuMemoryPerGpu
+	if node, found := dp.nodes[task.NodeName]; found && node.MemoryOfEveryGpuOnNode > 0 {
+		gpuMemoryPerGpu = float64(node.MemoryOfEveryGpuOnNode)
+	}
+	return requirementResources(task.AcceptedResource, gpuMemoryPerGpu)
+}
+
+func (dp *drfPlugin) jobAllocatedResources(job *podgroup_info.PodGroupInfo) resources {
+	var allocated resources
+	for status, tasks := range job.PodStatusIndex {
+		if !pod_status.IsActiveAllocatedStatus(status) {
+			continue
+		}
+		for _, task := range tasks {
+			allocated = allocated.add(dp.allocatedTaskResources(task))
+		}
+	}
+	return allocated
+}
+
+// isJobOverCapacity rejects jobs that would exceed the limits of their queue or one of its ancestors, and
+// non-preemptible jobs that would raise the dominant share of non-preemptible usage above the queue's fair share.
+func (dp *drfPlugin) isJobOverCapacity(job *podgroup_info.PodGroupInfo,
+	tasksToAllocate []*pod_info.PodInfo) *api.SchedulableResult {
+	requested := dp.tasksResources(tasksToAllocate)
+	for share, found := dp.queues[job.Queue]; found; share, found = dp.queues[share.queue.ParentQueue] {
+		if result := dp.overLimitResult(share, requested); result != nil {
+			log.InfraLogger.V(5).Infof("Job: <%v/%v> is over capacity. Reason: %v",
+				job.Namespace, job.Name, result.Message)
+			return result
+		}
+		if job.IsPreemptibleJob() {
+			continue
+		}
+		nonPreemptibleShare := share.allocatedNonPreemptible.add(requested).dominantShare(dp.totalResources)
+		fairShare := share.fairShare.dominantShare(dp.totalResources)
+		if nonPreemptibleShare > fairShare+exhaustedResourceShare {
+			result := &api.SchedulableResult{
+				IsSchedulable: false,
+				Reason:        v2alpha2.NonPreemptibleOverQuota,
+				Message: fmt.Sprintf("Non-preemptible workload is over the DRF fair share of %s. "+
+					"Its dominant resource share would be %.3f, while the fair share is %.3f. "+
+					"Use a preemptible workload to go over the fair share.",
+					share.queue.Name, nonPreemptibleShare, fairShare),
+			}
+			log.InfraLogger.V(5).Infof("Job: <%v/%v> is over capacity. Reason: %v",
+				job.Namespace, job.Name, result.Message)
+			return result
+		}
+	}
+
+	return &api.SchedulableResult{IsSchedulable: true}
+}
+
+func (dp *drfPlugin) overLimitResult(share *queueShare, requested resources) *api.SchedulableResult {
+	quota := share.queue.Resources
+	limits := []struct {
+		name     string
+		resource resourceName
+		limit    float64
+		unit     float64
+	}{
+		{api.CpuResource, cpuResource, quota.CPU.Limit, 1},
+		{api.MemoryResource, memoryResource, quota.Memory.Limit, mebibytes},
+		{api.GpuResource, gpuResource, quota.GPU.Limit, 1},
+	}
+	for _, limit := range limits {
+		if limit.limit == commonconstants.UnlimitedResourceQuantity || requested[limit.resource] == 0 {
+			continue
+		}
+		maxAllowed := limit.limit * limit.unit
+		if share.allocated[limit.resource]+requested[limit.resource] > maxAllowed {
+			return &api.SchedulableResult{
+				IsSchedulable: false,
+				Reason:        v2alpha2.OverLimit,
+				Message: api.GetJobOverMaxAllowedMessageForQueue(share.queue.Name, limit.name, maxAllowed,
+					share.allocated[limit.resource], requested[limit.resource]),
+			}
+		}
+	}
+	return nil
+}
+
+func (dp *drfPlugin) getQueueFairShare(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
+	share, found := dp.queues[queue.UID]
+	if !found {
+		return nil
+	}
+	return share.fairShare.toResourceRequirements()
+}
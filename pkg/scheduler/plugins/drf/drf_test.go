@@ -0,0 +1,459 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package drf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestDivideFairShare(t *testing.T) {
+	total := resources{cpuResource: 100, memoryResource: 100, gpuResource: 10, gpuMemoryResource: 100}
+
+	tests := []struct {
+		name       string
+		capacity   resources
+		requests   []resources
+		fairShares []resources
+	}{
+		{
+			name:     "gpu heavy and cpu heavy queues get equal dominant shares",
+			capacity: total,
+			requests: []resources{
+				{cpuResource: 20, gpuResource: 10, gpuMemoryResource: 100},
+				{cpuResource: 100, gpuResource: 2, gpuMemoryResource: 20},
+			},
+			fairShares: []resources{
+				{cpuResource: 50.0 / 3, gpuResource: 25.0 / 3, gpuMemoryResource: 250.0 / 3},
+				{cpuResource: 250.0 / 3, gpuResource: 5.0 / 3, gpuMemoryResource: 50.0 / 3},
+			},
+		},
+		{
+			name:     "small memory heavy queue gets its request and the rest is divided",
+			capacity: total,
+			requests: []resources{
+				{cpuResource: 20, gpuResource: 10, gpuMemoryResource: 100},
+				{cpuResource: 100, gpuResource: 2, gpuMemoryResource: 20},
+				{memoryResource: 50},
+			},
+			fairShares: []resources{
+				{cpuResource: 50.0 / 3, gpuResource: 25.0 / 3, gpuMemoryResource: 250.0 / 3},
+				{cpuResource: 250.0 / 3, gpuResource: 5.0 / 3, gpuMemoryResource: 50.0 / 3},
+				{memoryResource: 50},
+			},
+		},
+		{
+			name:     "queue keeps growing in resources that are not exhausted",
+			capacity: resources{cpuResource: 10, memoryResource: 100, gpuResource: 10, gpuMemoryResource: 100},
+			requests: []resources{
+				{cpuResource: 20, gpuResource: 4, gpuMemoryResource: 40},
+				{cpuResource: 20},
+			},
+			fairShares: []resources{
+				{cpuResource: 10.0 / 3, gpuResource: 4, gpuMemoryResource: 40},
+				{cpuResource: 20.0 / 3},
+			},
+		},
+		{
+			name:     "queue without a request gets nothing",
+			capacity: total,
+			requests: []resources{
+				{cpuResource: 20, gpuResource: 2},
+				{},
+			},
+			fairShares: []resources{
+				{cpuResource: 20, gpuResource: 2},
+				{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queues []*queueShare
+			for _, request := range tt.requests {
+				queues = append(queues, &queueShare{request: request})
+			}
+
+			divideFairShare(tt.capacity, total, queues)
+
+			for i, queue := range queues {
+				assert.InDeltaSlice(t, tt.fairShares[i][:], queue.fairShare[:], 1e-6, "queue %d", i)
+			}
+		})
+	}
+}
+
+func TestFairShareHierarchy(t *testing.T) {
+	ssn, plugin := newHierarchySession(t)
+
+	for queueID, share := range plugin.queues {
+		for i := range share.fairShare {
+			assert.LessOrEqual(t, share.fairShare[i], share.request[i]+1e-6,
+				"queue %s fair share is above its request", queueID)
+		}
+		var childrenFairShare resources
+		for _, childQueueID := range share.queue.ChildQueues {
+			childrenFairShare = childrenFairShare.add(plugin.queues[childQueueID].fairShare)
+		}
+		if len(share.queue.ChildQueues) > 0 {
+			for i := range share.fairShare {
+				assert.LessOrEqual(t, childrenFairShare[i], share.fairShare[i]+1e-6,
+					"children of queue %s are above its fair share", queueID)
+			}
+		}
+	}
+
+	expected := map[common_info.QueueID]struct {
+		milliCPU float64
+		gpus     float64
+	}{
+		"org":       {milliCPU: 32000, gpus: 8},
+		"research":  {milliCPU: 6400, gpus: 8},
+		"analytics": {milliCPU: 25600, gpus: 0},
+		"vision":    {milliCPU: 2618.18, gpus: 3},
+		"etl":       {milliCPU: 25600, gpus: 0},
+	}
+	for queueID, expectedFairShare := range expected {
+		fairShare := ssn.QueueFairShare(ssn.Queues[queueID])
+		assert.InDelta(t, expectedFairShare.milliCPU, fairShare.Cpu(), 1, "queue %s", queueID)
+		assert.InDelta(t, expectedFairShare.gpus, fairShare.GPUs(), 1e-6, "queue %s", queueID)
+	}
+
+	nlpFairShare := ssn.QueueFairShare(ssn.Queues["nlp"])
+	assert.InDelta(t, 5, nlpFairShare.GPUs(), 1e-6,
+		"nlp gets more GPUs than vision, whose GPUs have more memory")
+}
+
+func TestQueueOrder(t *testing.T) {
+	ssn, plugin := newHierarchySession(t)
+	visionJob := ssn.PodGroupInfos["vision-train"]
+	etlJob := ssn.PodGroupInfos["etl"]
+
+	tests := []struct {
+		name     string
+		lQueue   common_info.QueueID
+		rQueue   common_info.QueueID
+		lJob     *podgroup_info.PodGroupInfo
+		rJob     *podgroup_info.PodGroupInfo
+		lVictims []*podgroup_info.PodGroupInfo
+		expected int
+	}{
+		{
+			name:     "queue using the same GPUs count with less GPU memory goes first",
+			lQueue:   "nlp",
+			rQueue:   "vision",
+			expected: -1,
+		},
+		{
+			name:     "gpu heavy department goes before cpu heavy department with a larger dominant share",
+			lQueue:   "analytics",
+			rQueue:   "research",
+			lJob:     etlJob,
+			rJob:     visionJob,
+			expected: 1,
+		},
+		{
+			name:     "evicting victims lowers the dominant share",
+			lQueue:   "analytics",
+			rQueue:   "research",
+			lVictims: []*podgroup_info.PodGroupInfo{etlJob},
+			expected: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := plugin.queueOrder(ssn.Queues[tt.lQueue], ssn.Queues[tt.rQueue], tt.lJob, tt.rJob,
+				tt.lVictims, nil)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestIsJobOverCapacity(t *testing.T) {
+	tests := []struct {
+		name           string
+		job            *jobs_fake.TestJobBasic
+		expectedReason v2alpha2.UnschedulableReason
+	}{
+		{
+			name: "preemptible job may go over the fair share",
+			job: &jobs_fake.TestJobBasic{
+				Name: "etl-preemptible", QueueName: "etl", RequiredCPUsPerTask: 10,
+				Priority: constants.PriorityTrainNumber,
+				Tasks:    pendingTasks(3),
+			},
+		},
+		{
+			name: "non-preemptible job within the fair share",
+			job: &jobs_fake.TestJobBasic{
+				Name: "etl-build", QueueName: "etl", RequiredCPUsPerTask: 10,
+				Priority: constants.PriorityBuildNumber,
+				Tasks:    pendingTasks(1),
+			},
+		},
+		{
+			name: "non-preemptible job over the fair share",
+			job: &jobs_fake.TestJobBasic{
+				Name: "etl-build", QueueName: "etl", RequiredCPUsPerTask: 10,
+				Priority: constants.PriorityBuildNumber,
+				Tasks:    pendingTasks(3),
+			},
+			expectedReason: v2alpha2.NonPreemptibleOverQuota,
+		},
+		{
+			name: "job within the queue limit",
+			job: &jobs_fake.TestJobBasic{
+				Name: "vision-more", QueueName: "vision", RequiredGPUsPerTask: 1,
+				Priority: constants.PriorityTrainNumber,
+				Tasks:    pendingTasks(1),
+			},
+		},
+		{
+			name: "job over the queue limit",
+			job: &jobs_fake.TestJobBasic{
+				Name: "vision-more", QueueName: "vision", RequiredGPUsPerTask: 1,
+				Priority: constants.PriorityTrainNumber,
+				Tasks:    pendingTasks(2),
+			},
+			expectedReason: v2alpha2.OverLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, plugin := newHierarchySession(t)
+			jobs, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{tt.job})
+			job := jobs[common_info.PodGroupID(tt.job.Name)]
+			var tasks []*pod_info.PodInfo
+			for _, task := range job.GetAllPodsMap() {
+				tasks = append(tasks, task)
+			}
+
+			result := plugin.isJobOverCapacity(job, tasks)
+
+			assert.Equal(t, tt.expectedReason == "", result.IsSchedulable)
+			assert.Equal(t, tt.expectedReason, result.Reason)
+		})
+	}
+}
+
+func TestReclaim(t *testing.T) {
+	ssn, plugin := newHierarchySession(t)
+	for _, queueID := range []common_info.QueueID{"etl", "analytics"} {
+		plugin.queues[queueID].allocated[cpuResource] = 32000
+	}
+	plugin.onJobSolutionStart()
+
+	jobs, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{{
+		Name: "nlp-more", QueueName: "nlp", RequiredGPUsPerTask: 1, RequiredCPUsPerTask: 1,
+		Priority: constants.PriorityTrainNumber,
+		Tasks:    pendingTasks(1),
+	}})
+	reclaimer := jobs["nlp-more"]
+	etlJob := ssn.PodGroupInfos["etl"]
+	visionJob := ssn.PodGroupInfos["vision-train"]
+
+	assert.True(t, plugin.canReclaimResources(reclaimer))
+	assert.False(t, plugin.canReclaimResources(ssn.PodGroupInfos["nlp-train"]),
+		"nlp would be over its fair share with all its pending tasks")
+
+	assert.True(t, plugin.reclaimVictimFilter(reclaimer, etlJob), "analytics is over its fair share")
+	assert.False(t, plugin.reclaimVictimFilter(reclaimer, visionJob), "vision is within its fair share")
+
+	tests := []struct {
+		name     string
+		victims  []*podgroup_info.PodGroupInfo
+		expected bool
+	}{
+		{
+			name:     "victim side keeps a larger dominant share",
+			victims:  []*podgroup_info.PodGroupInfo{etlJob},
+			expected: true,
+		},
+		{
+			name:     "victim side would be left with a smaller dominant share",
+			victims:  []*podgroup_info.PodGroupInfo{visionJob},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenario := &reclaimScenario{preemptor: reclaimer, victims: map[common_info.PodGroupID]*api.VictimInfo{}}
+			for _, victim := range tt.victims {
+				var tasks []*pod_info.PodInfo
+				for _, task := range victim.GetAllPodsMap() {
+					if task.Status == pod_status.Running {
+						tasks = append(tasks, task)
+						break
+					}
+				}
+				scenario.victims[victim.UID] = &api.VictimInfo{Job: victim, Tasks: tasks}
+			}
+			assert.Equal(t, tt.expected, plugin.reclaimScenarioValidator(scenario))
+		})
+	}
+}
+
+func TestCrossPartitionResources(t *testing.T) {
+	const nodePoolLabelKey = "kai.scheduler/node-pool"
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{
+			Name: "etl", QueueName: "etl", RequiredCPUsPerTask: 4,
+			Priority: constants.PriorityTrainNumber,
+			Tasks:    []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "production-node"}},
+		},
+		{
+			Name: "batch-etl", QueueName: "etl", RequiredCPUsPerTask: 8,
+			Priority: constants.PriorityTrainNumber,
+			Tasks:    []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "batch-node"}},
+		},
+	})
+	jobsInfoMap["etl"].PodGroup.Labels = map[string]string{nodePoolLabelKey: "production"}
+	jobsInfoMap["batch-etl"].PodGroup.Labels = map[string]string{nodePoolLabelKey: "batch"}
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(map[string]nodes_fake.TestNodeBasic{
+		"production-node": {CPUMillis: 16, Labels: map[string]string{nodePoolLabelKey: "production"}},
+		"batch-node":      {CPUMillis: 16, Labels: map[string]string{nodePoolLabelKey: "batch"}},
+	}, tasksToNodeMap, nil)
+	ssn := &framework.Session{
+		Nodes:         nodesInfoMap,
+		PodGroupInfos: jobsInfoMap,
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"analytics": newQueue("analytics", "", "etl"),
+			"etl":       newQueue("etl", "analytics"),
+		},
+		SchedulerParams: conf.SchedulerParams{PartitionParams: &conf.SchedulingNodePoolParams{
+			NodePoolLabelKey:               nodePoolLabelKey,
+			NodePoolLabelValue:             "production",
+			CrossPartitionReclaimNodePools: []string{"batch"},
+		}},
+	}
+	plugin := New(nil).(*drfPlugin)
+	plugin.OnSessionOpen(ssn)
+
+	assert.Equal(t, 16000.0, plugin.totalResources[cpuResource])
+	assert.Equal(t, 4000.0, plugin.queues["etl"].allocated[cpuResource])
+	assert.Equal(t, 4000.0, plugin.queues["analytics"].allocated[cpuResource])
+
+	batchTask := jobsInfoMap["batch-etl"].GetAllPodsMap()["batch-etl-0"]
+	plugin.deallocateHandlerFn(ssn)(&framework.Event{Task: batchTask})
+	assert.Equal(t, 4000.0, plugin.queues["etl"].allocated[cpuResource])
+}
+
+type reclaimScenario struct {
+	preemptor *podgroup_info.PodGroupInfo
+	victims   map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (rs *reclaimScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return rs.preemptor
+}
+
+func (rs *reclaimScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return rs.victims
+}
+
+// newHierarchySession builds a session with a 3 level queue hierarchy: the org queue holds the research and
+// analytics departments. Research runs GPU jobs in the vision and nlp queues, where vision runs on nodes with larger
+// GPUs, and analytics runs CPU jobs in the etl queue.
+func newHierarchySession(t *testing.T) (*framework.Session, *drfPlugin) {
+	topology := nodes_fake.TestClusterTopology{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name: "vision-train", QueueName: "vision", RequiredGPUsPerTask: 1, RequiredCPUsPerTask: 1,
+				Priority: constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "a100"},
+					{State: pod_status.Running, NodeName: "a100"},
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name: "nlp-train", QueueName: "nlp", RequiredGPUsPerTask: 1, RequiredCPUsPerTask: 1,
+				Priority: constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "t4"},
+					{State: pod_status.Running, NodeName: "t4"},
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name: "etl", QueueName: "etl", RequiredCPUsPerTask: 10,
+				Priority: constants.PriorityTrainNumber,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Running, NodeName: "a100"},
+					{State: pod_status.Running, NodeName: "t4"},
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"a100": {GPUs: 4, GPUMemory: 80000, CPUMillis: 16},
+			"t4":   {GPUs: 4, GPUMemory: 16000, CPUMillis: 16},
+		},
+	}
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(topology.Jobs)
+	nodesInfoMap := nodes_fake.BuildNodesInfoMap(topology.Nodes, tasksToNodeMap, nil)
+
+	vision := newQueue("vision", "research")
+	vision.Resources.GPU.Limit = 3
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"org":       newQueue("org", "", "research", "analytics"),
+		"research":  newQueue("research", "org", "vision", "nlp"),
+		"analytics": newQueue("analytics", "org", "etl"),
+		"vision":    vision,
+		"nlp":       newQueue("nlp", "research"),
+		"etl":       newQueue("etl", "analytics"),
+	}
+
+	ssn := &framework.Session{Nodes: nodesInfoMap, PodGroupInfos: jobsInfoMap, Queues: queues}
+	plugin := New(nil).(*drfPlugin)
+	plugin.OnSessionOpen(ssn)
+	assert.Equal(t, 32000.0, plugin.totalResources[cpuResource])
+	assert.Equal(t, 4*80000.0+4*16000.0, plugin.totalResources[gpuMemoryResource])
+	return ssn, plugin
+}
+
+func newQueue(name, parent string, children ...string) *queue_info.QueueInfo {
+	unlimited := queue_info.ResourceQuota{Limit: commonconstants.UnlimitedResourceQuantity}
+	queue := &queue_info.QueueInfo{
+		UID:         common_info.QueueID(name),
+		Name:        name,
+		ParentQueue: common_info.QueueID(parent),
+		ChildQueues: []common_info.QueueID{},
+		Resources:   queue_info.QueueQuota{GPU: unlimited, CPU: unlimited, Memory: unlimited},
+	}
+	for _, child := range children {
+		queue.AddChildQueue(common_info.QueueID(child))
+	}
+	return queue
+}
+
+func pendingTasks(count int) []*tasks_fake.TestTaskBasic {
+	tasks := make([]*tasks_fake.TestTaskBasic, count)
+	for i := range tasks {
+		tasks[i] = &tasks_fake.TestTaskBasic{State: pod_status.Pending}
+	}
+	return tasks
+}
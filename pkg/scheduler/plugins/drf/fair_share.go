@@ -0,0 +1,99 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package drf
+
+import (
+	"math"
+)
+
+// exhaustedResourceShare is the share of the cluster below which a remaining resource or request is considered
+// exhausted, absorbing floating point leftovers.
+const exhaustedResourceShare = 1e-9
+
+type queueFilling struct {
+	queue *queueShare
+	// perShare is the amount of resources the queue receives for each unit of dominant share.
+	perShare resources
+	maxShare float64
+	share    float64
+}
+
+// divideFairShare divides capacity between sibling queues using DRF progressive filling. The dominant shares of all
+// queues grow at the same rate, each queue receiving resources in proportion to its request, until the queue's
+// request is met. A resource that runs out stops growing for every queue, while the queues keep growing in the other
+// resources they request, since a queue's request is the sum of many pods that do not all need the exhausted resource.
+func divideFairShare(capacity, total resources, queues []*queueShare) {
+	var active []*queueFilling
+	for _, queue := range queues {
+		queue.fairShare = resources{}
+		requestShare := queue.request.dominantShare(total)
+		if requestShare == 0 {
+			continue
+		}
+		active = append(active, &queueFilling{
+			queue:    queue,
+			perShare: queue.request.scale(1 / requestShare),
+			maxShare: requestShare,
+		})
+	}
+
+	remaining := capacity
+	var exhausted [numResources]bool
+	for i := range remaining {
+		exhausted[i] = remaining[i] <= total[i]*exhaustedResourceShare
+	}
+
+	for {
+		active = growingQueues(active, exhausted)
+		if len(active) == 0 {
+			return
+		}
+
+		var demand resources
+		step := math.Inf(1)
+		for _, filling := range active {
+			demand = demand.add(growth(filling.perShare, exhausted))
+			step = min(step, filling.maxShare-filling.share)
+		}
+		for i := range demand {
+			if demand[i] > 0 {
+				step = min(step, remaining[i]/demand[i])
+			}
+		}
+
+		for _, filling := range active {
+			filling.share += step
+			if filling.share >= filling.maxShare-exhaustedResourceShare {
+				filling.share = filling.maxShare
+			}
+			filling.queue.fairShare = filling.queue.fairShare.add(growth(filling.perShare, exhausted).scale(step))
+		}
+		remaining = remaining.sub(demand.scale(step))
+		for i := range remaining {
+			if demand[i] > 0 && remaining[i] <= total[i]*exhaustedResourceShare {
+				exhausted[i] = true
+			}
+		}
+	}
+}
+
+// growingQueues returns the queues whose request is not met yet and that request a resource that is not exhausted.
+func growingQueues(fillings []*queueFilling, exhausted [numResources]bool) []*queueFilling {
+	var growing []*queueFilling
+	for _, filling := range fillings {
+		if filling.share < filling.maxShare && growth(filling.perShare, exhausted) != (resources{}) {
+			growing = append(growing, filling)
+		}
+	}
+	return growing
+}
+
+func growth(perShare resources, exhausted [numResources]bool) resources {
+	for i := range perShare {
+		if exhausted[i] {
+			perShare[i] = 0
+		}
+	}
+	return perShare
+}
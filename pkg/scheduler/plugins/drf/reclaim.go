@@ -0,0 +1,149 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package drf
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// onJobSolutionStart keeps the usage of the queues before the reclaim scenarios of a job are simulated, since the
+// simulated evictions and allocations update the usage through the event handlers.
+func (dp *drfPlugin) onJobSolutionStart() {
+	dp.solutionStartAllocated = make(map[common_info.QueueID]resources, len(dp.queues))
+	for queueID, share := range dp.queues {
+		dp.solutionStartAllocated[queueID] = share.allocated
+	}
+}
+
+// canReclaimResources allows a job to reclaim when its queue policy allows it, and its queue and each of the queue's
+// ancestors stay within their DRF fair share with the job allocated.
+func (dp *drfPlugin) canReclaimResources(reclaimer *podgroup_info.PodGroupInfo) bool {
+	if !dp.preemptionPolicy.CanReclaimResources(reclaimer) {
+		return false
+	}
+	requested := dp.tasksResources(
+		podgroup_info.GetTasksToAllocate(reclaimer, dp.subGroupOrderFn, dp.taskOrderFn, false))
+	for share, found := dp.queues[reclaimer.Queue]; found; share, found = dp.queues[share.queue.ParentQueue] {
+		if !dp.isWithinFairShare(share, share.allocated.add(requested)) {
+			log.InfraLogger.V(5).Infof("Job: <%v/%v> cannot reclaim, queue <%s> would be over its DRF fair share",
+				reclaimer.Namespace, reclaimer.Name, share.queue.Name)
+			return false
+		}
+	}
+	return true
+}
+
+// reclaimVictimFilter allows victims whose queue is over its DRF fair share at every level of the hierarchy below
+// the closest ancestor it has in common with the reclaimer's queue.
+func (dp *drfPlugin) reclaimVictimFilter(reclaimer, victim *podgroup_info.PodGroupInfo) bool {
+	for _, share := range dp.queuesBelowCommonAncestor(victim.Queue, reclaimer.Queue) {
+		if dp.isWithinFairShare(share, share.allocated) {
+			return false
+		}
+	}
+	return true
+}
+
+// reclaimScenarioValidator accepts a reclaim scenario when the reclaimer's queues stay within their fair share with
+// the reclaimer allocated, and the victims do not leave their side of the hierarchy with a smaller dominant share
+// than the reclaimer's side, so reclaim moves resources towards the queue with the smaller dominant share and stops
+// before the two swap.
+func (dp *drfPlugin) reclaimScenarioValidator(scenario api.ScenarioInfo) bool {
+	reclaimer := scenario.GetPreemptor()
+	allocated := make(map[common_info.QueueID]resources, len(dp.solutionStartAllocated))
+	for queueID, queueAllocated := range dp.solutionStartAllocated {
+		allocated[queueID] = queueAllocated
+	}
+
+	requested := dp.tasksResources(
+		podgroup_info.GetTasksToAllocate(reclaimer, dp.subGroupOrderFn, dp.taskOrderFn, false))
+	dp.forEachQueueUp(reclaimer.Queue, func(share *queueShare) {
+		allocated[share.queue.UID] = allocated[share.queue.UID].add(requested)
+	})
+	for _, victim := range scenario.GetVictims() {
+		// Cross partition victims are not accounted to the queues of the partition, the reclaimer's queues are
+		// still required to stay within their fair share
+		if dp.isCrossPartitionJob != nil && dp.isCrossPartitionJob(victim.Job) {
+			continue
+		}
+		victimResources := dp.tasksAllocatedResources(victim)
+		dp.forEachQueueUp(victim.Job.Queue, func(share *queueShare) {
+			allocated[share.queue.UID] = allocated[share.queue.UID].sub(victimResources)
+		})
+	}
+
+	for share, found := dp.queues[reclaimer.Queue]; found; share, found = dp.queues[share.queue.ParentQueue] {
+		if !dp.isWithinFairShare(share, allocated[share.queue.UID]) {
+			return false
+		}
+	}
+
+	for _, victim := range scenario.GetVictims() {
+		if dp.isCrossPartitionJob != nil && dp.isCrossPartitionJob(victim.Job) {
+			continue
+		}
+		victimSide := dp.queuesBelowCommonAncestor(victim.Job.Queue, reclaimer.Queue)
+		reclaimerSide := dp.queuesBelowCommonAncestor(reclaimer.Queue, victim.Job.Queue)
+		if len(victimSide) == 0 || len(reclaimerSide) == 0 {
+			continue
+		}
+		victimTop := victimSide[len(victimSide)-1]
+		reclaimerTop := reclaimerSide[len(reclaimerSide)-1]
+		victimDominantShare := allocated[victimTop.queue.UID].dominantShare(dp.totalResources)
+		reclaimerDominantShare := allocated[reclaimerTop.queue.UID].dominantShare(dp.totalResources)
+		if victimDominantShare+exhaustedResourceShare < reclaimerDominantShare {
+			log.InfraLogger.V(5).Infof("Reclaim for job: <%v/%v> would leave queue <%s> with a smaller dominant "+
+				"share than queue <%s>: %.3f < %.3f", reclaimer.Namespace, reclaimer.Name, victimTop.queue.Name,
+				reclaimerTop.queue.Name, victimDominantShare, reclaimerDominantShare)
+			return false
+		}
+	}
+	return true
+}
+
+func (dp *drfPlugin) tasksAllocatedResources(victim *api.VictimInfo) resources {
+	var total resources
+	for _, task := range victim.Tasks {
+		total = total.add(dp.allocatedTaskResources(task))
+	}
+	return total
+}
+
+// queuesBelowCommonAncestor returns the queue and its ancestors, from the queue up, below the closest ancestor it
+// has in common with the other queue.
+func (dp *drfPlugin) queuesBelowCommonAncestor(queueID, otherID common_info.QueueID) []*queueShare {
+	otherAncestors := map[common_info.QueueID]bool{}
+	dp.forEachQueueUp(otherID, func(share *queueShare) {
+		otherAncestors[share.queue.UID] = true
+	})
+	var queues []*queueShare
+	for share, found := dp.queues[queueID]; found && !otherAncestors[share.queue.UID]; share, found =
+		dp.queues[share.queue.ParentQueue] {
+		queues = append(queues, share)
+	}
+	return queues
+}
+
+func (dp *drfPlugin) isWithinFairShare(share *queueShare, allocated resources) bool {
+	return allocated.dominantShare(dp.totalResources) <=
+		share.fairShare.dominantShare(dp.totalResources)+exhaustedResourceShare
+}
+
+// getQueueDeservedResources returns the fair share of the queue, since DRF has no deserved quota apart from it.
+func (dp *drfPlugin) getQueueDeservedResources(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
+	return dp.getQueueFairShare(queue)
+}
+
+func (dp *drfPlugin) getQueueAllocatedResources(queue *queue_info.QueueInfo) *resource_info.ResourceRequirements {
+	share, found := dp.queues[queue.UID]
+	if !found {
+		return nil
+	}
+	return share.allocated.toResourceRequirements()
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package drf
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+type resourceName int
+
+const (
+	cpuResource resourceName = iota
+	memoryResource
+	gpuResource
+	gpuMemoryResource
+	numResources
+)
+
+// resources holds a quantity for each dimension of the dominant share: CPU millicores, memory bytes, GPUs and GPU
+// memory MiB.
+type resources [numResources]float64
+
+func (r resources) add(other resources) resources {
+	for i := range r {
+		r[i] += other[i]
+	}
+	return r
+}
+
+func (r resources) sub(other resources) resources {
+	for i := range r {
+		r[i] -= other[i]
+	}
+	return r
+}
+
+func (r resources) scale(factor float64) resources {
+	for i := range r {
+		r[i] *= factor
+	}
+	return r
+}
+
+// dominantShare returns the largest share of total that r holds in any single resource.
+func (r resources) dominantShare(total resources) float64 {
+	share := 0.0
+	for i := range r {
+		if total[i] > 0 {
+			share = max(share, r[i]/total[i])
+		}
+	}
+	return share
+}
+
+func (r resources) toResourceRequirements() *resource_info.ResourceRequirements {
+	return resource_info.NewResourceRequirements(r[gpuResource], r[cpuResource], r[memoryResource])
+}
+
+func (r resources) String() string {
+	return fmt.Sprintf("CPU: %s (cores), memory: %s (GB), GPUs: %s, GPU memory: %s (MiB)",
+		resource_info.HumanizeResource(r[cpuResource], resource_info.MilliCPUToCores),
+		resource_info.HumanizeResource(r[memoryResource], resource_info.MemoryToGB),
+		resource_info.HumanizeResource(r[gpuResource], 1),
+		resource_info.HumanizeResource(r[gpuMemoryResource], 1))
+}
+
+func nodeResources(node *node_info.NodeInfo) resources {
+	gpus := node.Allocatable.GPUs()
+	return resources{
+		cpuResource:       node.Allocatable.Cpu(),
+		memoryResource:    node.Allocatable.Memory(),
+		gpuResource:       gpus,
+		gpuMemoryResource: gpus * float64(node.MemoryOfEveryGpuOnNode),
+	}
+}
+
+// requirementResources converts a pod request to resources. Requests by GPU memory are converted to GPUs and
+// requests by GPUs are converted to GPU memory using gpuMemoryPerGpu, so both dimensions reflect every request.
+// A whole GPU of a node with larger GPUs therefore weighs more in the GPU memory dimension.
+func requirementResources(req *resource_info.ResourceRequirements, gpuMemoryPerGpu float64) resources {
+	gpus := req.GetSumGPUs()
+	gpuMemory := gpus * gpuMemoryPerGpu
+	if req.GpuMemory() > 0 {
+		gpuMemory = float64(req.GpuMemory() * max(1, req.GetNumOfGpuDevices()))
+		if gpus == 0 && gpuMemoryPerGpu > 0 {
+			gpus = gpuMemory / gpuMemoryPerGpu
+		}
+	}
+	return resources{
+		cpuResource:       req.Cpu(),
+		memoryResource:    req.Memory(),
+		gpuResource:       gpus,
+		gpuMemoryResource: gpuMemory,
+	}
+}
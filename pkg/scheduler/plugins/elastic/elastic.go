@@ -4,6 +4,7 @@
 package elastic
 
 import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
@@ -23,6 +24,7 @@ func (pp *elasticPlugin) Name() string {
 
 func (pp *elasticPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddJobOrderFn(JobOrderFn)
+	ssn.AddPreemptScenarioValidatorFn(preemptScenarioValidatorFn)
 }
 
 func JobOrderFn(l, r interface{}) int {
@@ -67,4 +69,48 @@ func minAvailableState(pgi *podgroup_info.PodGroupInfo) (bool, bool, bool) {
 	return false, !exactlyAtMinAvailable, exactlyAtMinAvailable
 }
 
+// preemptScenarioValidatorFn rejects scenarios that evict more than the extras of the elastic jobs that are victims
+// only because their extras are preemptible, i.e. jobs that are not preemptible or have a priority that is not lower
+// than the preemptor's.
+func preemptScenarioValidatorFn(scenario api.ScenarioInfo) bool {
+	preemptor := scenario.GetPreemptor()
+	for _, victimInfo := range scenario.GetVictims() {
+		victim := victimInfo.Job
+		if !victim.ElasticExtrasPreemptible || !victim.HasElasticExtras() {
+			continue
+		}
+		if preemptor != nil && victim.IsPreemptibleJob() && victim.Priority < preemptor.Priority {
+			continue
+		}
+		if !keepsMinAvailable(victimInfo) {
+			return false
+		}
+	}
+	return true
+}
+
+// keepsMinAvailable returns true if every sub-group of the victim job keeps at least its minimum available of
+// active allocated tasks after the victim tasks are evicted.
+func keepsMinAvailable(victimInfo *api.VictimInfo) bool {
+	numVictimTasksPerSubGroup := map[string]int{}
+	for _, task := range victimInfo.Tasks {
+		subGroupName := podgroup_info.DefaultSubGroup
+		if task.SubGroupName != "" {
+			subGroupName = task.SubGroupName
+		}
+		numVictimTasksPerSubGroup[subGroupName]++
+	}
+
+	for subGroupName, numVictims := range numVictimTasksPerSubGroup {
+		subGroup, found := victimInfo.Job.GetSubGroups()[subGroupName]
+		if !found {
+			return false
+		}
+		if subGroup.GetNumActiveAllocatedTasks()-numVictims < int(subGroup.GetMinAvailable()) {
+			return false
+		}
+	}
+	return true
+}
+
 func (pp *elasticPlugin) OnSessionClose(_ *framework.Session) {}
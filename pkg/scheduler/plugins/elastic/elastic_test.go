@@ -4,14 +4,17 @@
 package elastic
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
@@ -533,3 +536,89 @@ func Test_elasticPlugin_OnSessionOpen(t *testing.T) {
 		})
 	}
 }
+
+type testScenario struct {
+	preemptor *podgroup_info.PodGroupInfo
+	victims   map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (s *testScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return s.preemptor
+}
+
+func (s *testScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+func Test_preemptScenarioValidatorFn(t *testing.T) {
+	tests := []struct {
+		name                     string
+		victimPriority           int32
+		elasticExtrasPreemptible bool
+		numVictimTasks           int
+		want                     bool
+	}{
+		{
+			name:                     "extras of a higher priority elastic job",
+			victimPriority:           constants.PriorityBuildNumber,
+			elasticExtrasPreemptible: true,
+			numVictimTasks:           1,
+			want:                     true,
+		},
+		{
+			name:                     "minimum available of a higher priority elastic job",
+			victimPriority:           constants.PriorityBuildNumber,
+			elasticExtrasPreemptible: true,
+			numVictimTasks:           2,
+			want:                     false,
+		},
+		{
+			name:                     "minimum available of a lower priority elastic job",
+			victimPriority:           constants.PriorityTrainNumber - 1,
+			elasticExtrasPreemptible: true,
+			numVictimTasks:           2,
+			want:                     true,
+		},
+		{
+			name:           "job without preemptible extras",
+			victimPriority: constants.PriorityBuildNumber,
+			numVictimTasks: 2,
+			want:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			victim := &podgroup_info.PodGroupInfo{
+				UID:      "victim",
+				Priority: tt.victimPriority,
+				PodSets: map[string]*subgroup_info.PodSet{
+					podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 1, nil),
+				},
+				PodStatusIndex:           map[pod_status.PodStatus]pod_info.PodsMap{},
+				Allocated:                resource_info.EmptyResource(),
+				ElasticExtrasPreemptible: tt.elasticExtrasPreemptible,
+			}
+			victim.GetActiveAllocatedTasksCount()
+			var tasks []*pod_info.PodInfo
+			for i := 0; i < 2; i++ {
+				task := &pod_info.PodInfo{
+					UID:    common_info.PodID(fmt.Sprintf("victim-%d", i)),
+					Status: pod_status.Running,
+					ResReq: resource_info.EmptyResourceRequirements(),
+				}
+				victim.AddTaskInfo(task)
+				tasks = append(tasks, task)
+			}
+			scenario := &testScenario{
+				preemptor: &podgroup_info.PodGroupInfo{UID: "preemptor", Priority: constants.PriorityTrainNumber},
+				victims: map[common_info.PodGroupID]*api.VictimInfo{
+					victim.UID: {Job: victim, Tasks: tasks[:tt.numVictimTasks]},
+				},
+			}
+
+			if got := preemptScenarioValidatorFn(scenario); got != tt.want {
+				t.Errorf("preemptScenarioValidatorFn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,27 +21,45 @@ package plugins
 
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/drf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/dynamicresources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/elastic"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/fairsharedecay"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpubalance"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpuinterconnect"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpunuma"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpupack"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpusharingorder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpuspread"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gputhermal"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpuutilization"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/imagelocality"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/jobdependency"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/kubeflow"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/minruntime"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/modelcolocation"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeavailability"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeplacement"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/noisyneighbor"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nominatednode"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/pdb"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/podaffinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/predicates"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/preemptiongrace"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/priority"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/ray"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/reflectjoborder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/resourcetype"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/runtimeclass"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scratchdisk"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/snapshot"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/softtaints"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/subgrouporder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/taskorder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/taskspread"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/topology"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/webhookpredicate"
 )
 
 func InitDefaultPlugins() {
@@ -63,10 +81,28 @@ func InitDefaultPlugins() {
 	framework.RegisterPluginBuilder("subgrouporder", subgrouporder.New)
 	framework.RegisterPluginBuilder("dynamicresources", dynamicresources.New)
 	framework.RegisterPluginBuilder("topology", topology.New)
+	framework.RegisterPluginBuilder("imagelocality", imagelocality.New)
+	framework.RegisterPluginBuilder("softtaints", softtaints.New)
+	framework.RegisterPluginBuilder("gpuutilization", gpuutilization.New)
+	framework.RegisterPluginBuilder("gputhermal", gputhermal.New)
+	framework.RegisterPluginBuilder("taskspread", taskspread.New)
+	framework.RegisterPluginBuilder("webhookpredicate", webhookpredicate.New)
+	framework.RegisterPluginBuilder("modelcolocation", modelcolocation.New)
+	framework.RegisterPluginBuilder("jobdependency", jobdependency.New)
+	framework.RegisterPluginBuilder("gpubalance", gpubalance.New)
+	framework.RegisterPluginBuilder("gpuinterconnect", gpuinterconnect.New)
+	framework.RegisterPluginBuilder("gpunuma", gpunuma.New)
+	framework.RegisterPluginBuilder("runtimeclass", runtimeclass.New)
+	framework.RegisterPluginBuilder("scratchdisk", scratchdisk.New)
+	framework.RegisterPluginBuilder("noisyneighbor", noisyneighbor.New)
 
 	// Plugins for Queues
 	framework.RegisterPluginBuilder("proportion", proportion.New)
+	framework.RegisterPluginBuilder("drf", drf.New)
 	framework.RegisterPluginBuilder("minruntime", minruntime.New)
+	framework.RegisterPluginBuilder("preemptiongrace", preemptiongrace.New)
+	framework.RegisterPluginBuilder("pdb", pdb.New)
+	framework.RegisterPluginBuilder("fairsharedecay", fairsharedecay.New)
 
 	// Other Plugins
 	framework.RegisterPluginBuilder("snapshot", snapshot.New)
@@ -0,0 +1,120 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package fairsharedecay
+
+import (
+	"math"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName       = "fairsharedecay"
+	halfLifeArg      = "halfLife"
+	toleranceArg     = "tolerance"
+	defaultHalfLife  = time.Hour
+	defaultTolerance = 0.05
+)
+
+// fairShareDecayPlugin deprioritizes queues that used a large part of the cluster recently. The usage of the queues is
+// accumulated across sessions with an exponential decay of the configured half-life, and each queue is compared by its
+// dominant usage share: its largest share of the recent usage of any resource, including the usage of its child
+// queues. Queues whose shares differ by no more than the tolerance are left to the next queue order functions.
+type fairShareDecayPlugin struct {
+	halfLife    time.Duration
+	tolerance   float64
+	usageShares map[common_info.QueueID]float64
+	now         func() time.Time
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	halfLife := utils.ParseDurationArg(arguments, halfLifeArg, pluginName, defaultHalfLife, utils.Positive)
+
+	tolerance := utils.ParseFloatArg(arguments, toleranceArg, pluginName, defaultTolerance,
+		func(t float64) bool { return t >= 0 && t <= 1 })
+
+	return &fairShareDecayPlugin{
+		halfLife:  halfLife,
+		tolerance: tolerance,
+		now:       time.Now,
+	}
+}
+
+func (fsd *fairShareDecayPlugin) Name() string {
+	return pluginName
+}
+
+func (fsd *fairShareDecayPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{framework.QueueOrderFnName}
+}
+
+func (fsd *fairShareDecayPlugin) OnSessionOpen(ssn *framework.Session) {
+	usage := &ssn.ResourceUsage
+	if ssn.Cache != nil {
+		if decayedUsage := ssn.Cache.DecayedQueueUsage(); decayedUsage != nil {
+			usage = decayedUsage.Update(&ssn.ResourceUsage, fsd.now(), fsd.halfLife)
+		}
+	}
+	fsd.usageShares = getDominantUsageShares(ssn.Queues, usage)
+	log.InfraLogger.V(6).Infof("Decayed dominant usage shares of queues: <%v>", fsd.usageShares)
+
+	ssn.AddQueueOrderFn(fsd.queueOrder)
+}
+
+func (fsd *fairShareDecayPlugin) OnSessionClose(_ *framework.Session) {
+	fsd.usageShares = nil
+}
+
+func (fsd *fairShareDecayPlugin) queueOrder(lQ, rQ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo,
+	_, _ []*podgroup_info.PodGroupInfo) int {
+	lShare, rShare := fsd.usageShares[lQ.UID], fsd.usageShares[rQ.UID]
+	if math.Abs(lShare-rShare) <= fsd.tolerance {
+		return 0
+	}
+	if lShare < rShare {
+		return -1
+	}
+	return 1
+}
+
+// getDominantUsageShares returns the dominant usage share of every queue. The usage of leaf queues is summed up their
+// hierarchy, and the usage reported for parent queues is ignored so it is not counted twice.
+func getDominantUsageShares(queues map[common_info.QueueID]*queue_info.QueueInfo,
+	usage *queue_info.ClusterUsage) map[common_info.QueueID]float64 {
+	queuesUsage := map[common_info.QueueID]queue_info.QueueUsage{}
+	totalUsage := queue_info.QueueUsage{}
+	for queueID, leafUsage := range usage.Queues {
+		leafQueue, found := queues[queueID]
+		if !found || len(leafQueue.ChildQueues) > 0 {
+			continue
+		}
+		for resource, value := range leafUsage {
+			totalUsage[resource] += value
+		}
+		for queue := leafQueue; queue != nil; queue = queues[queue.ParentQueue] {
+			if _, found := queuesUsage[queue.UID]; !found {
+				queuesUsage[queue.UID] = queue_info.QueueUsage{}
+			}
+			for resource, value := range leafUsage {
+				queuesUsage[queue.UID][resource] += value
+			}
+		}
+	}
+
+	shares := map[common_info.QueueID]float64{}
+	for queueID, queueUsage := range queuesUsage {
+		for resource, value := range queueUsage {
+			if totalUsage[resource] > 0 {
+				shares[queueID] = max(shares[queueID], value/totalUsage[resource])
+			}
+		}
+	}
+	return shares
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package fairsharedecay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestGetDominantUsageShares(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"dep-a": {UID: "dep-a", ChildQueues: []common_info.QueueID{"a1", "a2"}},
+		"a1":    {UID: "a1", ParentQueue: "dep-a"},
+		"a2":    {UID: "a2", ParentQueue: "dep-a"},
+		"dep-b": {UID: "dep-b", ChildQueues: []common_info.QueueID{"b1"}},
+		"b1":    {UID: "b1", ParentQueue: "dep-b"},
+	}
+	usage := queue_info.NewClusterUsage()
+	usage.Queues["a1"] = queue_info.QueueUsage{commonconstants.GpuResource: 6, v1.ResourceCPU: 10}
+	usage.Queues["a2"] = queue_info.QueueUsage{commonconstants.GpuResource: 2, v1.ResourceCPU: 70}
+	usage.Queues["b1"] = queue_info.QueueUsage{commonconstants.GpuResource: 2, v1.ResourceCPU: 20}
+	usage.Queues["dep-a"] = queue_info.QueueUsage{commonconstants.GpuResource: 100}
+	usage.Queues["deleted"] = queue_info.QueueUsage{commonconstants.GpuResource: 100}
+
+	shares := getDominantUsageShares(queues, usage)
+	expected := map[common_info.QueueID]float64{
+		"dep-a": 0.8,
+		"a1":    0.6,
+		"a2":    0.7,
+		"dep-b": 0.2,
+		"b1":    0.2,
+	}
+	assert.Len(t, shares, len(expected))
+	for queueID, share := range expected {
+		assert.InDelta(t, share, shares[queueID], 1e-9, "queue %s", queueID)
+	}
+}
+
+func TestQueueOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		lShare    float64
+		rShare    float64
+		tolerance float64
+		expected  int
+	}{
+		{
+			name:     "lower recent usage first",
+			lShare:   0.2,
+			rShare:   0.6,
+			expected: -1,
+		},
+		{
+			name:     "higher recent usage last",
+			lShare:   0.6,
+			rShare:   0.2,
+			expected: 1,
+		},
+		{
+			name:      "differences within the tolerance are ignored",
+			lShare:    0.3,
+			rShare:    0.35,
+			tolerance: 0.1,
+			expected:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := &fairShareDecayPlugin{
+				tolerance:   tt.tolerance,
+				usageShares: map[common_info.QueueID]float64{"l": tt.lShare, "r": tt.rShare},
+			}
+			result := plugin.queueOrder(&queue_info.QueueInfo{UID: "l"}, &queue_info.QueueInfo{UID: "r"},
+				nil, nil, nil, nil)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestOnSessionOpenAccumulatesAcrossSessions(t *testing.T) {
+	controller := gomock.NewController(t)
+	decayedUsage := queue_info.NewDecayedUsage()
+	mockCache := cache.NewMockCache(controller)
+	mockCache.EXPECT().DecayedQueueUsage().Return(decayedUsage).AnyTimes()
+
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"q1": {UID: "q1"},
+		"q2": {UID: "q2"},
+	}
+	start := time.Now()
+	openSession := func(now time.Time, q1Usage, q2Usage float64) *fairShareDecayPlugin {
+		plugin := New(map[string]string{halfLifeArg: "1h"}).(*fairShareDecayPlugin)
+		plugin.now = func() time.Time { return now }
+		usage := queue_info.NewClusterUsage()
+		usage.Queues["q1"] = queue_info.QueueUsage{commonconstants.GpuResource: q1Usage}
+		usage.Queues["q2"] = queue_info.QueueUsage{commonconstants.GpuResource: q2Usage}
+		plugin.OnSessionOpen(&framework.Session{Cache: mockCache, Queues: queues, ResourceUsage: *usage})
+		return plugin
+	}
+
+	plugin := openSession(start, 8, 0)
+	assert.InDelta(t, 1, plugin.usageShares["q1"], 1e-9)
+
+	// Half of q1's earlier usage remains after a half-life, against half of q2's new usage.
+	plugin = openSession(start.Add(time.Hour), 0, 12)
+	assert.InDelta(t, 0.4, plugin.usageShares["q1"], 1e-9)
+	assert.InDelta(t, 0.6, plugin.usageShares["q2"], 1e-9)
+	assert.Equal(t, 1, plugin.queueOrder(queues["q2"], queues["q1"], nil, nil, nil, nil))
+}
+
+func TestNewInvalidArguments(t *testing.T) {
+	plugin := New(map[string]string{halfLifeArg: "-1h", toleranceArg: "2"}).(*fairShareDecayPlugin)
+	assert.Equal(t, defaultHalfLife, plugin.halfLife)
+	assert.Equal(t, defaultTolerance, plugin.tolerance)
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpubalance
+
+import (
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName = "gpubalance"
+)
+
+// gpuBalancePlugin prefers placing fractional pods on the GPU groups that keep the memory used on the GPUs of a node
+// balanced, instead of filling one GPU while another stays empty, so the pods of every GPU keep room to grow. The
+// imbalance is the difference between the most and least used GPUs available to GPU sharing after the placement, as
+// a portion of the memory of a GPU. GPUs used by whole GPU pods are not counted. Its scores outweigh the gpupack and
+// gpuspread scores.
+type gpuBalancePlugin struct {
+	weight float64
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+	return &gpuBalancePlugin{weight: weight}
+}
+
+func (gbp *gpuBalancePlugin) Name() string {
+	return pluginName
+}
+
+func (gbp *gpuBalancePlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddGPUOrderFn(gbp.gpuOrderFn)
+}
+
+func (gbp *gpuBalancePlugin) OnSessionClose(_ *framework.Session) {}
+
+func (gbp *gpuBalancePlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	if !task.IsSharedGPURequest() || node.MemoryOfEveryGpuOnNode <= 0 {
+		return 0, nil
+	}
+
+	usedMemory := usedMemoryAfterPlacement(node, gpuIdx, node.GetResourceGpuMemory(task.ResReq))
+	if len(usedMemory) == 0 {
+		return 0, nil
+	}
+	imbalance := float64(slices.Max(usedMemory)-slices.Min(usedMemory)) / float64(node.MemoryOfEveryGpuOnNode)
+	score := gbp.weight * scores.GpuBalance * (1 - min(imbalance, 1))
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Imbalance: %f, score: %f",
+		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, imbalance, score)
+	return score, nil
+}
+
+// usedMemoryAfterPlacement returns the memory used on each shared and idle GPU of the node once taskMemory is placed
+// on gpuIdx, which is either a shared GPU group or an idle whole GPU.
+func usedMemoryAfterPlacement(node *node_info.NodeInfo, gpuIdx string, taskMemory int64) []int64 {
+	idleGpus := int(node.Idle.GPUs())
+	usedMemory := make([]int64, 0, len(node.UsedSharedGPUsMemory)+idleGpus)
+	for gpuGroup, memory := range node.UsedSharedGPUsMemory {
+		if memory == 0 {
+			continue
+		}
+		if gpuGroup == gpuIdx {
+			memory += taskMemory
+		}
+		usedMemory = append(usedMemory, memory)
+	}
+	if gpuIdx == pod_info.WholeGpuIndicator && idleGpus > 0 {
+		usedMemory = append(usedMemory, taskMemory)
+		idleGpus--
+	}
+	for range idleGpus {
+		usedMemory = append(usedMemory, 0)
+	}
+	return usedMemory
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpubalance
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestGpuOrderFn(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]string
+		fraction      string
+		usedMemory    map[string]int64
+		idleGpus      float64
+		gpuIdx        string
+		expectedScore float64
+	}{
+		{
+			name:          "fuller GPU group",
+			fraction:      "0.25",
+			usedMemory:    map[string]int64{"group-a": 750, "group-b": 250},
+			idleGpus:      1,
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "emptier GPU group",
+			fraction:      "0.25",
+			usedMemory:    map[string]int64{"group-a": 750, "group-b": 250},
+			idleGpus:      1,
+			gpuIdx:        "group-b",
+			expectedScore: 0.25 * scores.GpuBalance,
+		},
+		{
+			name:          "idle whole GPU",
+			fraction:      "0.25",
+			usedMemory:    map[string]int64{"group-a": 750, "group-b": 250},
+			idleGpus:      1,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0.5 * scores.GpuBalance,
+		},
+		{
+			name:          "GPU groups without used memory are not counted",
+			fraction:      "0.5",
+			usedMemory:    map[string]int64{"group-a": 500, "group-b": 0},
+			gpuIdx:        "group-a",
+			expectedScore: scores.GpuBalance,
+		},
+		{
+			name:          "configured weight",
+			arguments:     map[string]string{"weight": "2"},
+			fraction:      "0.25",
+			usedMemory:    map[string]int64{"group-a": 750, "group-b": 250},
+			idleGpus:      1,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: scores.GpuBalance,
+		},
+		{
+			name:          "invalid weight",
+			arguments:     map[string]string{"weight": "-1"},
+			fraction:      "0.25",
+			usedMemory:    map[string]int64{"group-a": 750, "group-b": 250},
+			idleGpus:      1,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0.5 * scores.GpuBalance,
+		},
+		{
+			name:          "whole GPU pod is not scored",
+			usedMemory:    map[string]int64{"group-a": 750},
+			idleGpus:      1,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*gpuBalancePlugin)
+			node := newNode(tt.usedMemory, tt.idleGpus)
+			score, err := plugin.gpuOrderFn(newTask(tt.fraction), node, tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedScore, score, 1e-9)
+		})
+	}
+}
+
+func TestBalancedVersusPackedPlacement(t *testing.T) {
+	node := newNode(map[string]int64{"group-a": 750, "group-b": 250}, 1)
+	task := newTask("0.25")
+	candidates := []string{"group-a", "group-b", pod_info.WholeGpuIndicator}
+
+	plugin := New(nil).(*gpuBalancePlugin)
+	balanceScore := func(gpuIdx string) float64 {
+		score, err := plugin.gpuOrderFn(task, node, gpuIdx)
+		assert.NoError(t, err)
+		return score
+	}
+	packScore := func(gpuIdx string) float64 {
+		portion, err := node.GetUsedGpuPortion(gpuIdx)
+		assert.NoError(t, err)
+		return portion
+	}
+
+	assert.Equal(t, []string{pod_info.WholeGpuIndicator, "group-b", "group-a"}, sortByScore(candidates, balanceScore))
+	assert.Equal(t, "group-a", sortByScore(candidates, packScore)[0])
+}
+
+func sortByScore(gpuIdxs []string, score func(string) float64) []string {
+	sorted := slices.Clone(gpuIdxs)
+	slices.SortStableFunc(sorted, func(l, r string) int {
+		if score(l) > score(r) {
+			return -1
+		}
+		if score(l) < score(r) {
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
+
+func newNode(usedMemory map[string]int64, idleGpus float64) *node_info.NodeInfo {
+	return &node_info.NodeInfo{
+		Name:                   "node-1",
+		MemoryOfEveryGpuOnNode: 1000,
+		Idle:                   resource_info.NewResource(0, 0, idleGpus),
+		GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+			UsedSharedGPUsMemory: usedMemory,
+		},
+	}
+}
+
+func newTask(fraction string) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: map[string]string{}}}
+	if fraction != "" {
+		pod.Annotations[commonconstants.GpuFraction] = fraction
+	} else {
+		pod.Spec.Containers = []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{commonconstants.GpuResource: resource.MustParse("1")},
+		}}}
+	}
+	return pod_info.NewTaskInfo(pod)
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuinterconnect
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName = "gpuinterconnect"
+)
+
+// gpuInterconnectPlugin prefers the nodes with a higher bandwidth interconnect between their GPUs, NVSwitch over
+// NVLink over PCIe, for pods that use more than one GPU, since their throughput depends on the GPU to GPU bandwidth.
+// Nodes with an unknown interconnect score lowest. Pods of a single GPU, or of a part of one, are not scored. Its
+// scores outweigh the packing and spreading scores.
+type gpuInterconnectPlugin struct {
+	weight float64
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+	return &gpuInterconnectPlugin{weight: weight}
+}
+
+func (gip *gpuInterconnectPlugin) Name() string {
+	return pluginName
+}
+
+func (gip *gpuInterconnectPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddNodeOrderFn(gip.nodeOrderFn)
+}
+
+func (gip *gpuInterconnectPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (gip *gpuInterconnectPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if task.ResReq.GetNumOfGpuDevices() <= 1 {
+		return 0, nil
+	}
+
+	score := gip.weight * scores.GpuInterconnect *
+		float64(node.GpuInterconnectTier) / float64(node_info.MaxGpuInterconnectTier)
+	log.InfraLogger.V(7).Infof("Estimating Task: <%v/%v> Job: <%v> for node: <%s>. Interconnect: %v, score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, node.GpuInterconnectTier, score)
+	return score, nil
+}
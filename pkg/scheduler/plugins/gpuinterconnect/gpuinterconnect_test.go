@@ -0,0 +1,111 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuinterconnect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestNodeOrderFn(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]string
+		gpus          string
+		fraction      string
+		tier          node_info.GpuInterconnectTier
+		expectedScore float64
+	}{
+		{
+			name:          "multi GPU pod on an NVSwitch node",
+			gpus:          "8",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: scores.GpuInterconnect,
+		},
+		{
+			name:          "multi GPU pod on an NVLink node",
+			gpus:          "2",
+			tier:          node_info.GpuInterconnectNVLink,
+			expectedScore: scores.GpuInterconnect * 2 / 3,
+		},
+		{
+			name:          "multi GPU pod on a PCIe node",
+			gpus:          "2",
+			tier:          node_info.GpuInterconnectPCIe,
+			expectedScore: scores.GpuInterconnect / 3,
+		},
+		{
+			name:          "multi GPU pod on a node with an unknown interconnect",
+			gpus:          "2",
+			tier:          node_info.GpuInterconnectUnknown,
+			expectedScore: 0,
+		},
+		{
+			name:          "single GPU pod",
+			gpus:          "1",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: 0,
+		},
+		{
+			name:          "fractional GPU pod",
+			fraction:      "0.5",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: 0,
+		},
+		{
+			name:          "CPU only pod",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: 0,
+		},
+		{
+			name:          "configured weight",
+			arguments:     map[string]string{"weight": "2"},
+			gpus:          "2",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: 2 * scores.GpuInterconnect,
+		},
+		{
+			name:          "invalid weight",
+			arguments:     map[string]string{"weight": "-1"},
+			gpus:          "2",
+			tier:          node_info.GpuInterconnectNVSwitch,
+			expectedScore: scores.GpuInterconnect,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*gpuInterconnectPlugin)
+			node := &node_info.NodeInfo{Name: "node-1", GpuInterconnectTier: tt.tier}
+			score, err := plugin.nodeOrderFn(newTask(tt.gpus, tt.fraction), node)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedScore, score, 1e-9)
+		})
+	}
+}
+
+func newTask(gpus, fraction string) *pod_info.PodInfo {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: map[string]string{}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{}}},
+	}
+	if gpus != "" {
+		pod.Spec.Containers[0].Resources.Requests = v1.ResourceList{
+			commonconstants.GpuResource: resource.MustParse(gpus),
+		}
+	}
+	if fraction != "" {
+		pod.Annotations[commonconstants.GpuFraction] = fraction
+	}
+	return pod_info.NewTaskInfo(pod)
+}
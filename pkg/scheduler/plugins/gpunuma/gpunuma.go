@@ -0,0 +1,49 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpunuma
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const pluginName = "gpunuma"
+
+// gpuNumaPlugin prefers placing GPU sharing pods with a NUMA node hint on shared GPUs attached to the NUMA node of
+// their CPUs. Its scores outweigh the gpupack, gpuspread and gpuutilization scores, so NUMA alignment takes precedence
+// over them.
+type gpuNumaPlugin struct {
+	weight float64
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	return &gpuNumaPlugin{weight: utils.ParseWeightArg(arguments, pluginName)}
+}
+
+func (gnp *gpuNumaPlugin) Name() string {
+	return pluginName
+}
+
+func (gnp *gpuNumaPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddGPUOrderFn(gnp.gpuOrderFn)
+}
+
+func (gnp *gpuNumaPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (gnp *gpuNumaPlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	if gpuIdx == pod_info.WholeGpuIndicator || !node.IsGpuGroupNumaAligned(task, gpuIdx) {
+		return 0, nil
+	}
+
+	score := gnp.weight * scores.GpuNuma
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, score)
+	return score, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpunuma
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestGpuOrderFn(t *testing.T) {
+	node := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
+	node.Annotations = map[string]string{commonconstants.GpuNumaNodes: "0,0,1,1"}
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(gomock.NewController(t))
+	nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).AnyTimes()
+	nodeInfo := node_info.NewNodeInfo(node, nodePodAffinityInfo)
+	for gpuGroup, gpuIndex := range map[string]string{"group-a": "0", "group-b": "2"} {
+		reservationPod := common_info.BuildPod("kai-resource-reservation", "gpu-reservation-"+gpuGroup, "n1",
+			v1.PodRunning, common_info.BuildResourceList("0", "0"), []metav1.OwnerReference{},
+			map[string]string{
+				commonconstants.AppLabelName: conf.GetConfig().ResourceReservationAppLabelValue,
+				commonconstants.GPUGroup:     gpuGroup,
+			},
+			map[string]string{commonconstants.ReservedGpuIndex: gpuIndex})
+		assert.NoError(t, nodeInfo.AddTask(pod_info.NewTaskInfo(reservationPod)))
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]string
+		podNumaNode   string
+		gpuIdx        string
+		expectedScore float64
+	}{
+		{
+			name:          "gpu on the pod's numa node",
+			podNumaNode:   "1",
+			gpuIdx:        "group-b",
+			expectedScore: scores.GpuNuma,
+		},
+		{
+			name:          "weighted score",
+			arguments:     map[string]string{"weight": "2"},
+			podNumaNode:   "1",
+			gpuIdx:        "group-b",
+			expectedScore: 2 * scores.GpuNuma,
+		},
+		{
+			name:        "gpu on another numa node",
+			podNumaNode: "1",
+			gpuIdx:      "group-a",
+		},
+		{
+			name:   "pod without a numa node",
+			gpuIdx: "group-b",
+		},
+		{
+			name:        "whole gpu is not scored",
+			podNumaNode: "1",
+			gpuIdx:      pod_info.WholeGpuIndicator,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var annotations map[string]string
+			if tt.podNumaNode != "" {
+				annotations = map[string]string{commonconstants.NumaNode: tt.podNumaNode}
+			}
+			pod := common_info.BuildPod("ns", "p1", "", v1.PodPending, common_info.BuildResourceList("1000m", "1G"),
+				[]metav1.OwnerReference{}, nil, annotations)
+			task := pod_info.NewTaskInfo(pod)
+
+			plugin := New(tt.arguments).(*gpuNumaPlugin)
+			score, err := plugin.gpuOrderFn(task, nodeInfo, tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScore, score)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gputhermal
+
+import (
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName           = "gputhermal"
+	maxAgeArg            = "maxMetricsAge"
+	coolTemperatureArg   = "coolTemperature"
+	hotTemperatureArg    = "hotTemperature"
+	coolPowerUsageArg    = "coolPowerUsage"
+	defaultAge           = time.Minute
+	defaultCoolTemp      = 60.0
+	defaultHotTemp       = 85.0
+	defaultCoolPowerUsed = 0.5
+	neutralCoolness      = 0.5
+)
+
+// gpuThermalPlugin mildly prefers GPUs and nodes that run cool, by their live temperature and power telemetry.
+// A GPU is fully cool at or below coolTemperature and coolPowerUsage, and fully hot at hotTemperature or at its power
+// limit. The scores are advisory and smaller than the gpuutilization scores. Missing or stale telemetry scores neutral.
+type gpuThermalPlugin struct {
+	weight          float64
+	maxAge          time.Duration
+	coolTemperature float64
+	hotTemperature  float64
+	coolPowerUsage  float64
+	provider        framework.GpuThermalMetricsProvider
+	now             func() time.Time
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+	coolTemperature := utils.ParseFloatArg(arguments, coolTemperatureArg, pluginName, defaultCoolTemp, nil)
+	hotTemperature := utils.ParseFloatArg(arguments, hotTemperatureArg, pluginName, defaultHotTemp, nil)
+	coolPowerUsage := utils.ParseFloatArg(arguments, coolPowerUsageArg, pluginName, defaultCoolPowerUsed,
+		func(p float64) bool { return p >= 0 && p < 1 })
+
+	maxAge := utils.ParseDurationArg(arguments, maxAgeArg, pluginName, defaultAge, utils.Positive)
+
+	if hotTemperature <= coolTemperature {
+		log.InfraLogger.V(2).Warnf("%s must be above %s for plugin %s. Using default values of %v and %v",
+			hotTemperatureArg, coolTemperatureArg, pluginName, defaultCoolTemp, defaultHotTemp)
+		coolTemperature, hotTemperature = defaultCoolTemp, defaultHotTemp
+	}
+
+	return &gpuThermalPlugin{
+		weight:          weight,
+		maxAge:          maxAge,
+		coolTemperature: coolTemperature,
+		hotTemperature:  hotTemperature,
+		coolPowerUsage:  coolPowerUsage,
+		now:             time.Now,
+	}
+}
+
+func (gtp *gpuThermalPlugin) Name() string {
+	return pluginName
+}
+
+func (gtp *gpuThermalPlugin) OnSessionOpen(ssn *framework.Session) {
+	provider, ok := ssn.GpuMetricsProvider().(framework.GpuThermalMetricsProvider)
+	if !ok {
+		log.InfraLogger.V(3).Infof("No GPU thermal metrics provider registered, plugin %s is inactive", pluginName)
+		return
+	}
+	gtp.provider = provider
+	ssn.AddGPUOrderFn(gtp.gpuOrderFn)
+	ssn.AddNodeOrderFn(gtp.nodeOrderFn)
+}
+
+func (gtp *gpuThermalPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (gtp *gpuThermalPlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	if gpuIdx == pod_info.WholeGpuIndicator || !task.IsSharedGPURequest() {
+		return 0, nil
+	}
+
+	sample, found := gtp.provider.GpuThermals(node.Name, gpuIdx)
+	score := gtp.score(sample, found)
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, score)
+	return score, nil
+}
+
+func (gtp *gpuThermalPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if !task.IsRequireAnyKindOfGPU() {
+		return 0, nil
+	}
+
+	sample, found := gtp.provider.NodeGpuThermals(node.Name)
+	score := gtp.score(sample, found)
+	log.InfraLogger.V(7).Infof("Estimating Task: <%v/%v> Job: <%v> for node: <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, score)
+	return score, nil
+}
+
+func (gtp *gpuThermalPlugin) score(sample framework.GpuThermalSample, found bool) float64 {
+	coolness := neutralCoolness
+	if found && gtp.now().Sub(sample.Timestamp) <= gtp.maxAge {
+		temperatureHeat := (sample.Temperature - gtp.coolTemperature) / (gtp.hotTemperature - gtp.coolTemperature)
+		powerHeat := (sample.PowerUsage - gtp.coolPowerUsage) / (1 - gtp.coolPowerUsage)
+		coolness = 1 - min(max(temperatureHeat, powerHeat, 0), 1)
+	}
+	return gtp.weight * scores.GpuThermal * coolness
+}
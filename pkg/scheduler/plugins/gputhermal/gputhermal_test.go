@@ -0,0 +1,182 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gputhermal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const nodeName = "node-1"
+
+type fakeMetricsProvider struct {
+	gpus  map[string]framework.GpuThermalSample
+	nodes map[string]framework.GpuThermalSample
+}
+
+func (f *fakeMetricsProvider) GpuUtilization(string, string) (framework.GpuUtilizationSample, bool) {
+	return framework.GpuUtilizationSample{}, false
+}
+
+func (f *fakeMetricsProvider) NodeGpuUtilization(string) (framework.GpuUtilizationSample, bool) {
+	return framework.GpuUtilizationSample{}, false
+}
+
+func (f *fakeMetricsProvider) GpuThermals(nodeName string, gpuIdx string) (framework.GpuThermalSample, bool) {
+	sample, found := f.gpus[nodeName+"/"+gpuIdx]
+	return sample, found
+}
+
+func (f *fakeMetricsProvider) NodeGpuThermals(nodeName string) (framework.GpuThermalSample, bool) {
+	sample, found := f.nodes[nodeName]
+	return sample, found
+}
+
+func TestGpuOrderFn(t *testing.T) {
+	now := time.Now()
+	provider := &fakeMetricsProvider{
+		gpus: map[string]framework.GpuThermalSample{
+			nodeName + "/cool":      {Temperature: 45, PowerUsage: 0.3, Timestamp: now},
+			nodeName + "/warm":      {Temperature: 70, PowerUsage: 0.4, Timestamp: now},
+			nodeName + "/power":     {Temperature: 50, PowerUsage: 0.9, Timestamp: now},
+			nodeName + "/hot":       {Temperature: 95, PowerUsage: 1, Timestamp: now},
+			nodeName + "/stale-hot": {Temperature: 95, PowerUsage: 1, Timestamp: now.Add(-2 * time.Minute)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		fractional    bool
+		gpuIdx        string
+		expectedScore float64
+	}{
+		{
+			name:          "cool GPU",
+			fractional:    true,
+			gpuIdx:        "cool",
+			expectedScore: scores.GpuThermal,
+		},
+		{
+			name:          "warm GPU",
+			fractional:    true,
+			gpuIdx:        "warm",
+			expectedScore: 0.6 * scores.GpuThermal,
+		},
+		{
+			name:          "high power usage",
+			fractional:    true,
+			gpuIdx:        "power",
+			expectedScore: 0.2 * scores.GpuThermal,
+		},
+		{
+			name:          "hot GPU",
+			fractional:    true,
+			gpuIdx:        "hot",
+			expectedScore: 0,
+		},
+		{
+			name:          "stale telemetry scores neutral",
+			fractional:    true,
+			gpuIdx:        "stale-hot",
+			expectedScore: neutralCoolness * scores.GpuThermal,
+		},
+		{
+			name:          "missing telemetry scores neutral",
+			fractional:    true,
+			gpuIdx:        "unknown",
+			expectedScore: neutralCoolness * scores.GpuThermal,
+		},
+		{
+			name:          "whole GPU task is not scored",
+			fractional:    false,
+			gpuIdx:        "cool",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU indicator is not scored",
+			fractional:    true,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(map[string]string{}).(*gpuThermalPlugin)
+			plugin.provider = provider
+			plugin.now = func() time.Time { return now }
+
+			score, err := plugin.gpuOrderFn(newTask(tt.fractional), newNode(), tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedScore, score, 1e-9)
+		})
+	}
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	now := time.Now()
+	provider := &fakeMetricsProvider{
+		nodes: map[string]framework.GpuThermalSample{
+			nodeName: {Temperature: 75, PowerUsage: 0.2, Timestamp: now.Add(-30 * time.Second)},
+		},
+	}
+
+	plugin := New(map[string]string{
+		"weight": "2", maxAgeArg: "10s", coolTemperatureArg: "50", hotTemperatureArg: "100",
+	}).(*gpuThermalPlugin)
+	plugin.provider = provider
+	plugin.now = func() time.Time { return now }
+
+	score, err := plugin.nodeOrderFn(newTask(false), newNode())
+	assert.NoError(t, err)
+	assert.InDelta(t, 2*neutralCoolness*scores.GpuThermal, score, 1e-9)
+
+	plugin.maxAge = time.Minute
+	score, err = plugin.nodeOrderFn(newTask(false), newNode())
+	assert.NoError(t, err)
+	assert.InDelta(t, 2*0.5*scores.GpuThermal, score, 1e-9)
+
+	cpuTask := pod_info.NewTaskInfo(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "cpu-pod", Namespace: "ns"}})
+	score, err = plugin.nodeOrderFn(cpuTask, newNode())
+	assert.NoError(t, err)
+	assert.Zero(t, score)
+}
+
+func TestNewInvalidArguments(t *testing.T) {
+	plugin := New(map[string]string{
+		"weight": "-1", maxAgeArg: "soon", coolTemperatureArg: "90", hotTemperatureArg: "80", coolPowerUsageArg: "1",
+	}).(*gpuThermalPlugin)
+	assert.Equal(t, 1.0, plugin.weight)
+	assert.Equal(t, defaultAge, plugin.maxAge)
+	assert.Equal(t, defaultCoolTemp, plugin.coolTemperature)
+	assert.Equal(t, defaultHotTemp, plugin.hotTemperature)
+	assert.Equal(t, defaultCoolPowerUsed, plugin.coolPowerUsage)
+}
+
+func newTask(fractional bool) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: map[string]string{}}}
+	if fractional {
+		pod.Annotations[commonconstants.GpuFraction] = "0.5"
+	} else {
+		pod.Spec.Containers = []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{commonconstants.GpuResource: resource.MustParse("1")},
+		}}}
+	}
+	return pod_info.NewTaskInfo(pod)
+}
+
+func newNode() *node_info.NodeInfo {
+	return &node_info.NodeInfo{Name: nodeName}
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuutilization
+
+import (
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName      = "gpuutilization"
+	maxAgeArg       = "maxMetricsAge"
+	defaultAge      = time.Minute
+	neutralIdleness = 0.5
+)
+
+// gpuUtilizationPlugin prefers placing fractional pods on GPUs and nodes with low live compute utilization.
+// Its scores outweigh the allocated-memory based gpupack scores, so an idle GPU is preferred even if more memory is
+// free on a busy one. Missing or stale metrics score neutral.
+type gpuUtilizationPlugin struct {
+	weight   float64
+	maxAge   time.Duration
+	provider framework.GpuMetricsProvider
+	now      func() time.Time
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+
+	maxAge := utils.ParseDurationArg(arguments, maxAgeArg, pluginName, defaultAge, utils.Positive)
+
+	return &gpuUtilizationPlugin{weight: weight, maxAge: maxAge, now: time.Now}
+}
+
+func (gup *gpuUtilizationPlugin) Name() string {
+	return pluginName
+}
+
+func (gup *gpuUtilizationPlugin) OnSessionOpen(ssn *framework.Session) {
+	gup.provider = ssn.GpuMetricsProvider()
+	if gup.provider == nil {
+		log.InfraLogger.V(3).Infof("No GPU metrics provider registered, plugin %s is inactive", pluginName)
+		return
+	}
+	ssn.AddGPUOrderFn(gup.gpuOrderFn)
+	ssn.AddNodeOrderFn(gup.nodeOrderFn)
+}
+
+func (gup *gpuUtilizationPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (gup *gpuUtilizationPlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	if gpuIdx == pod_info.WholeGpuIndicator || !task.IsSharedGPURequest() {
+		return 0, nil
+	}
+
+	sample, found := gup.provider.GpuUtilization(node.Name, gpuIdx)
+	score := gup.score(sample, found)
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, score)
+	return score, nil
+}
+
+func (gup *gpuUtilizationPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if !task.IsSharedGPURequest() {
+		return 0, nil
+	}
+
+	sample, found := gup.provider.NodeGpuUtilization(node.Name)
+	score := gup.score(sample, found)
+	log.InfraLogger.V(7).Infof("Estimating Task: <%v/%v> Job: <%v> for node: <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, score)
+	return score, nil
+}
+
+func (gup *gpuUtilizationPlugin) score(sample framework.GpuUtilizationSample, found bool) float64 {
+	idleness := neutralIdleness
+	if found && gup.now().Sub(sample.Timestamp) <= gup.maxAge {
+		idleness = 1 - min(max(sample.Utilization, 0), 1)
+	}
+	return gup.weight * scores.GpuUtilization * idleness
+}
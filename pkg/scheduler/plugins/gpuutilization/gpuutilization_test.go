@@ -0,0 +1,143 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuutilization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const nodeName = "node-1"
+
+type fakeMetricsProvider struct {
+	gpus  map[string]framework.GpuUtilizationSample
+	nodes map[string]framework.GpuUtilizationSample
+}
+
+func (f *fakeMetricsProvider) GpuUtilization(nodeName string, gpuIdx string) (framework.GpuUtilizationSample, bool) {
+	sample, found := f.gpus[nodeName+"/"+gpuIdx]
+	return sample, found
+}
+
+func (f *fakeMetricsProvider) NodeGpuUtilization(nodeName string) (framework.GpuUtilizationSample, bool) {
+	sample, found := f.nodes[nodeName]
+	return sample, found
+}
+
+func TestGpuOrderFn(t *testing.T) {
+	now := time.Now()
+	provider := &fakeMetricsProvider{
+		gpus: map[string]framework.GpuUtilizationSample{
+			nodeName + "/busy":  {Utilization: 0.9, Timestamp: now},
+			nodeName + "/idle":  {Utilization: 0.1, Timestamp: now},
+			nodeName + "/stale": {Utilization: 0.0, Timestamp: now.Add(-2 * time.Minute)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		fractional    bool
+		gpuIdx        string
+		expectedScore float64
+	}{
+		{
+			name:          "busy GPU",
+			fractional:    true,
+			gpuIdx:        "busy",
+			expectedScore: 0.1 * scores.GpuUtilization,
+		},
+		{
+			name:          "idle GPU",
+			fractional:    true,
+			gpuIdx:        "idle",
+			expectedScore: 0.9 * scores.GpuUtilization,
+		},
+		{
+			name:          "stale metrics score neutral",
+			fractional:    true,
+			gpuIdx:        "stale",
+			expectedScore: neutralIdleness * scores.GpuUtilization,
+		},
+		{
+			name:          "missing metrics score neutral",
+			fractional:    true,
+			gpuIdx:        "unknown",
+			expectedScore: neutralIdleness * scores.GpuUtilization,
+		},
+		{
+			name:          "whole GPU task is not scored",
+			fractional:    false,
+			gpuIdx:        "idle",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU indicator is not scored",
+			fractional:    true,
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(map[string]string{}).(*gpuUtilizationPlugin)
+			plugin.provider = provider
+			plugin.now = func() time.Time { return now }
+
+			score, err := plugin.gpuOrderFn(newTask(tt.fractional), newNode(), tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedScore, score, 1e-9)
+		})
+	}
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	now := time.Now()
+	provider := &fakeMetricsProvider{
+		nodes: map[string]framework.GpuUtilizationSample{
+			nodeName: {Utilization: 0.25, Timestamp: now.Add(-30 * time.Second)},
+		},
+	}
+
+	plugin := New(map[string]string{"weight": "2", maxAgeArg: "10s"}).(*gpuUtilizationPlugin)
+	plugin.provider = provider
+	plugin.now = func() time.Time { return now }
+
+	score, err := plugin.nodeOrderFn(newTask(true), newNode())
+	assert.NoError(t, err)
+	assert.InDelta(t, 2*neutralIdleness*scores.GpuUtilization, score, 1e-9)
+
+	plugin.maxAge = time.Minute
+	score, err = plugin.nodeOrderFn(newTask(true), newNode())
+	assert.NoError(t, err)
+	assert.InDelta(t, 2*0.75*scores.GpuUtilization, score, 1e-9)
+}
+
+func TestNewInvalidArguments(t *testing.T) {
+	plugin := New(map[string]string{"weight": "-1", maxAgeArg: "soon"}).(*gpuUtilizationPlugin)
+	assert.Equal(t, 1.0, plugin.weight)
+	assert.Equal(t, defaultAge, plugin.maxAge)
+}
+
+func newTask(fractional bool) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: map[string]string{}}}
+	if fractional {
+		pod.Annotations[commonconstants.GpuFraction] = "0.5"
+	}
+	return pod_info.NewTaskInfo(pod)
+}
+
+func newNode() *node_info.NodeInfo {
+	return &node_info.NodeInfo{Name: nodeName}
+}
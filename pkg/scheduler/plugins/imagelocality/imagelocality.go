@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imagelocality
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName = "imagelocality"
+
+	defaultImageTag    = "latest"
+	defaultRegistry    = "docker.io"
+	defaultRepoLibrary = "library"
+)
+
+// imageLocalityPlugin prefers nodes that already hold the images of the pod's containers, which speeds up pod startup.
+// GPU scores are only compared between GPUs of the same node, so the preference is expressed at the node level.
+type imageLocalityPlugin struct {
+	weight float64
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+	return &imageLocalityPlugin{weight: weight}
+}
+
+func (ilp *imageLocalityPlugin) Name() string {
+	return pluginName
+}
+
+func (ilp *imageLocalityPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddNodeOrderFn(ilp.nodeOrderFn)
+}
+
+func (ilp *imageLocalityPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (ilp *imageLocalityPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if task.Pod == nil || node.Node == nil || len(node.Node.Status.Images) == 0 {
+		return 0, nil
+	}
+
+	nodeImages := map[string]bool{}
+	for _, image := range node.Node.Status.Images {
+		for _, name := range image.Names {
+			if normalized, ok := normalizeImageName(name); ok {
+				nodeImages[normalized] = true
+			}
+		}
+	}
+
+	resolvedImages, presentImages := 0, 0
+	for _, image := range podImages(task.Pod) {
+		normalized, ok := normalizeImageName(image)
+		if !ok {
+			continue
+		}
+		resolvedImages++
+		if nodeImages[normalized] {
+			presentImages++
+		}
+	}
+	if resolvedImages == 0 {
+		return 0, nil
+	}
+
+	score := ilp.weight * scores.ImageLocality * float64(presentImages) / float64(resolvedImages)
+	log.InfraLogger.V(7).Infof("Estimating Task: <%v/%v> Job: <%v> for node: <%s>, <%d/%d> images present. Score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, presentImages, resolvedImages, score)
+	return score, nil
+}
+
+func podImages(pod *v1.Pod) []string {
+	var images []string
+	for _, container := range pod.Spec.InitContainers {
+		images = append(images, container.Image)
+	}
+	for _, container := range pod.Spec.Containers {
+		images = append(images, container.Image)
+	}
+	return images
+}
+
+// normalizeImageName returns the fully qualified form of an image reference (registry, repository and tag or digest),
+// so that short pod image names can be matched against the names reported in the node status.
+func normalizeImageName(image string) (string, bool) {
+	image = strings.TrimSpace(image)
+	if image == "" || strings.HasSuffix(image, "@") || strings.HasSuffix(image, ":") {
+		return "", false
+	}
+
+	name, digest, hasDigest := strings.Cut(image, "@")
+	if hasDigest && !strings.Contains(digest, ":") {
+		return "", false
+	}
+
+	components := strings.Split(name, "/")
+	if len(components) == 1 {
+		name = defaultRegistry + "/" + defaultRepoLibrary + "/" + name
+	} else if first := components[0]; !strings.ContainsAny(first, ".:") && first != "localhost" {
+		name = defaultRegistry + "/" + name
+	}
+
+	if hasDigest {
+		return name + "@" + digest, true
+	}
+	if strings.LastIndex(name, ":") <= strings.LastIndex(name, "/") {
+		name = name + ":" + defaultImageTag
+	}
+	return name, true
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imagelocality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestNodeOrderFn(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]string
+		podImages     []string
+		nodeImages    [][]string
+		expectedScore float64
+	}{
+		{
+			name:          "node without image status is neutral",
+			podImages:     []string{"nginx"},
+			nodeImages:    nil,
+			expectedScore: 0,
+		},
+		{
+			name:          "short image name matches fully qualified node image",
+			podImages:     []string{"nginx"},
+			nodeImages:    [][]string{{"docker.io/library/nginx:latest"}},
+			expectedScore: scores.ImageLocality,
+		},
+		{
+			name:          "partial presence is scored proportionally",
+			podImages:     []string{"nvcr.io/nvidia/pytorch:24.01", "busybox:1.36"},
+			nodeImages:    [][]string{{"nvcr.io/nvidia/pytorch:24.01"}},
+			expectedScore: scores.ImageLocality / 2,
+		},
+		{
+			name:          "digest reference matches node digest",
+			podImages:     []string{"nvcr.io/nvidia/triton@sha256:abcd"},
+			nodeImages:    [][]string{{"nvcr.io/nvidia/triton@sha256:abcd", "nvcr.io/nvidia/triton:24.01"}},
+			expectedScore: scores.ImageLocality,
+		},
+		{
+			name:          "unresolvable digest is neutral",
+			podImages:     []string{"nvcr.io/nvidia/triton@"},
+			nodeImages:    [][]string{{"nvcr.io/nvidia/triton:24.01"}},
+			expectedScore: 0,
+		},
+		{
+			name:          "weight is applied",
+			arguments:     map[string]string{"weight": "3"},
+			podImages:     []string{"nginx:1.25"},
+			nodeImages:    [][]string{{"docker.io/library/nginx:1.25"}},
+			expectedScore: 3 * scores.ImageLocality,
+		},
+		{
+			name:          "invalid weight falls back to default",
+			arguments:     map[string]string{"weight": "heavy"},
+			podImages:     []string{"nginx:1.25"},
+			nodeImages:    [][]string{{"docker.io/library/nginx:1.25"}},
+			expectedScore: scores.ImageLocality,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arguments := tt.arguments
+			if arguments == nil {
+				arguments = map[string]string{}
+			}
+			plugin := New(arguments).(*imageLocalityPlugin)
+
+			score, err := plugin.nodeOrderFn(buildTask(tt.podImages), buildNode(tt.nodeImages))
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expectedScore, score, 0.0001)
+		})
+	}
+}
+
+func buildTask(images []string) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"}}
+	for _, image := range images {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Image: image})
+	}
+	return pod_info.NewTaskInfo(pod)
+}
+
+func buildNode(images [][]string) *node_info.NodeInfo {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+	for _, names := range images {
+		node.Status.Images = append(node.Status.Images, v1.ContainerImage{Names: names})
+	}
+	return node_info.NewNodeInfo(node, nil)
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package jobdependency
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName = "jobdependency"
+
+	// missingDependencyCompletedArg sets whether a dependency that is not in the snapshot, e.g. one that was deleted
+	// after it completed, is considered completed.
+	missingDependencyCompletedArg = "missingDependencyCompleted"
+
+	// succeededPhase is the phase that workload controllers set on pod groups whose workload succeeded.
+	succeededPhase = v2alpha2.PodGroupPhase("Succeeded")
+)
+
+// jobDependencyPlugin holds back pod groups until the pod groups named by their kai.scheduler/depends-on annotation
+// have completed, so simple DAG workloads can be submitted at once. The dependencies are resolved from the snapshot
+// at session open. The dependencies of pod groups that depend on themselves through a cycle are ignored, so a cycle
+// does not hold back its pod groups forever.
+type jobDependencyPlugin struct {
+	missingDependencyCompleted bool
+	// waitingFor maps the pod groups that are held back to the key of a dependency that has not completed.
+	waitingFor map[common_info.PodGroupID]string
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	missingDependencyCompleted := true
+	if val, found := arguments[missingDependencyCompletedArg]; found {
+		if completed, err := strconv.ParseBool(val); err == nil {
+			missingDependencyCompleted = completed
+		} else {
+			log.InfraLogger.V(2).Warnf("Failed to parse %s: %s for plugin %s. Using default value of %v",
+				missingDependencyCompletedArg, val, pluginName, missingDependencyCompleted)
+		}
+	}
+	return &jobDependencyPlugin{missingDependencyCompleted: missingDependencyCompleted}
+}
+
+func (jdp *jobDependencyPlugin) Name() string {
+	return pluginName
+}
+
+func (jdp *jobDependencyPlugin) OnSessionOpen(ssn *framework.Session) {
+	jdp.waitingFor = map[common_info.PodGroupID]string{}
+
+	jobsByKey := map[string]*podgroup_info.PodGroupInfo{}
+	dependencies := map[string][]string{}
+	for _, job := range ssn.PodGroupInfos {
+		key := podGroupKey(job.Namespace, job.Name)
+		jobsByKey[key] = job
+		if jobDependencies := parseDependencies(job); len(jobDependencies) > 0 {
+			dependencies[key] = jobDependencies
+		}
+	}
+
+	cyclic := findCycles(dependencies)
+	for key, jobDependencies := range dependencies {
+		if cyclic[key] {
+			log.InfraLogger.Errorf("Pod group <%s> depends on itself through its dependencies %v, "+
+				"ignoring its %s annotation", key, jobDependencies, commonconstants.DependsOn)
+			continue
+		}
+		for _, dependency := range jobDependencies {
+			if !jdp.isCompleted(jobsByKey[dependency]) {
+				jdp.waitingFor[jobsByKey[key].UID] = dependency
+				log.InfraLogger.V(4).Infof("Pod group <%s> is waiting for pod group <%s> to complete",
+					key, dependency)
+				break
+			}
+		}
+	}
+
+	ssn.AddJobOrderFn(jdp.jobOrderFn)
+	ssn.AddIsJobOverCapacityFn(jdp.isJobWaitingForDependency)
+}
+
+func (jdp *jobDependencyPlugin) OnSessionClose(_ *framework.Session) {
+	jdp.waitingFor = nil
+}
+
+// jobOrderFn orders the pod groups that wait for a dependency after the ones that don't.
+func (jdp *jobDependencyPlugin) jobOrderFn(l, r interface{}) int {
+	_, lWaiting := jdp.waitingFor[l.(*podgroup_info.PodGroupInfo).UID]
+	_, rWaiting := jdp.waitingFor[r.(*podgroup_info.PodGroupInfo).UID]
+	if lWaiting == rWaiting {
+		return 0
+	}
+	if lWaiting {
+		return 1
+	}
+	return -1
+}
+
+func (jdp *jobDependencyPlugin) isJobWaitingForDependency(
+	job *podgroup_info.PodGroupInfo, _ []*pod_info.PodInfo,
+) *api.SchedulableResult {
+	dependency, waiting := jdp.waitingFor[job.UID]
+	if !waiting {
+		return &api.SchedulableResult{IsSchedulable: true}
+	}
+	return &api.SchedulableResult{
+		IsSchedulable: false,
+		Reason:        v2alpha2.WaitingForDependency,
+		Message:       fmt.Sprintf("Waiting for pod group %s to complete", dependency),
+	}
+}
+
+// parseDependencies returns the keys of the pod groups named by the annotation of the job, either by name, in the
+// namespace of the job, or as namespace/name.
+func parseDependencies(job *podgroup_info.PodGroupInfo) []string {
+	if job.PodGroup == nil {
+		return nil
+	}
+	value := job.PodGroup.Annotations[commonconstants.DependsOn]
+	var dependencies []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !strings.Contains(name, "/") {
+			name = podGroupKey(job.Namespace, name)
+		}
+		dependencies = append(dependencies, name)
+	}
+	return dependencies
+}
+
+// findCycles returns the pod groups that depend on themselves, directly or through other pod groups.
+func findCycles(dependencies map[string][]string) map[string]bool {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	cyclic := map[string]bool{}
+	var path []string
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = visiting
+		path = append(path, key)
+		for _, dependency := range dependencies[key] {
+			switch state[dependency] {
+			case unvisited:
+				visit(dependency)
+			case visiting:
+				for i := len(path) - 1; i >= 0; i-- {
+					cyclic[path[i]] = true
+					if path[i] == dependency {
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[key] = visited
+	}
+
+	for key := range dependencies {
+		if state[key] == unvisited {
+			visit(key)
+		}
+	}
+	return cyclic
+}
+
+// isCompleted returns true if the pod group has succeeded, by its phase or by the pod counts of its status, or if it
+// has succeeded pods and no pods that are still to run or running in the snapshot. The status is read too, since the
+// pods of a completed pod group may have been deleted. Pod groups that are not in the snapshot are completed unless
+// the plugin is configured to wait for them to be created and complete.
+func (jdp *jobDependencyPlugin) isCompleted(job *podgroup_info.PodGroupInfo) bool {
+	if job == nil {
+		return jdp.missingDependencyCompleted
+	}
+	if job.GetNumAliveTasks() > 0 {
+		return false
+	}
+	if len(job.PodStatusIndex[pod_status.Succeeded]) > 0 {
+		return true
+	}
+	if job.PodGroup == nil {
+		return false
+	}
+	status := job.PodGroup.Status
+	return status.Phase == succeededPhase ||
+		(status.Succeeded > 0 && status.Running == 0 && status.Pending == 0)
+}
+
+func podGroupKey(namespace, name string) string {
+	return namespace + "/" + name
+}
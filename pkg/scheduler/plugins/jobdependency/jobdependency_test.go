@@ -0,0 +1,173 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package jobdependency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestJobDependency(t *testing.T) {
+	type testJob struct {
+		name           string
+		status         pod_status.PodStatus
+		dependsOn      string
+		podGroupStatus v2alpha2.PodGroupStatus
+	}
+
+	tests := []struct {
+		name            string
+		arguments       map[string]string
+		jobs            []testJob
+		expectedWaiting map[string]string
+	}{
+		{
+			name: "dependency is running",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Running},
+				{name: "b", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{"b": "ns/a"},
+		},
+		{
+			name: "dependency succeeded",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Succeeded},
+				{name: "b", status: pod_status.Pending, dependsOn: "ns/a"},
+			},
+			expectedWaiting: map[string]string{},
+		},
+		{
+			name: "first dependency that has not completed is waited for",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Running},
+				{name: "b", status: pod_status.Succeeded},
+				{name: "c", status: pod_status.Pending, dependsOn: "b, a"},
+			},
+			expectedWaiting: map[string]string{"c": "ns/a"},
+		},
+		{
+			name: "missing dependency is completed",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Pending, dependsOn: "missing"},
+			},
+			expectedWaiting: map[string]string{},
+		},
+		{
+			name:      "missing dependency is waited for if configured",
+			arguments: map[string]string{missingDependencyCompletedArg: "false"},
+			jobs: []testJob{
+				{name: "a", status: pod_status.Pending, dependsOn: "missing"},
+			},
+			expectedWaiting: map[string]string{"a": "ns/missing"},
+		},
+		{
+			name: "dependency succeeded by its phase",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Deleted, podGroupStatus: v2alpha2.PodGroupStatus{Phase: succeededPhase}},
+				{name: "b", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{},
+		},
+		{
+			name: "dependency succeeded by its status pod counts",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Deleted, podGroupStatus: v2alpha2.PodGroupStatus{Succeeded: 2}},
+				{name: "b", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{},
+		},
+		{
+			name: "dependency with running pods in its status",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Deleted,
+					podGroupStatus: v2alpha2.PodGroupStatus{Succeeded: 1, Running: 1}},
+				{name: "b", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{"b": "ns/a"},
+		},
+		{
+			name: "dependencies of a cycle are ignored",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Pending, dependsOn: "b"},
+				{name: "b", status: pod_status.Pending, dependsOn: "a"},
+				{name: "c", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{"c": "ns/a"},
+		},
+		{
+			name: "self dependency is a cycle",
+			jobs: []testJob{
+				{name: "a", status: pod_status.Pending, dependsOn: "a"},
+			},
+			expectedWaiting: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var testJobs []*jobs_fake.TestJobBasic
+			for _, job := range tt.jobs {
+				testJobs = append(testJobs, &jobs_fake.TestJobBasic{
+					Name:                job.name,
+					Namespace:           "ns",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					Tasks:               []*tasks_fake.TestTaskBasic{{State: job.status}},
+				})
+			}
+			jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps(testJobs)
+			for _, job := range tt.jobs {
+				jobsInfoMap[common_info.PodGroupID(job.name)].PodGroup.Status = job.podGroupStatus
+				if job.dependsOn != "" {
+					jobsInfoMap[common_info.PodGroupID(job.name)].PodGroup.Annotations = map[string]string{
+						commonconstants.DependsOn: job.dependsOn,
+					}
+				}
+			}
+
+			plugin := New(tt.arguments).(*jobDependencyPlugin)
+			ssn := &framework.Session{PodGroupInfos: jobsInfoMap}
+			plugin.OnSessionOpen(ssn)
+
+			for _, job := range tt.jobs {
+				podGroup := jobsInfoMap[common_info.PodGroupID(job.name)]
+				result := plugin.isJobWaitingForDependency(podGroup, nil)
+				dependency, expectedWaiting := tt.expectedWaiting[job.name]
+				assert.Equal(t, !expectedWaiting, result.IsSchedulable, "job %s", job.name)
+				if expectedWaiting {
+					assert.Equal(t, v2alpha2.WaitingForDependency, result.Reason)
+					assert.Equal(t, "Waiting for pod group "+dependency+" to complete", result.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestJobOrderFn(t *testing.T) {
+	jobsInfoMap, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+		{Name: "waiting", Namespace: "ns", QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}}},
+		{Name: "ready", Namespace: "ns", QueueName: "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}}},
+	})
+	waiting := jobsInfoMap["waiting"]
+	ready := jobsInfoMap["ready"]
+	plugin := &jobDependencyPlugin{waitingFor: map[common_info.PodGroupID]string{waiting.UID: "ns/other"}}
+
+	assert.Equal(t, 1, plugin.jobOrderFn(waiting, ready))
+	assert.Equal(t, -1, plugin.jobOrderFn(ready, waiting))
+	assert.Equal(t, 0, plugin.jobOrderFn(ready, ready))
+}
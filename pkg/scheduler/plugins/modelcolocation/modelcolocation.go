@@ -0,0 +1,85 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package modelcolocation
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName       = "modelcolocation"
+	annotationKeyArg = "annotationKey"
+
+	defaultAnnotationKey = "kai.scheduler/model"
+)
+
+// modelColocationPlugin prefers placing fractional pods on GPU groups that already host pods of the same model, so that
+// inference replicas can share the KV-cache and weights of the model on the GPU. The model of a pod is the value of the
+// configured annotation. Only GPU groups that fit the pod are scored, so the memory and device count constraints of
+// GPU sharing still apply. Its scores outweigh the gpuutilization and gpupack scores.
+type modelColocationPlugin struct {
+	weight        float64
+	annotationKey string
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+
+	annotationKey := defaultAnnotationKey
+	if val, found := arguments[annotationKeyArg]; found && strings.TrimSpace(val) != "" {
+		annotationKey = strings.TrimSpace(val)
+	}
+
+	return &modelColocationPlugin{weight: weight, annotationKey: annotationKey}
+}
+
+func (mcp *modelColocationPlugin) Name() string {
+	return pluginName
+}
+
+func (mcp *modelColocationPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddGPUOrderFn(mcp.gpuOrderFn)
+}
+
+func (mcp *modelColocationPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (mcp *modelColocationPlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	if gpuIdx == pod_info.WholeGpuIndicator || !task.IsSharedGPURequest() {
+		return 0, nil
+	}
+	model := mcp.model(task)
+	if model == "" {
+		return 0, nil
+	}
+
+	for _, podInfo := range node.PodInfos {
+		if podInfo.UID == task.UID || !pod_status.IsActiveUsedStatus(podInfo.Status) ||
+			!slices.Contains(podInfo.GPUGroups, gpuIdx) || mcp.model(podInfo) != model {
+			continue
+		}
+		score := mcp.weight * scores.ModelColocation
+		log.InfraLogger.V(7).Infof(
+			"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Hosts model <%s>. Score: %f",
+			task.Namespace, task.Name, task.Job, gpuIdx, node.Name, model, score)
+		return score, nil
+	}
+	return 0, nil
+}
+
+func (mcp *modelColocationPlugin) model(podInfo *pod_info.PodInfo) string {
+	if podInfo.Pod == nil {
+		return ""
+	}
+	return podInfo.Pod.Annotations[mcp.annotationKey]
+}
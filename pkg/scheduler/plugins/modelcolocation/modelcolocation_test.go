@@ -0,0 +1,128 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package modelcolocation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestGpuOrderFn(t *testing.T) {
+	node := &node_info.NodeInfo{
+		Name: "node-1",
+		PodInfos: map[common_info.PodID]*pod_info.PodInfo{
+			"llama-0":    newPod("llama-0", "llama", true, pod_status.Running, "group-a"),
+			"mistral-0":  newPod("mistral-0", "mistral", true, pod_status.Running, "group-b"),
+			"llama-done": newPod("llama-done", "llama", true, pod_status.Succeeded, "group-c"),
+			"llama-new":  newPod("llama-new", "llama", true, pod_status.Pipelined, "group-d"),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]string
+		task          *pod_info.PodInfo
+		gpuIdx        string
+		expectedScore float64
+	}{
+		{
+			name:          "GPU group hosting the same model",
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: scores.ModelColocation,
+		},
+		{
+			name:          "GPU group hosting another model",
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-b",
+			expectedScore: 0,
+		},
+		{
+			name:          "finished pods are not counted",
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-c",
+			expectedScore: 0,
+		},
+		{
+			name:          "pods placed in the session are counted",
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-d",
+			expectedScore: scores.ModelColocation,
+		},
+		{
+			name:          "configured weight",
+			arguments:     map[string]string{"weight": "2"},
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 2 * scores.ModelColocation,
+		},
+		{
+			name:          "configured annotation key",
+			arguments:     map[string]string{annotationKeyArg: "example.com/model"},
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "pod without a model is not scored",
+			task:          newPod("other", "", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU pod is not scored",
+			task:          newPod("llama-1", "llama", false, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU indicator is not scored",
+			task:          newPod("llama-1", "llama", true, pod_status.Pending),
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*modelColocationPlugin)
+			score, err := plugin.gpuOrderFn(tt.task, node, tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScore, score)
+		})
+	}
+}
+
+func newPod(name, model string, fractional bool, status pod_status.PodStatus, gpuGroups ...string,
+) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: name, Namespace: "ns", UID: types.UID(name), Annotations: map[string]string{},
+	}}
+	if model != "" {
+		pod.Annotations[defaultAnnotationKey] = model
+	}
+	if fractional {
+		pod.Annotations[commonconstants.GpuFraction] = "0.5"
+	} else {
+		pod.Spec.Containers = []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{commonconstants.GpuResource: resource.MustParse("1")},
+		}}}
+	}
+	podInfo := pod_info.NewTaskInfo(pod)
+	podInfo.Status = status
+	podInfo.GPUGroups = gpuGroups
+	return podInfo
+}
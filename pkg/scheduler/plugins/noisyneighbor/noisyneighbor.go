@@ -0,0 +1,160 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package noisyneighbor
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName             = "noisyneighbor"
+	annotationKeyArg       = "annotationKey"
+	incompatibleClassesArg = "incompatibleClasses"
+	excludeArg             = "exclude"
+
+	defaultAnnotationKey       = "kai.scheduler/workload-class"
+	defaultIncompatibleClasses = "latency-sensitive=batch"
+)
+
+// noisyNeighborPlugin keeps fractional pods off GPU groups that host pods of an incompatible workload class, so that
+// e.g. batch jobs do not degrade latency-sensitive inference sharing their GPU. The workload class of a pod is the
+// value of the configured annotation, and the incompatible classes are configured as pairs, which apply both ways.
+// GPU groups that host an incompatible class are penalized above the gpusharingorder preference for shared GPUs, or
+// are excluded when exclude is set.
+type noisyNeighborPlugin struct {
+	weight              float64
+	annotationKey       string
+	incompatibleClasses map[string]map[string]bool
+	exclude             bool
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	weight := utils.ParseWeightArg(arguments, pluginName)
+
+	annotationKey := defaultAnnotationKey
+	if val, found := arguments[annotationKeyArg]; found && strings.TrimSpace(val) != "" {
+		annotationKey = strings.TrimSpace(val)
+	}
+
+	incompatibleClasses := defaultIncompatibleClasses
+	if val, found := arguments[incompatibleClassesArg]; found {
+		incompatibleClasses = val
+	}
+
+	exclude := utils.ParseBoolArg(arguments, excludeArg, pluginName, false)
+
+	return &noisyNeighborPlugin{
+		weight:              weight,
+		annotationKey:       annotationKey,
+		incompatibleClasses: parseIncompatibleClasses(incompatibleClasses),
+		exclude:             exclude,
+	}
+}
+
+// parseIncompatibleClasses parses comma separated pairs of incompatible workload classes, e.g.
+// "latency-sensitive=batch,latency-sensitive=training", into a symmetric compatibility matrix.
+func parseIncompatibleClasses(value string) map[string]map[string]bool {
+	matrix := map[string]map[string]bool{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		left, right, found := strings.Cut(pair, "=")
+		left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+		if !found || left == "" || right == "" {
+			log.InfraLogger.V(2).Warnf("Failed to parse the %s pair <%s> for plugin %s, ignoring it",
+				incompatibleClassesArg, pair, pluginName)
+			continue
+		}
+		for _, classes := range [][2]string{{left, right}, {right, left}} {
+			if matrix[classes[0]] == nil {
+				matrix[classes[0]] = map[string]bool{}
+			}
+			matrix[classes[0]][classes[1]] = true
+		}
+	}
+	return matrix
+}
+
+func (nnp *noisyNeighborPlugin) Name() string {
+	return pluginName
+}
+
+func (nnp *noisyNeighborPlugin) ClaimedFns() []framework.FnName {
+	if nnp.exclude {
+		return []framework.FnName{framework.GPUOrderFnName, framework.GpuFilterFnName}
+	}
+	return []framework.FnName{framework.GPUOrderFnName}
+}
+
+func (nnp *noisyNeighborPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddGPUOrderFn(nnp.gpuOrderFn)
+	if nnp.exclude {
+		ssn.AddGpuFilterFn(nnp.gpuFilterFn)
+	}
+}
+
+func (nnp *noisyNeighborPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (nnp *noisyNeighborPlugin) gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (
+	float64, error) {
+	neighborClass, found := nnp.incompatibleNeighborClass(task, node, gpuIdx)
+	if !found {
+		return 0, nil
+	}
+	score := -nnp.weight * scores.NoisyNeighbor
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Hosts incompatible workload class "+
+			"<%s>. Score: %f", task.Namespace, task.Name, task.Job, gpuIdx, node.Name, neighborClass, score)
+	return score, nil
+}
+
+func (nnp *noisyNeighborPlugin) gpuFilterFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) bool {
+	neighborClass, found := nnp.incompatibleNeighborClass(task, node, gpuIdx)
+	if found {
+		log.InfraLogger.V(6).Infof("Excluding gpuIdx: <%s> on node: <%s> for Task: <%v/%v>, it hosts incompatible "+
+			"workload class <%s>", gpuIdx, node.Name, task.Namespace, task.Name, neighborClass)
+	}
+	return !found
+}
+
+// incompatibleNeighborClass returns the workload class of an active pod of the GPU group that is incompatible with the
+// workload class of the fractional task.
+func (nnp *noisyNeighborPlugin) incompatibleNeighborClass(task *pod_info.PodInfo, node *node_info.NodeInfo,
+	gpuIdx string) (string, bool) {
+	if gpuIdx == pod_info.WholeGpuIndicator || !task.IsSharedGPURequest() {
+		return "", false
+	}
+	incompatible := nnp.incompatibleClasses[nnp.workloadClass(task)]
+	if len(incompatible) == 0 {
+		return "", false
+	}
+
+	for _, podInfo := range node.PodInfos {
+		if podInfo.UID == task.UID || !pod_status.IsActiveUsedStatus(podInfo.Status) ||
+			!slices.Contains(podInfo.GPUGroups, gpuIdx) {
+			continue
+		}
+		if neighborClass := nnp.workloadClass(podInfo); incompatible[neighborClass] {
+			return neighborClass, true
+		}
+	}
+	return "", false
+}
+
+func (nnp *noisyNeighborPlugin) workloadClass(podInfo *pod_info.PodInfo) string {
+	if podInfo.Pod == nil {
+		return ""
+	}
+	return podInfo.Pod.Annotations[nnp.annotationKey]
+}
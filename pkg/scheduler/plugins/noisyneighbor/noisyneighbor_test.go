@@ -0,0 +1,161 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package noisyneighbor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestGpuOrderFn(t *testing.T) {
+	node := &node_info.NodeInfo{
+		Name: "node-1",
+		PodInfos: map[common_info.PodID]*pod_info.PodInfo{
+			"batch-0":     newPod("batch-0", "batch", true, pod_status.Running, "group-a"),
+			"inference-0": newPod("inference-0", "latency-sensitive", true, pod_status.Running, "group-b"),
+			"batch-done":  newPod("batch-done", "batch", true, pod_status.Succeeded, "group-c"),
+			"batch-new":   newPod("batch-new", "batch", true, pod_status.Pipelined, "group-d"),
+			"training-0":  newPod("training-0", "training", true, pod_status.Running, "group-e"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		arguments        map[string]string
+		task             *pod_info.PodInfo
+		gpuIdx           string
+		expectedScore    float64
+		expectedFiltered bool
+	}{
+		{
+			name:          "GPU group hosting an incompatible class",
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: -scores.NoisyNeighbor,
+		},
+		{
+			name:          "incompatible classes apply both ways",
+			task:          newPod("batch-1", "batch", true, pod_status.Pending),
+			gpuIdx:        "group-b",
+			expectedScore: -scores.NoisyNeighbor,
+		},
+		{
+			name:          "GPU group hosting a compatible class",
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-b",
+			expectedScore: 0,
+		},
+		{
+			name:          "finished pods are not counted",
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-c",
+			expectedScore: 0,
+		},
+		{
+			name:          "pods placed in the session are counted",
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-d",
+			expectedScore: -scores.NoisyNeighbor,
+		},
+		{
+			name:          "configured incompatible classes",
+			arguments:     map[string]string{incompatibleClassesArg: "latency-sensitive=training, batch = training"},
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-e",
+			expectedScore: -scores.NoisyNeighbor,
+		},
+		{
+			name:          "classes that are not configured as incompatible",
+			arguments:     map[string]string{incompatibleClassesArg: "latency-sensitive=training,invalid"},
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "configured weight",
+			arguments:     map[string]string{"weight": "2"},
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: -2 * scores.NoisyNeighbor,
+		},
+		{
+			name:          "configured annotation key",
+			arguments:     map[string]string{annotationKeyArg: "example.com/class"},
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:             "excluded GPU group",
+			arguments:        map[string]string{excludeArg: "true"},
+			task:             newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:           "group-a",
+			expectedScore:    -scores.NoisyNeighbor,
+			expectedFiltered: true,
+		},
+		{
+			name:          "pod without a class is not scored",
+			task:          newPod("other", "", true, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU pod is not scored",
+			task:          newPod("inference-1", "latency-sensitive", false, pod_status.Pending),
+			gpuIdx:        "group-a",
+			expectedScore: 0,
+		},
+		{
+			name:          "whole GPU indicator is not scored",
+			task:          newPod("inference-1", "latency-sensitive", true, pod_status.Pending),
+			gpuIdx:        pod_info.WholeGpuIndicator,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*noisyNeighborPlugin)
+			score, err := plugin.gpuOrderFn(tt.task, node, tt.gpuIdx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScore, score)
+			if plugin.exclude {
+				assert.Equal(t, !tt.expectedFiltered, plugin.gpuFilterFn(tt.task, node, tt.gpuIdx))
+			}
+		})
+	}
+}
+
+func newPod(name, class string, fractional bool, status pod_status.PodStatus, gpuGroups ...string,
+) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: name, Namespace: "ns", UID: types.UID(name), Annotations: map[string]string{},
+	}}
+	if class != "" {
+		pod.Annotations[defaultAnnotationKey] = class
+	}
+	if fractional {
+		pod.Annotations[commonconstants.GpuFraction] = "0.5"
+	} else {
+		pod.Spec.Containers = []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{commonconstants.GpuResource: resource.MustParse("1")},
+		}}}
+	}
+	podInfo := pod_info.NewTaskInfo(pod)
+	podInfo.Status = status
+	podInfo.GPUGroups = gpuGroups
+	return podInfo
+}
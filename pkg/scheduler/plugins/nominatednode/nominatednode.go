@@ -4,6 +4,7 @@
 package nominatednode
 
 import (
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -31,7 +32,7 @@ func (nnp *nominatedNodeNamePlugin) OnSessionOpen(ssn *framework.Session) {
 func (nnp *nominatedNodeNamePlugin) nodeOrderFn() api.NodeOrderFn {
 	return func(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
 		score := 0.0
-		if task.Pod.Status.NominatedNodeName == node.Name {
+		if task.Pod.Status.NominatedNodeName == node.Name || rebindNode(task) == node.Name {
 			score = scores.NominatedNode
 		}
 
@@ -42,4 +43,10 @@ func (nnp *nominatedNodeNamePlugin) nodeOrderFn() api.NodeOrderFn {
 	}
 }
 
+// rebindNode returns the node that Session.RebindPod moved the task's pod to, carried to the restored pod in an
+// annotation, or an empty string.
+func rebindNode(task *pod_info.PodInfo) string {
+	return task.Pod.Annotations[commonconstants.RebindNode]
+}
+
 func (nnp *nominatedNodeNamePlugin) OnSessionClose(*framework.Session) {}
@@ -11,7 +11,9 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
@@ -65,6 +67,19 @@ var _ = Describe("NominatedNode Scoring tests", func() {
 			},
 			expectedScore: scores.NominatedNode,
 		},
+		"Matching rebind node": {
+			task: &pod_info.PodInfo{
+				Pod: &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{commonconstants.RebindNode: "worker-node"},
+					},
+				},
+			},
+			node: &node_info.NodeInfo{
+				Name: "worker-node",
+			},
+			expectedScore: scores.NominatedNode,
+		},
 	}
 	for caseName, caseSpec := range cases {
 		caseName := caseName
@@ -0,0 +1,187 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package pdb
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const pluginName = "pdb"
+
+// disruptionBudget is a PodDisruptionBudget of the snapshot, with the pods it covers that the session evicted so far.
+type disruptionBudget struct {
+	pdb            *policyv1.PodDisruptionBudget
+	selector       labels.Selector
+	disruptedTasks map[common_info.PodID]bool
+}
+
+func (b *disruptionBudget) covers(task *pod_info.PodInfo) bool {
+	return task.Pod != nil && task.Namespace == b.pdb.Namespace && b.selector.Matches(labels.Set(task.Pod.Labels))
+}
+
+func (b *disruptionBudget) remainingDisruptions() int32 {
+	return b.pdb.Status.DisruptionsAllowed - int32(len(b.disruptedTasks))
+}
+
+func (b *disruptionBudget) String() string {
+	return fmt.Sprintf("%s/%s", b.pdb.Namespace, b.pdb.Name)
+}
+
+// pdbPlugin keeps pods protected by PodDisruptionBudgets from being preempted or reclaimed beyond the disruptions
+// their budgets allow, as read from the snapshot.
+type pdbPlugin struct {
+	budgets []*disruptionBudget
+
+	reportedAttackers map[common_info.PodGroupID]bool
+}
+
+func New(_ map[string]string) framework.Plugin {
+	return &pdbPlugin{}
+}
+
+func (pp *pdbPlugin) Name() string {
+	return pluginName
+}
+
+func (pp *pdbPlugin) OnSessionOpen(ssn *framework.Session) {
+	pp.budgets = nil
+	for _, pdb := range ssn.PodDisruptionBudgets {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to parse the selector of PodDisruptionBudget <%s/%s>: %v",
+				pdb.Namespace, pdb.Name, err)
+			continue
+		}
+		pp.budgets = append(pp.budgets, &disruptionBudget{
+			pdb:            pdb,
+			selector:       selector,
+			disruptedTasks: map[common_info.PodID]bool{},
+		})
+	}
+	if len(pp.budgets) == 0 {
+		return
+	}
+	pp.reportedAttackers = map[common_info.PodGroupID]bool{}
+
+	ssn.AddPreemptVictimFilterFn(pp.victimFilterFn)
+	ssn.AddReclaimVictimFilterFn(pp.victimFilterFn)
+	ssn.AddPreemptScenarioValidatorFn(pp.scenarioValidatorFn)
+	ssn.AddReclaimScenarioValidatorFn(pp.scenarioValidatorFn)
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc:   pp.onAllocate,
+		DeallocateFunc: pp.onDeallocate,
+	})
+}
+
+func (pp *pdbPlugin) OnSessionClose(_ *framework.Session) {
+	pp.budgets = nil
+	pp.reportedAttackers = nil
+}
+
+// victimFilterFn filters out jobs whose active tasks are all protected by a budget that allows no more disruptions.
+// Jobs with only some protected tasks, and budgets shared by several victims, are checked by the scenario validator.
+func (pp *pdbPlugin) victimFilterFn(attacker *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	var blockingBudget *disruptionBudget
+	for _, task := range victim.GetAllPodsMap() {
+		if !pod_status.IsActiveUsedStatus(task.Status) {
+			continue
+		}
+		blockingBudget = pp.exhaustedBudget(task)
+		if blockingBudget == nil {
+			return true
+		}
+	}
+	if blockingBudget == nil {
+		return true
+	}
+
+	pp.reportProtectedVictim(attacker, victim, blockingBudget)
+	return false
+}
+
+// scenarioValidatorFn rejects scenarios that evict more pods covered by a budget than it still allows. The victims
+// that the simulation of the scenario already evicted are counted by the budget itself.
+func (pp *pdbPlugin) scenarioValidatorFn(scenario api.ScenarioInfo) bool {
+	evictions := map[*disruptionBudget]int32{}
+	for _, victimInfo := range scenario.GetVictims() {
+		for _, task := range victimInfo.Tasks {
+			for _, budget := range pp.budgets {
+				if !budget.covers(task) {
+					continue
+				}
+				if !budget.disruptedTasks[task.UID] {
+					evictions[budget]++
+				}
+				if evictions[budget] > budget.remainingDisruptions() {
+					pp.reportProtectedVictim(scenario.GetPreemptor(), victimInfo.Job, budget)
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// onDeallocate counts the evictions of the session against the budgets covering the evicted pods, as the statuses of
+// the budgets are not updated during the session.
+func (pp *pdbPlugin) onDeallocate(event *framework.Event) {
+	if event.Task.Status != pod_status.Releasing {
+		return
+	}
+	for _, budget := range pp.budgets {
+		if budget.covers(event.Task) {
+			budget.disruptedTasks[event.Task.UID] = true
+		}
+	}
+}
+
+// onAllocate stops counting the eviction of a pod once it is undone.
+func (pp *pdbPlugin) onAllocate(event *framework.Event) {
+	for _, budget := range pp.budgets {
+		delete(budget.disruptedTasks, event.Task.UID)
+	}
+}
+
+func (pp *pdbPlugin) exhaustedBudget(task *pod_info.PodInfo) *disruptionBudget {
+	for _, budget := range pp.budgets {
+		if budget.covers(task) && budget.remainingDisruptions() <= 0 {
+			return budget
+		}
+	}
+	return nil
+}
+
+// reportProtectedVictim sets the reason on the attacker once per session, so it is shown if no other victims are
+// found for it.
+func (pp *pdbPlugin) reportProtectedVictim(
+	attacker *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo, budget *disruptionBudget,
+) {
+	if attacker == nil {
+		log.InfraLogger.V(4).Infof("Job <%s/%s> cannot be moved, PodDisruptionBudget <%s> allows no more "+
+			"disruptions", victim.Namespace, victim.Name, budget)
+		return
+	}
+	log.InfraLogger.V(4).Infof("Job <%s/%s> cannot be evicted for job <%s/%s>, PodDisruptionBudget <%s> allows no "+
+		"more disruptions", victim.Namespace, victim.Name, attacker.Namespace, attacker.Name, budget)
+	if pp.reportedAttackers[attacker.UID] {
+		return
+	}
+	pp.reportedAttackers[attacker.UID] = true
+	attacker.SetJobFitError(enginev2alpha2.ProtectedByPodDisruptionBudget,
+		fmt.Sprintf("Pods of job %s/%s cannot be evicted, PodDisruptionBudget %s allows no more disruptions",
+			victim.Namespace, victim.Name, budget), nil)
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package pdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type testScenario struct {
+	preemptor *podgroup_info.PodGroupInfo
+	victims   map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (s *testScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return s.preemptor
+}
+
+func (s *testScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+func TestVictimFilterFn(t *testing.T) {
+	tests := []struct {
+		name               string
+		disruptionsAllowed int32
+		selector           *metav1.LabelSelector
+		namespace          string
+		expectedVictim     bool
+	}{
+		{
+			name:               "budget allows disruptions",
+			disruptionsAllowed: 1,
+			selector:           &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}},
+			namespace:          "ns",
+			expectedVictim:     true,
+		},
+		{
+			name:           "budget allows no disruptions",
+			selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}},
+			namespace:      "ns",
+			expectedVictim: false,
+		},
+		{
+			name:           "budget selects other pods",
+			selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "training"}},
+			namespace:      "ns",
+			expectedVictim: true,
+		},
+		{
+			name:           "budget of another namespace",
+			selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}},
+			namespace:      "other",
+			expectedVictim: true,
+		},
+		{
+			name:           "empty selector selects all pods of the namespace",
+			selector:       &metav1.LabelSelector{},
+			namespace:      "ns",
+			expectedVictim: false,
+		},
+		{
+			name:           "nil selector selects no pods",
+			namespace:      "ns",
+			expectedVictim: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			victim := newJob("serving", newTask("serving-0"), newTask("serving-1"))
+			reclaimer := newJob("reclaimer")
+			plugin := newPlugin(t, []*podgroup_info.PodGroupInfo{victim, reclaimer},
+				newPDB(tt.namespace, tt.selector, tt.disruptionsAllowed))
+
+			assert.Equal(t, tt.expectedVictim, plugin.victimFilterFn(reclaimer, victim))
+			if tt.expectedVictim {
+				assert.Empty(t, reclaimer.JobFitErrors)
+				return
+			}
+			assert.Len(t, reclaimer.JobFitErrors, 1)
+			assert.Equal(t, enginev2alpha2.ProtectedByPodDisruptionBudget, reclaimer.JobFitErrors[0].Reason)
+			assert.Equal(t,
+				"Pods of job ns/serving cannot be evicted, PodDisruptionBudget "+tt.namespace+"/pdb allows no more "+
+					"disruptions", reclaimer.JobFitErrors[0].Message)
+		})
+	}
+}
+
+func TestVictimFilterFn_PartiallyProtectedJob(t *testing.T) {
+	protected := newTask("serving-0")
+	unprotected := newTask("serving-1")
+	unprotected.Pod.Labels = nil
+	victim := newJob("serving", protected, unprotected)
+	reclaimer := newJob("reclaimer")
+	plugin := newPlugin(t, []*podgroup_info.PodGroupInfo{victim, reclaimer},
+		newPDB("ns", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}}, 0))
+
+	assert.True(t, plugin.victimFilterFn(reclaimer, victim))
+	assert.True(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, unprotected)))
+	assert.False(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, protected, unprotected)))
+	assert.Len(t, reclaimer.JobFitErrors, 1)
+}
+
+func TestScenarioValidatorFn_CountsDisruptions(t *testing.T) {
+	first := newTask("serving-0")
+	second := newTask("serving-1")
+	victim := newJob("serving", first, second)
+	reclaimer := newJob("reclaimer")
+	plugin := newPlugin(t, []*podgroup_info.PodGroupInfo{victim, reclaimer},
+		newPDB("ns", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}}, 1))
+
+	assert.True(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, first)))
+	assert.False(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, first, second)))
+
+	first.Status = pod_status.Releasing
+	plugin.onDeallocate(&framework.Event{Task: first})
+	assert.False(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, second)))
+	assert.False(t, plugin.victimFilterFn(reclaimer, victim))
+	assert.True(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, first)),
+		"a victim already evicted by the simulation is counted once")
+
+	first.Status = pod_status.Running
+	plugin.onAllocate(&framework.Event{Task: first})
+	assert.True(t, plugin.scenarioValidatorFn(newScenario(reclaimer, victim, second)))
+}
+
+func TestOnSessionOpen_NoBudgets(t *testing.T) {
+	ssn := &framework.Session{}
+	plugin := New(nil).(*pdbPlugin)
+	plugin.OnSessionOpen(ssn)
+
+	assert.Empty(t, ssn.ReclaimVictimFilterFns)
+	assert.Empty(t, ssn.ReclaimScenarioValidatorFns)
+}
+
+func newPlugin(t *testing.T, jobs []*podgroup_info.PodGroupInfo, pdbs ...*policyv1.PodDisruptionBudget) *pdbPlugin {
+	ssn := &framework.Session{
+		PodGroupInfos:        map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{},
+		PodDisruptionBudgets: pdbs,
+	}
+	for _, job := range jobs {
+		ssn.PodGroupInfos[job.UID] = job
+	}
+	plugin := New(nil).(*pdbPlugin)
+	plugin.OnSessionOpen(ssn)
+	assert.Len(t, ssn.ReclaimVictimFilterFns, 1)
+	assert.Len(t, ssn.PreemptVictimFilterFns, 1)
+	return plugin
+}
+
+func newScenario(preemptor, victim *podgroup_info.PodGroupInfo, tasks ...*pod_info.PodInfo) *testScenario {
+	return &testScenario{
+		preemptor: preemptor,
+		victims:   map[common_info.PodGroupID]*api.VictimInfo{victim.UID: {Job: victim, Tasks: tasks}},
+	}
+}
+
+func newPDB(namespace string, selector *metav1.LabelSelector, disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: namespace},
+		Spec:       policyv1.PodDisruptionBudgetSpec{Selector: selector},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+	}
+}
+
+func newJob(uid common_info.PodGroupID, tasks ...*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(uid, tasks...)
+	job.Name = string(uid)
+	job.Namespace = "ns"
+	return job
+}
+
+func newTask(name string) *pod_info.PodInfo {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			UID:       types.UID(name),
+			Labels:    map[string]string{"app": "serving"},
+		},
+		Spec:   v1.PodSpec{NodeName: "node"},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	return pod_info.NewTaskInfo(pod)
+}
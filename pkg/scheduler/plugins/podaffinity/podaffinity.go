@@ -48,7 +48,8 @@ func (pp *podAffinityPlugin) OnSessionOpen(ssn *framework.Session) {
 	k8sPluginScoreres := predicates.NewSessionScorePredicates(ssn)
 
 	ssn.AddNodePreOrderFn(pp.nodePreOrderFn(k8sPluginScoreres))
-	ssn.AddNodeOrderFn(pp.nodeOrderFn(k8sPluginScoreres))
+	// Inter-pod affinity and topology spread score a node by the pods in its whole topology domain.
+	ssn.AddCrossNodeOrderFn(pp.nodeOrderFn(k8sPluginScoreres))
 	pp.skipOrderFn = make(skipOrderFn)
 }
 
@@ -23,9 +23,11 @@ import (
 	"fmt"
 	"strings"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ksf "k8s.io/kube-scheduler/framework"
 
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -41,9 +43,10 @@ import (
 )
 
 const (
-	predicatePluginName       = "predicates"
-	prePredicateErrorFormat   = "%s: %v.%s\n"
-	prePredicateReasonsFormat = " Reasons: %s"
+	predicatePluginName        = "predicates"
+	gpuDevicePluginResourceArg = "gpuDevicePluginResource"
+	prePredicateErrorFormat    = "%s: %v.%s\n"
+	prePredicateReasonsFormat  = " Reasons: %s"
 )
 
 type prePredicateError struct {
@@ -103,6 +106,10 @@ func (pp *predicatesPlugin) Name() string {
 	return predicatePluginName
 }
 
+func (pp *predicatesPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{framework.PrePredicateFnName, framework.PredicateFnName}
+}
+
 func (pp *predicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 	k8sPredicates := predicates.NewSessionPredicates(ssn)
 
@@ -117,6 +124,29 @@ func (pp *predicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 		return pp.evaluateTaskOnPredicates(task, job, node, k8sPredicates,
 			ssn.IsTaskAllocationOnNodeOverCapacityFn, ssn.IsRestrictNodeSchedulingEnabled, pp.skipPredicates)
 	})
+
+	gpuDevicePluginResource := v1.ResourceName(commonconstants.GpuResource)
+	if resourceName, found := pp.pluginArguments[gpuDevicePluginResourceArg]; found && resourceName != "" {
+		gpuDevicePluginResource = v1.ResourceName(resourceName)
+	}
+	ssn.AddPredicateFn(func(task *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+		return node.PredicateByGpuDevicePlugin(task, gpuDevicePluginResource)
+	})
+
+	availableGpuModels := getAvailableGpuModels(ssn.Nodes)
+	ssn.AddPredicateFn(func(task *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+		return node.PredicateByGpuModel(task, availableGpuModels)
+	})
+}
+
+func getAvailableGpuModels(nodes map[string]*node_info.NodeInfo) []string {
+	models := sets.New[string]()
+	for _, node := range nodes {
+		if model := node.GetGpuModel(); model != "" {
+			models.Insert(model)
+		}
+	}
+	return sets.List(models)
 }
 
 func evaluateTaskOnPrePredicate(task *pod_info.PodInfo, k8sPredicates k8s_internal.SessionPredicates,
@@ -0,0 +1,195 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemptiongrace
+
+import (
+	"time"
+
+	"github.com/xhit/go-str2duration/v2"
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName               = "preemptiongrace"
+	defaultGracePeriodConfig = "defaultGracePeriod"
+	maxGracePeriodConfig     = "maxGracePeriod"
+	defaultMaxGracePeriod    = time.Hour
+
+	// podBoundCondition is set on the pod by the binder once its bind request succeeded.
+	podBoundCondition v1.PodConditionType = "PodBound"
+)
+
+// preemptionGracePlugin protects freshly bound tasks from being preempted or reclaimed, so their warmup work is not
+// wasted. The grace period is resolved from the pod annotation, the podgroup annotation, the victim's queue hierarchy
+// and finally the plugin default, and is always capped by maxGracePeriod.
+type preemptionGracePlugin struct {
+	defaultGracePeriod time.Duration
+	maxGracePeriod     time.Duration
+	queues             map[common_info.QueueID]*queue_info.QueueInfo
+	now                func() time.Time
+
+	jobProtectionCache map[common_info.PodGroupID]bool
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	return &preemptionGracePlugin{
+		defaultGracePeriod: parseDuration(arguments, defaultGracePeriodConfig, 0),
+		maxGracePeriod:     parseDuration(arguments, maxGracePeriodConfig, defaultMaxGracePeriod),
+		now:                time.Now,
+		jobProtectionCache: map[common_info.PodGroupID]bool{},
+	}
+}
+
+func parseDuration(arguments map[string]string, config string, defaultValue time.Duration) time.Duration {
+	value := arguments[config]
+	if len(value) == 0 {
+		return defaultValue
+	}
+	duration, err := str2duration.ParseDuration(value)
+	if err != nil || duration < 0 {
+		log.InfraLogger.Errorf("Failed to parse %v (%v) for plugin %v, using default value %v",
+			config, value, pluginName, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
+
+func (pgp *preemptionGracePlugin) Name() string {
+	return pluginName
+}
+
+func (pgp *preemptionGracePlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddPreemptVictimFilterFn(pgp.victimFilterFn)
+	ssn.AddReclaimVictimFilterFn(pgp.victimFilterFn)
+	ssn.AddPreemptScenarioValidatorFn(pgp.scenarioValidatorFn)
+	ssn.AddReclaimScenarioValidatorFn(pgp.scenarioValidatorFn)
+	pgp.queues = ssn.Queues
+	pgp.jobProtectionCache = map[common_info.PodGroupID]bool{}
+}
+
+func (pgp *preemptionGracePlugin) OnSessionClose(_ *framework.Session) {
+	pgp.queues = nil
+	pgp.jobProtectionCache = nil
+}
+
+// victimFilterFn filters out jobs whose active tasks are all within their grace period, as no victim can be taken
+// from them. Jobs with only some protected tasks are checked per task by the scenario validator.
+func (pgp *preemptionGracePlugin) victimFilterFn(_ *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if protected, found := pgp.jobProtectionCache[victim.UID]; found {
+		return !protected
+	}
+
+	activeTasks, protectedTasks := 0, 0
+	for _, task := range victim.GetAllPodsMap() {
+		if !pod_status.IsActiveUsedStatus(task.Status) {
+			continue
+		}
+		activeTasks++
+		if !pgp.isTaskProtected(victim, task) {
+			break
+		}
+		protectedTasks++
+	}
+	protected := activeTasks > 0 && protectedTasks == activeTasks
+
+	pgp.jobProtectionCache[victim.UID] = protected
+	return !protected
+}
+
+func (pgp *preemptionGracePlugin) scenarioValidatorFn(scenario api.ScenarioInfo) bool {
+	for _, victimInfo := range scenario.GetVictims() {
+		for _, task := range victimInfo.Tasks {
+			if pgp.isTaskProtected(victimInfo.Job, task) {
+				log.InfraLogger.V(6).Infof("Task <%s/%s> is within its preemption grace period",
+					task.Namespace, task.Name)
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isTaskProtected returns whether the task was bound less than its grace period ago. To avoid a job being protected
+// indefinitely by tasks that keep being re-bound, protection also ends once maxGracePeriod has passed since the job
+// last started.
+func (pgp *preemptionGracePlugin) isTaskProtected(job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo) bool {
+	bindTime := getBindTimestamp(task.Pod)
+	if bindTime == nil {
+		return false
+	}
+
+	gracePeriod := min(pgp.getGracePeriod(job, task), pgp.maxGracePeriod)
+	now := pgp.now()
+	if !now.Before(bindTime.Add(gracePeriod)) {
+		return false
+	}
+	if job.LastStartTimestamp != nil && !job.LastStartTimestamp.IsZero() &&
+		!now.Before(job.LastStartTimestamp.Add(pgp.maxGracePeriod)) {
+		return false
+	}
+	return true
+}
+
+func (pgp *preemptionGracePlugin) getGracePeriod(job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo) time.Duration {
+	if task.Pod != nil {
+		if gracePeriod, found := parseAnnotation(task.Pod.Annotations); found {
+			return gracePeriod
+		}
+	}
+	if job.PodGroup != nil {
+		if gracePeriod, found := parseAnnotation(job.PodGroup.Annotations); found {
+			return gracePeriod
+		}
+	}
+
+	for queue := pgp.queues[job.Queue]; queue != nil; queue = pgp.queues[queue.ParentQueue] {
+		if queue.PreemptionGracePeriod != nil {
+			return queue.PreemptionGracePeriod.Duration
+		}
+	}
+	return pgp.defaultGracePeriod
+}
+
+func parseAnnotation(annotations map[string]string) (time.Duration, bool) {
+	value, found := annotations[commonconstants.PreemptionGracePeriod]
+	if !found {
+		return 0, false
+	}
+	duration, err := str2duration.ParseDuration(value)
+	if err != nil || duration < 0 {
+		log.InfraLogger.V(4).Warnf("Invalid %s annotation value: %s", commonconstants.PreemptionGracePeriod, value)
+		return 0, false
+	}
+	return duration, true
+}
+
+// getBindTimestamp returns the time the pod was bound to its node, falling back to the time it was scheduled or
+// started for pods that were not bound by the binder.
+func getBindTimestamp(pod *v1.Pod) *time.Time {
+	if pod == nil {
+		return nil
+	}
+	for _, conditionType := range []v1.PodConditionType{podBoundCondition, v1.PodScheduled} {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == conditionType && condition.Status == v1.ConditionTrue &&
+				!condition.LastTransitionTime.IsZero() {
+				return &condition.LastTransitionTime.Time
+			}
+		}
+	}
+	if pod.Status.StartTime != nil && !pod.Status.StartTime.IsZero() {
+		return &pod.Status.StartTime.Time
+	}
+	return nil
+}
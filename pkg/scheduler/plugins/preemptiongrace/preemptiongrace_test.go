@@ -0,0 +1,185 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemptiongrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+type testScenario struct {
+	victims map[common_info.PodGroupID]*api.VictimInfo
+}
+
+func (s *testScenario) GetPreemptor() *podgroup_info.PodGroupInfo {
+	return nil
+}
+
+func (s *testScenario) GetVictims() map[common_info.PodGroupID]*api.VictimInfo {
+	return s.victims
+}
+
+func TestIsTaskProtected(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name                string
+		arguments           map[string]string
+		queueGracePeriod    *metav1.Duration
+		parentGracePeriod   *metav1.Duration
+		podGroupAnnotations map[string]string
+		podAnnotations      map[string]string
+		bindTime            *time.Time
+		lastStartTime       *time.Time
+		expectedProtected   bool
+	}{
+		{
+			name:              "no grace period configured",
+			bindTime:          ptr(now.Add(-time.Second)),
+			expectedProtected: false,
+		},
+		{
+			name:              "within default grace period",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10m"},
+			bindTime:          ptr(now.Add(-5 * time.Minute)),
+			expectedProtected: true,
+		},
+		{
+			name:              "past default grace period",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10m"},
+			bindTime:          ptr(now.Add(-15 * time.Minute)),
+			expectedProtected: false,
+		},
+		{
+			name:              "unbound task is not protected",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10m"},
+			expectedProtected: false,
+		},
+		{
+			name:              "queue overrides default",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10m"},
+			queueGracePeriod:  &metav1.Duration{Duration: time.Minute},
+			bindTime:          ptr(now.Add(-5 * time.Minute)),
+			expectedProtected: false,
+		},
+		{
+			name:              "parent queue is used when queue has no grace period",
+			parentGracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+			bindTime:          ptr(now.Add(-5 * time.Minute)),
+			expectedProtected: true,
+		},
+		{
+			name:                "podgroup annotation overrides queue",
+			queueGracePeriod:    &metav1.Duration{Duration: time.Minute},
+			podGroupAnnotations: map[string]string{commonconstants.PreemptionGracePeriod: "10m"},
+			bindTime:            ptr(now.Add(-5 * time.Minute)),
+			expectedProtected:   true,
+		},
+		{
+			name:                "pod annotation overrides podgroup",
+			podGroupAnnotations: map[string]string{commonconstants.PreemptionGracePeriod: "10m"},
+			podAnnotations:      map[string]string{commonconstants.PreemptionGracePeriod: "1m"},
+			bindTime:            ptr(now.Add(-5 * time.Minute)),
+			expectedProtected:   false,
+		},
+		{
+			name:              "grace period is capped by max grace period",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10h", maxGracePeriodConfig: "1h"},
+			bindTime:          ptr(now.Add(-2 * time.Hour)),
+			expectedProtected: false,
+		},
+		{
+			name:              "re-bound task of a long running job is not protected",
+			arguments:         map[string]string{defaultGracePeriodConfig: "10m", maxGracePeriodConfig: "1h"},
+			bindTime:          ptr(now.Add(-time.Minute)),
+			lastStartTime:     ptr(now.Add(-2 * time.Hour)),
+			expectedProtected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*preemptionGracePlugin)
+			plugin.now = func() time.Time { return now }
+			plugin.queues = map[common_info.QueueID]*queue_info.QueueInfo{
+				"parent": {UID: "parent", PreemptionGracePeriod: tt.parentGracePeriod},
+				"queue":  {UID: "queue", ParentQueue: "parent", PreemptionGracePeriod: tt.queueGracePeriod},
+			}
+
+			job := newJob("job", tt.podGroupAnnotations, tt.lastStartTime)
+			task := newTask("pod", tt.podAnnotations, tt.bindTime)
+			assert.Equal(t, tt.expectedProtected, plugin.isTaskProtected(job, task))
+		})
+	}
+}
+
+func TestVictimFilterAndScenarioValidator(t *testing.T) {
+	now := time.Now()
+	plugin := New(map[string]string{defaultGracePeriodConfig: "10m"}).(*preemptionGracePlugin)
+	plugin.now = func() time.Time { return now }
+	plugin.queues = map[common_info.QueueID]*queue_info.QueueInfo{"queue": {UID: "queue"}}
+
+	fresh := newTask("fresh", nil, ptr(now.Add(-time.Minute)))
+	old := newTask("old", nil, ptr(now.Add(-time.Hour)))
+
+	allFresh := newJob("all-fresh", nil, nil, newTask("other-fresh", nil, ptr(now.Add(-time.Minute))))
+	mixed := newJob("mixed", nil, nil, fresh, old)
+
+	assert.False(t, plugin.victimFilterFn(nil, allFresh))
+	assert.True(t, plugin.victimFilterFn(nil, mixed))
+
+	assert.True(t, plugin.scenarioValidatorFn(&testScenario{victims: map[common_info.PodGroupID]*api.VictimInfo{
+		mixed.UID: {Job: mixed, Tasks: []*pod_info.PodInfo{old}},
+	}}))
+	assert.False(t, plugin.scenarioValidatorFn(&testScenario{victims: map[common_info.PodGroupID]*api.VictimInfo{
+		mixed.UID: {Job: mixed, Tasks: []*pod_info.PodInfo{old, fresh}},
+	}}))
+}
+
+func newJob(uid common_info.PodGroupID, annotations map[string]string, lastStartTime *time.Time,
+	tasks ...*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(uid, tasks...)
+	job.Queue = "queue"
+	job.PodGroup = &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	job.LastStartTimestamp = lastStartTime
+	return job
+}
+
+func newTask(name string, annotations map[string]string, bindTime *time.Time) *pod_info.PodInfo {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns",
+			UID:         types.UID(name),
+			Annotations: annotations,
+		},
+		Spec:   v1.PodSpec{NodeName: "node"},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	if bindTime != nil {
+		pod.Status.Conditions = []v1.PodCondition{{
+			Type:               podBoundCondition,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(*bindTime),
+		}}
+	}
+	return pod_info.NewTaskInfo(pod)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -37,6 +37,10 @@ func (pp *priorityPlugin) Name() string {
 	return "priority"
 }
 
+func (pp *priorityPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{framework.JobOrderFnName}
+}
+
 func (pp *priorityPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddJobOrderFn(JobOrderFn)
 }
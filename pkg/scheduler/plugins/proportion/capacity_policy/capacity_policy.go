@@ -57,7 +57,16 @@ func (cp *CapacityPolicy) IsTaskAllocationOnNodeOverCapacity(task *pod_info.PodI
 		requiredInitQuota.GPU)
 
 	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota}
-	return cp.isJobOverCapacity(requestedShare, job, checkFns)
+	if result := cp.isJobOverCapacity(requestedShare, job, checkFns); !result.IsSchedulable {
+		return result
+	}
+
+	requestedGpus, _ := node.GetTaskGpuUsage(task)
+	result := cp.resultsOverGpuTypeCapacity(requestedGpus, job, node)
+	if !result.IsSchedulable {
+		log.InfraLogger.V(5).Infof("Job: <%v/%v> is over capacity. Reason: %v", job.Namespace, job.Name, result.Message)
+	}
+	return result
 }
 
 func (cp *CapacityPolicy) isJobOverCapacity(requestedShare rs.ResourceQuantities, job *podgroup_info.PodGroupInfo,
@@ -6,6 +6,7 @@ package capacity_policy
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
@@ -1079,6 +1080,131 @@ var _ = Describe("Capacity Policy Check", func() {
 			}
 
 		})
+
+		Context("gpu types", func() {
+			h100Node := &node_info.NodeInfo{
+				Name: "h100-node",
+				Node: &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "h100-node",
+						Labels: map[string]string{node_info.GpuProductLabel: "NVIDIA-H100-80GB-HBM3"},
+					},
+				},
+			}
+			tests := map[string]struct {
+				leafGpuTypes   map[string]*rs.ResourceShare
+				topGpuTypes    map[string]*rs.ResourceShare
+				priority       int32
+				expectedResult bool
+				expectedReason v2alpha2.UnschedulableReason
+			}{
+				"below gpu type limit": {
+					leafGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: commonconstants.UnlimitedResourceQuantity, MaxAllowed: 4, Allocated: 2},
+					},
+					priority:       constants.PriorityTrainNumber,
+					expectedResult: true,
+				},
+				"above gpu type limit": {
+					leafGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: commonconstants.UnlimitedResourceQuantity, MaxAllowed: 4, Allocated: 3},
+					},
+					priority:       constants.PriorityTrainNumber,
+					expectedResult: false,
+					expectedReason: v2alpha2.OverLimit,
+				},
+				"limit of another gpu type": {
+					leafGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: commonconstants.UnlimitedResourceQuantity, MaxAllowed: 4, Allocated: 2},
+						"A100": {Deserved: commonconstants.UnlimitedResourceQuantity, MaxAllowed: 8, Allocated: 8},
+					},
+					priority:       constants.PriorityTrainNumber,
+					expectedResult: true,
+				},
+				"above parent queue gpu type limit": {
+					topGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: commonconstants.UnlimitedResourceQuantity, MaxAllowed: 4, Allocated: 3},
+					},
+					priority:       constants.PriorityTrainNumber,
+					expectedResult: false,
+					expectedReason: v2alpha2.OverLimit,
+				},
+				"preemptible job above gpu type quota": {
+					leafGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: 4, MaxAllowed: commonconstants.UnlimitedResourceQuantity, Allocated: 3,
+							AllocatedNotPreemptible: 3},
+					},
+					priority:       constants.PriorityTrainNumber,
+					expectedResult: true,
+				},
+				"non preemptible job above gpu type quota": {
+					leafGpuTypes: map[string]*rs.ResourceShare{
+						"H100": {Deserved: 4, MaxAllowed: commonconstants.UnlimitedResourceQuantity, Allocated: 3,
+							AllocatedNotPreemptible: 3},
+					},
+					priority:       constants.PriorityBuildNumber,
+					expectedResult: false,
+					expectedReason: v2alpha2.NonPreemptibleOverQuota,
+				},
+			}
+
+			for testName, testData := range tests {
+				testName := testName
+				testData := testData
+				It(testName, func() {
+					unlimitedShare := rs.QueueResourceShare{
+						GPU: rs.ResourceShare{
+							Deserved:   commonconstants.UnlimitedResourceQuantity,
+							MaxAllowed: commonconstants.UnlimitedResourceQuantity,
+						},
+					}
+					queues := map[common_info.QueueID]*rs.QueueAttributes{
+						"top-queue": {
+							UID:                "top-queue",
+							Name:               "top-queue",
+							ChildQueues:        []common_info.QueueID{"leaf-queue"},
+							GpuTypes:           testData.topGpuTypes,
+							QueueResourceShare: unlimitedShare,
+						},
+						"leaf-queue": {
+							UID:                "leaf-queue",
+							Name:               "leaf-queue",
+							ParentQueue:        "top-queue",
+							GpuTypes:           testData.leafGpuTypes,
+							QueueResourceShare: unlimitedShare,
+						},
+					}
+					job := &podgroup_info.PodGroupInfo{
+						Name:      "job-a",
+						Namespace: "team-a",
+						Queue:     "leaf-queue",
+						Priority:  testData.priority,
+						PodSets: map[string]*subgroup_info.PodSet{
+							podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 1, nil).
+								WithPodInfos(map[common_info.PodID]*pod_info.PodInfo{
+									"task-a": {
+										UID:       "task-a",
+										Job:       "job-a",
+										Name:      "task-a",
+										Namespace: "team-a",
+										Status:    pod_status.Pending,
+										ResReq:    resource_info.NewResourceRequirements(2, 0, 0),
+									},
+								}),
+						},
+					}
+
+					capacityPolicy := New(queues)
+					result := capacityPolicy.IsTaskAllocationOnNodeOverCapacity(job.GetAllPodsMap()["task-a"], job,
+						h100Node)
+					Expect(result.IsSchedulable).To(Equal(testData.expectedResult))
+					if !testData.expectedResult {
+						Expect(result.Reason).To(Equal(testData.expectedReason))
+						Expect(result.Message).To(ContainSubstring("H100 GPUs"))
+					}
+				})
+			}
+		})
 	})
 })
 
@@ -0,0 +1,63 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+// resultsOverGpuTypeCapacity checks the GPUs that the task takes on the node against the limits of the GPU types of the job's
+// queue and its ancestors that match the node's GPU model, and against their quotas for non-preemptible jobs.
+func (cp *CapacityPolicy) resultsOverGpuTypeCapacity(requestedGpus float64, job *podgroup_info.PodGroupInfo,
+	node *node_info.NodeInfo) *api.SchedulableResult {
+	if requestedGpus == 0 {
+		return Schedulable()
+	}
+
+	for queueAttributes, ok := cp.queues[job.Queue]; ok; queueAttributes, ok = cp.queues[queueAttributes.ParentQueue] {
+		for gpuType, share := range queueAttributes.GpuTypes {
+			if !node.HasGpuModel(gpuType) {
+				continue
+			}
+			if share.MaxAllowed != commonconstants.UnlimitedResourceQuantity &&
+				share.MaxAllowed < share.Allocated+requestedGpus {
+				return gpuTypeOverCapacityResult(v2alpha2.OverLimit,
+					api.GetJobOverGpuTypeLimitMessageForQueue(queueAttributes.Name, gpuType, share.MaxAllowed,
+						share.Allocated, requestedGpus),
+					queueAttributes, requestedGpus)
+			}
+			if !job.IsPreemptibleJob() && share.Deserved != commonconstants.UnlimitedResourceQuantity &&
+				share.Deserved < share.AllocatedNotPreemptible+requestedGpus {
+				return gpuTypeOverCapacityResult(v2alpha2.NonPreemptibleOverQuota,
+					api.GetBuildOverGpuTypeQuotaMessageForQueue(queueAttributes.Name, gpuType, share.Deserved,
+						share.AllocatedNotPreemptible, requestedGpus),
+					queueAttributes, requestedGpus)
+			}
+		}
+	}
+
+	return Schedulable()
+}
+
+func gpuTypeOverCapacityResult(reason v2alpha2.UnschedulableReason, message string,
+	queueAttributes *rs.QueueAttributes, requestedGpus float64) *api.SchedulableResult {
+	requestedShare := rs.NewResourceQuantities(0, 0, requestedGpus)
+	return &api.SchedulableResult{
+		IsSchedulable: false,
+		Reason:        reason,
+		Message:       message,
+		Details: &v2alpha2.UnschedulableExplanationDetails{
+			QueueDetails: &v2alpha2.QuotaDetails{
+				Name:                       string(queueAttributes.UID),
+				PodGroupRequestedResources: utils.ResourceRequirementsFromQuantities(requestedShare).ToResourceList(),
+			},
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package loan_repayment
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var resourceNames = map[rs.ResourceName]v1.ResourceName{
+	rs.CpuResource:    v1.ResourceCPU,
+	rs.MemoryResource: v1.ResourceMemory,
+	rs.GpuResource:    commonconstants.GpuResource,
+}
+
+// LoanRepayment lets lender queues get their lent quota back gradually: a queue that uses less than its deserved
+// quota while a sibling queue uses more lends the difference to the sibling. The jobs of the borrower are not reclaimed
+// by jobs of the lender, so the loan is repaid as the borrower's jobs complete, until the lender has been starved for
+// the starvation threshold. A zero threshold disables the policy.
+type LoanRepayment struct {
+	queueAttributes     map[common_info.QueueID]*rs.QueueAttributes
+	loans               map[common_info.QueueID]map[common_info.QueueID]bool
+	starvedSince        map[common_info.QueueID]time.Time
+	starvationThreshold time.Duration
+	now                 time.Time
+}
+
+// New calculates the loans between the queues at now, reports them in the queue loans metric, and records in
+// queueLoans the lenders that are starved: they have jobs waiting for the quota they lent.
+func New(queueAttributes map[common_info.QueueID]*rs.QueueAttributes, queueLoans *queue_info.QueueLoans,
+	starvationThreshold time.Duration, now time.Time) *LoanRepayment {
+	loans := calculateLoans(queueAttributes)
+	recordLoanMetrics(queueAttributes, loans)
+	lrp := &LoanRepayment{
+		queueAttributes:     queueAttributes,
+		loans:               map[common_info.QueueID]map[common_info.QueueID]bool{},
+		starvationThreshold: starvationThreshold,
+		now:                 now,
+	}
+	for _, loan := range loans {
+		if _, found := lrp.loans[loan.Lender]; !found {
+			lrp.loans[loan.Lender] = map[common_info.QueueID]bool{}
+		}
+		lrp.loans[loan.Lender][loan.Borrower] = true
+	}
+
+	var starvedLenders []common_info.QueueID
+	for lender := range lrp.loans {
+		if isStarved(queueAttributes[lender]) {
+			starvedLenders = append(starvedLenders, lender)
+		}
+	}
+	if queueLoans == nil {
+		queueLoans = queue_info.NewQueueLoans()
+	}
+	lrp.starvedSince = queueLoans.Update(starvedLenders, now)
+	return lrp
+}
+
+// recordLoanMetrics reports the loans of the cycle by the names of the lender and borrower queues.
+func recordLoanMetrics(queueAttributes map[common_info.QueueID]*rs.QueueAttributes, loans []queue_info.Loan) {
+	metrics.ResetQueueLoans()
+	for _, loan := range loans {
+		for resource, amount := range loan.Resources {
+			switch resource {
+			case v1.ResourceCPU:
+				amount /= resource_info.MilliCPUToCores
+			case v1.ResourceMemory:
+				amount /= resource_info.MemoryToGB
+			}
+			metrics.UpdateQueueLoan(queueAttributes[loan.Lender].Name, queueAttributes[loan.Borrower].Name,
+				string(resource), amount)
+		}
+	}
+}
+
+// ReclaimVictimFilter returns false for victims whose queue hierarchy borrows from the reclaimer's queue hierarchy,
+// unless the lender has been starved for the starvation threshold.
+func (lrp *LoanRepayment) ReclaimVictimFilter(reclaimer *podgroup_info.PodGroupInfo,
+	victim *podgroup_info.PodGroupInfo) bool {
+	if lrp.starvationThreshold == 0 {
+		return true
+	}
+	lender, borrower := lrp.getSiblingAncestors(reclaimer.Queue, victim.Queue)
+	if lender == nil || !lrp.loans[lender.UID][borrower.UID] {
+		return true
+	}
+
+	since, starved := lrp.starvedSince[lender.UID]
+	if starved && !lrp.now.Before(since.Add(lrp.starvationThreshold)) {
+		return true
+	}
+	log.InfraLogger.V(5).Infof("Victim <%v/%v> is repaying the loan of queue <%s> to queue <%s> by completion",
+		victim.Namespace, victim.Name, borrower.Name, lender.Name)
+	return false
+}
+
+// getSiblingAncestors returns the ancestors of the two queues, the queues themselves included, that share a parent.
+func (lrp *LoanRepayment) getSiblingAncestors(lQueueID, rQueueID common_info.QueueID) (
+	*rs.QueueAttributes, *rs.QueueAttributes) {
+	for lQueue, found := lrp.queueAttributes[lQueueID]; found; lQueue, found =
+		lrp.queueAttributes[lQueue.ParentQueue] {
+		for rQueue, found := lrp.queueAttributes[rQueueID]; found; rQueue, found =
+			lrp.queueAttributes[rQueue.ParentQueue] {
+			if lQueue.UID == rQueue.UID {
+				return nil, nil
+			}
+			if lQueue.ParentQueue == rQueue.ParentQueue {
+				return lQueue, rQueue
+			}
+		}
+	}
+	return nil, nil
+}
+
+// calculateLoans returns the loans between sibling queues. For every resource, the part of the deserved quota that
+// the lenders do not use is lent to the borrowers in proportion to their usage beyond their deserved quota.
+func calculateLoans(queueAttributes map[common_info.QueueID]*rs.QueueAttributes) []queue_info.Loan {
+	siblings := map[common_info.QueueID][]*rs.QueueAttributes{}
+	for _, queue := range queueAttributes {
+		siblings[queue.ParentQueue] = append(siblings[queue.ParentQueue], queue)
+	}
+
+	var loans []queue_info.Loan
+	for _, queues := range siblings {
+		loanIndices := map[[2]common_info.QueueID]int{}
+		for _, resource := range rs.AllResources {
+			totalLent, totalBorrowed := 0.0, 0.0
+			for _, queue := range queues {
+				totalLent += getLent(queue, resource)
+				totalBorrowed += getBorrowed(queue, resource)
+			}
+			if totalLent == 0 || totalBorrowed == 0 {
+				continue
+			}
+			for _, lender := range queues {
+				for _, borrower := range queues {
+					amount := getLent(lender, resource) * getBorrowed(borrower, resource) /
+						max(totalLent, totalBorrowed)
+					if amount == 0 {
+						continue
+					}
+					key := [2]common_info.QueueID{lender.UID, borrower.UID}
+					if _, found := loanIndices[key]; !found {
+						loanIndices[key] = len(loans)
+						loans = append(loans, queue_info.Loan{
+							Borrower:  borrower.UID,
+							Lender:    lender.UID,
+							Resources: queue_info.QueueUsage{},
+						})
+					}
+					loans[loanIndices[key]].Resources[resourceNames[resource]] = amount
+				}
+			}
+		}
+	}
+	return loans
+}
+
+// isStarved returns whether the queue requests more of a resource than it is allocated, while being allocated less
+// than its deserved quota of that resource.
+func isStarved(queue *rs.QueueAttributes) bool {
+	for _, resource := range rs.AllResources {
+		resourceShare := queue.ResourceShare(resource)
+		if getLent(queue, resource) > 0 && resourceShare.Request > resourceShare.Allocated {
+			return true
+		}
+	}
+	return false
+}
+
+func getLent(queue *rs.QueueAttributes, resource rs.ResourceName) float64 {
+	resourceShare := queue.ResourceShare(resource)
+	if resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity {
+		return 0
+	}
+	return max(resourceShare.Deserved-resourceShare.Allocated, 0)
+}
+
+func getBorrowed(queue *rs.QueueAttributes, resource rs.ResourceName) float64 {
+	resourceShare := queue.ResourceShare(resource)
+	if resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity {
+		return 0
+	}
+	return max(resourceShare.Allocated-resourceShare.Deserved, 0)
+}
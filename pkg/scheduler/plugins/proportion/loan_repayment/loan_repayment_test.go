@@ -0,0 +1,117 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package loan_repayment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// buildQueues returns two departments deserving 4 GPUs each. dept-a holds team-a, which uses 1 GPU and requests
+// requestedGpus, and dept-b holds team-b, which uses 7 GPUs.
+func buildQueues(requestedGpus float64) map[common_info.QueueID]*rs.QueueAttributes {
+	gpuShare := func(deserved, allocated, request float64) rs.QueueResourceShare {
+		return rs.QueueResourceShare{
+			GPU: rs.ResourceShare{Deserved: deserved, Allocated: allocated, Request: request},
+			CPU: rs.ResourceShare{Deserved: commonconstants.UnlimitedResourceQuantity},
+		}
+	}
+	return map[common_info.QueueID]*rs.QueueAttributes{
+		"dept-a": {UID: "dept-a", Name: "dept-a", QueueResourceShare: gpuShare(4, 1, requestedGpus)},
+		"dept-b": {UID: "dept-b", Name: "dept-b", QueueResourceShare: gpuShare(4, 7, 7)},
+		"team-a": {UID: "team-a", Name: "team-a", ParentQueue: "dept-a",
+			QueueResourceShare: gpuShare(4, 1, requestedGpus)},
+		"team-b": {UID: "team-b", Name: "team-b", ParentQueue: "dept-b", QueueResourceShare: gpuShare(4, 7, 7)},
+	}
+}
+
+func TestLoanRepayment_CalculatesLoans(t *testing.T) {
+	assert.Equal(t, []queue_info.Loan{{
+		Borrower:  "dept-b",
+		Lender:    "dept-a",
+		Resources: queue_info.QueueUsage{commonconstants.GpuResource: 3},
+	}}, calculateLoans(buildQueues(1)))
+}
+
+func TestLoanRepayment_ReclaimVictimFilter(t *testing.T) {
+	start := time.Now()
+	reclaimer := &podgroup_info.PodGroupInfo{Name: "reclaimer", Queue: "team-a"}
+
+	tests := []struct {
+		name                string
+		starvationThreshold time.Duration
+		requestedGpus       []float64
+		times               []time.Time
+		victimQueue         common_info.QueueID
+		expectedResult      bool
+	}{
+		{
+			name:           "disabled policy",
+			requestedGpus:  []float64{4},
+			times:          []time.Time{start},
+			victimQueue:    "team-b",
+			expectedResult: true,
+		},
+		{
+			name:                "borrower repays by completion",
+			starvationThreshold: time.Minute,
+			requestedGpus:       []float64{4},
+			times:               []time.Time{start},
+			victimQueue:         "team-b",
+			expectedResult:      false,
+		},
+		{
+			name:                "lender starved for less than the threshold",
+			starvationThreshold: time.Minute,
+			requestedGpus:       []float64{4, 4},
+			times:               []time.Time{start, start.Add(30 * time.Second)},
+			victimQueue:         "team-b",
+			expectedResult:      false,
+		},
+		{
+			name:                "lender starved for the threshold",
+			starvationThreshold: time.Minute,
+			requestedGpus:       []float64{4, 4},
+			times:               []time.Time{start, start.Add(time.Minute)},
+			victimQueue:         "team-b",
+			expectedResult:      true,
+		},
+		{
+			name:                "starvation restarts after the lender is satisfied",
+			starvationThreshold: time.Minute,
+			requestedGpus:       []float64{4, 1, 4},
+			times:               []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)},
+			victimQueue:         "team-b",
+			expectedResult:      false,
+		},
+		{
+			name:                "victim queue is not a borrower",
+			starvationThreshold: time.Minute,
+			requestedGpus:       []float64{4},
+			times:               []time.Time{start},
+			victimQueue:         "team-a",
+			expectedResult:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queueLoans := queue_info.NewQueueLoans()
+			var loanRepayment *LoanRepayment
+			for i, requestedGpus := range tt.requestedGpus {
+				loanRepayment = New(buildQueues(requestedGpus), queueLoans, tt.starvationThreshold, tt.times[i])
+			}
+			victim := &podgroup_info.PodGroupInfo{Name: "victim", Queue: tt.victimQueue}
+			assert.Equal(t, tt.expectedResult, loanRepayment.ReclaimVictimFilter(reclaimer, victim))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package over_quota_policy
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+// OverQuotaPolicy applies the over-quota policy of the queues: the jobs of Strict queues never take the allocation of
+// their queue beyond its deserved quota, and the jobs of Elastic queues are not reclaimed before the over-quota
+// deadline of their queue has passed. Borrow queues keep the usual behavior.
+type OverQuotaPolicy struct {
+	queues          map[common_info.QueueID]*queue_info.QueueInfo
+	queueAttributes map[common_info.QueueID]*rs.QueueAttributes
+}
+
+func New(queues map[common_info.QueueID]*queue_info.QueueInfo,
+	queueAttributes map[common_info.QueueID]*rs.QueueAttributes) *OverQuotaPolicy {
+	return &OverQuotaPolicy{queues: queues, queueAttributes: queueAttributes}
+}
+
+// IsJobOverQuota returns an unschedulable result if allocating the tasks would take a Strict queue of the job's queue
+// hierarchy beyond its deserved quota.
+func (oqp *OverQuotaPolicy) IsJobOverQuota(job *podgroup_info.PodGroupInfo,
+	tasksToAllocate []*pod_info.PodInfo) *api.SchedulableResult {
+	requested := rs.EmptyResourceQuantities()
+	for _, task := range tasksToAllocate {
+		requested.Add(utils.QuantifyResourceRequirements(task.ResReq))
+	}
+
+	queueAttributes, resource := oqp.getStrictQueueOverQuota(job.Queue, requested)
+	if queueAttributes == nil {
+		return &api.SchedulableResult{IsSchedulable: true}
+	}
+	message := getOverQuotaMessage(queueAttributes, requested, resource)
+	log.InfraLogger.V(5).Infof("Job: <%v/%v> is over quota. Reason: %v", job.Namespace, job.Name, message)
+	return &api.SchedulableResult{
+		IsSchedulable: false,
+		Reason:        v2alpha2.StrictOverQuota,
+		Message:       message,
+	}
+}
+
+// CanReclaimResources returns false if reclaiming the required resources would take a Strict queue of the
+// reclaimer's queue hierarchy beyond its deserved quota.
+func (oqp *OverQuotaPolicy) CanReclaimResources(reclaimer *podgroup_info.PodGroupInfo,
+	requiredResources *resource_info.Resource) bool {
+	queueAttributes, resource := oqp.getStrictQueueOverQuota(reclaimer.Queue, utils.QuantifyResource(requiredResources))
+	if queueAttributes == nil {
+		return true
+	}
+	log.InfraLogger.V(5).Infof("Reclaimer <%v/%v> cannot reclaim resources: queue <%s> has the %s over-quota "+
+		"policy and would go over its %s quota", reclaimer.Namespace, reclaimer.Name, queueAttributes.Name,
+		enginev2.OverQuotaPolicyStrict, resource)
+	return false
+}
+
+// ReclaimVictimFilter returns false for victims of Elastic queues that started less than the over-quota deadline of
+// their queue ago, or of Elastic queues without a deadline.
+func (oqp *OverQuotaPolicy) ReclaimVictimFilter(_ *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if oqp.getPolicy(victim.Queue) != enginev2.OverQuotaPolicyElastic {
+		return true
+	}
+
+	deadline := oqp.getDeadline(victim.Queue)
+	if deadline == nil {
+		log.InfraLogger.V(5).Infof("Victim <%v/%v> is protected by the %s over-quota policy of its queue",
+			victim.Namespace, victim.Name, enginev2.OverQuotaPolicyElastic)
+		return false
+	}
+	if victim.LastStartTimestamp == nil || victim.LastStartTimestamp.IsZero() {
+		return true
+	}
+	protectedUntil := victim.LastStartTimestamp.Add(deadline.Duration)
+	protected := time.Now().Before(protectedUntil)
+	if protected {
+		log.InfraLogger.V(5).Infof("Victim <%v/%v> is protected by the %s over-quota policy of its queue until %v",
+			victim.Namespace, victim.Name, enginev2.OverQuotaPolicyElastic, protectedUntil)
+	}
+	return !protected
+}
+
+// getStrictQueueOverQuota returns the first queue of the hierarchy of queueID, from the queue up to its top queue,
+// whose over-quota policy is Strict and whose allocation would exceed its deserved quota with the requested
+// resources, along with the exceeding resource.
+func (oqp *OverQuotaPolicy) getStrictQueueOverQuota(queueID common_info.QueueID, requested rs.ResourceQuantities) (
+	*rs.QueueAttributes, rs.ResourceName) {
+	for queueAttributes, found := oqp.queueAttributes[queueID]; found; queueAttributes, found =
+		oqp.queueAttributes[queueAttributes.ParentQueue] {
+		if oqp.getPolicy(queueAttributes.UID) != enginev2.OverQuotaPolicyStrict {
+			continue
+		}
+		for _, resource := range rs.AllResources {
+			resourceShare := queueAttributes.ResourceShare(resource)
+			if resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity || requested[resource] == 0 {
+				continue
+			}
+			if resourceShare.Deserved < resourceShare.Allocated+requested[resource] {
+				return queueAttributes, resource
+			}
+		}
+	}
+	return nil, ""
+}
+
+// getPolicy returns the over-quota policy of the queue, inherited from the closest ancestor that sets one.
+func (oqp *OverQuotaPolicy) getPolicy(queueID common_info.QueueID) enginev2.QueueOverQuotaPolicy {
+	for queue := oqp.queues[queueID]; queue != nil; queue = oqp.queues[queue.ParentQueue] {
+		if queue.OverQuotaPolicy != "" {
+			return queue.OverQuotaPolicy
+		}
+	}
+	return enginev2.OverQuotaPolicyBorrow
+}
+
+// getDeadline returns the over-quota deadline of the queue, inherited from the closest ancestor that sets one.
+func (oqp *OverQuotaPolicy) getDeadline(queueID common_info.QueueID) *metav1.Duration {
+	for queue := oqp.queues[queueID]; queue != nil; queue = oqp.queues[queue.ParentQueue] {
+		if queue.OverQuotaDeadline != nil {
+			return queue.OverQuotaDeadline
+		}
+	}
+	return nil
+}
+
+func getOverQuotaMessage(queueAttributes *rs.QueueAttributes, requested rs.ResourceQuantities,
+	resource rs.ResourceName) string {
+	resourceShare := queueAttributes.ResourceShare(resource)
+	return fmt.Sprintf("Workload requested %v %s, but queue %s has the %s over-quota policy with a %s quota of %v, "+
+		"while %v are already allocated.", requested[resource], resource, queueAttributes.Name,
+		enginev2.OverQuotaPolicyStrict, resource, resourceShare.Deserved, resourceShare.Allocated)
+}
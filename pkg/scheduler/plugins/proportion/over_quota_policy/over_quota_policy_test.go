@@ -0,0 +1,212 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package over_quota_policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// buildQueues returns a department with a deserved quota of 8 GPUs, of which 6 are allocated, holding a team with a
+// deserved quota of 4 GPUs, of which 3 are allocated.
+func buildQueues(policies map[common_info.QueueID]enginev2.QueueOverQuotaPolicy) (
+	map[common_info.QueueID]*queue_info.QueueInfo, map[common_info.QueueID]*rs.QueueAttributes) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"dept": {UID: "dept", Name: "dept"},
+		"team": {UID: "team", Name: "team", ParentQueue: "dept"},
+	}
+	for queueID, policy := range policies {
+		queues[queueID].OverQuotaPolicy = policy
+	}
+	queueAttributes := map[common_info.QueueID]*rs.QueueAttributes{
+		"dept": {UID: "dept", Name: "dept",
+			QueueResourceShare: rs.QueueResourceShare{GPU: rs.ResourceShare{Deserved: 8, Allocated: 6}}},
+		"team": {UID: "team", Name: "team", ParentQueue: "dept",
+			QueueResourceShare: rs.QueueResourceShare{GPU: rs.ResourceShare{Deserved: 4, Allocated: 3}}},
+	}
+	return queues, queueAttributes
+}
+
+func TestOverQuotaPolicy_IsJobOverQuota(t *testing.T) {
+	tests := []struct {
+		name           string
+		policies       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy
+		requestedGpus  float64
+		expectedResult bool
+	}{
+		{
+			name:           "no policy within quota",
+			requestedGpus:  1,
+			expectedResult: true,
+		},
+		{
+			name:           "no policy over quota",
+			requestedGpus:  2,
+			expectedResult: true,
+		},
+		{
+			name:           "borrow over quota",
+			policies:       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{"team": enginev2.OverQuotaPolicyBorrow},
+			requestedGpus:  2,
+			expectedResult: true,
+		},
+		{
+			name:           "elastic over quota",
+			policies:       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{"team": enginev2.OverQuotaPolicyElastic},
+			requestedGpus:  2,
+			expectedResult: true,
+		},
+		{
+			name:           "strict within quota",
+			policies:       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{"team": enginev2.OverQuotaPolicyStrict},
+			requestedGpus:  1,
+			expectedResult: true,
+		},
+		{
+			name:           "strict over quota",
+			policies:       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{"team": enginev2.OverQuotaPolicyStrict},
+			requestedGpus:  2,
+			expectedResult: false,
+		},
+		{
+			name:           "strict inherited from the parent queue",
+			policies:       map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{"dept": enginev2.OverQuotaPolicyStrict},
+			requestedGpus:  2,
+			expectedResult: false,
+		},
+		{
+			name: "strict parent queue over quota",
+			policies: map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{
+				"dept": enginev2.OverQuotaPolicyStrict,
+				"team": enginev2.OverQuotaPolicyBorrow,
+			},
+			requestedGpus:  3,
+			expectedResult: false,
+		},
+		{
+			name: "strict parent queue within quota",
+			policies: map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{
+				"dept": enginev2.OverQuotaPolicyStrict,
+				"team": enginev2.OverQuotaPolicyBorrow,
+			},
+			requestedGpus:  2,
+			expectedResult: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := New(buildQueues(tt.policies))
+			job := &podgroup_info.PodGroupInfo{UID: "job", Queue: "team"}
+			tasks := []*pod_info.PodInfo{{ResReq: resource_info.NewResourceRequirementsWithGpus(tt.requestedGpus)}}
+
+			result := policy.IsJobOverQuota(job, tasks)
+			assert.Equal(t, tt.expectedResult, result.IsSchedulable)
+			if !tt.expectedResult {
+				assert.Equal(t, v2alpha2.StrictOverQuota, result.Reason)
+			}
+
+			assert.Equal(t, tt.expectedResult,
+				policy.CanReclaimResources(job, resource_info.NewResource(0, 0, tt.requestedGpus)))
+		})
+	}
+}
+
+func TestOverQuotaPolicy_ReclaimVictimFilter(t *testing.T) {
+	startedLongAgo := time.Now().Add(-time.Hour)
+	startedRecently := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name               string
+		policy             enginev2.QueueOverQuotaPolicy
+		deadline           *metav1.Duration
+		lastStartTimestamp *time.Time
+		expectedReclaimed  bool
+	}{
+		{
+			name:               "no policy",
+			lastStartTimestamp: &startedRecently,
+			expectedReclaimed:  true,
+		},
+		{
+			name:               "borrow",
+			policy:             enginev2.OverQuotaPolicyBorrow,
+			lastStartTimestamp: &startedRecently,
+			expectedReclaimed:  true,
+		},
+		{
+			name:               "strict",
+			policy:             enginev2.OverQuotaPolicyStrict,
+			lastStartTimestamp: &startedRecently,
+			expectedReclaimed:  true,
+		},
+		{
+			name:               "elastic without a deadline",
+			policy:             enginev2.OverQuotaPolicyElastic,
+			lastStartTimestamp: &startedLongAgo,
+			expectedReclaimed:  false,
+		},
+		{
+			name:               "elastic before the deadline",
+			policy:             enginev2.OverQuotaPolicyElastic,
+			deadline:           &metav1.Duration{Duration: 10 * time.Minute},
+			lastStartTimestamp: &startedRecently,
+			expectedReclaimed:  false,
+		},
+		{
+			name:               "elastic after the deadline",
+			policy:             enginev2.OverQuotaPolicyElastic,
+			deadline:           &metav1.Duration{Duration: 10 * time.Minute},
+			lastStartTimestamp: &startedLongAgo,
+			expectedReclaimed:  true,
+		},
+		{
+			name:              "elastic with a deadline and an unknown start time",
+			policy:            enginev2.OverQuotaPolicyElastic,
+			deadline:          &metav1.Duration{Duration: 10 * time.Minute},
+			expectedReclaimed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queues, queueAttributes := buildQueues(nil)
+			queues["team"].OverQuotaPolicy = tt.policy
+			queues["team"].OverQuotaDeadline = tt.deadline
+			policy := New(queues, queueAttributes)
+
+			reclaimer := &podgroup_info.PodGroupInfo{UID: "reclaimer", Queue: "other"}
+			victim := &podgroup_info.PodGroupInfo{UID: "victim", Queue: "team", LastStartTimestamp: tt.lastStartTimestamp}
+			assert.Equal(t, tt.expectedReclaimed, policy.ReclaimVictimFilter(reclaimer, victim))
+		})
+	}
+}
+
+func TestOverQuotaPolicy_ReclaimVictimFilterInheritance(t *testing.T) {
+	queues, queueAttributes := buildQueues(map[common_info.QueueID]enginev2.QueueOverQuotaPolicy{
+		"dept": enginev2.OverQuotaPolicyElastic,
+	})
+	queues["dept"].OverQuotaDeadline = &metav1.Duration{Duration: 10 * time.Minute}
+	policy := New(queues, queueAttributes)
+
+	startedRecently := time.Now().Add(-time.Minute)
+	startedLongAgo := time.Now().Add(-time.Hour)
+	reclaimer := &podgroup_info.PodGroupInfo{UID: "reclaimer", Queue: "other"}
+	assert.False(t, policy.ReclaimVictimFilter(reclaimer,
+		&podgroup_info.PodGroupInfo{UID: "victim", Queue: "team", LastStartTimestamp: &startedRecently}))
+	assert.True(t, policy.ReclaimVictimFilter(reclaimer,
+		&podgroup_info.PodGroupInfo{UID: "victim", Queue: "team", LastStartTimestamp: &startedLongAgo}))
+}
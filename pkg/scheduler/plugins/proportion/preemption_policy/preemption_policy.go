@@ -0,0 +1,86 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemption_policy
+
+import (
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// PreemptionPolicy constrains the victims that a preemptor may take from other queues, by the preemption policies of
+// both the preemptor's and the victim's queues. Victims from the preemptor's own queue are not constrained.
+type PreemptionPolicy struct {
+	queues map[common_info.QueueID]*queue_info.QueueInfo
+}
+
+func New(queues map[common_info.QueueID]*queue_info.QueueInfo) *PreemptionPolicy {
+	return &PreemptionPolicy{queues}
+}
+
+// CanReclaimResources returns false for reclaimers whose queue may never take victims from other queues.
+func (pp *PreemptionPolicy) CanReclaimResources(reclaimer *podgroup_info.PodGroupInfo) bool {
+	return pp.getPolicy(reclaimer.Queue) != enginev2.PreemptionPolicyNever
+}
+
+// VictimFilter returns whether the policy of the preemptor's queue allows taking victims from the victim's queue, and
+// the policy of the victim's queue allows giving victims to the preemptor's queue.
+func (pp *PreemptionPolicy) VictimFilter(preemptor *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if preemptor.Queue == victim.Queue {
+		return true
+	}
+	return pp.allows(preemptor.Queue, preemptor.Queue, victim.Queue) &&
+		pp.allows(victim.Queue, preemptor.Queue, victim.Queue)
+}
+
+// allows returns whether the policy of the queue allows jobs of the preemptor queue to take victims from the victim
+// queue, where the queue is one of them. A LowerPriorityOnly policy allows it when the victim queue has the lower
+// priority, whichever of the two queues has the policy.
+func (pp *PreemptionPolicy) allows(queueID, preemptorQueueID, victimQueueID common_info.QueueID) bool {
+	switch policy := pp.getPolicy(queueID); policy {
+	case enginev2.PreemptionPolicyNever:
+		return false
+	case enginev2.PreemptionPolicyLowerPriorityOnly:
+		return pp.hasLowerPriority(victimQueueID, preemptorQueueID)
+	case enginev2.PreemptionPolicyAny:
+		return true
+	default:
+		log.InfraLogger.V(4).Warnf("Unknown preemption policy <%s> of queue <%s>, allowing any victim",
+			policy, queueID)
+		return true
+	}
+}
+
+// getPolicy returns the preemption policy of the queue, inherited from the closest ancestor that sets one.
+func (pp *PreemptionPolicy) getPolicy(queueID common_info.QueueID) enginev2.QueuePreemptionPolicy {
+	for queue := pp.queues[queueID]; queue != nil; queue = pp.queues[queue.ParentQueue] {
+		if queue.PreemptionPolicy != "" {
+			return queue.PreemptionPolicy
+		}
+	}
+	return enginev2.PreemptionPolicyAny
+}
+
+// hasLowerPriority returns whether queue has a lower priority than other. Queue priorities only compare between
+// siblings, so the priorities of the ancestors of both queues under their closest common ancestor are compared.
+func (pp *PreemptionPolicy) hasLowerPriority(queueID, otherID common_info.QueueID) bool {
+	queuePath := pp.pathFromRoot(queueID)
+	otherPath := pp.pathFromRoot(otherID)
+	for i := 0; i < len(queuePath) && i < len(otherPath); i++ {
+		if queuePath[i] != otherPath[i] {
+			return queuePath[i].Priority < otherPath[i].Priority
+		}
+	}
+	return false
+}
+
+func (pp *PreemptionPolicy) pathFromRoot(queueID common_info.QueueID) []*queue_info.QueueInfo {
+	var path []*queue_info.QueueInfo
+	for queue := pp.queues[queueID]; queue != nil; queue = pp.queues[queue.ParentQueue] {
+		path = append([]*queue_info.QueueInfo{queue}, path...)
+	}
+	return path
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemption_policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+// buildQueues returns two departments of different priorities, each holding a high and a low priority queue:
+// dept-high (priority 200): production (150), research (50)
+// dept-low (priority 100): batch (150), scratch (50)
+func buildQueues(policies map[common_info.QueueID]enginev2.QueuePreemptionPolicy) map[common_info.QueueID]*queue_info.QueueInfo {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"dept-high":  {UID: "dept-high", Priority: 200},
+		"dept-low":   {UID: "dept-low", Priority: 100},
+		"production": {UID: "production", ParentQueue: "dept-high", Priority: 150},
+		"research":   {UID: "research", ParentQueue: "dept-high", Priority: 50},
+		"batch":      {UID: "batch", ParentQueue: "dept-low", Priority: 150},
+		"scratch":    {UID: "scratch", ParentQueue: "dept-low", Priority: 50},
+	}
+	for queueID, policy := range policies {
+		queues[queueID].PreemptionPolicy = policy
+	}
+	return queues
+}
+
+func TestPreemptionPolicy_VictimFilter(t *testing.T) {
+	victimQueues := []common_info.QueueID{"production", "research", "batch", "scratch"}
+
+	tests := []struct {
+		name            string
+		policies        map[common_info.QueueID]enginev2.QueuePreemptionPolicy
+		preemptorQueue  common_info.QueueID
+		expectedAllowed map[common_info.QueueID]bool
+	}{
+		{
+			name:           "no policy allows any victim",
+			preemptorQueue: "research",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name:           "any",
+			policies:       map[common_info.QueueID]enginev2.QueuePreemptionPolicy{"research": enginev2.PreemptionPolicyAny},
+			preemptorQueue: "research",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name:           "never only allows victims from the same queue",
+			policies:       map[common_info.QueueID]enginev2.QueuePreemptionPolicy{"batch": enginev2.PreemptionPolicyNever},
+			preemptorQueue: "batch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": false, "batch": true, "scratch": false,
+			},
+		},
+		{
+			name: "lower priority only from a low priority queue",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"research": enginev2.PreemptionPolicyLowerPriorityOnly,
+			},
+			preemptorQueue: "research",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "lower priority only from a high priority queue",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"production": enginev2.PreemptionPolicyLowerPriorityOnly,
+			},
+			preemptorQueue: "production",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "lower priority only compares departments across departments",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"batch": enginev2.PreemptionPolicyLowerPriorityOnly,
+			},
+			preemptorQueue: "batch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": false, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "policy inherited from the parent queue",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"dept-low": enginev2.PreemptionPolicyNever,
+			},
+			preemptorQueue: "scratch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": false, "batch": false, "scratch": true,
+			},
+		},
+		{
+			name: "queue policy overrides the parent queue",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"dept-low": enginev2.PreemptionPolicyNever,
+				"scratch":  enginev2.PreemptionPolicyAny,
+			},
+			preemptorQueue: "scratch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": false, "scratch": true,
+			},
+		},
+		{
+			name: "victim queue that never gives victims to other queues",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"production": enginev2.PreemptionPolicyNever,
+			},
+			preemptorQueue: "scratch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "victim queue that only gives victims to higher priority queues",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"dept-high": enginev2.PreemptionPolicyLowerPriorityOnly,
+			},
+			preemptorQueue: "batch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": false, "research": false, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "victim queue policy within the same department",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"research": enginev2.PreemptionPolicyLowerPriorityOnly,
+			},
+			preemptorQueue: "production",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": true, "scratch": true,
+			},
+		},
+		{
+			name: "both queue policies must allow the victim",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{
+				"scratch": enginev2.PreemptionPolicyAny,
+				"batch":   enginev2.PreemptionPolicyNever,
+			},
+			preemptorQueue: "scratch",
+			expectedAllowed: map[common_info.QueueID]bool{
+				"production": true, "research": true, "batch": false, "scratch": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := New(buildQueues(tt.policies))
+			preemptor := &podgroup_info.PodGroupInfo{UID: "preemptor", Queue: tt.preemptorQueue}
+			for _, victimQueue := range victimQueues {
+				victim := &podgroup_info.PodGroupInfo{UID: "victim", Queue: victimQueue}
+				assert.Equal(t, tt.expectedAllowed[victimQueue], policy.VictimFilter(preemptor, victim),
+					"victim from queue %s", victimQueue)
+			}
+		})
+	}
+}
+
+func TestPreemptionPolicy_CanReclaimResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[common_info.QueueID]enginev2.QueuePreemptionPolicy
+		expected bool
+	}{
+		{
+			name:     "no policy",
+			expected: true,
+		},
+		{
+			name:     "lower priority only",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{"research": enginev2.PreemptionPolicyLowerPriorityOnly},
+			expected: true,
+		},
+		{
+			name:     "never",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{"research": enginev2.PreemptionPolicyNever},
+			expected: false,
+		},
+		{
+			name:     "never inherited from the parent queue",
+			policies: map[common_info.QueueID]enginev2.QueuePreemptionPolicy{"dept-high": enginev2.PreemptionPolicyNever},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := New(buildQueues(tt.policies))
+			reclaimer := &podgroup_info.PodGroupInfo{UID: "reclaimer", Queue: "research"}
+			assert.Equal(t, tt.expected, policy.CanReclaimResources(reclaimer))
+		})
+	}
+}
@@ -22,6 +22,7 @@ package proportion
 import (
 	"math"
 	"strconv"
+	"time"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
@@ -38,6 +39,9 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 	cp "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/capacity_policy"
+	lrpolicy "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/loan_repayment"
+	oqpolicy "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/over_quota_policy"
+	ppolicy "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/preemption_policy"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/queue_order"
 	rec "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/reclaimable"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_division"
@@ -48,19 +52,29 @@ import (
 
 const (
 	mebibytes = 1000 * 1000
+
+	loanStarvationThresholdArg = "loanStarvationThreshold"
 )
 
 type proportionPlugin struct {
 	totalResource       rs.ResourceQuantities
 	queues              map[common_info.QueueID]*rs.QueueAttributes
 	jobSimulationQueues map[common_info.QueueID]*rs.QueueAttributes
+	// gpuTypeTaskNodes holds the nodes of the allocated tasks, since the node of a task may already be reset when
+	// it is deallocated.
+	gpuTypeTaskNodes map[common_info.PodID]*node_info.NodeInfo
 	// Arguments given for the plugin
 	pluginArguments               map[string]string
 	subGroupOrderFn               common_info.LessFn
 	taskOrderFunc                 common_info.LessFn
 	reclaimablePlugin             *rec.Reclaimable
+	preemptionPolicy              *ppolicy.PreemptionPolicy
+	overQuotaPolicy               *oqpolicy.OverQuotaPolicy
+	loanRepayment                 *lrpolicy.LoanRepayment
 	allowConsolidatingReclaim     bool
+	isCrossPartitionJob           func(job *podgroup_info.PodGroupInfo) bool
 	relcaimerSaturationMultiplier float64
+	loanStarvationThreshold       time.Duration
 }
 
 func New(arguments map[string]string) framework.Plugin {
@@ -77,11 +91,22 @@ func New(arguments map[string]string) framework.Plugin {
 		}
 	}
 
+	var loanStarvationThreshold time.Duration
+	if val, exists := arguments[loanStarvationThresholdArg]; exists {
+		if d, err := time.ParseDuration(val); err == nil && d >= 0 {
+			loanStarvationThreshold = d
+		} else {
+			log.InfraLogger.V(1).Errorf("Failed to parse %s: %s. Loan repayment is disabled.",
+				loanStarvationThresholdArg, val)
+		}
+	}
+
 	return &proportionPlugin{
 		totalResource:                 rs.EmptyResourceQuantities(),
 		queues:                        map[common_info.QueueID]*rs.QueueAttributes{},
 		pluginArguments:               arguments,
 		relcaimerSaturationMultiplier: multiplier,
+		loanStarvationThreshold:       loanStarvationThreshold,
 	}
 }
 
@@ -89,18 +114,43 @@ func (pp *proportionPlugin) Name() string {
 	return "proportion"
 }
 
+func (pp *proportionPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{
+		framework.QueueOrderFnName,
+		framework.CanReclaimResourcesFnName,
+		framework.ReclaimScenarioValidatorFnName,
+		framework.PreemptVictimFilterFnName,
+		framework.ReclaimVictimFilterFnName,
+		framework.GetQueueFairShareFnName,
+		framework.EventHandlerFnName,
+	}
+}
+
 func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	pp.calculateResourcesProportion(ssn)
 	pp.subGroupOrderFn = ssn.SubGroupOrderFn
 	pp.taskOrderFunc = ssn.TaskOrderFn
 	pp.reclaimablePlugin = rec.New(pp.relcaimerSaturationMultiplier)
 	capacityPolicy := cp.New(pp.queues)
+	pp.preemptionPolicy = ppolicy.New(ssn.Queues)
+	pp.overQuotaPolicy = oqpolicy.New(ssn.Queues, pp.queues)
+	var queueLoans *queue_info.QueueLoans
+	if ssn.Cache != nil {
+		queueLoans = ssn.Cache.QueueLoans()
+	}
+	pp.loanRepayment = lrpolicy.New(pp.queues, queueLoans, pp.loanStarvationThreshold, time.Now())
 	ssn.AddQueueOrderFn(pp.queueOrder)
 	ssn.AddCanReclaimResourcesFn(pp.CanReclaimResourcesFn)
 	ssn.AddReclaimScenarioValidatorFn(pp.reclaimableFn)
+	ssn.AddPreemptVictimFilterFn(pp.preemptionPolicy.VictimFilter)
+	ssn.AddReclaimVictimFilterFn(pp.preemptionPolicy.VictimFilter)
+	ssn.AddReclaimVictimFilterFn(pp.overQuotaPolicy.ReclaimVictimFilter)
+	ssn.AddReclaimVictimFilterFn(pp.loanRepayment.ReclaimVictimFilter)
 	ssn.AddOnJobSolutionStartFn(pp.OnJobSolutionStartFn)
 	ssn.AddIsNonPreemptibleJobOverQueueQuotaFns(capacityPolicy.IsNonPreemptibleJobOverQuota)
+	ssn.AddIsNonPreemptibleJobOverQueueQuotaFns(pp.overQuotaPolicy.IsJobOverQuota)
 	ssn.AddIsJobOverCapacityFn(capacityPolicy.IsJobOverQueueCapacity)
+	ssn.AddIsJobOverCapacityFn(pp.overQuotaPolicy.IsJobOverQuota)
 	ssn.AddIsTaskAllocationOnNodeOverCapacityFn(capacityPolicy.IsTaskAllocationOnNodeOverCapacity)
 
 	// Register event handlers.
@@ -113,11 +163,17 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddGetQueueDeservedResourcesFn(pp.getQueueDeservedResourcesFn)
 	ssn.AddGetQueueFairShareFn(pp.getQueueFairShareFn)
 	pp.allowConsolidatingReclaim = ssn.AllowConsolidatingReclaim()
+	pp.isCrossPartitionJob = ssn.IsCrossPartitionJob
 }
 
 func (pp *proportionPlugin) OnSessionClose(*framework.Session) {
 	pp.totalResource = nil
 	pp.queues = nil
+	pp.gpuTypeTaskNodes = nil
+	pp.preemptionPolicy = nil
+	pp.overQuotaPolicy = nil
+	pp.loanRepayment = nil
+	pp.isCrossPartitionJob = nil
 }
 
 func (pp *proportionPlugin) OnJobSolutionStartFn() {
@@ -128,7 +184,13 @@ func (pp *proportionPlugin) OnJobSolutionStartFn() {
 }
 
 func (pp *proportionPlugin) CanReclaimResourcesFn(reclaimer *podgroup_info.PodGroupInfo) bool {
+	if !pp.preemptionPolicy.CanReclaimResources(reclaimer) {
+		return false
+	}
 	reclaimerInfo := pp.buildReclaimerInfo(reclaimer)
+	if !pp.overQuotaPolicy.CanReclaimResources(reclaimer, reclaimerInfo.RequiredResources) {
+		return false
+	}
 	return pp.reclaimablePlugin.CanReclaimResources(pp.queues, reclaimerInfo)
 }
 
@@ -139,6 +201,11 @@ func (pp *proportionPlugin) reclaimableFn(
 	totalVictimsResources := make(map[common_info.QueueID][]*resource_info.Resource)
 	victims := scenario.GetVictims()
 	for _, victim := range victims {
+		// Cross partition victims are not accounted to the queues of the partition, the reclaimer's queues are
+		// still required to stay within their fair share
+		if pp.isCrossPartitionJob != nil && pp.isCrossPartitionJob(victim.Job) {
+			continue
+		}
 		totalJobResources := pp.getVictimResources(victim)
 		if len(totalJobResources) == 0 {
 			continue
@@ -241,8 +308,13 @@ func (pp *proportionPlugin) calculateResourcesProportion(ssn *framework.Session)
 		"queues: <%d>", pp.totalResource, len(ssn.Nodes), len(pp.queues))
 }
 
+// setTotalResources sums the resources of the nodes of the session's partition. Nodes of other node pools, which are
+// in the session only for cross partition reclaim, are not divided between the queues of the partition.
 func (pp *proportionPlugin) setTotalResources(ssn *framework.Session) {
 	for _, node := range ssn.Nodes {
+		if ssn.IsCrossPartitionNode(node) {
+			continue
+		}
 		pp.totalResource.Add(getNodeResources(ssn, node))
 	}
 }
@@ -281,6 +353,7 @@ func getNodeResources(ssn *framework.Session, node *node_info.NodeInfo) rs.Resou
 
 func (pp *proportionPlugin) createQueueAttributes(ssn *framework.Session) {
 	pp.createQueueResourceAttrs(ssn)
+	pp.setWeightedGuarantees(ssn)
 	pp.updateQueuesCurrentResourceUsage(ssn)
 	pp.setFairShare()
 }
@@ -325,6 +398,7 @@ func (pp *proportionPlugin) createQueueResourceAttrs(ssn *framework.Session) {
 		limit = queue.Resources.GPU.Limit
 		overQuotaWeight = queue.Resources.GPU.OverQuotaWeight
 		queueAttributes.SetQuotaResources(rs.GpuResource, deserved, limit, overQuotaWeight)
+		queueAttributes.SetGpuTypeQuotas(queue.Resources.GpuTypes)
 
 		usage, found := ssn.ResourceUsage.Queues[queue.UID]
 		if found {
@@ -336,8 +410,14 @@ func (pp *proportionPlugin) createQueueResourceAttrs(ssn *framework.Session) {
 	}
 }
 
+// updateQueuesCurrentResourceUsage accounts the jobs of the session's partition to their queues. Jobs of other node
+// pools are accounted by the scheduler of their own partition.
 func (pp *proportionPlugin) updateQueuesCurrentResourceUsage(ssn *framework.Session) {
+	pp.gpuTypeTaskNodes = map[common_info.PodID]*node_info.NodeInfo{}
 	for _, job := range ssn.PodGroupInfos {
+		if ssn.IsCrossPartitionJob(job) {
+			continue
+		}
 		log.InfraLogger.V(7).Infof("Updateding queue consumed resources based on job <%s/%s>.",
 			job.Namespace, job.Name)
 
@@ -347,6 +427,7 @@ func (pp *proportionPlugin) updateQueuesCurrentResourceUsage(ssn *framework.Sess
 					resources := utils.QuantifyResourceRequirements(t.AcceptedResource)
 					isPreemptible := job.IsPreemptibleJob()
 					pp.updateQueuesResourceUsageForAllocatedJob(job.Queue, resources, isPreemptible)
+					pp.allocateQueuesGpuTypes(ssn, t, job.Queue, resources[rs.GpuResource], isPreemptible)
 				}
 			} else if status == pod_status.Pending {
 				for _, t := range tasks {
@@ -375,6 +456,34 @@ func (pp *proportionPlugin) updateQueuesResourceUsageForAllocatedJob(queueId com
 	}
 }
 
+// allocateQueuesGpuTypes adds the GPUs of the task to the per GPU model allocation of the queue and its ancestors,
+// according to the GPU model of the task's node.
+func (pp *proportionPlugin) allocateQueuesGpuTypes(ssn *framework.Session, task *pod_info.PodInfo,
+	queueId common_info.QueueID, gpus float64, preemptibleJob bool) {
+	node, found := ssn.Nodes[task.NodeName]
+	if !found || gpus == 0 {
+		return
+	}
+	pp.gpuTypeTaskNodes[task.UID] = node
+	for queueAttributes, ok := pp.queues[queueId]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
+		queueAttributes.AddGpuTypeAllocation(node, gpus, preemptibleJob)
+	}
+}
+
+// deallocateQueuesGpuTypes removes the GPUs of the task from the per GPU model allocation of the queue and its
+// ancestors.
+func (pp *proportionPlugin) deallocateQueuesGpuTypes(task *pod_info.PodInfo, queueId common_info.QueueID,
+	gpus float64, preemptibleJob bool) {
+	node, found := pp.gpuTypeTaskNodes[task.UID]
+	if !found {
+		return
+	}
+	delete(pp.gpuTypeTaskNodes, task.UID)
+	for queueAttributes, ok := pp.queues[queueId]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
+		queueAttributes.AddGpuTypeAllocation(node, -gpus, preemptibleJob)
+	}
+}
+
 func (pp *proportionPlugin) updateQueuesResourceUsageForPendingJob(queueId common_info.QueueID,
 	resourceQuantities rs.ResourceQuantities) {
 
@@ -430,6 +539,9 @@ func (pp *proportionPlugin) getChildQueues(parentQueue *rs.QueueAttributes) map[
 func (pp *proportionPlugin) allocateHandlerFn(ssn *framework.Session) func(event *framework.Event) {
 	return func(event *framework.Event) {
 		job := ssn.PodGroupInfos[event.Task.Job]
+		if ssn.IsCrossPartitionJob(job) {
+			return
+		}
 		isPreemptibleJob := job.IsPreemptibleJob()
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
 
@@ -443,6 +555,7 @@ func (pp *proportionPlugin) allocateHandlerFn(ssn *framework.Session) func(event
 				}
 			}
 		}
+		pp.allocateQueuesGpuTypes(ssn, event.Task, job.Queue, taskResources[rs.GpuResource], isPreemptibleJob)
 
 		leafQueue := pp.queues[job.Queue]
 		log.InfraLogger.V(7).Infof("Proportion AllocateFunc: job <%v/%v>, task resources <%s>, "+
@@ -454,6 +567,9 @@ func (pp *proportionPlugin) allocateHandlerFn(ssn *framework.Session) func(event
 func (pp *proportionPlugin) deallocateHandlerFn(ssn *framework.Session) func(event *framework.Event) {
 	return func(event *framework.Event) {
 		job := ssn.PodGroupInfos[event.Task.Job]
+		if ssn.IsCrossPartitionJob(job) {
+			return
+		}
 		isPreemptibleJob := job.IsPreemptibleJob()
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
 
@@ -467,6 +583,7 @@ func (pp *proportionPlugin) deallocateHandlerFn(ssn *framework.Session) func(eve
 				}
 			}
 		}
+		pp.deallocateQueuesGpuTypes(event.Task, job.Queue, taskResources[rs.GpuResource], isPreemptibleJob)
 
 		leafQueue := pp.queues[job.Queue]
 		log.InfraLogger.V(7).Infof("Proportion DeallocateFunc: job <%v/%v>, task resources <%s>, "+
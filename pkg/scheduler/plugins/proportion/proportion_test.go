@@ -24,12 +24,16 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	k8splugins "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal/plugins"
 	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
 )
 
 const schedulerName = "kai-scheduler"
@@ -941,6 +945,65 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 	})
 })
 
+var _ = Describe("Cross partition resources", func() {
+	It("should not account nodes and jobs of other node pools", func() {
+		jobs, _, _ := jobs_fake.BuildJobsAndTasksMaps([]*jobs_fake.TestJobBasic{
+			{
+				Name:                "production-job",
+				RequiredCPUsPerTask: 1,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "production-node"}},
+			},
+			{
+				Name:                "batch-job",
+				RequiredCPUsPerTask: 2,
+				QueueName:           "queue0",
+				Priority:            constants.PriorityTrainNumber,
+				Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Running, NodeName: "batch-node"}},
+			},
+		})
+		jobs["production-job"].PodGroup.Labels = map[string]string{"pool": "production"}
+		jobs["batch-job"].PodGroup.Labels = map[string]string{"pool": "batch"}
+		for _, job := range jobs {
+			for _, task := range job.GetAllPodsMap() {
+				task.AcceptedResource = task.ResReq.Clone()
+			}
+		}
+		buildNode := func(name, pool string) *node_info.NodeInfo {
+			return &node_info.NodeInfo{
+				Name:        name,
+				Node:        &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}}},
+				Allocatable: common_info.BuildResource("8000m", "10G"),
+			}
+		}
+		ssn := &framework.Session{
+			SchedulerParams: conf.SchedulerParams{
+				SchedulerName: schedulerName,
+				PartitionParams: &conf.SchedulingNodePoolParams{
+					NodePoolLabelKey:               "pool",
+					NodePoolLabelValue:             "production",
+					CrossPartitionReclaimNodePools: []string{"batch"},
+				},
+			},
+			Nodes: map[string]*node_info.NodeInfo{
+				"production-node": buildNode("production-node", "production"),
+				"batch-node":      buildNode("batch-node", "batch"),
+			},
+			PodGroupInfos: jobs,
+			Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+				"queue0": {UID: "queue0", Name: "queue0"},
+			},
+		}
+
+		plugin := New(map[string]string{}).(*proportionPlugin)
+		plugin.calculateResourcesProportion(ssn)
+
+		Expect(plugin.totalResource[rs.CpuResource]).To(Equal(float64(8000)))
+		Expect(plugin.queues["queue0"].GetAllocatedShare()[rs.CpuResource]).To(Equal(float64(1000)))
+	})
+})
+
 var _ = Describe("New", func() {
 	Context("Initializing proportion plugin", func() {
 		var args map[string]string
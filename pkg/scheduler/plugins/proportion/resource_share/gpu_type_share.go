@@ -0,0 +1,54 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_share
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+// SetGpuTypeQuotas sets the per GPU model quotas of the queue. Only the Deserved, MaxAllowed, Allocated and
+// AllocatedNotPreemptible fields of a GPU type share are used.
+func (q *QueueAttributes) SetGpuTypeQuotas(quotas map[string]queue_info.GpuTypeQuota) {
+	if len(quotas) == 0 {
+		q.GpuTypes = nil
+		return
+	}
+
+	q.GpuTypes = make(map[string]*ResourceShare, len(quotas))
+	for gpuType, quota := range quotas {
+		q.GpuTypes[gpuType] = &ResourceShare{
+			Deserved:   quota.Quota,
+			MaxAllowed: quota.Limit,
+		}
+	}
+}
+
+// AddGpuTypeAllocation adds GPUs allocated on the node to the GPU types of the queue that match the node's GPU model.
+// Negative GPUs release an allocation.
+func (q *QueueAttributes) AddGpuTypeAllocation(node *node_info.NodeInfo, gpus float64, preemptible bool) {
+	if gpus == 0 {
+		return
+	}
+	for gpuType, share := range q.GpuTypes {
+		if !node.HasGpuModel(gpuType) {
+			continue
+		}
+		share.Allocated += gpus
+		if !preemptible {
+			share.AllocatedNotPreemptible += gpus
+		}
+	}
+}
+
+func cloneGpuTypes(gpuTypes map[string]*ResourceShare) map[string]*ResourceShare {
+	if gpuTypes == nil {
+		return nil
+	}
+	clone := make(map[string]*ResourceShare, len(gpuTypes))
+	for gpuType, share := range gpuTypes {
+		clone[gpuType] = share.Clone()
+	}
+	return clone
+}
@@ -26,6 +26,8 @@ type QueueAttributes struct {
 	ChildQueues       []common_info.QueueID
 	CreationTimestamp metav1.Time
 	Priority          int
+	// GpuTypes holds the GPU shares of the queue per GPU model, for the GPU models with a quota or a limit.
+	GpuTypes map[string]*ResourceShare
 	QueueResourceShare
 }
 
@@ -37,6 +39,7 @@ func (q *QueueAttributes) Clone() *QueueAttributes {
 		ChildQueues:        slices.Clone(q.ChildQueues),
 		CreationTimestamp:  q.CreationTimestamp,
 		Priority:           q.Priority,
+		GpuTypes:           cloneGpuTypes(q.GpuTypes),
 		QueueResourceShare: q.QueueResourceShare,
 	}
 }
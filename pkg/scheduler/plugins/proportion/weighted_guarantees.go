@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// setWeightedGuarantees derives the deserved quotas of queues with a guarantee weight, top down from the cluster
+// capacity. For every resource, the deserved quota of a parent queue that is left after the explicit quotas of its
+// child queues is divided among the child queues with a guarantee weight and no explicit quota, in proportion to their
+// weights. A derived quota is the capacity its own child queues are divided from.
+func (pp *proportionPlugin) setWeightedGuarantees(ssn *framework.Session) {
+	weights := map[common_info.QueueID]int{}
+	for _, queue := range ssn.Queues {
+		if queue.GuaranteeWeight != nil && *queue.GuaranteeWeight > 0 {
+			weights[queue.UID] = *queue.GuaranteeWeight
+		}
+	}
+	if len(weights) == 0 {
+		return
+	}
+
+	pp.divideGuarantees("cluster", pp.getTopQueues(), pp.totalResource, weights)
+}
+
+func (pp *proportionPlugin) divideGuarantees(parentName string, siblings map[common_info.QueueID]*rs.QueueAttributes,
+	capacity rs.ResourceQuantities, weights map[common_info.QueueID]int,
+) {
+	for _, resource := range rs.AllResources {
+		remaining := capacity[resource]
+		totalWeight := 0
+		var weightedQueues []*rs.QueueAttributes
+		for queueID, queue := range siblings {
+			deserved := queue.ResourceShare(resource).Deserved
+			if weight, found := weights[queueID]; found && deserved == 0 {
+				totalWeight += weight
+				weightedQueues = append(weightedQueues, queue)
+			} else if deserved > 0 {
+				remaining -= deserved
+			}
+		}
+		if totalWeight == 0 {
+			continue
+		}
+		if remaining < 0 {
+			log.InfraLogger.V(2).Warnf("Explicit %s quotas of the child queues of <%s> exceed its capacity of %v, "+
+				"queues with a guarantee weight get no %s quota", resource, parentName, capacity[resource], resource)
+			remaining = 0
+		}
+
+		for _, queue := range weightedQueues {
+			resourceShare := queue.ResourceShare(resource)
+			derived := remaining * float64(weights[queue.UID]) / float64(totalWeight)
+			queue.SetQuotaResources(resource, derived, resourceShare.MaxAllowed, resourceShare.OverQuotaWeight)
+			log.InfraLogger.V(5).Infof("Derived %s quota of queue <%s> from its guarantee weight: %v",
+				resource, queue.Name, derived)
+		}
+	}
+
+	for _, queue := range siblings {
+		if len(queue.ChildQueues) == 0 {
+			continue
+		}
+		childCapacity := capacity.Clone()
+		for _, resource := range rs.AllResources {
+			if deserved := queue.ResourceShare(resource).Deserved; deserved != commonconstants.UnlimitedResourceQuantity {
+				childCapacity[resource] = deserved
+			}
+		}
+		pp.divideGuarantees(queue.Name, pp.getChildQueues(queue), childCapacity, weights)
+	}
+}
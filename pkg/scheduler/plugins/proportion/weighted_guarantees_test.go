@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Set weighted guarantees", func() {
+	type testQueue struct {
+		parent      common_info.QueueID
+		gpuDeserved float64
+		cpuDeserved float64
+		weight      *int
+	}
+	type testData struct {
+		queues         map[common_info.QueueID]testQueue
+		totalResources rs.ResourceQuantities
+		expectedGPU    map[common_info.QueueID]float64
+		expectedCPU    map[common_info.QueueID]float64
+	}
+
+	DescribeTable("Derive deserved quotas from guarantee weights", func(data testData) {
+		ssn := &framework.Session{Queues: map[common_info.QueueID]*queue_info.QueueInfo{}}
+		plugin := &proportionPlugin{
+			totalResource: data.totalResources,
+			queues:        map[common_info.QueueID]*rs.QueueAttributes{},
+		}
+		for queueID, queue := range data.queues {
+			ssn.Queues[queueID] = &queue_info.QueueInfo{UID: queueID, Name: string(queueID),
+				ParentQueue: queue.parent, GuaranteeWeight: queue.weight}
+			attributes := &rs.QueueAttributes{UID: queueID, Name: string(queueID), ParentQueue: queue.parent}
+			attributes.SetQuotaResources(rs.GpuResource, queue.gpuDeserved, commonconstants.UnlimitedResourceQuantity, 1)
+			attributes.SetQuotaResources(rs.CpuResource, queue.cpuDeserved, commonconstants.UnlimitedResourceQuantity, 1)
+			plugin.queues[queueID] = attributes
+		}
+		for queueID, queue := range data.queues {
+			if queue.parent != "" {
+				plugin.queues[queue.parent].ChildQueues = append(plugin.queues[queue.parent].ChildQueues, queueID)
+			}
+		}
+
+		plugin.setWeightedGuarantees(ssn)
+
+		for queueID, expected := range data.expectedGPU {
+			Expect(plugin.queues[queueID].GPU.Deserved).To(BeNumerically("~", expected, 1e-9),
+				"GPU deserved of queue %s", queueID)
+		}
+		for queueID, expected := range data.expectedCPU {
+			Expect(plugin.queues[queueID].CPU.Deserved).To(BeNumerically("~", expected, 1e-9),
+				"CPU deserved of queue %s", queueID)
+		}
+	},
+		Entry("top queues divide the cluster capacity by weight", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {weight: ptr.To(1)},
+				"d2": {weight: ptr.To(3)},
+			},
+			totalResources: rs.NewResourceQuantities(8000, 0, 8),
+			expectedGPU:    map[common_info.QueueID]float64{"d1": 2, "d2": 6},
+			expectedCPU:    map[common_info.QueueID]float64{"d1": 2000, "d2": 6000},
+		}),
+		Entry("explicit quotas are kept and taken from the parent quota first", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {gpuDeserved: 4},
+				"q1": {parent: "d1", weight: ptr.To(1)},
+				"q2": {parent: "d1", weight: ptr.To(1)},
+				"q3": {parent: "d1", gpuDeserved: 2, weight: ptr.To(6)},
+			},
+			totalResources: rs.NewResourceQuantities(0, 0, 10),
+			expectedGPU:    map[common_info.QueueID]float64{"d1": 4, "q1": 1, "q2": 1, "q3": 2},
+		}),
+		Entry("derived quotas are divided further down the hierarchy", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {weight: ptr.To(1)},
+				"d2": {gpuDeserved: 4},
+				"q1": {parent: "d1", weight: ptr.To(2)},
+				"q2": {parent: "d1", weight: ptr.To(1)},
+			},
+			totalResources: rs.NewResourceQuantities(0, 0, 10),
+			expectedGPU:    map[common_info.QueueID]float64{"d1": 6, "d2": 4, "q1": 4, "q2": 2},
+		}),
+		Entry("explicit quotas above the parent quota leave nothing to weighted queues", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {gpuDeserved: 2},
+				"q1": {parent: "d1", gpuDeserved: 3},
+				"q2": {parent: "d1", weight: ptr.To(1)},
+			},
+			totalResources: rs.NewResourceQuantities(0, 0, 10),
+			expectedGPU:    map[common_info.QueueID]float64{"q1": 3, "q2": 0},
+		}),
+		Entry("children of an unlimited queue divide the capacity of its parent", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {gpuDeserved: commonconstants.UnlimitedResourceQuantity},
+				"q1": {parent: "d1", weight: ptr.To(1)},
+				"q2": {parent: "d1", weight: ptr.To(1)},
+			},
+			totalResources: rs.NewResourceQuantities(0, 0, 10),
+			expectedGPU: map[common_info.QueueID]float64{
+				"d1": commonconstants.UnlimitedResourceQuantity, "q1": 5, "q2": 5,
+			},
+		}),
+		Entry("queues without weights keep their quotas", testData{
+			queues: map[common_info.QueueID]testQueue{
+				"d1": {gpuDeserved: 3},
+				"q1": {parent: "d1"},
+			},
+			totalResources: rs.NewResourceQuantities(0, 0, 10),
+			expectedGPU:    map[common_info.QueueID]float64{"d1": 3, "q1": 0},
+		}),
+	)
+})
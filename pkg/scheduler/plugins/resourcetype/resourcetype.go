@@ -4,24 +4,47 @@
 package resourcetype
 
 import (
+	"strings"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName            = "resourcetype"
+	gpuNodeLabelPrefixArg = "gpuNodeLabelPrefix"
+
+	defaultGpuNodeLabelPrefix = "nvidia.com/"
 )
 
+// resourceType prefers CPU nodes for pods that require no GPU, so that the CPU-only helper pods of GPU jobs do not
+// take the CPU and memory of GPU nodes from GPU pods. With the default weight, the preference outweighs the packing,
+// spreading, availability and GPU sharing scores, so such pods land on CPU nodes whenever one fits and on GPU nodes
+// only as a last resort. Pods
+// that select nodes by a GPU node label, e.g. nvidia.com/gpu.product, need a feature of GPU nodes and are not scored.
 type resourceType struct {
-	pluginArguments map[string]string
+	weight             float64
+	gpuNodeLabelPrefix string
 }
 
 func New(arguments map[string]string) framework.Plugin {
-	return &resourceType{pluginArguments: arguments}
+	weight := utils.ParseWeightArg(arguments, pluginName)
+
+	gpuNodeLabelPrefix := defaultGpuNodeLabelPrefix
+	if val, found := arguments[gpuNodeLabelPrefixArg]; found && strings.TrimSpace(val) != "" {
+		gpuNodeLabelPrefix = strings.TrimSpace(val)
+	}
+
+	return &resourceType{weight: weight, gpuNodeLabelPrefix: gpuNodeLabelPrefix}
 }
 
 func (pp *resourceType) Name() string {
-	return "resourcetype"
+	return pluginName
 }
 
 func (pp *resourceType) OnSessionOpen(ssn *framework.Session) {
@@ -32,8 +55,8 @@ func (pp *resourceType) nodeOrderFn() api.NodeOrderFn {
 	return func(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
 		score := 0.0
 		isCPUOnlyTask := task.IsCPUOnlyRequest()
-		if isCPUOnlyTask && node.IsCPUOnlyNode() {
-			score = scores.ResourceType
+		if isCPUOnlyTask && node.IsCPUOnlyNode() && !pp.selectsGpuNodeLabel(task) {
+			score = pp.weight * scores.ResourceType
 		}
 		log.InfraLogger.V(7).Infof(
 			"Task %s requests GPU: %t. On node with %f total allocatable GPU. Score: %f",
@@ -42,4 +65,31 @@ func (pp *resourceType) nodeOrderFn() api.NodeOrderFn {
 	}
 }
 
+// selectsGpuNodeLabel returns true if the node selector or the required node affinity of the pod selects nodes by a
+// GPU node label.
+func (pp *resourceType) selectsGpuNodeLabel(task *pod_info.PodInfo) bool {
+	if task.Pod == nil {
+		return false
+	}
+	for key := range task.Pod.Spec.NodeSelector {
+		if strings.HasPrefix(key, pp.gpuNodeLabelPrefix) {
+			return true
+		}
+	}
+
+	affinity := task.Pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return false
+	}
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			if strings.HasPrefix(expression.Key, pp.gpuNodeLabelPrefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (pp *resourceType) OnSessionClose(_ *framework.Session) {}
@@ -49,7 +49,7 @@ var _ = Describe("resourceType", func() {
 			})
 
 			Context("scoring a node for a task", func() {
-				It("Returns 10 score if both task is CPU only and node doesn't have GPUs", func() {
+				It("Returns the resource type score if both task is CPU only and node doesn't have GPUs", func() {
 					task := createFakeTask("task-1", 0, 500)
 					node := createFakeNode("node-1", 0, map[v1.ResourceName]int{})
 					score, _ := nodeOrderFn(task, node)
@@ -83,6 +83,70 @@ var _ = Describe("resourceType", func() {
 					score, _ := nodeOrderFn(task, node)
 					Expect(score).To(Equal(0.0))
 				})
+				It("Returns 0 score on labelled GPU nodes", func() {
+					node := createFakeNode("node-1", 1, map[v1.ResourceName]int{})
+					node.Node.Labels = map[string]string{
+						"nvidia.com/gpu.present": "true",
+						"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB",
+					}
+					cpuTask := createFakeTask("task-1", 0, 500)
+					gpuTask := createFakeTask("task-2", 1, 500)
+					gpuTask.Pod.Spec.NodeSelector = map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"}
+					for _, task := range []*pod_info.PodInfo{cpuTask, gpuTask} {
+						score, _ := nodeOrderFn(task, node)
+						Expect(score).To(Equal(0.0), task.Name)
+					}
+				})
+				It("Scores CPU nodes above the packing, spreading, availability and GPU sharing scores", func() {
+					task := createFakeTask("task-1", 0, 500)
+					score, _ := nodeOrderFn(task, createFakeNode("node-1", 0, map[v1.ResourceName]int{}))
+					Expect(score).To(BeNumerically(">",
+						scores.MaxHighDensity+scores.Availability+scores.GpuSharing+scores.NoisyNeighbor))
+				})
+				It("Returns 0 score if task is CPU only and selects nodes by a GPU node label", func() {
+					task := createFakeTask("task-1", 0, 500)
+					task.Pod.Spec.NodeSelector = map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"}
+					node := createFakeNode("node-1", 0, map[v1.ResourceName]int{})
+					score, _ := nodeOrderFn(task, node)
+					Expect(score).To(Equal(0.0))
+				})
+				It("Returns 0 score if task is CPU only and requires a GPU node label by affinity", func() {
+					task := createFakeTask("task-1", 0, 500)
+					task.Pod.Spec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+							NodeSelectorTerms: []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{{
+								Key: "nvidia.com/gpu.present", Operator: v1.NodeSelectorOpExists,
+							}}}},
+						},
+					}}
+					node := createFakeNode("node-1", 0, map[v1.ResourceName]int{})
+					score, _ := nodeOrderFn(task, node)
+					Expect(score).To(Equal(0.0))
+				})
+			})
+		})
+
+		Describe("arguments", func() {
+			scoreOf := func(arguments map[string]string, task *pod_info.PodInfo) float64 {
+				ssn := framework.Session{}
+				resourcetype.New(arguments).OnSessionOpen(&ssn)
+				score, _ := ssn.NodeOrderFns[0](task, createFakeNode("node-1", 0, map[v1.ResourceName]int{}))
+				return score
+			}
+
+			It("Multiplies the score by the weight", func() {
+				score := scoreOf(map[string]string{"weight": "200"}, createFakeTask("task-1", 0, 500))
+				Expect(score).To(Equal(float64(200 * scores.ResourceType)))
+			})
+			It("Uses the default weight for an invalid weight", func() {
+				score := scoreOf(map[string]string{"weight": "-1"}, createFakeTask("task-1", 0, 500))
+				Expect(score).To(Equal(float64(scores.ResourceType)))
+			})
+			It("Uses the configured GPU node label prefix", func() {
+				task := createFakeTask("task-1", 0, 500)
+				task.Pod.Spec.NodeSelector = map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"}
+				score := scoreOf(map[string]string{"gpuNodeLabelPrefix": "example.com/"}, task)
+				Expect(score).To(Equal(float64(scores.ResourceType)))
 			})
 		})
 	})
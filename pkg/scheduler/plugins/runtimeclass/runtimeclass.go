@@ -0,0 +1,112 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimeclass
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName = "runtimeclass"
+
+	runtimeClassesAnnotationArg   = "runtimeClassesAnnotation"
+	timeSlicedRuntimeClassesArg   = "timeSlicedRuntimeClasses"
+	timeSlicingNodeLabelArg       = "timeSlicingNodeLabel"
+	defaultTimeSlicingNodeLabel   = "nvidia.com/gpu.sharing-strategy=time-slicing"
+	defaultTimeSlicedRuntimeClass = "nvidia-time-slicing"
+)
+
+// runtimeClassPlugin places pods only on nodes that support their runtime class. A node lists the runtime classes it
+// supports in a comma separated annotation, and nodes without the annotation support any runtime class. GPU pods of a
+// time-sliced runtime class are placed only on nodes whose GPUs are time-sliced, identified by a node label, since
+// their GPU semantics differ from the exclusive GPUs of the other runtime classes.
+type runtimeClassPlugin struct {
+	runtimeClassesAnnotation string
+	timeSlicedRuntimeClasses []string
+	timeSlicingLabelKey      string
+	timeSlicingLabelValue    string
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	runtimeClassesAnnotation := commonconstants.RuntimeClasses
+	if val, found := arguments[runtimeClassesAnnotationArg]; found && val != "" {
+		runtimeClassesAnnotation = val
+	}
+
+	timeSlicedRuntimeClasses := []string{defaultTimeSlicedRuntimeClass}
+	if val, found := arguments[timeSlicedRuntimeClassesArg]; found {
+		timeSlicedRuntimeClasses = splitList(val)
+	}
+
+	timeSlicingNodeLabel := utils.ParseArg(arguments, timeSlicingNodeLabelArg, pluginName,
+		defaultTimeSlicingNodeLabel, func(val string) (string, error) { return val, nil },
+		func(label string) bool {
+			labelKey, _, found := strings.Cut(label, "=")
+			return found && labelKey != ""
+		})
+	labelKey, labelValue, _ := strings.Cut(timeSlicingNodeLabel, "=")
+
+	return &runtimeClassPlugin{
+		runtimeClassesAnnotation: runtimeClassesAnnotation,
+		timeSlicedRuntimeClasses: timeSlicedRuntimeClasses,
+		timeSlicingLabelKey:      labelKey,
+		timeSlicingLabelValue:    labelValue,
+	}
+}
+
+func (rcp *runtimeClassPlugin) Name() string {
+	return pluginName
+}
+
+func (rcp *runtimeClassPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddPredicateFn(rcp.predicateFn)
+}
+
+func (rcp *runtimeClassPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (rcp *runtimeClassPlugin) predicateFn(
+	task *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) error {
+	if task.Pod == nil || task.Pod.Spec.RuntimeClassName == nil || node.Node == nil {
+		return nil
+	}
+	runtimeClass := *task.Pod.Spec.RuntimeClassName
+
+	if value, found := node.Node.Annotations[rcp.runtimeClassesAnnotation]; found {
+		if supported := splitList(value); !slices.Contains(supported, runtimeClass) {
+			return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+				fmt.Sprintf("node does not support runtime class %s, only %s", runtimeClass,
+					strings.Join(supported, ", ")))
+		}
+	}
+
+	isTimeSliced := slices.Contains(rcp.timeSlicedRuntimeClasses, runtimeClass)
+	if isTimeSliced && !task.ResReq.GpuResourceRequirement.IsEmpty() &&
+		node.Node.Labels[rcp.timeSlicingLabelKey] != rcp.timeSlicingLabelValue {
+		return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+			fmt.Sprintf("runtime class %s requires time-sliced GPUs, and the node is not labeled %s=%s",
+				runtimeClass, rcp.timeSlicingLabelKey, rcp.timeSlicingLabelValue))
+	}
+	return nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
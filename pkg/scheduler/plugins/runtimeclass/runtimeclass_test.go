@@ -0,0 +1,124 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimeclass
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+func TestPredicateFn(t *testing.T) {
+	timeSlicingLabels := map[string]string{"nvidia.com/gpu.sharing-strategy": "time-slicing"}
+
+	tests := []struct {
+		name            string
+		arguments       map[string]string
+		runtimeClass    *string
+		gpus            string
+		nodeAnnotations map[string]string
+		nodeLabels      map[string]string
+		expectedError   string
+	}{
+		{
+			name:            "pod without a runtime class",
+			nodeAnnotations: map[string]string{commonconstants.RuntimeClasses: "nvidia"},
+		},
+		{
+			name:         "node without supported runtime classes",
+			runtimeClass: ptr.To("kata"),
+		},
+		{
+			name:            "supported runtime class",
+			runtimeClass:    ptr.To("nvidia"),
+			gpus:            "1",
+			nodeAnnotations: map[string]string{commonconstants.RuntimeClasses: "runc, nvidia"},
+		},
+		{
+			name:            "unsupported runtime class",
+			runtimeClass:    ptr.To("kata"),
+			nodeAnnotations: map[string]string{commonconstants.RuntimeClasses: "runc,nvidia"},
+			expectedError:   "node does not support runtime class kata, only runc, nvidia",
+		},
+		{
+			name:         "time-sliced GPU pod on a time-slicing node",
+			runtimeClass: ptr.To("nvidia-time-slicing"),
+			gpus:         "1",
+			nodeLabels:   timeSlicingLabels,
+		},
+		{
+			name:          "time-sliced GPU pod on an exclusive node",
+			runtimeClass:  ptr.To("nvidia-time-slicing"),
+			gpus:          "1",
+			expectedError: "runtime class nvidia-time-slicing requires time-sliced GPUs, and the node is not labeled nvidia.com/gpu.sharing-strategy=time-slicing",
+		},
+		{
+			name:         "time-sliced CPU only pod on an exclusive node",
+			runtimeClass: ptr.To("nvidia-time-slicing"),
+		},
+		{
+			name:         "exclusive GPU pod on an exclusive node",
+			runtimeClass: ptr.To("nvidia"),
+			gpus:         "1",
+		},
+		{
+			name: "configured mapping",
+			arguments: map[string]string{
+				runtimeClassesAnnotationArg: "example.com/runtimes",
+				timeSlicedRuntimeClassesArg: "shared-a,shared-b",
+				timeSlicingNodeLabelArg:     "example.com/time-slicing=true",
+			},
+			runtimeClass:    ptr.To("shared-b"),
+			gpus:            "1",
+			nodeAnnotations: map[string]string{"example.com/runtimes": "shared-b"},
+			expectedError:   "runtime class shared-b requires time-sliced GPUs, and the node is not labeled example.com/time-slicing=true",
+		},
+		{
+			name:         "invalid time-slicing node label",
+			arguments:    map[string]string{timeSlicingNodeLabelArg: "time-slicing"},
+			runtimeClass: ptr.To("nvidia-time-slicing"),
+			gpus:         "1",
+			nodeLabels:   timeSlicingLabels,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*runtimeClassPlugin)
+			node := &node_info.NodeInfo{
+				Name: "node-1",
+				Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1", Annotations: tt.nodeAnnotations, Labels: tt.nodeLabels,
+				}},
+			}
+			err := plugin.predicateFn(newTask(tt.runtimeClass, tt.gpus), nil, node)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func newTask(runtimeClass *string, gpus string) *pod_info.PodInfo {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+		Spec:       v1.PodSpec{RuntimeClassName: runtimeClass, Containers: []v1.Container{{}}},
+	}
+	if gpus != "" {
+		pod.Spec.Containers[0].Resources.Requests = v1.ResourceList{
+			commonconstants.GpuResource: resource.MustParse(gpus),
+		}
+	}
+	return pod_info.NewTaskInfo(pod)
+}
@@ -4,11 +4,21 @@
 package scores
 
 const (
-	MaxHighDensity = 9
-	ResourceType   = 10
-	Availability   = 100
-	GpuSharing     = 1000
-	Topology       = 10000
-	K8sPlugins     = 100000
-	NominatedNode  = 1000000
+	GpuThermal      = 5
+	MaxHighDensity  = 9
+	GpuUtilization  = 10
+	ImageLocality   = 10
+	GpuBalance      = 10
+	ModelColocation = 50
+	GpuInterconnect = 90
+	GpuNuma         = 100
+	Availability    = 100
+	GpuSharing      = 1000
+	NoisyNeighbor   = 1500
+	ResourceType    = 2000
+	TaskSpread      = 5000
+	Topology        = 10000
+	K8sPlugins      = 100000
+	SoftTaint       = 500000
+	NominatedNode   = 1000000
 )
@@ -0,0 +1,126 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package scratchdisk
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName = "scratchdisk"
+
+	nvmeCapacityLabelArg    = "nvmeCapacityLabel"
+	nvmeCapacityResourceArg = "nvmeCapacityResource"
+)
+
+// scratchDiskPlugin places pods only on nodes with enough local NVMe scratch. A pod that needs local NVMe scratch sets
+// the size it needs in the kai.scheduler/local-nvme-scratch annotation, and must fit the NVMe capacity of the node
+// that the other pods' annotations do not claim. The NVMe capacity of a node is read from a node label, or from an
+// allocatable extended resource of the node when nvmeCapacityResource is set.
+type scratchDiskPlugin struct {
+	nvmeCapacityLabel    string
+	nvmeCapacityResource v1.ResourceName
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	nvmeCapacityLabel := commonconstants.LocalNvmeCapacity
+	if val, found := arguments[nvmeCapacityLabelArg]; found && val != "" {
+		nvmeCapacityLabel = val
+	}
+	return &scratchDiskPlugin{
+		nvmeCapacityLabel:    nvmeCapacityLabel,
+		nvmeCapacityResource: v1.ResourceName(arguments[nvmeCapacityResourceArg]),
+	}
+}
+
+func (sdp *scratchDiskPlugin) Name() string {
+	return pluginName
+}
+
+func (sdp *scratchDiskPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddPredicateFn(sdp.predicateFn)
+}
+
+func (sdp *scratchDiskPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (sdp *scratchDiskPlugin) predicateFn(
+	task *pod_info.PodInfo, _ *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) error {
+	requested, found := nvmeScratch(task)
+	if !found {
+		return nil
+	}
+	capacity, found := sdp.nvmeCapacity(node)
+	if !found {
+		return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+			fmt.Sprintf("node has no local NVMe scratch, and the pod requests %s", requested.String()))
+	}
+	available := capacity.DeepCopy()
+	for _, pod := range node.PodInfos {
+		if pod.UID == task.UID || !pod_status.IsActiveUsedStatus(pod.Status) {
+			continue
+		}
+		if used, found := nvmeScratch(pod); found {
+			available.Sub(used)
+		}
+	}
+	if requested.Cmp(available) > 0 {
+		return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+			fmt.Sprintf("node has %s local NVMe scratch available out of %s, and the pod requests %s",
+				available.String(), capacity.String(), requested.String()))
+	}
+	return nil
+}
+
+// nvmeCapacity returns the local NVMe capacity of the node, from its allocatable extended resource when one is
+// configured, or else from its label.
+func (sdp *scratchDiskPlugin) nvmeCapacity(node *node_info.NodeInfo) (resource.Quantity, bool) {
+	if node.Node == nil {
+		return resource.Quantity{}, false
+	}
+	if sdp.nvmeCapacityResource != "" {
+		capacity, found := node.Node.Status.Allocatable[sdp.nvmeCapacityResource]
+		return capacity, found
+	}
+	value, found := node.Node.Labels[sdp.nvmeCapacityLabel]
+	if !found {
+		return resource.Quantity{}, false
+	}
+	capacity, err := resource.ParseQuantity(value)
+	if err != nil {
+		log.InfraLogger.V(4).Warnf("Invalid %s label value of node <%s>: %s", sdp.nvmeCapacityLabel, node.Name, value)
+		return resource.Quantity{}, false
+	}
+	return capacity, true
+}
+
+// nvmeScratch returns the local NVMe scratch the pod requests in its annotation.
+func nvmeScratch(task *pod_info.PodInfo) (resource.Quantity, bool) {
+	if task.Pod == nil {
+		return resource.Quantity{}, false
+	}
+	value, found := task.Pod.Annotations[commonconstants.LocalNvmeScratch]
+	if !found {
+		return resource.Quantity{}, false
+	}
+	scratch, err := resource.ParseQuantity(value)
+	if err != nil {
+		log.InfraLogger.V(4).Warnf("Invalid %s annotation value of pod <%s/%s>: %s",
+			commonconstants.LocalNvmeScratch, task.Namespace, task.Name, value)
+		return resource.Quantity{}, false
+	}
+	return scratch, true
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package scratchdisk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+)
+
+const nvmeResource = "example.com/local-nvme"
+
+func TestPredicateFn(t *testing.T) {
+	tests := []struct {
+		name           string
+		arguments      map[string]string
+		nvmeScratch    string
+		nodeLabels     map[string]string
+		runningScratch string
+		expectedError  string
+	}{
+		{
+			name: "pod without scratch requirements",
+		},
+		{
+			name:        "NVMe scratch fits the node label",
+			nvmeScratch: "1Ti",
+			nodeLabels:  map[string]string{commonconstants.LocalNvmeCapacity: "2Ti"},
+		},
+		{
+			name:           "NVMe scratch claimed by running pods",
+			nvmeScratch:    "1Ti",
+			nodeLabels:     map[string]string{commonconstants.LocalNvmeCapacity: "2Ti"},
+			runningScratch: "1536Gi",
+			expectedError:  "node has 512Gi local NVMe scratch available out of 2Ti, and the pod requests 1Ti",
+		},
+		{
+			name:          "NVMe scratch on a node without NVMe",
+			nvmeScratch:   "1Ti",
+			expectedError: "node has no local NVMe scratch, and the pod requests 1Ti",
+		},
+		{
+			name:          "invalid NVMe capacity label",
+			nvmeScratch:   "1Ti",
+			nodeLabels:    map[string]string{commonconstants.LocalNvmeCapacity: "large"},
+			expectedError: "node has no local NVMe scratch, and the pod requests 1Ti",
+		},
+		{
+			name:        "configured NVMe capacity label",
+			arguments:   map[string]string{nvmeCapacityLabelArg: "example.com/nvme"},
+			nvmeScratch: "1Ti",
+			nodeLabels:  map[string]string{"example.com/nvme": "1Ti"},
+		},
+		{
+			name:        "NVMe capacity from an extended resource",
+			arguments:   map[string]string{nvmeCapacityResourceArg: nvmeResource},
+			nvmeScratch: "3Ti",
+			nodeLabels:  map[string]string{commonconstants.LocalNvmeCapacity: "1Ti"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*scratchDiskPlugin)
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(gomock.NewController(t))
+			nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).AnyTimes()
+			node := node_info.NewNodeInfo(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.nodeLabels},
+				Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("64"),
+					v1.ResourceMemory: resource.MustParse("512Gi"),
+					nvmeResource:      resource.MustParse("4Ti"),
+				}},
+			}, nodePodAffinityInfo)
+			if tt.runningScratch != "" {
+				running := newTask("running", "node-1", v1.PodRunning, tt.runningScratch)
+				assert.NoError(t, node.AddTask(running))
+			}
+
+			task := newTask("pending", "", v1.PodPending, tt.nvmeScratch)
+			err := plugin.predicateFn(task, nil, node)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func newTask(name, nodeName string, phase v1.PodPhase, nvmeScratch string) *pod_info.PodInfo {
+	requests := common_info.BuildResourceList("1", "1G")
+	annotations := map[string]string{}
+	if nvmeScratch != "" {
+		annotations[commonconstants.LocalNvmeScratch] = nvmeScratch
+	}
+	pod := common_info.BuildPod("ns", name, nodeName, phase, requests, nil, nil, annotations)
+	return pod_info.NewTaskInfo(pod)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package softtaints
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName       = "softtaints"
+	annotationKeyArg = "annotationKey"
+	taintKeysArg     = "taintKeys"
+
+	defaultAnnotationKey = "kai.scheduler/soft-taint"
+)
+
+// softTaintsPlugin makes soft-tainted nodes a last resort instead of excluding them: tasks are placed on them only
+// when no other node fits. A node is soft-tainted when it carries the configured annotation (its value is the taint
+// value) or a taint with one of the configured keys. Tasks that tolerate the soft taint are not penalized.
+type softTaintsPlugin struct {
+	annotationKey string
+	taintKeys     map[string]bool
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	annotationKey := defaultAnnotationKey
+	if val, found := arguments[annotationKeyArg]; found {
+		annotationKey = strings.TrimSpace(val)
+	}
+
+	taintKeys := map[string]bool{}
+	for _, key := range strings.Split(arguments[taintKeysArg], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			taintKeys[key] = true
+		}
+	}
+
+	return &softTaintsPlugin{annotationKey: annotationKey, taintKeys: taintKeys}
+}
+
+func (stp *softTaintsPlugin) Name() string {
+	return pluginName
+}
+
+func (stp *softTaintsPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddNodeOrderFn(stp.nodeOrderFn)
+}
+
+func (stp *softTaintsPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (stp *softTaintsPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if node.Node == nil {
+		return 0, nil
+	}
+
+	for _, taint := range stp.softTaints(node.Node) {
+		if task.Pod != nil && tolerates(task.Pod.Spec.Tolerations, &taint) {
+			continue
+		}
+		log.InfraLogger.V(7).Infof("Task: <%v/%v> does not tolerate soft taint <%s> of node <%s>",
+			task.Namespace, task.Name, taint.ToString(), node.Name)
+		return -scores.SoftTaint, nil
+	}
+	return 0, nil
+}
+
+func (stp *softTaintsPlugin) softTaints(node *v1.Node) []v1.Taint {
+	var taints []v1.Taint
+	if stp.annotationKey != "" {
+		if value, found := node.Annotations[stp.annotationKey]; found {
+			taints = append(taints, v1.Taint{
+				Key:    stp.annotationKey,
+				Value:  value,
+				Effect: v1.TaintEffectPreferNoSchedule,
+			})
+		}
+	}
+	for _, taint := range node.Spec.Taints {
+		if stp.taintKeys[taint.Key] {
+			taints = append(taints, taint)
+		}
+	}
+	return taints
+}
+
+func tolerates(tolerations []v1.Toleration, taint *v1.Taint) bool {
+	for _, toleration := range tolerations {
+		if toleration.ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
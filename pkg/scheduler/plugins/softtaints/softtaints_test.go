@@ -0,0 +1,102 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package softtaints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestNodeOrderFn(t *testing.T) {
+	spotTaint := v1.Taint{Key: "spot", Value: "true", Effect: v1.TaintEffectPreferNoSchedule}
+
+	tests := []struct {
+		name            string
+		arguments       map[string]string
+		nodeAnnotations map[string]string
+		nodeTaints      []v1.Taint
+		tolerations     []v1.Toleration
+		expectedScore   float64
+	}{
+		{
+			name:          "untainted node is not penalized",
+			expectedScore: 0,
+		},
+		{
+			name:            "annotated node is penalized",
+			nodeAnnotations: map[string]string{defaultAnnotationKey: "spot"},
+			expectedScore:   -scores.SoftTaint,
+		},
+		{
+			name:            "toleration of the annotation removes the penalty",
+			nodeAnnotations: map[string]string{defaultAnnotationKey: "spot"},
+			tolerations: []v1.Toleration{
+				{Key: defaultAnnotationKey, Operator: v1.TolerationOpEqual, Value: "spot"},
+			},
+			expectedScore: 0,
+		},
+		{
+			name:            "toleration with another value does not remove the penalty",
+			nodeAnnotations: map[string]string{defaultAnnotationKey: "spot"},
+			tolerations: []v1.Toleration{
+				{Key: defaultAnnotationKey, Operator: v1.TolerationOpEqual, Value: "preemptible"},
+			},
+			expectedScore: -scores.SoftTaint,
+		},
+		{
+			name:            "custom annotation key",
+			arguments:       map[string]string{annotationKeyArg: "example.com/last-resort"},
+			nodeAnnotations: map[string]string{defaultAnnotationKey: "spot", "example.com/last-resort": ""},
+			expectedScore:   -scores.SoftTaint,
+		},
+		{
+			name:          "configured taint key is penalized",
+			arguments:     map[string]string{taintKeysArg: "other, spot"},
+			nodeTaints:    []v1.Taint{spotTaint},
+			expectedScore: -scores.SoftTaint,
+		},
+		{
+			name:          "taint key that is not configured is ignored",
+			nodeTaints:    []v1.Taint{spotTaint},
+			expectedScore: 0,
+		},
+		{
+			name:          "tolerated taint is not penalized",
+			arguments:     map[string]string{taintKeysArg: "spot"},
+			nodeTaints:    []v1.Taint{spotTaint},
+			tolerations:   []v1.Toleration{{Key: "spot", Operator: v1.TolerationOpExists}},
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arguments := tt.arguments
+			if arguments == nil {
+				arguments = map[string]string{}
+			}
+			plugin := New(arguments).(*softTaintsPlugin)
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+				Spec:       v1.PodSpec{Tolerations: tt.tolerations},
+			}
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node", Annotations: tt.nodeAnnotations},
+				Spec:       v1.NodeSpec{Taints: tt.nodeTaints},
+			}
+
+			score, err := plugin.nodeOrderFn(pod_info.NewTaskInfo(pod), node_info.NewNodeInfo(node, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScore, score)
+		})
+	}
+}
@@ -24,6 +24,10 @@ func (pp *taskOrderPlugin) Name() string {
 	return "taskorder"
 }
 
+func (pp *taskOrderPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{framework.TaskOrderFnName}
+}
+
 func (pp *taskOrderPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddTaskOrderFn(TaskOrderFn)
 }
@@ -0,0 +1,198 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package taskspread
+
+import (
+	"fmt"
+	"strconv"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName = "taskspread"
+
+	hardPolicy = "hard"
+	softPolicy = "soft"
+)
+
+// spreadLimit is the number of tasks of a podgroup that may share a node, and whether exceeding it is forbidden or
+// only discouraged.
+type spreadLimit struct {
+	maxTasksPerNode int
+	soft            bool
+}
+
+// jobTasksOnNodes holds the nodes of the allocated and pipelined tasks of a limited podgroup, and their number on
+// every node, kept up to date with the allocations and deallocations of the session.
+type jobTasksOnNodes struct {
+	taskNodes  map[common_info.PodID]string
+	nodeCounts map[string]int
+}
+
+// taskSpreadPlugin spreads the tasks of podgroups annotated with kai.scheduler/max-tasks-per-node across nodes.
+// Under the hard policy, the default, a node that already holds the maximum number of tasks of the podgroup does not
+// fit another one. Under the soft policy such a node is scored lower for every task above the maximum, so that it is
+// only used when no other node fits. Tasks that are allocated or pipelined in the current session are counted.
+type taskSpreadPlugin struct {
+	podGroupInfos map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
+	limits        map[common_info.PodGroupID]spreadLimit
+	tasksOnNodes  map[common_info.PodGroupID]*jobTasksOnNodes
+}
+
+func New(_ map[string]string) framework.Plugin {
+	return &taskSpreadPlugin{}
+}
+
+func (tsp *taskSpreadPlugin) Name() string {
+	return pluginName
+}
+
+func (tsp *taskSpreadPlugin) ClaimedFns() []framework.FnName {
+	return []framework.FnName{framework.PredicateFnName, framework.NodeOrderFnName, framework.EventHandlerFnName}
+}
+
+func (tsp *taskSpreadPlugin) OnSessionOpen(ssn *framework.Session) {
+	tsp.podGroupInfos = ssn.PodGroupInfos
+	tsp.limits = map[common_info.PodGroupID]spreadLimit{}
+	tsp.tasksOnNodes = map[common_info.PodGroupID]*jobTasksOnNodes{}
+	for _, job := range ssn.PodGroupInfos {
+		limit, found := parseSpreadLimit(job)
+		if !found {
+			continue
+		}
+		tsp.limits[job.UID] = limit
+		tasksOnNodes := &jobTasksOnNodes{taskNodes: map[common_info.PodID]string{}, nodeCounts: map[string]int{}}
+		for _, task := range job.GetAllPodsMap() {
+			tasksOnNodes.update(task)
+		}
+		tsp.tasksOnNodes[job.UID] = tasksOnNodes
+	}
+
+	ssn.AddPredicateFn(tsp.predicateFn)
+	ssn.AddNodeOrderFn(tsp.nodeOrderFn)
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc:   tsp.onTaskEvent,
+		DeallocateFunc: tsp.onTaskEvent,
+	})
+}
+
+func (tsp *taskSpreadPlugin) OnSessionClose(_ *framework.Session) {
+	tsp.podGroupInfos = nil
+	tsp.limits = nil
+	tsp.tasksOnNodes = nil
+}
+
+func (tsp *taskSpreadPlugin) onTaskEvent(event *framework.Event) {
+	if tasksOnNodes, found := tsp.tasksOnNodes[event.Task.Job]; found {
+		tasksOnNodes.update(event.Task)
+	}
+}
+
+func (tsp *taskSpreadPlugin) predicateFn(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) error {
+	limit, found := tsp.limits[job.UID]
+	if !found || limit.soft {
+		return nil
+	}
+
+	tasksOnNode := tsp.countTasksOnNode(job, task, node.Name)
+	if tasksOnNode < limit.maxTasksPerNode {
+		return nil
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+		fmt.Sprintf("node already has %d tasks of podgroup %s, which allows at most %d tasks per node",
+			tasksOnNode, job.Name, limit.maxTasksPerNode))
+}
+
+func (tsp *taskSpreadPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	limit, found := tsp.limits[task.Job]
+	if !found || !limit.soft {
+		return 0, nil
+	}
+	job, found := tsp.podGroupInfos[task.Job]
+	if !found {
+		return 0, nil
+	}
+
+	excess := tsp.countTasksOnNode(job, task, node.Name) + 1 - limit.maxTasksPerNode
+	if excess <= 0 {
+		return 0, nil
+	}
+	log.InfraLogger.V(7).Infof("Task: <%v/%v> would be one of %d tasks of podgroup <%s> on node <%s>, above %d",
+		task.Namespace, task.Name, excess+limit.maxTasksPerNode, job.Name, node.Name, limit.maxTasksPerNode)
+	return -float64(excess) * scores.TaskSpread, nil
+}
+
+// countTasksOnNode returns the number of the job's tasks, other than task, that are allocated or pipelined to the node.
+func (tsp *taskSpreadPlugin) countTasksOnNode(
+	job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo, nodeName string,
+) int {
+	tasksOnNodes, found := tsp.tasksOnNodes[job.UID]
+	if !found {
+		return 0
+	}
+	count := tasksOnNodes.nodeCounts[nodeName]
+	if tasksOnNodes.taskNodes[task.UID] == nodeName {
+		count--
+	}
+	return count
+}
+
+// update moves the task to the node it is allocated or pipelined to, if any. The node of a deallocated task may
+// already be reset, so the task is removed from the node it was counted on.
+func (t *jobTasksOnNodes) update(task *pod_info.PodInfo) {
+	nodeName := ""
+	if pod_status.IsActiveAllocatedStatus(task.Status) {
+		nodeName = task.NodeName
+	}
+	previousNodeName, found := t.taskNodes[task.UID]
+	if found && previousNodeName == nodeName {
+		return
+	}
+	if found {
+		t.nodeCounts[previousNodeName]--
+		delete(t.taskNodes, task.UID)
+	}
+	if nodeName != "" {
+		t.nodeCounts[nodeName]++
+		t.taskNodes[task.UID] = nodeName
+	}
+}
+
+func parseSpreadLimit(job *podgroup_info.PodGroupInfo) (spreadLimit, bool) {
+	if job.PodGroup == nil {
+		return spreadLimit{}, false
+	}
+	value, found := job.PodGroup.Annotations[commonconstants.MaxTasksPerNode]
+	if !found {
+		return spreadLimit{}, false
+	}
+	maxTasksPerNode, err := strconv.Atoi(value)
+	if err != nil || maxTasksPerNode < 1 {
+		log.InfraLogger.V(4).Warnf("Invalid %s annotation value of podgroup <%s/%s>: %s",
+			commonconstants.MaxTasksPerNode, job.Namespace, job.Name, value)
+		return spreadLimit{}, false
+	}
+
+	limit := spreadLimit{maxTasksPerNode: maxTasksPerNode}
+	switch policy := job.PodGroup.Annotations[commonconstants.MaxTasksPerNodePolicy]; policy {
+	case "", hardPolicy:
+	case softPolicy:
+		limit.soft = true
+	default:
+		log.InfraLogger.V(4).Warnf("Invalid %s annotation value of podgroup <%s/%s>: %s. Using the %s policy",
+			commonconstants.MaxTasksPerNodePolicy, job.Namespace, job.Name, policy, hardPolicy)
+	}
+	return limit, true
+}
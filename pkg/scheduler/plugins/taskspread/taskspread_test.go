@@ -0,0 +1,205 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package taskspread
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+// buildJob returns a job with a pending task, job-0, and a running task on each of the given nodes.
+func buildJob(annotations map[string]string, runningOnNodes ...string) *podgroup_info.PodGroupInfo {
+	tasks := []*pod_info.PodInfo{buildTask("job-0", "", v1.PodPending)}
+	for i, nodeName := range runningOnNodes {
+		tasks = append(tasks, buildTask("job-"+string(rune('1'+i)), nodeName, v1.PodRunning))
+	}
+	job := podgroup_info.NewPodGroupInfo("job", tasks...)
+	job.Name = "job"
+	job.Namespace = "ns"
+	job.PodGroup = &enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "ns", Annotations: annotations},
+	}
+	return job
+}
+
+func buildTask(name, nodeName string, phase v1.PodPhase) *pod_info.PodInfo {
+	pod := common_info.BuildPod("ns", name, nodeName, phase, common_info.BuildResourceList("1", "1G"),
+		[]metav1.OwnerReference{}, nil, nil)
+	task := pod_info.NewTaskInfo(pod)
+	task.Job = "job"
+	return task
+}
+
+func openSession(job *podgroup_info.PodGroupInfo) *taskSpreadPlugin {
+	plugin := New(nil).(*taskSpreadPlugin)
+	plugin.OnSessionOpen(&framework.Session{
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+	})
+	return plugin
+}
+
+func pendingTask(job *podgroup_info.PodGroupInfo) *pod_info.PodInfo {
+	for _, task := range job.GetAllPodsMap() {
+		if task.Name == "job-0" {
+			return task
+		}
+	}
+	return nil
+}
+
+func TestPredicateFn(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		runningOnNodes []string
+		expectedFit    bool
+	}{
+		{
+			name:           "podgroup without the annotation is not limited",
+			runningOnNodes: []string{"node-1", "node-1"},
+			expectedFit:    true,
+		},
+		{
+			name:           "node with a task of the podgroup does not fit another one",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "1"},
+			runningOnNodes: []string{"node-1", "node-2"},
+			expectedFit:    false,
+		},
+		{
+			name:           "node without tasks of the podgroup fits",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "1"},
+			runningOnNodes: []string{"node-2", "node-2"},
+			expectedFit:    true,
+		},
+		{
+			name:           "node below the maximum fits",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "2"},
+			runningOnNodes: []string{"node-1", "node-2"},
+			expectedFit:    true,
+		},
+		{
+			name:           "node at the maximum does not fit",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "2"},
+			runningOnNodes: []string{"node-1", "node-1"},
+			expectedFit:    false,
+		},
+		{
+			name: "soft policy does not filter nodes",
+			annotations: map[string]string{
+				commonconstants.MaxTasksPerNode:       "1",
+				commonconstants.MaxTasksPerNodePolicy: softPolicy,
+			},
+			runningOnNodes: []string{"node-1"},
+			expectedFit:    true,
+		},
+		{
+			name:           "invalid maximum is ignored",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "0"},
+			runningOnNodes: []string{"node-1"},
+			expectedFit:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := buildJob(tt.annotations, tt.runningOnNodes...)
+			plugin := openSession(job)
+			err := plugin.predicateFn(pendingTask(job), job, &node_info.NodeInfo{Name: "node-1"})
+			if tt.expectedFit {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPredicateFnCountsTasksPlacedInSession(t *testing.T) {
+	job := buildJob(map[string]string{commonconstants.MaxTasksPerNode: "1"})
+	placed := buildTask("job-1", "", v1.PodPending)
+	job.AddTaskInfo(placed)
+	plugin := openSession(job)
+	node := &node_info.NodeInfo{Name: "node-1"}
+
+	assert.NoError(t, plugin.predicateFn(pendingTask(job), job, node))
+
+	placed.NodeName = node.Name
+	assert.NoError(t, job.UpdateTaskStatus(placed, pod_status.Pipelined))
+	plugin.onTaskEvent(&framework.Event{Task: placed})
+	assert.Error(t, plugin.predicateFn(pendingTask(job), job, node))
+
+	placed.NodeName = ""
+	assert.NoError(t, job.UpdateTaskStatus(placed, pod_status.Pending))
+	plugin.onTaskEvent(&framework.Event{Task: placed})
+	assert.NoError(t, plugin.predicateFn(pendingTask(job), job, node), "deallocated tasks are not counted")
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	softAnnotations := func(maxTasksPerNode string) map[string]string {
+		return map[string]string{
+			commonconstants.MaxTasksPerNode:       maxTasksPerNode,
+			commonconstants.MaxTasksPerNodePolicy: softPolicy,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		runningOnNodes []string
+		expectedScore  float64
+	}{
+		{
+			name:           "podgroup without the annotation is not scored",
+			runningOnNodes: []string{"node-1"},
+			expectedScore:  0,
+		},
+		{
+			name:           "hard policy is not scored",
+			annotations:    map[string]string{commonconstants.MaxTasksPerNode: "1"},
+			runningOnNodes: []string{"node-1"},
+			expectedScore:  0,
+		},
+		{
+			name:           "node below the maximum is not penalized",
+			annotations:    softAnnotations("2"),
+			runningOnNodes: []string{"node-1", "node-2"},
+			expectedScore:  0,
+		},
+		{
+			name:           "node at the maximum is penalized",
+			annotations:    softAnnotations("1"),
+			runningOnNodes: []string{"node-1"},
+			expectedScore:  -scores.TaskSpread,
+		},
+		{
+			name:           "penalty grows with the tasks above the maximum",
+			annotations:    softAnnotations("1"),
+			runningOnNodes: []string{"node-1", "node-1", "node-2"},
+			expectedScore:  -2 * scores.TaskSpread,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := buildJob(tt.annotations, tt.runningOnNodes...)
+			plugin := openSession(job)
+			score, err := plugin.nodeOrderFn(pendingTask(job), &node_info.NodeInfo{Name: "node-1"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScore, score)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package topology
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -13,6 +14,9 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 )
 
+// errNoJobAllocatableDomains is returned when no domain of the relevant levels can host all the tasks of a job
+var errNoJobAllocatableDomains = errors.New("no domains found")
+
 type jobAllocationMetaData struct {
 	maxPodResources    *resource_info.ResourceRequirements
 	allocationTestPods []*pod_info.PodInfo
@@ -47,6 +51,15 @@ func (t *topologyPlugin) subSetNodesFn(job *podgroup_info.PodGroupInfo, tasks []
 	}
 
 	jobAllocatableDomains, err := t.getJobAllocatableDomains(job, len(tasks), topologyTree)
+	if errors.Is(err, errNoJobAllocatableDomains) && jobHasTopologyRequiredConstraint(job) {
+		// A required constraint is never relaxed by spreading the job over several domains
+		job.SetJobFitError(
+			podgroup_info.PodSchedulingErrors,
+			fmt.Sprintf("No single %s domain in topology tree %s can host all the %d pods of the workload",
+				job.TopologyConstraint.RequiredLevel, topologyTree.Name, len(tasks)),
+			nil)
+		return []node_info.NodeSet{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -209,8 +222,8 @@ func (t *topologyPlugin) getJobAllocatableDomains(job *podgroup_info.PodGroupInf
 	}
 
 	if len(domains) == 0 {
-		return nil, fmt.Errorf("no domains found for the job <%s/%s>, workload topology name: %s",
-			job.Namespace, job.Name, topologyTree.Name)
+		return nil, fmt.Errorf("%w for the job <%s/%s>, workload topology name: %s",
+			errNoJobAllocatableDomains, job.Namespace, job.Name, topologyTree.Name)
 	}
 
 	return domains, nil
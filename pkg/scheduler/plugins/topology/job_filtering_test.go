@@ -234,6 +234,70 @@ func TestTopologyPlugin_subsetNodesFn(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "required level - no single domain fits the job",
+			job: &jobs_fake.TestJobBasic{
+				Name:                "test-job",
+				RequiredCPUsPerTask: 600,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			jobTopologyConstraint: &enginev2alpha2.TopologyConstraint{
+				Topology:              "test-topology",
+				RequiredTopologyLevel: "zone",
+			},
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node-1": {
+					CPUMillis:  1000,
+					MaxTaskNum: ptr.To(100),
+				},
+				"node-2": {
+					CPUMillis:  1000,
+					MaxTaskNum: ptr.To(100),
+				},
+			},
+			nodesToDomains: map[string]DomainID{
+				"node-1": "zone1",
+				"node-2": "zone2",
+			},
+			setupTopologyTree: func() *Info {
+				tree := &Info{
+					Name: "test-topology",
+					TopologyResource: &kueuev1alpha1.Topology{
+						Spec: kueuev1alpha1.TopologySpec{
+							Levels: []kueuev1alpha1.TopologyLevel{
+								{NodeLabel: "zone"},
+							},
+						},
+					},
+					DomainsByLevel: map[DomainLevel]LevelDomainInfos{
+						"zone": {
+							"zone1": NewDomainInfo("zone1", "zone"),
+							"zone2": NewDomainInfo("zone2", "zone"),
+						},
+					},
+				}
+
+				root := NewDomainInfo(rootDomainId, rootLevel)
+				root.Children = map[DomainID]*DomainInfo{
+					"zone1": tree.DomainsByLevel["zone"]["zone1"],
+					"zone2": tree.DomainsByLevel["zone"]["zone2"],
+				}
+				tree.DomainsByLevel[rootLevel] = map[DomainID]*DomainInfo{rootDomainId: root}
+
+				return tree
+			},
+			domainParent: map[DomainID]DomainID{
+				"zone1": rootDomainId,
+				"zone2": rootDomainId,
+			},
+			domainLevel: map[DomainID]DomainLevel{
+				rootDomainId: rootLevel,
+			},
+			expectedJobFitError: "No single zone domain in topology tree test-topology can host all the 2 pods of the workload",
+		},
 		{
 			name: "insufficient allocatable pods - no domains found",
 			job: &jobs_fake.TestJobBasic{
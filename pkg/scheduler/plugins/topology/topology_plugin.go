@@ -7,6 +7,7 @@ import (
 	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
@@ -66,7 +67,7 @@ func (*topologyPlugin) addNodeDataToTopology(topologyTree *Info, topology *kueue
 	for levelIndex := len(topology.Spec.Levels) - 1; levelIndex >= 0; levelIndex-- {
 		level := topology.Spec.Levels[levelIndex]
 
-		domainId := calcDomainId(levelIndex, topology.Spec.Levels, nodeInfo.Node.Labels)
+		domainId := DomainID(topology_info.CalcDomainId(levelIndex, topology.Spec.Levels, nodeInfo.Node.Labels))
 		domainLevel := DomainLevel(level.NodeLabel)
 		domainsForLevel, foundLevelLabel := topologyTree.DomainsByLevel[domainLevel]
 		if !foundLevelLabel {
@@ -4,8 +4,6 @@
 package topology
 
 import (
-	"strings"
-
 	kueuev1alpha1 "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -62,12 +60,3 @@ func NewDomainInfo(id DomainID, level DomainLevel) *DomainInfo {
 func (t *DomainInfo) AddNode(nodeInfo *node_info.NodeInfo) {
 	t.Nodes[nodeInfo.Name] = nodeInfo
 }
-
-func calcDomainId(leafLevelIndex int, levels []kueuev1alpha1.TopologyLevel, nodeLabels map[string]string) DomainID {
-	domainsNames := make([]string, leafLevelIndex+1)
-	for levelIndex := leafLevelIndex; levelIndex >= 0; levelIndex-- {
-		levelLabel := levels[levelIndex].NodeLabel
-		domainsNames[levelIndex] = nodeLabels[levelLabel]
-	}
-	return DomainID(strings.Join(domainsNames, "."))
-}
@@ -0,0 +1,64 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const weightArg = "weight"
+
+// ParseArg returns the named plugin argument parsed by parse, or defaultValue if the argument is not set. An argument
+// that fails to parse, or that valid rejects, is logged and defaultValue is returned. A nil valid accepts any value.
+func ParseArg[T any](arguments map[string]string, name, pluginName string, defaultValue T,
+	parse func(string) (T, error), valid func(T) bool) T {
+	val, found := arguments[name]
+	if !found {
+		return defaultValue
+	}
+	if parsed, err := parse(val); err == nil && (valid == nil || valid(parsed)) {
+		return parsed
+	}
+	log.InfraLogger.V(2).Warnf("Failed to parse %s: %s for plugin %s. Using default value of %v",
+		name, val, pluginName, defaultValue)
+	return defaultValue
+}
+
+// ParseFloatArg returns the named float plugin argument, or defaultValue if it is not set or not valid.
+func ParseFloatArg(arguments map[string]string, name, pluginName string, defaultValue float64,
+	valid func(float64) bool) float64 {
+	return ParseArg(arguments, name, pluginName, defaultValue, func(val string) (float64, error) {
+		return strconv.ParseFloat(val, 64)
+	}, valid)
+}
+
+// ParseWeightArg returns the non-negative "weight" plugin argument that multiplies the scores of the plugin, or 1 if
+// it is not set or not valid.
+func ParseWeightArg(arguments map[string]string, pluginName string) float64 {
+	return ParseFloatArg(arguments, weightArg, pluginName, 1.0, NonNegative)
+}
+
+// ParseDurationArg returns the named duration plugin argument, or defaultValue if it is not set or not valid.
+func ParseDurationArg(arguments map[string]string, name, pluginName string, defaultValue time.Duration,
+	valid func(time.Duration) bool) time.Duration {
+	return ParseArg(arguments, name, pluginName, defaultValue, time.ParseDuration, valid)
+}
+
+// ParseBoolArg returns the named boolean plugin argument, or defaultValue if it is not set or malformed.
+func ParseBoolArg(arguments map[string]string, name, pluginName string, defaultValue bool) bool {
+	return ParseArg(arguments, name, pluginName, defaultValue, strconv.ParseBool, nil)
+}
+
+// NonNegative accepts values that are zero or above.
+func NonNegative[T float64 | time.Duration](value T) bool {
+	return value >= 0
+}
+
+// Positive accepts values above zero.
+func Positive[T float64 | time.Duration](value T) bool {
+	return value > 0
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFloatArg(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments map[string]string
+		valid     func(float64) bool
+		expected  float64
+	}{
+		{
+			name:     "not set",
+			expected: 0.5,
+		},
+		{
+			name:      "valid",
+			arguments: map[string]string{"ratio": "0.25"},
+			valid:     NonNegative[float64],
+			expected:  0.25,
+		},
+		{
+			name:      "malformed",
+			arguments: map[string]string{"ratio": "quarter"},
+			expected:  0.5,
+		},
+		{
+			name:      "rejected",
+			arguments: map[string]string{"ratio": "-1"},
+			valid:     NonNegative[float64],
+			expected:  0.5,
+		},
+		{
+			name:      "accepted without validation",
+			arguments: map[string]string{"ratio": "-1"},
+			expected:  -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseFloatArg(tt.arguments, "ratio", "test", 0.5, tt.valid))
+		})
+	}
+}
+
+func TestParseWeightArg(t *testing.T) {
+	assert.Equal(t, 1.0, ParseWeightArg(nil, "test"))
+	assert.Equal(t, 2.5, ParseWeightArg(map[string]string{"weight": "2.5"}, "test"))
+	assert.Equal(t, 0.0, ParseWeightArg(map[string]string{"weight": "0"}, "test"))
+	assert.Equal(t, 1.0, ParseWeightArg(map[string]string{"weight": "-2"}, "test"))
+}
+
+func TestParseDurationArg(t *testing.T) {
+	assert.Equal(t, time.Minute, ParseDurationArg(nil, "maxAge", "test", time.Minute, Positive))
+	assert.Equal(t, 5*time.Second,
+		ParseDurationArg(map[string]string{"maxAge": "5s"}, "maxAge", "test", time.Minute, Positive))
+	assert.Equal(t, time.Minute,
+		ParseDurationArg(map[string]string{"maxAge": "0s"}, "maxAge", "test", time.Minute, Positive))
+	assert.Equal(t, time.Minute,
+		ParseDurationArg(map[string]string{"maxAge": "five"}, "maxAge", "test", time.Minute, Positive))
+}
+
+func TestParseBoolArg(t *testing.T) {
+	assert.False(t, ParseBoolArg(nil, "exclude", "test", false))
+	assert.True(t, ParseBoolArg(map[string]string{"exclude": "true"}, "exclude", "test", false))
+	assert.True(t, ParseBoolArg(map[string]string{"exclude": "yes"}, "exclude", "test", true))
+}
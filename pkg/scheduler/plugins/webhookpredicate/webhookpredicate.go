@@ -0,0 +1,263 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package webhookpredicate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/utils"
+)
+
+const (
+	pluginName       = "webhookpredicate"
+	urlArg           = "url"
+	timeoutArg       = "timeout"
+	failurePolicyArg = "failurePolicy"
+	defaultTimeout   = time.Second
+
+	// failurePolicyIgnore lets tasks be placed on nodes the webhook could not be asked about (fail open).
+	failurePolicyIgnore = "Ignore"
+	// failurePolicyFail keeps tasks off nodes the webhook could not be asked about (fail closed).
+	failurePolicyFail = "Fail"
+)
+
+// PredicateRequest is the body posted to the webhook for a task and a node.
+type PredicateRequest struct {
+	Pod      PredicatePod      `json:"pod"`
+	PodGroup PredicatePodGroup `json:"podGroup"`
+	Node     PredicateNode     `json:"node"`
+}
+
+// PredicatePod is what the webhook is told about the pod. It leaves out the name and UID of the pod, as the decisions
+// are shared by the pods that it cannot tell apart.
+type PredicatePod struct {
+	Namespace   string            `json:"namespace"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type PredicatePodGroup struct {
+	Name  string `json:"name"`
+	Queue string `json:"queue"`
+}
+
+type PredicateNode struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PredicateResponse is the webhook's decision. Reason is reported in the fit errors of denied tasks.
+type PredicateResponse struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// decisionKey identifies a decision by the signature of the task, which covers what the webhook is told about the task
+// and its pod group, and the node.
+type decisionKey struct {
+	signature string
+	node      string
+}
+
+// webhookPredicatePlugin asks an external HTTP service whether a task may be placed on a node, to enforce placement
+// rules maintained outside the scheduler. Decisions are cached for the session and shared by the tasks with the same
+// labels, annotations and pod group, such as the pods of a gang, so a gang costs one call per node. The webhook is
+// called without holding the plugin's lock, so predicates of other nodes are not serialized behind it. If the webhook
+// fails, the failure policy decides for the rest of the session without calling it again, so an unavailable webhook
+// costs at most a few timeouts per session.
+type webhookPredicatePlugin struct {
+	url           string
+	failurePolicy string
+	client        *http.Client
+
+	mutex       sync.Mutex
+	signatures  map[common_info.PodID]string
+	decisions   map[decisionKey]*PredicateResponse
+	callFailure error
+}
+
+func New(arguments map[string]string) framework.Plugin {
+	timeout := utils.ParseDurationArg(arguments, timeoutArg, pluginName, defaultTimeout, utils.Positive)
+
+	failurePolicy := failurePolicyFail
+	if value, found := arguments[failurePolicyArg]; found {
+		if value == failurePolicyIgnore || value == failurePolicyFail {
+			failurePolicy = value
+		} else {
+			log.InfraLogger.V(2).Warnf("Unknown %s: %s for plugin %s. Using %s",
+				failurePolicyArg, value, pluginName, failurePolicy)
+		}
+	}
+
+	return &webhookPredicatePlugin{
+		url:           arguments[urlArg],
+		failurePolicy: failurePolicy,
+		client:        &http.Client{Timeout: timeout},
+	}
+}
+
+func (wp *webhookPredicatePlugin) Name() string {
+	return pluginName
+}
+
+func (wp *webhookPredicatePlugin) ClaimedFns() []framework.FnName {
+	if wp.url == "" {
+		return nil
+	}
+	return []framework.FnName{framework.PredicateFnName}
+}
+
+func (wp *webhookPredicatePlugin) OnSessionOpen(ssn *framework.Session) {
+	if wp.url == "" {
+		log.InfraLogger.Errorf("Plugin %s requires the %s argument, no tasks are checked", pluginName, urlArg)
+		return
+	}
+	wp.signatures = map[common_info.PodID]string{}
+	wp.decisions = map[decisionKey]*PredicateResponse{}
+	wp.callFailure = nil
+	ssn.AddPredicateFn(wp.predicateFn)
+}
+
+func (wp *webhookPredicatePlugin) OnSessionClose(_ *framework.Session) {
+	wp.signatures = nil
+	wp.decisions = nil
+}
+
+func (wp *webhookPredicatePlugin) predicateFn(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) error {
+	decision, err := wp.decide(task, job, node)
+	if err != nil {
+		if wp.failurePolicy == failurePolicyIgnore {
+			return nil
+		}
+		return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+			fmt.Sprintf("node(s) could not be checked by the placement webhook: %v", err))
+	}
+	if decision.Allowed {
+		return nil
+	}
+	reason := decision.Reason
+	if reason == "" {
+		reason = "node(s) were denied by the placement webhook"
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name, reason)
+}
+
+func (wp *webhookPredicatePlugin) decide(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) (*PredicateResponse, error) {
+	wp.mutex.Lock()
+	signature, found := wp.signatures[task.UID]
+	if !found {
+		signature = taskSignature(newPredicatePod(task), newPredicatePodGroup(job))
+		wp.signatures[task.UID] = signature
+	}
+	key := decisionKey{signature: signature, node: node.Name}
+	decision, found := wp.decisions[key]
+	callFailure := wp.callFailure
+	wp.mutex.Unlock()
+	if found {
+		return decision, nil
+	}
+	if callFailure != nil {
+		return nil, callFailure
+	}
+
+	decision, err := wp.call(newPredicateRequest(task, job, node))
+
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	if err != nil {
+		if wp.callFailure == nil {
+			log.InfraLogger.Errorf("Placement webhook %s failed for task <%s/%s> on node <%s>, applying the %s "+
+				"failure policy for the rest of the session: %v", wp.url, task.Namespace, task.Name, node.Name,
+				wp.failurePolicy, err)
+			wp.callFailure = err
+		}
+		return nil, err
+	}
+	wp.decisions[key] = decision
+	return decision, nil
+}
+
+func (wp *webhookPredicatePlugin) call(request *PredicateRequest) (*PredicateResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := wp.client.Post(wp.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	decision := &PredicateResponse{}
+	if err := json.Unmarshal(responseBody, decision); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	return decision, nil
+}
+
+func newPredicateRequest(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) *PredicateRequest {
+	request := &PredicateRequest{
+		Pod:      newPredicatePod(task),
+		PodGroup: newPredicatePodGroup(job),
+		Node:     PredicateNode{Name: node.Name},
+	}
+	if node.Node != nil {
+		request.Node.Labels = node.Node.Labels
+	}
+	return request
+}
+
+func newPredicatePod(task *pod_info.PodInfo) PredicatePod {
+	pod := PredicatePod{Namespace: task.Namespace}
+	if task.Pod != nil {
+		pod.Labels = task.Pod.Labels
+		pod.Annotations = task.Pod.Annotations
+	}
+	return pod
+}
+
+func newPredicatePodGroup(job *podgroup_info.PodGroupInfo) PredicatePodGroup {
+	if job == nil {
+		return PredicatePodGroup{}
+	}
+	return PredicatePodGroup{Name: job.Name, Queue: string(job.Queue)}
+}
+
+// taskSignature hashes everything the webhook is told about the task and its pod group, so the tasks that the webhook
+// cannot tell apart share their decisions.
+func taskSignature(pod PredicatePod, podGroup PredicatePodGroup) string {
+	content, _ := json.Marshal(struct {
+		Pod      PredicatePod      `json:"pod"`
+		PodGroup PredicatePodGroup `json:"podGroup"`
+	}{pod, podGroup})
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,270 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package webhookpredicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	k8splugins "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal/plugins"
+)
+
+func TestPredicateFn(t *testing.T) {
+	tests := []struct {
+		name            string
+		failurePolicy   string
+		status          int
+		response        string
+		delay           time.Duration
+		expectedAllowed bool
+		expectedReason  string
+	}{
+		{
+			name:            "allowed",
+			status:          http.StatusOK,
+			response:        `{"allowed": true}`,
+			expectedAllowed: true,
+		},
+		{
+			name:           "denied with a reason",
+			status:         http.StatusOK,
+			response:       `{"allowed": false, "reason": "node(s) are outside the data residency region"}`,
+			expectedReason: "node(s) are outside the data residency region",
+		},
+		{
+			name:           "denied without a reason",
+			status:         http.StatusOK,
+			response:       `{"allowed": false}`,
+			expectedReason: "node(s) were denied by the placement webhook",
+		},
+		{
+			name:           "server error fails closed",
+			failurePolicy:  failurePolicyFail,
+			status:         http.StatusInternalServerError,
+			expectedReason: "node(s) could not be checked by the placement webhook: unexpected status 500 Internal Server Error",
+		},
+		{
+			name:            "server error fails open",
+			failurePolicy:   failurePolicyIgnore,
+			status:          http.StatusInternalServerError,
+			expectedAllowed: true,
+		},
+		{
+			name:            "timeout fails open",
+			failurePolicy:   failurePolicyIgnore,
+			status:          http.StatusOK,
+			response:        `{"allowed": false}`,
+			delay:           100 * time.Millisecond,
+			expectedAllowed: true,
+		},
+		{
+			name:     "invalid response fails closed",
+			status:   http.StatusOK,
+			response: `not json`,
+			expectedReason: "node(s) could not be checked by the placement webhook: invalid response: " +
+				"invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				request := &PredicateRequest{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+				assert.Equal(t, "ns", request.Pod.Namespace)
+				assert.Equal(t, map[string]string{"app": "train"}, request.Pod.Labels)
+				assert.Equal(t, "job", request.PodGroup.Name)
+				assert.Equal(t, "queue0", request.PodGroup.Queue)
+				assert.Equal(t, "node0", request.Node.Name)
+				assert.Equal(t, map[string]string{"region": "eu"}, request.Node.Labels)
+				time.Sleep(tt.delay)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			arguments := map[string]string{urlArg: server.URL, timeoutArg: "50ms"}
+			if tt.failurePolicy != "" {
+				arguments[failurePolicyArg] = tt.failurePolicy
+			}
+			plugin := New(arguments).(*webhookPredicatePlugin)
+			ssn := &framework.Session{}
+			plugin.OnSessionOpen(ssn)
+			assert.Len(t, ssn.PredicateFns, 1)
+
+			task, job, node := newTask(), newJob(), newNode()
+			for range 2 {
+				err := plugin.predicateFn(task, job, node)
+				if tt.expectedAllowed {
+					assert.NoError(t, err)
+					continue
+				}
+				var fitError *common_info.FitError
+				assert.ErrorAs(t, err, &fitError)
+				assert.Equal(t, []string{tt.expectedReason}, fitError.Reasons)
+				assert.Equal(t, "node0", fitError.NodeName)
+			}
+			assert.Equal(t, int32(1), calls.Load(), "the decision must be cached within the session")
+		})
+	}
+}
+
+func TestPredicateFn_SharedDecisions(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		request := &PredicateRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"allowed": %v}`, request.Pod.Labels["app"] == "train")))
+	}))
+	defer server.Close()
+
+	plugin := New(map[string]string{urlArg: server.URL}).(*webhookPredicatePlugin)
+	plugin.OnSessionOpen(&framework.Session{})
+
+	job := newJob()
+	tasks := []*pod_info.PodInfo{
+		newNamedTask("train-0", map[string]string{"app": "train"}),
+		newNamedTask("train-1", map[string]string{"app": "train"}),
+		newNamedTask("eval-0", map[string]string{"app": "eval"}),
+	}
+	for _, node := range []*node_info.NodeInfo{newNamedNode("node0"), newNamedNode("node1")} {
+		for _, task := range tasks {
+			err := plugin.predicateFn(task, job, node)
+			assert.Equal(t, task.Pod.Labels["app"] == "train", err == nil, "task %s on node %s", task.Name, node.Name)
+		}
+	}
+	assert.Equal(t, int32(4), calls.Load(), "tasks with the same labels and pod group must share decisions")
+}
+
+func TestPredicateFn_CallsWebhookWithoutLock(t *testing.T) {
+	node0Received := make(chan struct{})
+	releaseNode0 := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &PredicateRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		if request.Node.Name == "node0" {
+			close(node0Received)
+			<-releaseNode0
+		}
+		_, _ = w.Write([]byte(`{"allowed": true}`))
+	}))
+	defer server.Close()
+
+	plugin := New(map[string]string{urlArg: server.URL, timeoutArg: "5s"}).(*webhookPredicatePlugin)
+	plugin.OnSessionOpen(&framework.Session{})
+
+	task, job := newTask(), newJob()
+	node0Done := make(chan error)
+	go func() {
+		node0Done <- plugin.predicateFn(task, job, newNamedNode("node0"))
+	}()
+	<-node0Received
+
+	assert.NoError(t, plugin.predicateFn(task, job, newNamedNode("node1")),
+		"the predicate of a node must not wait for the webhook call of another node")
+	close(releaseNode0)
+	assert.NoError(t, <-node0Done)
+}
+
+func TestOnSessionOpen_WithoutURL(t *testing.T) {
+	plugin := New(map[string]string{})
+	ssn := &framework.Session{}
+	plugin.OnSessionOpen(ssn)
+	assert.Empty(t, ssn.PredicateFns)
+	assert.Empty(t, plugin.(*webhookPredicatePlugin).ClaimedFns())
+}
+
+type orderingPlugin struct{}
+
+func (p *orderingPlugin) Name() string { return "ordering" }
+func (p *orderingPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddQueueOrderFn(func(_, _ *queue_info.QueueInfo, _, _ *podgroup_info.PodGroupInfo,
+		_, _ []*podgroup_info.PodGroupInfo) int {
+		return 0
+	})
+	ssn.AddJobOrderFn(func(_, _ interface{}) int { return 0 })
+}
+func (p *orderingPlugin) OnSessionClose(*framework.Session) {}
+
+func TestOpenSession_WithoutURL(t *testing.T) {
+	framework.RegisterPluginBuilder(pluginName, New)
+	framework.RegisterPluginBuilder("ordering", func(map[string]string) framework.Plugin { return &orderingPlugin{} })
+	mockCache := cache.NewMockCache(gomock.NewController(t))
+	mockCache.EXPECT().Snapshot().AnyTimes().Return(api.NewClusterInfo(), nil)
+	mockCache.EXPECT().InternalK8sPlugins().AnyTimes().Return(&k8splugins.K8sPlugins{})
+
+	ssn, err := framework.OpenSession(mockCache, &conf.SchedulerConfiguration{
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{Name: "ordering"}, {Name: pluginName}}}},
+	}, &conf.SchedulerParams{}, "1", nil)
+	assert.NoError(t, err, "a plugin without a url must not fail the session")
+	if assert.NotNil(t, ssn) {
+		assert.Empty(t, ssn.PredicateFns)
+	}
+}
+
+func TestNew_Arguments(t *testing.T) {
+	plugin := New(map[string]string{urlArg: "http://policy", timeoutArg: "2s", failurePolicyArg: "Ignore"}).(*webhookPredicatePlugin)
+	assert.Equal(t, 2*time.Second, plugin.client.Timeout)
+	assert.Equal(t, failurePolicyIgnore, plugin.failurePolicy)
+
+	plugin = New(map[string]string{urlArg: "http://policy", timeoutArg: "soon", failurePolicyArg: "Maybe"}).(*webhookPredicatePlugin)
+	assert.Equal(t, defaultTimeout, plugin.client.Timeout)
+	assert.Equal(t, failurePolicyFail, plugin.failurePolicy)
+}
+
+func newTask() *pod_info.PodInfo {
+	return newNamedTask("pod", map[string]string{"app": "train"})
+}
+
+func newNamedTask(name string, labels map[string]string) *pod_info.PodInfo {
+	return pod_info.NewTaskInfo(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			UID:       types.UID(name),
+			Labels:    labels,
+		},
+	})
+}
+
+func newJob() *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo("job")
+	job.Name = "job"
+	job.Queue = "queue0"
+	return job
+}
+
+func newNode() *node_info.NodeInfo {
+	return newNamedNode("node0")
+}
+
+func newNamedNode(name string) *node_info.NodeInfo {
+	return &node_info.NodeInfo{
+		Name: name,
+		Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"region": "eu"}}},
+	}
+}
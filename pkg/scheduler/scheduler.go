@@ -93,6 +93,7 @@ func NewScheduler(
 		FullHierarchyFairness:       schedulerParams.FullHierarchyFairness,
 		NumOfStatusRecordingWorkers: schedulerParams.NumOfStatusRecordingWorkers,
 		UpdatePodEvictionCondition:  schedulerParams.UpdatePodEvictionCondition,
+		MaxSnapshotStaleness:        schedulerParams.MaxSnapshotStaleness,
 	}
 
 	scheduler := &Scheduler{
@@ -130,14 +131,20 @@ func (s *Scheduler) runOnce() {
 		log.InfraLogger.Errorf("Error while opening session, will try again next cycle. \nCause: %+v", err)
 		return
 	}
-	defer framework.CloseSession(ssn)
+	defer func() {
+		if err := framework.CloseSession(ssn); err != nil {
+			log.InfraLogger.Errorf("Error while closing session, job statuses will be re-recorded next cycle. "+
+				"\nCause: %v", err)
+		}
+	}()
 
 	actions, _ := conf_util.GetActionsFromConfig(s.config)
-	for _, action := range actions {
+	for _, dispatch := range ssn.DispatchActions(actions) {
+		action := dispatch.Action
 		log.InfraLogger.SetAction(string(action.Name()))
 		metrics.SetCurrentAction(string(action.Name()))
 		actionStartTime := time.Now()
-		action.Execute(ssn)
+		dispatch.Execute(ssn)
 		metrics.UpdateActionDuration(string(action.Name()), metrics.Duration(actionStartTime))
 	}
 	log.InfraLogger.RemoveActionLogger()
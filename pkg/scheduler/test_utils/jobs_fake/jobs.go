@@ -48,6 +48,7 @@ type TestJobBasic struct {
 	Tasks                               []*tasks_fake.TestTaskBasic
 	SubGroups                           map[string]*subgroup_info.PodSet
 	StaleDuration                       *time.Duration
+	ElasticExtrasPreemptible            bool
 }
 
 func BuildJobsAndTasksMaps(Jobs []*TestJobBasic) (
@@ -86,6 +87,7 @@ func BuildJobsAndTasksMaps(Jobs []*TestJobBasic) (
 			jobName, job.Namespace, jobUID, jobAllocatedResource, job.SubGroups, taskInfos, job.Priority, queueUID,
 			jobCreationTime, *job.MinAvailable, job.StaleDuration, job.Topology,
 		)
+		jobInfo.ElasticExtrasPreemptible = job.ElasticExtrasPreemptible
 		jobsInfoMap[common_info.PodGroupID(job.Name)] = jobInfo
 	}
 
@@ -20,7 +20,9 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
@@ -82,6 +84,7 @@ type TestQueueBasic struct {
 	ParentQueue                 string
 	InteractiveTimeoutInMinutes int64
 	UseOnlyFreeCPUResources     bool
+	MaxRunningJobs              *int
 	V1                          bool
 }
 
@@ -91,6 +94,7 @@ type TestDepartmentBasic struct {
 	MaxAllowedGPUs   float64
 	MaxAllowedCPUs   *float64
 	MaxAllowedMemory *float64
+	MaxRunningJobs   *int
 }
 
 type TestSessionConfig struct {
@@ -331,6 +335,8 @@ func GetTestCacheMock(
 		cacheMock.KubeClient(), cacheMock.KubeInformerFactory(), cacheMock.SnapshotSharedLister(),
 	)
 	cacheMock.EXPECT().InternalK8sPlugins().AnyTimes().Return(k8sPlugins)
+	cacheMock.EXPECT().QueueLoans().AnyTimes().Return(queue_info.NewQueueLoans())
+	cacheMock.EXPECT().PipelineAges().AnyTimes().Return(pod_info.NewPipelineAges())
 
 	if cacheRequirements.NumberOfCacheEvictions != 0 {
 		cacheMock.EXPECT().Evict(Any(), Any(), Any(), Any()).
@@ -342,6 +348,9 @@ func GetTestCacheMock(
 			MaxTimes(cacheRequirements.NumberOfPipelineActions)
 	}
 
+	cacheMock.EXPECT().PatchTaskAnnotations(Any(), Any()).AnyTimes()
+	cacheMock.EXPECT().PatchNodeAnnotations(Any(), Any()).AnyTimes()
+
 	helpersMock := k8s_utils.NewMockInterface(controller)
 	k8s_utils.Helpers = helpersMock
 	helpersMock.EXPECT().PatchPodAnnotationsAndLabelsInterface(Any(), Any(), Any(), Any()).Return(nil).AnyTimes()
@@ -67,6 +67,9 @@ func CreateFakeSession(schedulerConfig *TestSessionConfig,
 	ssn.OverrideMaxNumberConsolidationPreemptees(-1)
 	ssn.OverrideAllowConsolidatingReclaim(true)
 	ssn.OverrideSchedulerName(schedulerName)
+	if testMetadata.Mocks != nil && testMetadata.Mocks.SchedulerConf != nil {
+		ssn.Config.QueueActionOrder = testMetadata.Mocks.SchedulerConf.QueueActionOrder
+	}
 
 	if controller != nil || createCacheMockIfNotExists {
 		ssn.Cache = GetTestCacheMock(controller, testMetadata.Mocks, getDRAObjects(testMetadata), clusterPodAffinityInfo)
@@ -94,9 +97,10 @@ func BuildQueueInfoMap(testMetadata TestTopologyBasic) map[common_info.QueueID]*
 				CreationTimestamp: metav1.Time{Time: time.Now().Add(time.Minute * time.Duration(queueIndex))},
 			},
 			Spec: enginev2.QueueSpec{
-				DisplayName: queue.Name,
-				ParentQueue: queue.ParentQueue,
-				Priority:    queue.Priority,
+				DisplayName:    queue.Name,
+				ParentQueue:    queue.ParentQueue,
+				Priority:       queue.Priority,
+				MaxRunningJobs: queue.MaxRunningJobs,
 				Resources: &enginev2.QueueResources{
 					GPU: enginev2.QueueResource{
 						Quota:           queue.DeservedGPUs,
@@ -172,6 +176,7 @@ func BuildDepartmentInfoMap(testMetadata TestTopologyBasic) map[common_info.Queu
 				CreationTimestamp: metav1.Time{Time: time.Now().Add(time.Minute * time.Duration(departmentIndex))},
 			},
 			Spec: enginev2.QueueSpec{
+				MaxRunningJobs: department.MaxRunningJobs,
 				Resources: &enginev2.QueueResources{
 					GPU: enginev2.QueueResource{
 						Quota:           department.DeservedGPUs,